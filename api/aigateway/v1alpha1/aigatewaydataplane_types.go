@@ -21,6 +21,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 )
 
 // AIGatewayDataPlane is the Schema for the AIGateway data planes API.
@@ -128,6 +130,21 @@ type DeploymentOptions struct {
 	//
 	// +optional
 	PodTemplateSpec *corev1.PodTemplateSpec `json:"podTemplateSpec,omitempty"`
+
+	// Hardened controls the security settings the operator applies to the
+	// AI Gateway Pods. With enabled, a hardened security context (non-root user,
+	// read-only root filesystem, dropped capabilities) and the related
+	// volumes are applied to the AI Gateway container. With baseline and
+	// restricted, the Pods comply with the Pod Security Standard of the same
+	// name and the PodSecurityCompliant condition reports the PodTemplateSpec
+	// patches violating it.
+	//
+	// Changing this on an existing AIGatewayDataPlane causes a rolling restart of
+	// its Pods.
+	//
+	// +optional
+	// +kubebuilder:default=enabled
+	Hardened commonv1alpha1.HardeningState `json:"hardened,omitempty"`
 }
 
 // Scaling defines the scaling options for the deployment.
@@ -1,14 +1,49 @@
 package v1alpha1
 
-// HardeningState controls whether the operator applies a hardened
-// security context to a DataPlane's proxy container.
+import "github.com/kong/kong-operator/v2/api/common/consts"
+
+// HardeningState controls the security settings the operator applies to a
+// DataPlane's Pods.
+//
+// The baseline and restricted levels map to the Pod Security Standards levels
+// of the same name: https://kubernetes.io/docs/concepts/security/pod-security-standards/
 //
-// +kubebuilder:validation:Enum=enabled;disabled
+// +kubebuilder:validation:Enum=enabled;disabled;baseline;restricted
 type HardeningState string
 
 const (
-	// HardeningStateEnabled enables the hardened security context.
+	// HardeningStateEnabled enables the hardened security context of the
+	// proxy container (non-root user, read-only root filesystem, dropped
+	// capabilities).
 	HardeningStateEnabled HardeningState = "enabled"
 	// HardeningStateDisabled disables the hardened security context (default).
 	HardeningStateDisabled HardeningState = "disabled"
+	// HardeningStateBaseline complies with the baseline Pod Security Standard:
+	// the containers run with the RuntimeDefault seccomp profile, without
+	// privilege escalation and with all capabilities but NET_BIND_SERVICE
+	// dropped, and the service account token is not mounted.
+	HardeningStateBaseline HardeningState = "baseline"
+	// HardeningStateRestricted complies with the restricted Pod Security Standard:
+	// on top of baseline, the containers run as a non-root user with a read-only
+	// root filesystem and writable emptyDir volumes for the Kong prefix and /tmp.
+	HardeningStateRestricted HardeningState = "restricted"
+)
+
+// -----------------------------------------------------------------------------
+// Hardening - PodSecurityCompliant Condition Constants
+// -----------------------------------------------------------------------------
+
+const (
+	// PodSecurityCompliantType indicates whether the PodTemplateSpec patches
+	// provided by the user comply with the Pod Security Standard of the
+	// hardening level. It's only set for the baseline and restricted levels
+	// and it doesn't affect the readiness of the resource.
+	PodSecurityCompliantType consts.ConditionType = "PodSecurityCompliant"
+
+	// PodSecurityCompliantReason indicates the PodTemplateSpec patches comply
+	// with the Pod Security Standard.
+	PodSecurityCompliantReason consts.ConditionReason = "Compliant"
+	// PodSecurityViolationReason indicates the PodTemplateSpec patches violate
+	// the Pod Security Standard.
+	PodSecurityViolationReason consts.ConditionReason = "PodSecurityViolation"
 )
@@ -0,0 +1,35 @@
+package v1alpha1
+
+// DataPlaneTopology defines how a DataPlane is spread across availability zones.
+type DataPlaneTopology struct {
+	// Zones lists the availability zones the DataPlane runs in. A Deployment is
+	// created for every zone, with its Pods scheduled on the nodes labelled with
+	// the zone's name in the topology.kubernetes.io/zone label.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Zones []DataPlaneTopologyZone `json:"zones"`
+}
+
+// DataPlaneTopologyZone defines an availability zone a DataPlane runs in.
+type DataPlaneTopologyZone struct {
+	// Name is the name of the zone, as set in the topology.kubernetes.io/zone
+	// label of the zone's nodes.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	Name string `json:"name"`
+
+	// Replicas is the number of replicas of the zone's Deployment.
+	// It defaults to 1 and cannot be set when scaling is, in which case
+	// every zone is autoscaled on its own.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+}
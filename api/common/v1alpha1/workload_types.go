@@ -0,0 +1,29 @@
+package v1alpha1
+
+// WorkloadType is the type of the Kubernetes workload running a DataPlane's Pods.
+//
+// +kubebuilder:validation:Enum=Deployment;DaemonSet
+type WorkloadType string
+
+const (
+	// WorkloadTypeDeployment runs the DataPlane's Pods using a Deployment (default).
+	WorkloadTypeDeployment WorkloadType = "Deployment"
+	// WorkloadTypeDaemonSet runs one DataPlane Pod on every eligible node using a DaemonSet.
+	WorkloadTypeDaemonSet WorkloadType = "DaemonSet"
+)
+
+// HostBinding controls how a DataPlane's proxy ports are bound on the nodes
+// running its Pods.
+//
+// +kubebuilder:validation:Enum=None;HostNetwork;HostPort
+type HostBinding string
+
+const (
+	// HostBindingNone does not bind any port on the nodes (default).
+	HostBindingNone HostBinding = "None"
+	// HostBindingHostNetwork runs the DataPlane's Pods in the node's network namespace.
+	HostBindingHostNetwork HostBinding = "HostNetwork"
+	// HostBindingHostPort binds the DataPlane's ingress Service ports on the nodes
+	// as host ports forwarded to the matching proxy ports.
+	HostBindingHostPort HostBinding = "HostPort"
+)
@@ -77,6 +77,48 @@ func (in *ControlPlaneRef) DeepCopy() *ControlPlaneRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneTopology) DeepCopyInto(out *DataPlaneTopology) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]DataPlaneTopologyZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneTopology.
+func (in *DataPlaneTopology) DeepCopy() *DataPlaneTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneTopologyZone) DeepCopyInto(out *DataPlaneTopologyZone) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneTopologyZone.
+func (in *DataPlaneTopologyZone) DeepCopy() *DataPlaneTopologyZone {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneTopologyZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionRef) DeepCopyInto(out *ExtensionRef) {
 	*out = *in
@@ -12,3 +12,13 @@ const (
 	// is invalid or missing for a cross-namespace reference.
 	KongReferenceGrantReasonRefNotPermitted = "RefNotPermitted"
 )
+
+const (
+	// KongCredentialAPIKeyGeneratorReasonPending is the reason used with the Programmed
+	// condition when the current generated credential is not yet Programmed in Konnect.
+	KongCredentialAPIKeyGeneratorReasonPending = "Pending"
+	// KongCredentialAPIKeyGeneratorReasonSecretConflict is the reason used with the
+	// Programmed condition when the Secret the key should be stored in already exists
+	// and is not owned by the KongCredentialAPIKeyGenerator.
+	KongCredentialAPIKeyGeneratorReasonSecretConflict = "SecretConflict"
+)
@@ -58,12 +58,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KongCredentialACLList{},
 		&KongCredentialAPIKey{},
 		&KongCredentialAPIKeyList{},
+		&KongCredentialAPIKeyGenerator{},
+		&KongCredentialAPIKeyGeneratorList{},
 		&KongCredentialBasicAuth{},
 		&KongCredentialBasicAuthList{},
 		&KongCredentialHMAC{},
 		&KongCredentialHMACList{},
 		&KongCredentialJWT{},
 		&KongCredentialJWTList{},
+		&KongCredentialMTLS{},
+		&KongCredentialMTLSList{},
+		&KongCredentialOAuth2{},
+		&KongCredentialOAuth2List{},
 		&KongCustomEntity{},
 		&KongCustomEntityList{},
 		&KongDataPlaneClientCertificate{},
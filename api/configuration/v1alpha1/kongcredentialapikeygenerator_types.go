@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+)
+
+// KongCredentialAPIKeyGenerator generates API key credentials for a consumer.
+// The operator creates a cryptographically random key, stores it in a Secret
+// owned by this resource and manages the KongCredentialAPIKey which syncs it to Konnect.
+// When rotation is configured, a new key is generated periodically and the
+// previous credential is kept for the overlap window so that both keys are valid
+// while clients switch over.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Secret",description="The Secret holding the current key",type=string,JSONPath=`.status.secretName`
+// +kubebuilder:printcolumn:name="Credential",description="The KongCredentialAPIKey holding the current key",type=string,JSONPath=`.status.currentCredential`
+// +kubebuilder:printcolumn:name="Programmed",description="The current credential is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kong:channels=kong-operator
+type KongCredentialAPIKeyGenerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the API key generator specification.
+	Spec KongCredentialAPIKeyGeneratorSpec `json:"spec"`
+
+	// Status contains the API key generator status.
+	//
+	// +kubebuilder:default={conditions: {{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status KongCredentialAPIKeyGeneratorStatus `json:"status,omitempty"`
+}
+
+// KongCredentialAPIKeyGeneratorSpec defines specification of a Kong API key generator.
+//
+// +kubebuilder:validation:XValidation:rule="oldSelf.consumerRef == self.consumerRef",message="spec.consumerRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(oldSelf.secretName) && has(self.secretName) && oldSelf.secretName == self.secretName) || (!has(oldSelf.secretName) && !has(self.secretName))",message="spec.secretName is immutable"
+type KongCredentialAPIKeyGeneratorSpec struct {
+	// ConsumerRef is a reference to a Consumer the generated API keys are associated with.
+	//
+	// +required
+	ConsumerRef corev1.LocalObjectReference `json:"consumerRef"`
+
+	// SecretName is the name of the Secret the current key is stored in under the "key" entry.
+	// The Secret is created and owned by the operator.
+	// When not set, the name of the KongCredentialAPIKeyGenerator is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName,omitempty"`
+
+	// KeyLength is the number of random bytes used to generate a key.
+	// The key is stored base64 (URL) encoded.
+	//
+	// +optional
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=128
+	KeyLength int32 `json:"keyLength,omitempty"`
+
+	// Rotation configures scheduled rotation of the generated key.
+	// When not set, the key is generated once and never rotated.
+	//
+	// +optional
+	Rotation *KongCredentialAPIKeyRotation `json:"rotation,omitempty"`
+
+	// Tags is a list of tags applied to the generated API key credentials.
+	Tags commonv1alpha1.Tags `json:"tags,omitempty"`
+}
+
+// DefaultKongCredentialAPIKeyRotationOverlap is the default overlap window
+// during which both the previous and the current key are valid.
+const DefaultKongCredentialAPIKeyRotationOverlap = time.Hour
+
+// KongCredentialAPIKeyRotation configures scheduled rotation of a generated API key.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.overlap) || duration(self.overlap) < duration(self.interval)",message="overlap must be shorter than interval"
+type KongCredentialAPIKeyRotation struct {
+	// Interval is the time between key rotations. It has to be at least 1m.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="interval must be at least 1m"
+	Interval metav1.Duration `json:"interval"`
+
+	// Overlap is the time the previous key stays valid after a rotation.
+	// It has to be shorter than the interval. Defaults to 1h.
+	//
+	// +optional
+	Overlap *metav1.Duration `json:"overlap,omitempty"`
+}
+
+// GetOverlap returns the overlap window, falling back to the default when unset.
+func (r *KongCredentialAPIKeyRotation) GetOverlap() time.Duration {
+	if r == nil || r.Overlap == nil {
+		return DefaultKongCredentialAPIKeyRotationOverlap
+	}
+	return r.Overlap.Duration
+}
+
+// KongCredentialAPIKeyGeneratorStatus represents the current status of the API key generator.
+type KongCredentialAPIKeyGeneratorStatus struct {
+	// SecretName is the name of the Secret holding the current key.
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// CurrentCredential is the name of the KongCredentialAPIKey holding the current key.
+	//
+	// +optional
+	CurrentCredential string `json:"currentCredential,omitempty"`
+
+	// PreviousCredential is the KongCredentialAPIKey holding the previous key
+	// while it is still within the rotation overlap window.
+	//
+	// +optional
+	PreviousCredential *KongCredentialAPIKeyGeneratorPreviousCredential `json:"previousCredential,omitempty"`
+
+	// LastRotationTime is the time the current key was generated.
+	//
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// NextRotationTime is the time the key is going to be rotated.
+	// It is only set when rotation is configured.
+	//
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// Conditions describe the status of the generator.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// KongCredentialAPIKeyGeneratorPreviousCredential describes the credential
+// holding the previous key during the rotation overlap window.
+type KongCredentialAPIKeyGeneratorPreviousCredential struct {
+	// Name is the name of the KongCredentialAPIKey.
+	//
+	// +required
+	Name string `json:"name"`
+
+	// ExpirationTime is the time after which the credential is deleted.
+	//
+	// +required
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// KongCredentialAPIKeyGeneratorList contains a list of API key generators.
+// +kubebuilder:object:root=true
+type KongCredentialAPIKeyGeneratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KongCredentialAPIKeyGenerator `json:"items"`
+}
+
+// GetSecretName returns the name of the Secret the generated key is stored in.
+func (g *KongCredentialAPIKeyGenerator) GetSecretName() string {
+	if g.Spec.SecretName != "" {
+		return g.Spec.SecretName
+	}
+	return g.Name
+}
+
+// GetConditions returns the Status Conditions.
+func (g *KongCredentialAPIKeyGenerator) GetConditions() []metav1.Condition {
+	return g.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (g *KongCredentialAPIKeyGenerator) SetConditions(conditions []metav1.Condition) {
+	g.Status.Conditions = conditions
+}
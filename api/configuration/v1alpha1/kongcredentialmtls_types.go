@@ -0,0 +1,105 @@
+/*
+Copyright 2026 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+)
+
+// KongCredentialMTLS is the schema for mTLS credentials API which defines an mTLS (mtls-auth) credential for consumers.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=kongcredentialmtlses
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Subject",description="The subject name of the client certificate",type=string,JSONPath=`.spec.subject_name`
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="(!self.status.conditions.exists(c, c.type == 'Programmed' && c.status == 'True')) ? true : oldSelf.spec.consumerRef == self.spec.consumerRef",message="spec.consumerRef is immutable when an entity is already Programmed"
+// +kong:channels=kong-operator
+type KongCredentialMTLS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the mTLS credential specification.
+	Spec KongCredentialMTLSSpec `json:"spec"`
+
+	// Status contains the mTLS credential status.
+	//
+	// +kubebuilder:default={conditions: {{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status KongCredentialMTLSStatus `json:"status,omitempty"`
+}
+
+// KongCredentialMTLSSpec defines specification of a Kong mTLS credential.
+// +kubebuilder:validation:XValidation:rule="(has(oldSelf.adopt) && has(self.adopt)) || (!has(oldSelf.adopt) && !has(self.adopt))", message="Cannot set or unset spec.adopt in updates"
+type KongCredentialMTLSSpec struct {
+	KongCredentialMTLSAPISpec `json:",inline"`
+
+	// ConsumerRef is a reference to a Consumer this KongCredentialMTLS is associated with.
+	//
+	// +required
+	ConsumerRef corev1.LocalObjectReference `json:"consumerRef"`
+
+	// Adopt is the options for adopting an mTLS credential from an existing mTLS credential in Konnect.
+	// +optional
+	Adopt *commonv1alpha1.AdoptOptions `json:"adopt,omitempty"`
+}
+
+// KongCredentialMTLSAPISpec defines specification of an mTLS credential.
+type KongCredentialMTLSAPISpec struct {
+	// SubjectName is the Subject Alternative Name (SAN) or Common Name (CN)
+	// of the client certificate that should be mapped to the consumer.
+	// The CA certificates used to verify client certificates are configured
+	// on the mtls-auth plugin.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	SubjectName string `json:"subject_name"`
+	// Tags is a list of tags for the mTLS credential.
+	Tags commonv1alpha1.Tags `json:"tags,omitempty"`
+}
+
+// KongCredentialMTLSStatus represents the current status of the mTLS credential resource.
+type KongCredentialMTLSStatus struct {
+	// Konnect contains the Konnect entity status.
+	// +optional
+	Konnect *konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs `json:"konnect,omitempty"`
+
+	// Conditions describe the status of the Konnect entity.
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// KongCredentialMTLSList contains a list of mTLS credentials.
+// +kubebuilder:object:root=true
+type KongCredentialMTLSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KongCredentialMTLS `json:"items"`
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+)
+
+// KongCredentialOAuth2 is the schema for OAuth2 credentials API which defines an OAuth2 application credential for consumers.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="(!self.status.conditions.exists(c, c.type == 'Programmed' && c.status == 'True')) ? true : oldSelf.spec.consumerRef == self.spec.consumerRef",message="spec.consumerRef is immutable when an entity is already Programmed"
+// +kong:channels=kong-operator
+type KongCredentialOAuth2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the OAuth2 credential specification.
+	Spec KongCredentialOAuth2Spec `json:"spec"`
+
+	// Status contains the OAuth2 credential status.
+	//
+	// +kubebuilder:default={conditions: {{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status KongCredentialOAuth2Status `json:"status,omitempty"`
+}
+
+// KongCredentialOAuth2Spec defines specification of a Kong OAuth2 credential.
+// +kubebuilder:validation:XValidation:rule="(has(oldSelf.adopt) && has(self.adopt)) || (!has(oldSelf.adopt) && !has(self.adopt))", message="Cannot set or unset spec.adopt in updates"
+type KongCredentialOAuth2Spec struct {
+	KongCredentialOAuth2APISpec `json:",inline"`
+
+	// ConsumerRef is a reference to a Consumer this KongCredentialOAuth2 is associated with.
+	//
+	// +required
+	ConsumerRef corev1.LocalObjectReference `json:"consumerRef"`
+
+	// Adopt is the options for adopting an OAuth2 credential from an existing OAuth2 credential in Konnect.
+	// +optional
+	Adopt *commonv1alpha1.AdoptOptions `json:"adopt,omitempty"`
+}
+
+// KongCredentialOAuth2ClientType is the type of an OAuth2 application.
+type KongCredentialOAuth2ClientType string
+
+const (
+	// KongCredentialOAuth2ClientTypeConfidential is the type of an OAuth2 application
+	// which is able to keep its client secret confidential.
+	KongCredentialOAuth2ClientTypeConfidential KongCredentialOAuth2ClientType = "confidential"
+	// KongCredentialOAuth2ClientTypePublic is the type of an OAuth2 application
+	// which is not able to keep its client secret confidential.
+	KongCredentialOAuth2ClientTypePublic KongCredentialOAuth2ClientType = "public"
+)
+
+// KongCredentialOAuth2APISpec defines specification of an OAuth2 credential.
+type KongCredentialOAuth2APISpec struct {
+	// Name is the name of the OAuth2 application.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// ClientID is the client ID of the OAuth2 application.
+	// It is required as the ID generated by Kong would change on every update.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"client_id"`
+	// ClientSecret is the client secret of the OAuth2 application.
+	// It can be provided inline or sourced from a Kubernetes Secret.
+	// It is required as the secret generated by Kong would change on every update.
+	//
+	// +required
+	ClientSecret *SensitiveDataSource `json:"client_secret,omitempty"`
+	// ClientType is the type of the OAuth2 application.
+	//
+	// +optional
+	// +kubebuilder:default=confidential
+	// +kubebuilder:validation:Enum=confidential;public
+	ClientType KongCredentialOAuth2ClientType `json:"client_type,omitempty"`
+	// HashSecret indicates whether the client secret should be stored hashed.
+	//
+	// +optional
+	HashSecret *bool `json:"hash_secret,omitempty"`
+	// RedirectURIs is a list of URLs the OAuth2 application can redirect to.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	RedirectURIs []string `json:"redirect_uris,omitempty"`
+	// Tags is a list of tags for the OAuth2 credential.
+	Tags commonv1alpha1.Tags `json:"tags,omitempty"`
+}
+
+// KongCredentialOAuth2Status represents the current status of the OAuth2 credential resource.
+type KongCredentialOAuth2Status struct {
+	// Konnect contains the Konnect entity status.
+	// +optional
+	Konnect *konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs `json:"konnect,omitempty"`
+
+	// Conditions describe the status of the Konnect entity.
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// KongCredentialOAuth2List contains a list of OAuth2 credentials.
+// +kubebuilder:object:root=true
+type KongCredentialOAuth2List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KongCredentialOAuth2 `json:"items"`
+}
+
+// GetSensitiveDataSecretRefs returns all Secret references used to populate
+// sensitive fields of the OAuth2 credential.
+func (obj *KongCredentialOAuth2) GetSensitiveDataSecretRefs() []SensitiveDataSecretRef {
+	if obj == nil {
+		return nil
+	}
+	src := obj.Spec.ClientSecret
+	if src == nil || src.Type != SensitiveDataSourceTypeSecretRef || src.SecretRef == nil {
+		return nil
+	}
+	return []SensitiveDataSecretRef{*src.SecretRef}
+}
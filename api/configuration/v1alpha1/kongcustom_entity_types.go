@@ -3,6 +3,9 @@ package v1alpha1
 import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
 )
 
 const (
@@ -25,6 +28,8 @@ type KongEntityScope string
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
 // +kubebuilder:printcolumn:name="Programmed",type=string,JSONPath=`.status.conditions[?(@.type=="Programmed")].status`
 // +kubebuilder:validation:XValidation:rule="self.spec.type == oldSelf.spec.type",message="The spec.type field is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec.controlPlaneRef) || has(self.spec.controlPlaneRef)", message="controlPlaneRef is required once set"
+// +kubebuilder:validation:XValidation:rule="(!has(self.spec.controlPlaneRef) || !has(self.status) || !self.status.conditions.exists(c, c.type == 'Programmed' && c.status == 'True')) ? true : (has(oldSelf.spec.controlPlaneRef) && oldSelf.spec.controlPlaneRef == self.spec.controlPlaneRef)", message="spec.controlPlaneRef is immutable when an entity is already Programmed"
 // +kong:channels=kong-operator
 type KongCustomEntity struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -38,18 +43,40 @@ type KongCustomEntity struct {
 
 // KongCustomEntitySpec defines the specification of the KongCustomEntity.
 // +kubebuilder:validation:XValidation:rule="!(self.type in ['services','routes','upstreams','targets','plugins','consumers','consumer_groups'])",message="The type field cannot be one of the known Kong entity types"
+// +kubebuilder:validation:XValidation:rule="has(self.controllerName) || (has(self.controlPlaneRef) && self.controlPlaneRef.type == 'konnectNamespacedRef')",message="controllerName is required unless controlPlaneRef is konnectNamespacedRef"
+// +kubebuilder:validation:XValidation:rule="!has(self.controlPlaneRef) || self.controlPlaneRef.type == 'kic' || !has(self.parentRef)",message="parentRef can't be used with a Konnect controlPlaneRef, use serviceRef or routeRef instead"
+// +kubebuilder:validation:XValidation:rule="(!has(self.serviceRef) && !has(self.routeRef)) || (has(self.controlPlaneRef) && self.controlPlaneRef.type == 'konnectNamespacedRef')",message="serviceRef and routeRef can be used only when controlPlaneRef is konnectNamespacedRef"
 type KongCustomEntitySpec struct {
 	// EntityType is the type of the Kong entity. The type is used in generating declarative configuration.
 	EntityType string `json:"type"`
 	// Fields defines the fields of the Kong entity itself.
 	Fields apiextensionsv1.JSON `json:"fields"`
 	// ControllerName specifies the controller that should reconcile it, like ingress class.
-	ControllerName string `json:"controllerName"`
+	// It is required unless the entity is managed in a Konnect ControlPlane.
+	// +optional
+	ControllerName string `json:"controllerName,omitempty"`
 
 	// ParentRef references the kubernetes resource it attached to when its scope is "attached".
 	// Currently only KongPlugin/KongClusterPlugin allowed. This will make the custom entity to be attached
 	// to the entity(service/route/consumer) where the plugin is attached.
 	ParentRef *ObjectReference `json:"parentRef,omitempty"`
+
+	// ControlPlaneRef is a reference to a ControlPlane this KongCustomEntity is associated with.
+	// When it refers to a Konnect ControlPlane, the entity is synced to Konnect instead of
+	// being translated by the ingress controller.
+	// +kubebuilder:validation:XValidation:message="'konnectID' type is not supported", rule="self.type != 'konnectID'"
+	// +optional
+	ControlPlaneRef *commonv1alpha1.ControlPlaneRef `json:"controlPlaneRef,omitempty"`
+
+	// ServiceRef is a reference to a KongService in the same namespace the entity is attached to.
+	// The Konnect ID of the KongService is set in the "service" foreign field of the entity.
+	// +optional
+	ServiceRef *TargetRef `json:"serviceRef,omitempty"`
+
+	// RouteRef is a reference to a KongRoute in the same namespace the entity is attached to.
+	// The Konnect ID of the KongRoute is set in the "route" foreign field of the entity.
+	// +optional
+	RouteRef *TargetRef `json:"routeRef,omitempty"`
 }
 
 // ObjectReference defines reference of a kubernetes object.
@@ -67,6 +94,10 @@ type ObjectReference struct {
 
 // KongCustomEntityStatus defines the status of the KongCustomEntity.
 type KongCustomEntityStatus struct {
+	// Konnect contains the Konnect entity status.
+	// +optional
+	Konnect *konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef `json:"konnect,omitempty"`
+
 	// Conditions describe the current conditions of the KongCustomEntityStatus.
 	//
 	// Known condition types are:
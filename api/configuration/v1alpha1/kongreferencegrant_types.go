@@ -116,7 +116,7 @@ type ReferenceGrantFrom struct {
 // ReferenceGrantTo describes what Kinds are allowed as targets of the
 // references.
 //
-// +kubebuilder:validation:XValidation:rule=".self.group != 'core' || .self.kind == 'Secret'",message="Only 'Secret' kind is supported for 'core' group"
+// +kubebuilder:validation:XValidation:rule=".self.group != 'core' || .self.kind in ['Secret', 'ConfigMap']",message="Only 'Secret' and 'ConfigMap' kinds are supported for 'core' group"
 // +kubebuilder:validation:XValidation:rule=".self.group != 'konnect.konghq.com' || .self.kind in ['KonnectGatewayControlPlane', 'KonnectAPIAuthConfiguration', 'Portal', 'KonnectEventGateway', 'EventGatewayBackendCluster', 'EventGatewayListener', 'EventGatewayVirtualCluster', 'KonnectConfigStore', 'KonnectAIGateway', 'AIGatewayConsumerGroup']",message="Only 'KonnectGatewayControlPlane', 'KonnectAPIAuthConfiguration', 'Portal', 'KonnectEventGateway', 'EventGatewayBackendCluster', 'EventGatewayListener', 'EventGatewayVirtualCluster', 'KonnectConfigStore', 'KonnectAIGateway', and 'AIGatewayConsumerGroup' kinds are supported for 'konnect.konghq.com' group"
 // +kubebuilder:validation:XValidation:rule=".self.group != 'configuration.konghq.com' || .self.kind in ['KongPlugin', 'KongService', 'KongCertificate', 'KongCACertificate', 'KongUpstream']",message="Only 'KongPlugin', 'KongService', 'KongCertificate', 'KongCACertificate' and 'KongUpstream' kinds are supported for 'configuration.konghq.com' group"
 type ReferenceGrantTo struct {
@@ -3673,6 +3673,142 @@ func (in *KongCredentialAPIKeyAPISpec) DeepCopy() *KongCredentialAPIKeyAPISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyGenerator) DeepCopyInto(out *KongCredentialAPIKeyGenerator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyGenerator.
+func (in *KongCredentialAPIKeyGenerator) DeepCopy() *KongCredentialAPIKeyGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialAPIKeyGenerator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyGeneratorList) DeepCopyInto(out *KongCredentialAPIKeyGeneratorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongCredentialAPIKeyGenerator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyGeneratorList.
+func (in *KongCredentialAPIKeyGeneratorList) DeepCopy() *KongCredentialAPIKeyGeneratorList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyGeneratorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialAPIKeyGeneratorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyGeneratorPreviousCredential) DeepCopyInto(out *KongCredentialAPIKeyGeneratorPreviousCredential) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyGeneratorPreviousCredential.
+func (in *KongCredentialAPIKeyGeneratorPreviousCredential) DeepCopy() *KongCredentialAPIKeyGeneratorPreviousCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyGeneratorPreviousCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyGeneratorSpec) DeepCopyInto(out *KongCredentialAPIKeyGeneratorSpec) {
+	*out = *in
+	out.ConsumerRef = in.ConsumerRef
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(KongCredentialAPIKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(commonv1alpha1.Tags, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyGeneratorSpec.
+func (in *KongCredentialAPIKeyGeneratorSpec) DeepCopy() *KongCredentialAPIKeyGeneratorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyGeneratorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyGeneratorStatus) DeepCopyInto(out *KongCredentialAPIKeyGeneratorStatus) {
+	*out = *in
+	if in.PreviousCredential != nil {
+		in, out := &in.PreviousCredential, &out.PreviousCredential
+		*out = new(KongCredentialAPIKeyGeneratorPreviousCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyGeneratorStatus.
+func (in *KongCredentialAPIKeyGeneratorStatus) DeepCopy() *KongCredentialAPIKeyGeneratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyGeneratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongCredentialAPIKeyList) DeepCopyInto(out *KongCredentialAPIKeyList) {
 	*out = *in
@@ -3705,6 +3841,27 @@ func (in *KongCredentialAPIKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialAPIKeyRotation) DeepCopyInto(out *KongCredentialAPIKeyRotation) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialAPIKeyRotation.
+func (in *KongCredentialAPIKeyRotation) DeepCopy() *KongCredentialAPIKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialAPIKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongCredentialAPIKeySpec) DeepCopyInto(out *KongCredentialAPIKeySpec) {
 	*out = *in
@@ -4173,6 +4330,277 @@ func (in *KongCredentialJWTStatus) DeepCopy() *KongCredentialJWTStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialMTLS) DeepCopyInto(out *KongCredentialMTLS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialMTLS.
+func (in *KongCredentialMTLS) DeepCopy() *KongCredentialMTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialMTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialMTLS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialMTLSAPISpec) DeepCopyInto(out *KongCredentialMTLSAPISpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(commonv1alpha1.Tags, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialMTLSAPISpec.
+func (in *KongCredentialMTLSAPISpec) DeepCopy() *KongCredentialMTLSAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialMTLSAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialMTLSList) DeepCopyInto(out *KongCredentialMTLSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongCredentialMTLS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialMTLSList.
+func (in *KongCredentialMTLSList) DeepCopy() *KongCredentialMTLSList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialMTLSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialMTLSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialMTLSSpec) DeepCopyInto(out *KongCredentialMTLSSpec) {
+	*out = *in
+	in.KongCredentialMTLSAPISpec.DeepCopyInto(&out.KongCredentialMTLSAPISpec)
+	out.ConsumerRef = in.ConsumerRef
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(commonv1alpha1.AdoptOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialMTLSSpec.
+func (in *KongCredentialMTLSSpec) DeepCopy() *KongCredentialMTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialMTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialMTLSStatus) DeepCopyInto(out *KongCredentialMTLSStatus) {
+	*out = *in
+	if in.Konnect != nil {
+		in, out := &in.Konnect, &out.Konnect
+		*out = new(v1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialMTLSStatus.
+func (in *KongCredentialMTLSStatus) DeepCopy() *KongCredentialMTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialMTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialOAuth2) DeepCopyInto(out *KongCredentialOAuth2) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialOAuth2.
+func (in *KongCredentialOAuth2) DeepCopy() *KongCredentialOAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialOAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialOAuth2) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialOAuth2APISpec) DeepCopyInto(out *KongCredentialOAuth2APISpec) {
+	*out = *in
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(SensitiveDataSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HashSecret != nil {
+		in, out := &in.HashSecret, &out.HashSecret
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RedirectURIs != nil {
+		in, out := &in.RedirectURIs, &out.RedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(commonv1alpha1.Tags, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialOAuth2APISpec.
+func (in *KongCredentialOAuth2APISpec) DeepCopy() *KongCredentialOAuth2APISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialOAuth2APISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialOAuth2List) DeepCopyInto(out *KongCredentialOAuth2List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongCredentialOAuth2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialOAuth2List.
+func (in *KongCredentialOAuth2List) DeepCopy() *KongCredentialOAuth2List {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialOAuth2List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongCredentialOAuth2List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialOAuth2Spec) DeepCopyInto(out *KongCredentialOAuth2Spec) {
+	*out = *in
+	in.KongCredentialOAuth2APISpec.DeepCopyInto(&out.KongCredentialOAuth2APISpec)
+	out.ConsumerRef = in.ConsumerRef
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(commonv1alpha1.AdoptOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialOAuth2Spec.
+func (in *KongCredentialOAuth2Spec) DeepCopy() *KongCredentialOAuth2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialOAuth2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongCredentialOAuth2Status) DeepCopyInto(out *KongCredentialOAuth2Status) {
+	*out = *in
+	if in.Konnect != nil {
+		in, out := &in.Konnect, &out.Konnect
+		*out = new(v1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCredentialOAuth2Status.
+func (in *KongCredentialOAuth2Status) DeepCopy() *KongCredentialOAuth2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(KongCredentialOAuth2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongCustomEntity) DeepCopyInto(out *KongCustomEntity) {
 	*out = *in
@@ -4241,6 +4669,21 @@ func (in *KongCustomEntitySpec) DeepCopyInto(out *KongCustomEntitySpec) {
 		*out = new(ObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneRef != nil {
+		in, out := &in.ControlPlaneRef, &out.ControlPlaneRef
+		*out = new(commonv1alpha1.ControlPlaneRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(TargetRef)
+		**out = **in
+	}
+	if in.RouteRef != nil {
+		in, out := &in.RouteRef, &out.RouteRef
+		*out = new(TargetRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongCustomEntitySpec.
@@ -4256,6 +4699,11 @@ func (in *KongCustomEntitySpec) DeepCopy() *KongCustomEntitySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongCustomEntityStatus) DeepCopyInto(out *KongCustomEntityStatus) {
 	*out = *in
+	if in.Konnect != nil {
+		in, out := &in.Konnect, &out.Konnect
+		*out = new(v1alpha2.KonnectEntityStatusWithControlPlaneRef)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
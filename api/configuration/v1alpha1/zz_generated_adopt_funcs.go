@@ -76,6 +76,26 @@ func (obj *KongCredentialHMAC) SetAdoptOptions(opts *commonv1alpha1.AdoptOptions
 	obj.Spec.Adopt = opts
 }
 
+// GetAdoptOptions gets the options to adopt the resource from an existing resource.
+func (obj *KongCredentialOAuth2) GetAdoptOptions() *commonv1alpha1.AdoptOptions {
+	return obj.Spec.Adopt
+}
+
+// SetAdoptOptions sets the options to adopt the resource from an existing resource.
+func (obj *KongCredentialOAuth2) SetAdoptOptions(opts *commonv1alpha1.AdoptOptions) {
+	obj.Spec.Adopt = opts
+}
+
+// GetAdoptOptions gets the options to adopt the resource from an existing resource.
+func (obj *KongCredentialMTLS) GetAdoptOptions() *commonv1alpha1.AdoptOptions {
+	return obj.Spec.Adopt
+}
+
+// SetAdoptOptions sets the options to adopt the resource from an existing resource.
+func (obj *KongCredentialMTLS) SetAdoptOptions(opts *commonv1alpha1.AdoptOptions) {
+	obj.Spec.Adopt = opts
+}
+
 // GetAdoptOptions gets the options to adopt the resource from an existing resource.
 func (obj *KongCACertificate) GetAdoptOptions() *commonv1alpha1.AdoptOptions {
 	return obj.Spec.Adopt
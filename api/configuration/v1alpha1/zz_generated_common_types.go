@@ -49,7 +49,9 @@ type ConfigMapKeyRef struct {
 	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key,omitzero"`
 
-	// Namespace is the namespace of the ConfigMap
+	// Namespace is the namespace of the ConfigMap. It defaults to the namespace
+	// of the referrer. Other namespaces must be permitted by a KongReferenceGrant
+	// in that namespace.
 	//
 	// +optional
 	// +kubebuilder:validation:MaxLength=63
@@ -175,9 +177,9 @@ func nestedSDKUnionMemberForKey(object map[string]any, key string) (string, any,
 }
 
 // flattenSensitiveData recursively replaces any SensitiveDataSource (or
-// dedicated per-field DataSource) JSON object shape
-// {"type": "inline|secretRef", "value": X, ...} with the bare value X,
-// translating the CRD wire format to the Konnect SDK wire format which
+// dedicated per-field DataSource, or ConfigMapDataSource) JSON object shape
+// {"type": "inline|secretRef|configMapRef", "value": X, ...} with the bare
+// value X, translating the CRD wire format to the Konnect SDK wire format which
 // expects plain values (of whatever type X is) for sensitive fields.
 func flattenSensitiveData(v any) any {
 	switch x := v.(type) {
@@ -186,7 +188,7 @@ func flattenSensitiveData(v any) any {
 			x[k] = flattenSensitiveData(val)
 		}
 		typ, _ := x["type"].(string)
-		if typ != "inline" && typ != "secretRef" {
+		if typ != "inline" && typ != "secretRef" && typ != "configMapRef" {
 			return x
 		}
 		if rawVal, hasVal := x["value"]; hasVal {
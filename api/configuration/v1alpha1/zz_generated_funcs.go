@@ -532,6 +532,156 @@ func (obj *KongCredentialHMAC) GetConsumerRefName() string {
 	return obj.Spec.ConsumerRef.Name
 }
 
+func (obj *KongCredentialOAuth2) initKonnectStatus() {
+	obj.Status.Konnect = &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs{}
+}
+
+// GetKonnectStatus returns the Konnect status contained in the KongCredentialOAuth2 status.
+func (obj *KongCredentialOAuth2) GetKonnectStatus() *konnectv1alpha2.KonnectEntityStatus {
+	if obj.Status.Konnect == nil {
+		return nil
+	}
+	return &obj.Status.Konnect.KonnectEntityStatus
+}
+
+// GetKonnectID returns the Konnect ID in the KongCredentialOAuth2 status.
+func (obj *KongCredentialOAuth2) GetKonnectID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ID
+}
+
+// SetKonnectID sets the Konnect ID in the KongCredentialOAuth2 status.
+func (obj *KongCredentialOAuth2) SetKonnectID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ID = id
+}
+
+// PersistsKonnectID reports whether the KongCredentialOAuth2 persists a Konnect ID in status.
+func (*KongCredentialOAuth2) PersistsKonnectID() bool {
+	return true
+}
+
+// GetControlPlaneID returns the ControlPlane ID in the KongCredentialOAuth2 status.
+func (obj *KongCredentialOAuth2) GetControlPlaneID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ControlPlaneID
+}
+
+// SetControlPlaneID sets the ControlPlane ID in the KongCredentialOAuth2 status.
+func (obj *KongCredentialOAuth2) SetControlPlaneID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ControlPlaneID = id
+}
+
+// GetTypeName returns the KongCredentialOAuth2 Kind name.
+func (obj KongCredentialOAuth2) GetTypeName() string {
+	return "KongCredentialOAuth2"
+}
+
+// GetConditions returns the Status Conditions.
+func (obj *KongCredentialOAuth2) GetConditions() []metav1.Condition {
+	return obj.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (obj *KongCredentialOAuth2) SetConditions(conditions []metav1.Condition) {
+	obj.Status.Conditions = conditions
+}
+
+func (obj *KongCredentialOAuth2) SetKonnectConsumerIDInStatus(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ConsumerID = id
+}
+
+func (obj *KongCredentialOAuth2) GetConsumerRefName() string {
+	return obj.Spec.ConsumerRef.Name
+}
+
+func (obj *KongCredentialMTLS) initKonnectStatus() {
+	obj.Status.Konnect = &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs{}
+}
+
+// GetKonnectStatus returns the Konnect status contained in the KongCredentialMTLS status.
+func (obj *KongCredentialMTLS) GetKonnectStatus() *konnectv1alpha2.KonnectEntityStatus {
+	if obj.Status.Konnect == nil {
+		return nil
+	}
+	return &obj.Status.Konnect.KonnectEntityStatus
+}
+
+// GetKonnectID returns the Konnect ID in the KongCredentialMTLS status.
+func (obj *KongCredentialMTLS) GetKonnectID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ID
+}
+
+// SetKonnectID sets the Konnect ID in the KongCredentialMTLS status.
+func (obj *KongCredentialMTLS) SetKonnectID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ID = id
+}
+
+// PersistsKonnectID reports whether the KongCredentialMTLS persists a Konnect ID in status.
+func (*KongCredentialMTLS) PersistsKonnectID() bool {
+	return true
+}
+
+// GetControlPlaneID returns the ControlPlane ID in the KongCredentialMTLS status.
+func (obj *KongCredentialMTLS) GetControlPlaneID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ControlPlaneID
+}
+
+// SetControlPlaneID sets the ControlPlane ID in the KongCredentialMTLS status.
+func (obj *KongCredentialMTLS) SetControlPlaneID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ControlPlaneID = id
+}
+
+// GetTypeName returns the KongCredentialMTLS Kind name.
+func (obj KongCredentialMTLS) GetTypeName() string {
+	return "KongCredentialMTLS"
+}
+
+// GetConditions returns the Status Conditions.
+func (obj *KongCredentialMTLS) GetConditions() []metav1.Condition {
+	return obj.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (obj *KongCredentialMTLS) SetConditions(conditions []metav1.Condition) {
+	obj.Status.Conditions = conditions
+}
+
+func (obj *KongCredentialMTLS) SetKonnectConsumerIDInStatus(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ConsumerID = id
+}
+
+func (obj *KongCredentialMTLS) GetConsumerRefName() string {
+	return obj.Spec.ConsumerRef.Name
+}
+
 func (obj *KongCACertificate) initKonnectStatus() {
 	obj.Status.Konnect = &konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef{}
 }
@@ -1270,3 +1420,77 @@ func (obj *KongDataPlaneClientCertificate) SetControlPlaneRef(ref *commonv1alpha
 func (obj *KongDataPlaneClientCertificate) GetControlPlaneRef() *commonv1alpha1.ControlPlaneRef {
 	return obj.Spec.ControlPlaneRef
 }
+
+func (obj *KongCustomEntity) initKonnectStatus() {
+	obj.Status.Konnect = &konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef{}
+}
+
+// GetKonnectStatus returns the Konnect status contained in the KongCustomEntity status.
+func (obj *KongCustomEntity) GetKonnectStatus() *konnectv1alpha2.KonnectEntityStatus {
+	if obj.Status.Konnect == nil {
+		return nil
+	}
+	return &obj.Status.Konnect.KonnectEntityStatus
+}
+
+// GetKonnectID returns the Konnect ID in the KongCustomEntity status.
+func (obj *KongCustomEntity) GetKonnectID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ID
+}
+
+// SetKonnectID sets the Konnect ID in the KongCustomEntity status.
+func (obj *KongCustomEntity) SetKonnectID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ID = id
+}
+
+// PersistsKonnectID reports whether the KongCustomEntity persists a Konnect ID in status.
+func (*KongCustomEntity) PersistsKonnectID() bool {
+	return true
+}
+
+// GetControlPlaneID returns the ControlPlane ID in the KongCustomEntity status.
+func (obj *KongCustomEntity) GetControlPlaneID() string {
+	if obj.Status.Konnect == nil {
+		return ""
+	}
+	return obj.Status.Konnect.ControlPlaneID
+}
+
+// SetControlPlaneID sets the ControlPlane ID in the KongCustomEntity status.
+func (obj *KongCustomEntity) SetControlPlaneID(id string) {
+	if obj.Status.Konnect == nil {
+		obj.initKonnectStatus()
+	}
+	obj.Status.Konnect.ControlPlaneID = id
+}
+
+// GetTypeName returns the KongCustomEntity Kind name.
+func (obj KongCustomEntity) GetTypeName() string {
+	return "KongCustomEntity"
+}
+
+// GetConditions returns the Status Conditions.
+func (obj *KongCustomEntity) GetConditions() []metav1.Condition {
+	return obj.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (obj *KongCustomEntity) SetConditions(conditions []metav1.Condition) {
+	obj.Status.Conditions = conditions
+}
+
+// SetControlPlaneRef sets the ControlPlaneRef.
+func (obj *KongCustomEntity) SetControlPlaneRef(ref *commonv1alpha1.ControlPlaneRef) {
+	obj.Spec.ControlPlaneRef = ref
+}
+
+// GetControlPlaneRef returns the ControlPlaneRef.
+func (obj *KongCustomEntity) GetControlPlaneRef() *commonv1alpha1.ControlPlaneRef {
+	return obj.Spec.ControlPlaneRef
+}
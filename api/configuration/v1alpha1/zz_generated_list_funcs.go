@@ -37,6 +37,16 @@ func (obj KongCredentialHMACList) GetItems() []KongCredentialHMAC {
 	return obj.Items
 }
 
+// GetItems returns the list of KongCredentialOAuth2 items.
+func (obj KongCredentialOAuth2List) GetItems() []KongCredentialOAuth2 {
+	return obj.Items
+}
+
+// GetItems returns the list of KongCredentialMTLS items.
+func (obj KongCredentialMTLSList) GetItems() []KongCredentialMTLS {
+	return obj.Items
+}
+
 // GetItems returns the list of KongCACertificate items.
 func (obj KongCACertificateList) GetItems() []KongCACertificate {
 	return obj.Items
@@ -91,3 +101,8 @@ func (obj KongSNIList) GetItems() []KongSNI {
 func (obj KongDataPlaneClientCertificateList) GetItems() []KongDataPlaneClientCertificate {
 	return obj.Items
 }
+
+// GetItems returns the list of KongCustomEntity items.
+func (obj KongCustomEntityList) GetItems() []KongCustomEntity {
+	return obj.Items
+}
@@ -11,6 +11,19 @@ const (
 	KongUpstreamPolicyAnnotationKey = "konghq.com/upstream-policy"
 )
 
+const (
+	// KongUpstreamPolicyConditionTargetsHealthy is the type of the condition set on the ancestors of a KongUpstreamPolicy
+	// with health checks when the controller watches the health of the upstream targets. It is True when all Kong
+	// Gateways consider all the targets of the ancestor's upstreams healthy.
+	KongUpstreamPolicyConditionTargetsHealthy = "TargetsHealthy"
+
+	// KongUpstreamPolicyReasonTargetsHealthy is the reason of the TargetsHealthy condition when all targets are healthy.
+	KongUpstreamPolicyReasonTargetsHealthy = "TargetsHealthy"
+	// KongUpstreamPolicyReasonTargetsUnhealthy is the reason of the TargetsHealthy condition when some Kong Gateways
+	// don't consider some of the targets healthy.
+	KongUpstreamPolicyReasonTargetsUnhealthy = "TargetsUnhealthy"
+)
+
 // KongUpstreamPolicy allows configuring algorithm that should be used for load balancing traffic between Kong
 // Upstream's Targets. It also allows configuring health checks for Kong Upstream's Targets.
 //
@@ -106,6 +106,32 @@ type MetricsConfig struct {
 	// +kubebuilder:default=false
 	// +kube:validation:Optional
 	StatusCode bool `json:"statusCode"`
+
+	// PerRoute indicates whether the metrics exposed by the operator are broken
+	// down per Kong Route. When unset, the metrics of all the Routes of a Service
+	// are aggregated.
+	//
+	// +kubebuilder:default=false
+	// +kube:validation:Optional
+	PerRoute bool `json:"perRoute"`
+
+	// PerConsumer indicates whether the metrics are broken down per Kong Consumer.
+	// This translates into deployed instances having `per_consumer` option set
+	// on the Prometheus plugin.
+	//
+	// +kubebuilder:default=false
+	// +kube:validation:Optional
+	PerConsumer bool `json:"perConsumer"`
+
+	// CardinalityLimit is the maximum number of series exposed by the operator
+	// for the metrics of a DataPlane. Series above the limit are dropped and
+	// counted in the gateway_operator_dataplane_metrics_dropped_series metric.
+	// When several extensions apply to a DataPlane, the lowest limit is used.
+	//
+	// +kubebuilder:default=10000
+	// +kubebuilder:validation:Minimum=1
+	// +kube:validation:Optional
+	CardinalityLimit int32 `json:"cardinalityLimit,omitempty"`
 }
 
 // DataPlaneMetricsExtensionStatus defines the status of the DataPlaneMetricsExtension.
@@ -121,13 +147,22 @@ type DataPlaneMetricsExtensionStatus struct {
 }
 
 // ServiceSelector holds the service selector specification.
+// Services matching either the names or the label selector are selected.
+//
+// +kubebuilder:validation:XValidation:message="At least one of matchNames or selector must be set",rule="(has(self.matchNames) && size(self.matchNames) > 0) || has(self.selector)"
 type ServiceSelector struct {
 	// MatchNames holds the list of Services names to match.
 	//
 	// +listType=map
 	// +listMapKey=name
-	// +required
+	// +kube:validation:Optional
 	MatchNames []ServiceSelectorEntry `json:"matchNames,omitempty"`
+
+	// Selector is a label selector matching Services in the namespace of the
+	// ControlPlane.
+	//
+	// +kube:validation:Optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 // ServiceSelectorEntry holds the name of a service to match.
@@ -558,6 +558,11 @@ func (in *ServiceSelector) DeepCopyInto(out *ServiceSelector) {
 		*out = make([]ServiceSelectorEntry, len(*in))
 		copy(*out, *in)
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSelector.
@@ -155,6 +155,15 @@ type PodDisruptionBudgetSpec struct {
 
 // DataPlaneDeploymentOptions specifies options for the Deployments (as in the Kubernetes
 // resource "Deployment") which are created and managed for the DataPlane resource.
+//
+// +kubebuilder:validation:XValidation:message="Using replicas or scaling is not allowed when workloadType is DaemonSet.",rule="!has(self.workloadType) || self.workloadType != 'DaemonSet' || (!has(self.replicas) && !has(self.scaling))"
+// +kubebuilder:validation:XValidation:message="Using rollout is not allowed when workloadType is DaemonSet.",rule="!has(self.workloadType) || self.workloadType != 'DaemonSet' || !has(self.rollout)"
+// +kubebuilder:validation:XValidation:message="hostBinding can only be set when workloadType is DaemonSet.",rule="!has(self.hostBinding) || self.hostBinding == 'None' || (has(self.workloadType) && self.workloadType == 'DaemonSet')"
+// +kubebuilder:validation:XValidation:message="hostBinding cannot be set when hardened is baseline or restricted.",rule="!has(self.hostBinding) || self.hostBinding == 'None' || !has(self.hardened) || (self.hardened != 'baseline' && self.hardened != 'restricted')"
+// +kubebuilder:validation:XValidation:message="Using replicas is not allowed when topology is set, set the zones' replicas instead.",rule="!has(self.topology) || !has(self.replicas)"
+// +kubebuilder:validation:XValidation:message="Using zones' replicas is not allowed when scaling is set.",rule="!has(self.topology) || !has(self.scaling) || !has(self.scaling.horizontal) || self.topology.zones.all(z, !has(z.replicas))"
+// +kubebuilder:validation:XValidation:message="Using vertical scaling is not allowed when topology is set.",rule="!has(self.topology) || !has(self.scaling) || !has(self.scaling.vertical)"
+// +kubebuilder:validation:XValidation:message="Using topology is not allowed with rollout or when workloadType is DaemonSet.",rule="!has(self.topology) || (!has(self.rollout) && (!has(self.workloadType) || self.workloadType != 'DaemonSet'))"
 type DataPlaneDeploymentOptions struct {
 	DeploymentOptions `json:",inline"`
 
@@ -163,17 +172,55 @@ type DataPlaneDeploymentOptions struct {
 	// +optional
 	Rollout *Rollout `json:"rollout,omitempty"`
 
-	// Hardened indicates whether the operator should apply a hardened
-	// security context (non-root user, read-only root filesystem, dropped
-	// capabilities) and the related volumes and environment variables to
-	// the DataPlane's proxy container.
+	// Hardened controls the security settings the operator applies to the
+	// DataPlane's Pods. With enabled, a hardened security context (non-root
+	// user, read-only root filesystem, dropped capabilities) and the related
+	// volumes and environment variables are applied to the proxy container.
+	// With baseline and restricted, the Pods comply with the Pod Security
+	// Standard of the same name and the PodSecurityCompliant condition reports
+	// the PodTemplateSpec patches violating it.
 	//
-	// Enabling this on an existing DataPlane causes a rolling restart of
+	// Changing this on an existing DataPlane causes a rolling restart of
 	// its Pods.
 	//
 	// +optional
 	// +kubebuilder:default=disabled
 	Hardened commonv1alpha1.HardeningState `json:"hardened,omitempty"`
+
+	// WorkloadType is the type of the Kubernetes workload running the DataPlane's
+	// Pods. With DaemonSet one Pod runs on every node matching the PodTemplateSpec's
+	// nodeSelector, affinity and tolerations, in which case replicas, scaling and
+	// rollout cannot be set.
+	//
+	// Changing this on an existing DataPlane replaces its workload.
+	//
+	// +optional
+	// +kubebuilder:default=Deployment
+	WorkloadType commonv1alpha1.WorkloadType `json:"workloadType,omitempty"`
+
+	// HostBinding controls how the DataPlane's proxy ports are bound on the nodes
+	// running its Pods. HostNetwork runs the Pods in the nodes' network namespace,
+	// where the proxy listens on the ingress Service ports (the Gateway listener
+	// ports) instead of the ports they target, while HostPort binds every port of
+	// the ingress Service on the nodes and forwards it to the matching proxy port.
+	// With HostNetwork the admin API and status ports are bound on the Pod IP,
+	// which is the node's address, and on the loopback interface only.
+	// It can only be set when workloadType is DaemonSet, and not with the baseline
+	// and restricted hardening levels as both Pod Security Standards forbid host
+	// networking and host ports.
+	//
+	// +optional
+	// +kubebuilder:default=None
+	HostBinding commonv1alpha1.HostBinding `json:"hostBinding,omitempty"`
+
+	// Topology spreads the DataPlane across availability zones with one
+	// Deployment per zone. Each zone's Deployment has its own replicas, or its
+	// own HorizontalPodAutoscaler created from scaling, and the ingress Service
+	// prefers routing traffic to endpoints in the client's zone unless its
+	// trafficDistribution is set.
+	//
+	// +optional
+	Topology *commonv1alpha1.DataPlaneTopology `json:"topology,omitempty"`
 }
 
 // DataPlaneNetworkOptions defines network related options for a DataPlane.
@@ -217,6 +264,27 @@ type DataPlaneServiceOptions struct {
 	//
 	// +kubebuilder:validation:MaxItems=64
 	Ports []DataPlaneServicePort `json:"ports,omitempty"`
+
+	// LoadBalancerIP requests a specific (e.g. pre-allocated static) IP address
+	// from the cloud provider's load balancer. It only applies to Services
+	// of type `LoadBalancer` and is ignored by providers that do not support it.
+	//
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#loadbalancer
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=45
+	LoadBalancerIP *string `json:"loadBalancerIP,omitempty" hash:"ignore"`
+
+	// ExternalIPs is a list of IP addresses for which nodes in the cluster
+	// will also accept traffic for the Service. These IPs are not managed by
+	// Kubernetes and have to be routed to the cluster nodes by the user.
+	//
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#external-ips
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=45
+	ExternalIPs []string `json:"externalIPs,omitempty" hash:"ignore"`
 }
 
 // DataPlaneServicePort contains information on service's port.
@@ -410,6 +478,21 @@ type DataPlaneStatus struct {
 	// +kubebuilder:default=0
 	Replicas int32 `json:"replicas"`
 
+	// Zones reports the replicas of every zone the DataPlane runs in.
+	// It is set only if a topology was configured in the spec.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Zones []DataPlaneZoneStatus `json:"zones,omitempty"`
+
+	// VerticalScaling contains the resources recommended for the proxy container.
+	// It is set only if vertical scaling was configured in the spec.
+	//
+	// +optional
+	VerticalScaling *DataPlaneVerticalScalingStatus `json:"verticalScaling,omitempty"`
+
 	// RolloutStatus contains information about the rollout.
 	// It is set only if a rollout strategy was configured in the spec.
 	//
@@ -417,6 +500,56 @@ type DataPlaneStatus struct {
 	RolloutStatus *DataPlaneRolloutStatus `json:"rollout,omitempty"`
 }
 
+// DataPlaneZoneStatus describes the replicas of a DataPlane's zone.
+type DataPlaneZoneStatus struct {
+	// Name is the name of the zone.
+	//
+	// +required
+	Name string `json:"name"`
+
+	// ReadyReplicas indicates how many of the zone's replicas have reported to be ready.
+	//
+	// +kubebuilder:default=0
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Replicas indicates how many replicas have been set for the zone.
+	//
+	// +kubebuilder:default=0
+	Replicas int32 `json:"replicas"`
+}
+
+// DataPlaneVerticalScalingStatus describes the resources recommended for
+// the DataPlane's proxy container and the ones applied to it.
+type DataPlaneVerticalScalingStatus struct {
+	// Recommendation contains the resources recommended for the proxy container.
+	//
+	// +optional
+	Recommendation corev1.ResourceList `json:"recommendation,omitempty"`
+
+	// LowerBound contains the minimum resources recommended for the proxy container.
+	//
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+
+	// UpperBound contains the maximum resources recommended for the proxy container.
+	//
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+
+	// Applied contains the recommended resources set as the proxy container's
+	// requests. It is set only when the ApplyOnRollout mode is used.
+	//
+	// +optional
+	Applied corev1.ResourceList `json:"applied,omitempty"`
+
+	// AppliedGeneration is the DataPlane generation the applied resources
+	// were taken for. They're updated from the recommendation only when the
+	// DataPlane's generation changes.
+	//
+	// +optional
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+}
+
 // DataPlaneRolloutStatus describes the DataPlane rollout status.
 type DataPlaneRolloutStatus struct {
 	// Services contain the information about the services which are available
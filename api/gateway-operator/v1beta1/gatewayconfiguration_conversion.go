@@ -123,7 +123,10 @@ func gatewayConfigDataPlaneOptionsV2ToV1(o *operatorv2beta1.GatewayConfigDataPla
 			Annotations:     o.Deployment.Annotations,
 			Labels:          o.Deployment.Labels,
 		},
-		Hardened: o.Deployment.Hardened,
+		Hardened:     o.Deployment.Hardened,
+		WorkloadType: o.Deployment.WorkloadType,
+		HostBinding:  o.Deployment.HostBinding,
+		Topology:     o.Deployment.Topology,
 	}
 	if o.Deployment.Rollout != nil {
 		deployment.Rollout = &Rollout{
@@ -209,7 +212,10 @@ func gatewayConfigDataPlaneOptionsV1ToV2(o *GatewayConfigDataPlaneOptions) *oper
 			Annotations:     o.Deployment.Annotations,
 			Labels:          o.Deployment.Labels,
 		},
-		Hardened: o.Deployment.Hardened,
+		Hardened:     o.Deployment.Hardened,
+		WorkloadType: o.Deployment.WorkloadType,
+		HostBinding:  o.Deployment.HostBinding,
+		Topology:     o.Deployment.Topology,
 	}
 	if o.Deployment.Rollout != nil &&
 		o.Deployment.Rollout.Strategy.BlueGreen != nil {
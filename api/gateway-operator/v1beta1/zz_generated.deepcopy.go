@@ -269,6 +269,11 @@ func (in *DataPlaneDeploymentOptions) DeepCopyInto(out *DataPlaneDeploymentOptio
 		*out = new(Rollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(v1alpha1.DataPlaneTopology)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneDeploymentOptions.
@@ -469,6 +474,16 @@ func (in *DataPlaneServiceOptions) DeepCopyInto(out *DataPlaneServiceOptions) {
 		*out = make([]DataPlaneServicePort, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancerIP != nil {
+		in, out := &in.LoadBalancerIP, &out.LoadBalancerIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalIPs != nil {
+		in, out := &in.ExternalIPs, &out.ExternalIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneServiceOptions.
@@ -550,6 +565,16 @@ func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]DataPlaneZoneStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(DataPlaneVerticalScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RolloutStatus != nil {
 		in, out := &in.RolloutStatus, &out.RolloutStatus
 		*out = new(DataPlaneRolloutStatus)
@@ -567,6 +592,64 @@ func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneVerticalScalingStatus) DeepCopyInto(out *DataPlaneVerticalScalingStatus) {
+	*out = *in
+	if in.Recommendation != nil {
+		in, out := &in.Recommendation, &out.Recommendation
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneVerticalScalingStatus.
+func (in *DataPlaneVerticalScalingStatus) DeepCopy() *DataPlaneVerticalScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneVerticalScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneZoneStatus) DeepCopyInto(out *DataPlaneZoneStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneZoneStatus.
+func (in *DataPlaneZoneStatus) DeepCopy() *DataPlaneZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentOptions) DeepCopyInto(out *DeploymentOptions) {
 	*out = *in
@@ -1075,6 +1158,11 @@ func (in *Scaling) DeepCopyInto(out *Scaling) {
 		*out = new(HorizontalScaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(VerticalScaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scaling.
@@ -1131,6 +1219,35 @@ func (in *ServiceOptions) DeepCopy() *ServiceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalScaling) DeepCopyInto(out *VerticalScaling) {
+	*out = *in
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalScaling.
+func (in *VerticalScaling) DeepCopy() *VerticalScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchNamespaces) DeepCopyInto(out *WatchNamespaces) {
 	*out = *in
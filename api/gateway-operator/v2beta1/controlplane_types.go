@@ -260,6 +260,8 @@ const (
 //
 // +kubebuilder:validation:XValidation:message="Ref has to be provided when type is set to ref",rule="self.type != 'ref' || has(self.ref)"
 // +kubebuilder:validation:XValidation:message="Ref cannot be provided when type is set to managedByOwner",rule="self.type != 'managedByOwner' || !has(self.ref)"
+// +kubebuilder:validation:XValidation:message="Partitions can only be provided when type is set to ref",rule="self.type == 'ref' || !has(self.partitions)"
+// +kubebuilder:validation:XValidation:message="Partitions cannot reference the DataPlane referenced by ref",rule="!has(self.partitions) || !has(self.ref) || self.partitions.all(p, p.ref.name != self.ref.name)"
 type ControlPlaneDataPlaneTarget struct {
 	// Type indicates the type of the DataPlane target.
 	//
@@ -271,6 +273,93 @@ type ControlPlaneDataPlaneTarget struct {
 	//
 	// +optional
 	Ref *ControlPlaneDataPlaneTargetRef `json:"ref,omitempty"`
+
+	// Partitions split the configuration across additional DataPlanes.
+	// The DataPlane of a partition only receives the routes translated from
+	// the objects matching the partition, while the DataPlane referenced by
+	// Ref receives the routes of the objects not matching any partition.
+	// The routes of an object matching several partitions are sent to all
+	// of them. The other entities (e.g. consumers, certificates or vaults)
+	// are sent to all DataPlanes.
+	//
+	// Partitions can only be used with DB-less DataPlanes.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="DataPlanes of partitions must be unique",rule="self.all(p, self.exists_one(q, q.ref.name == p.ref.name))"
+	Partitions []ControlPlaneDataPlanePartition `json:"partitions,omitempty"`
+}
+
+// ControlPlaneDataPlanePartition defines a DataPlane receiving only the routes
+// translated from a subset of the objects watched by the ControlPlane.
+//
+// +kubebuilder:validation:XValidation:message="At least one of namespaces, selector or listeners has to be provided",rule="has(self.namespaces) || has(self.selector) || has(self.listeners)"
+type ControlPlaneDataPlanePartition struct {
+	// Name is the name of the partition.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Ref is the reference to the DataPlane receiving the routes of the partition.
+	//
+	// +required
+	Ref ControlPlaneDataPlaneTargetRef `json:"ref"`
+
+	// Namespaces are the namespaces of the objects whose routes belong to the
+	// partition. Objects of all namespaces match when omitted.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Selector selects the objects whose routes belong to the partition by
+	// their labels. Objects with any labels match when omitted.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Listeners select the Gateway API routes whose routes belong to the
+	// partition by the Gateway listeners they are attached to through their
+	// parentRefs. When set, objects which are not Gateway API routes (e.g.
+	// Ingresses) don't match. Objects attached to any listener match when omitted.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []ControlPlaneDataPlanePartitionListener `json:"listeners,omitempty"`
+}
+
+// ControlPlaneDataPlanePartitionListener selects the Gateway API routes
+// attached to a listener of a Gateway.
+type ControlPlaneDataPlanePartitionListener struct {
+	// GatewayName is the name of the Gateway.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	GatewayName string `json:"gatewayName"`
+
+	// GatewayNamespace is the namespace of the Gateway.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	GatewayNamespace string `json:"gatewayNamespace"`
+
+	// SectionName is the name of the listener. Routes attached to any listener
+	// of the Gateway match when omitted. Routes whose parentRef doesn't set
+	// a sectionName are attached to all the listeners of the Gateway, so they
+	// match any listener.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SectionName *string `json:"sectionName,omitempty"`
 }
 
 // ControlPlaneDataPlaneTargetType defines the type of the DataPlane target
@@ -17,6 +17,7 @@ limitations under the License.
 package v2beta1
 
 import (
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -157,6 +158,15 @@ type GatewayConfigDataPlaneOptions struct {
 
 // DataPlaneDeploymentOptions specifies options for the Deployments (as in the Kubernetes
 // resource "Deployment") which are created and managed for the DataPlane resource.
+//
+// +kubebuilder:validation:XValidation:message="Using replicas or scaling is not allowed when workloadType is DaemonSet.",rule="!has(self.workloadType) || self.workloadType != 'DaemonSet' || (!has(self.replicas) && !has(self.scaling))"
+// +kubebuilder:validation:XValidation:message="Using rollout is not allowed when workloadType is DaemonSet.",rule="!has(self.workloadType) || self.workloadType != 'DaemonSet' || !has(self.rollout)"
+// +kubebuilder:validation:XValidation:message="hostBinding can only be set when workloadType is DaemonSet.",rule="!has(self.hostBinding) || self.hostBinding == 'None' || (has(self.workloadType) && self.workloadType == 'DaemonSet')"
+// +kubebuilder:validation:XValidation:message="hostBinding cannot be set when hardened is baseline or restricted.",rule="!has(self.hostBinding) || self.hostBinding == 'None' || !has(self.hardened) || (self.hardened != 'baseline' && self.hardened != 'restricted')"
+// +kubebuilder:validation:XValidation:message="Using replicas is not allowed when topology is set, set the zones' replicas instead.",rule="!has(self.topology) || !has(self.replicas)"
+// +kubebuilder:validation:XValidation:message="Using zones' replicas is not allowed when scaling is set.",rule="!has(self.topology) || !has(self.scaling) || !has(self.scaling.horizontal) || self.topology.zones.all(z, !has(z.replicas))"
+// +kubebuilder:validation:XValidation:message="Using vertical scaling is not allowed when topology is set.",rule="!has(self.topology) || !has(self.scaling) || !has(self.scaling.vertical)"
+// +kubebuilder:validation:XValidation:message="Using topology is not allowed with rollout or when workloadType is DaemonSet.",rule="!has(self.topology) || (!has(self.rollout) && (!has(self.workloadType) || self.workloadType != 'DaemonSet'))"
 type DataPlaneDeploymentOptions struct {
 	DeploymentOptions `json:",inline"`
 
@@ -165,17 +175,55 @@ type DataPlaneDeploymentOptions struct {
 	// +optional
 	Rollout *Rollout `json:"rollout,omitempty"`
 
-	// Hardened indicates whether the operator should apply a hardened
-	// security context (non-root user, read-only root filesystem, dropped
-	// capabilities) and the related volumes and environment variables to
-	// the DataPlane's proxy container.
+	// Hardened controls the security settings the operator applies to the
+	// DataPlane's Pods. With enabled, a hardened security context (non-root
+	// user, read-only root filesystem, dropped capabilities) and the related
+	// volumes and environment variables are applied to the proxy container.
+	// With baseline and restricted, the Pods comply with the Pod Security
+	// Standard of the same name and the PodSecurityCompliant condition reports
+	// the PodTemplateSpec patches violating it.
 	//
-	// Enabling this on an existing DataPlane causes a rolling restart of
+	// Changing this on an existing DataPlane causes a rolling restart of
 	// its Pods.
 	//
 	// +optional
 	// +kubebuilder:default=disabled
 	Hardened commonv1alpha1.HardeningState `json:"hardened,omitempty"`
+
+	// WorkloadType is the type of the Kubernetes workload running the DataPlane's
+	// Pods. With DaemonSet one Pod runs on every node matching the PodTemplateSpec's
+	// nodeSelector, affinity and tolerations, in which case replicas, scaling and
+	// rollout cannot be set.
+	//
+	// Changing this on an existing DataPlane replaces its workload.
+	//
+	// +optional
+	// +kubebuilder:default=Deployment
+	WorkloadType commonv1alpha1.WorkloadType `json:"workloadType,omitempty"`
+
+	// HostBinding controls how the DataPlane's proxy ports are bound on the nodes
+	// running its Pods. HostNetwork runs the Pods in the nodes' network namespace,
+	// where the proxy listens on the ingress Service ports (the Gateway listener
+	// ports) instead of the ports they target, while HostPort binds every port of
+	// the ingress Service on the nodes and forwards it to the matching proxy port.
+	// With HostNetwork the admin API and status ports are bound on the Pod IP,
+	// which is the node's address, and on the loopback interface only.
+	// It can only be set when workloadType is DaemonSet, and not with the baseline
+	// and restricted hardening levels as both Pod Security Standards forbid host
+	// networking and host ports.
+	//
+	// +optional
+	// +kubebuilder:default=None
+	HostBinding commonv1alpha1.HostBinding `json:"hostBinding,omitempty"`
+
+	// Topology spreads the DataPlane across availability zones with one
+	// Deployment per zone. Each zone's Deployment has its own replicas, or its
+	// own HorizontalPodAutoscaler created from scaling, and the ingress Service
+	// prefers routing traffic to endpoints in the client's zone unless its
+	// trafficDistribution is set.
+	//
+	// +optional
+	Topology *commonv1alpha1.DataPlaneTopology `json:"topology,omitempty"`
 }
 
 // GatewayConfigDataPlaneNetworkOptions defines network related options for a DataPlane.
@@ -186,6 +234,109 @@ type GatewayConfigDataPlaneNetworkOptions struct {
 	//
 	// +optional
 	Services *GatewayConfigDataPlaneServices `json:"services,omitempty"`
+
+	// NetworkPolicy configures the NetworkPolicy created for the DataPlane
+	// when the operator runs in Kubernetes.
+	//
+	// +optional
+	NetworkPolicy *GatewayConfigDataPlaneNetworkPolicy `json:"networkPolicy,omitempty"`
+}
+
+// NetworkPolicyProfile is the profile of the NetworkPolicy created for a
+// Gateway's DataPlane.
+//
+// +kubebuilder:validation:Enum=Permissive;Restricted;DefaultDenyWithAllowlist
+type NetworkPolicyProfile string
+
+const (
+	// NetworkPolicyProfilePermissive only restricts the access to the Admin API
+	// to the operator, the proxy and metrics ports accept traffic from anywhere
+	// and egress traffic is not restricted (default).
+	NetworkPolicyProfilePermissive NetworkPolicyProfile = "Permissive"
+	// NetworkPolicyProfileRestricted restricts ingress traffic like
+	// NetworkPolicyProfilePermissive and restricts egress traffic to DNS and
+	// to the Pods running in the cluster.
+	NetworkPolicyProfileRestricted NetworkPolicyProfile = "Restricted"
+	// NetworkPolicyProfileDefaultDenyWithAllowlist denies all traffic but the
+	// operator's access to the Admin API and the metrics, DNS, and the traffic
+	// allowed by the ingress peers, the backends referenced by the routes
+	// attached to the Gateway and the additional egress rules.
+	NetworkPolicyProfileDefaultDenyWithAllowlist NetworkPolicyProfile = "DefaultDenyWithAllowlist"
+)
+
+// GatewayConfigDataPlaneNetworkPolicy defines the NetworkPolicy created for a
+// Gateway's DataPlane.
+//
+// +kubebuilder:validation:XValidation:message="ingressPeers can only be set with the DefaultDenyWithAllowlist profile",rule="!has(self.ingressPeers) || (has(self.profile) && self.profile == 'DefaultDenyWithAllowlist')"
+// +kubebuilder:validation:XValidation:message="additionalEgress cannot be set with the Permissive profile",rule="!has(self.additionalEgress) || (has(self.profile) && self.profile != 'Permissive')"
+type GatewayConfigDataPlaneNetworkPolicy struct {
+	// Profile is the profile of the NetworkPolicy.
+	//
+	// With Permissive, only the access to the Admin API is restricted to the
+	// operator.
+	//
+	// With Restricted, egress traffic is also restricted to DNS and to the
+	// Pods running in the cluster.
+	//
+	// With DefaultDenyWithAllowlist, the proxy and metrics ports only accept
+	// traffic from the ingress peers (and the metrics port from the operator),
+	// and egress traffic is restricted to DNS, to the backends referenced by
+	// the routes attached to the Gateway and to the additional egress rules.
+	// Backends in another namespace than the route are only allowed when
+	// a ReferenceGrant permits the reference.
+	//
+	// DNS traffic is allowed to the kube-dns Pods (labeled k8s-app=kube-dns)
+	// in the kube-system namespace. Clusters running DNS elsewhere need
+	// an additional egress rule.
+	//
+	// Hybrid Gateways can reach Konnect on port 443 when egress traffic is
+	// restricted, see KonnectEgress.
+	//
+	// +optional
+	// +kubebuilder:default=Permissive
+	Profile NetworkPolicyProfile `json:"profile,omitempty"`
+
+	// IngressPeers are the peers allowed to reach the proxy and metrics ports
+	// of the DataPlane with the DefaultDenyWithAllowlist profile.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	IngressPeers []networkingv1.NetworkPolicyPeer `json:"ingressPeers,omitempty"`
+
+	// AdditionalEgress are egress rules added to the ones of the profile, e.g.
+	// to allow plugins to reach services running outside the cluster.
+	// They cannot be set with the Permissive profile which doesn't restrict
+	// egress traffic.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AdditionalEgress []networkingv1.NetworkPolicyEgressRule `json:"additionalEgress,omitempty"`
+
+	// KonnectEgress configures the egress rule allowing hybrid Gateways to
+	// reach Konnect on port 443 when egress traffic is restricted.
+	// When not set, port 443 is allowed to any destination.
+	//
+	// +optional
+	KonnectEgress *GatewayConfigDataPlaneNetworkPolicyKonnectEgress `json:"konnectEgress,omitempty"`
+}
+
+// GatewayConfigDataPlaneNetworkPolicyKonnectEgress configures the egress rule
+// allowing hybrid Gateways to reach Konnect.
+//
+// +kubebuilder:validation:XValidation:message="ipBlocks cannot be set when the Konnect egress rule is disabled",rule="!has(self.disabled) || !self.disabled || !has(self.ipBlocks)"
+type GatewayConfigDataPlaneNetworkPolicyKonnectEgress struct {
+	// Disabled disables the egress rule, e.g. when Konnect is reached through
+	// a proxy allowed by an additional egress rule.
+	//
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// IPBlocks restricts the egress rule to the IP blocks of the Konnect
+	// region(s) the DataPlane connects to.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	IPBlocks []networkingv1.IPBlock `json:"ipBlocks,omitempty"`
 }
 
 // GatewayConfigDataPlaneServices contains Services related DataPlane configuration.
@@ -5,7 +5,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-// +kubebuilder:validation:XValidation:message="Using both replicas and scaling fields is not allowed.",rule="!(has(self.scaling) && has(self.replicas))"
+// +kubebuilder:validation:XValidation:message="Using both replicas and scaling fields is not allowed.",rule="!(has(self.replicas) && has(self.scaling) && has(self.scaling.horizontal))"
 
 // DeploymentOptions is a shared type used on objects to indicate that their
 // configuration results in a Deployment which is managed by the Operator and
@@ -54,6 +54,10 @@ type Scaling struct {
 	// HorizontalScaling defines horizontal scaling options for the deployment.
 	// +optional
 	HorizontalScaling *HorizontalScaling `json:"horizontal,omitempty"`
+
+	// VerticalScaling defines vertical scaling options for the deployment.
+	// +optional
+	VerticalScaling *VerticalScaling `json:"vertical,omitempty"`
 }
 
 // HorizontalScaling defines horizontal scaling options for the deployment.
@@ -94,6 +98,48 @@ type HorizontalScaling struct {
 	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty" protobuf:"bytes,5,opt,name=behavior"`
 }
 
+// VerticalScalingMode defines how the resources recommended for the proxy
+// container are used.
+//
+// +kubebuilder:validation:Enum=RecommendOnly;ApplyOnRollout
+type VerticalScalingMode string
+
+const (
+	// VerticalScalingModeRecommendOnly only reports the recommended resources
+	// in the status, without changing the running Pods.
+	VerticalScalingModeRecommendOnly VerticalScalingMode = "RecommendOnly"
+	// VerticalScalingModeApplyOnRollout applies the recommended resources the
+	// next time the Pods are rolled out because of a spec change, instead of
+	// evicting the running Pods.
+	VerticalScalingModeApplyOnRollout VerticalScalingMode = "ApplyOnRollout"
+)
+
+// VerticalScaling defines vertical scaling options for the deployment.
+// The resources of the proxy container are recommended by a
+// VerticalPodAutoscaler, which requires its CRDs and recommender to be
+// installed in the cluster. The VerticalPodAutoscaler never evicts Pods.
+type VerticalScaling struct {
+	// Mode defines how the recommended resources are used.
+	// With RecommendOnly they're only reported in the status, while with
+	// ApplyOnRollout they're also set as the proxy container's requests the
+	// next time the spec changes, going through the BlueGreen rollout when one
+	// is configured.
+	//
+	// +optional
+	// +kubebuilder:default=RecommendOnly
+	Mode VerticalScalingMode `json:"mode,omitempty"`
+
+	// MinAllowed is the lower limit of the resources recommended for the proxy container.
+	//
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+
+	// MaxAllowed is the upper limit of the resources recommended for the proxy container.
+	//
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
 // Rollout defines options for rollouts.
 type Rollout struct {
 	// Strategy contains the deployment strategy for rollout.
@@ -26,6 +26,7 @@ import (
 	"github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
 	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -114,6 +115,59 @@ func (in *ControlPlaneController) DeepCopy() *ControlPlaneController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDataPlanePartition) DeepCopyInto(out *ControlPlaneDataPlanePartition) {
+	*out = *in
+	out.Ref = in.Ref
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ControlPlaneDataPlanePartitionListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDataPlanePartition.
+func (in *ControlPlaneDataPlanePartition) DeepCopy() *ControlPlaneDataPlanePartition {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDataPlanePartition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDataPlanePartitionListener) DeepCopyInto(out *ControlPlaneDataPlanePartitionListener) {
+	*out = *in
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDataPlanePartitionListener.
+func (in *ControlPlaneDataPlanePartitionListener) DeepCopy() *ControlPlaneDataPlanePartitionListener {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDataPlanePartitionListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneDataPlaneStatus) DeepCopyInto(out *ControlPlaneDataPlaneStatus) {
 	*out = *in
@@ -167,6 +221,13 @@ func (in *ControlPlaneDataPlaneTarget) DeepCopyInto(out *ControlPlaneDataPlaneTa
 		*out = new(ControlPlaneDataPlaneTargetRef)
 		**out = **in
 	}
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]ControlPlaneDataPlanePartition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDataPlaneTarget.
@@ -598,6 +659,11 @@ func (in *DataPlaneDeploymentOptions) DeepCopyInto(out *DataPlaneDeploymentOptio
 		*out = new(Rollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(v1alpha1.DataPlaneTopology)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneDeploymentOptions.
@@ -678,6 +744,11 @@ func (in *GatewayConfigDataPlaneNetworkOptions) DeepCopyInto(out *GatewayConfigD
 		*out = new(GatewayConfigDataPlaneServices)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(GatewayConfigDataPlaneNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkOptions.
@@ -690,6 +761,62 @@ func (in *GatewayConfigDataPlaneNetworkOptions) DeepCopy() *GatewayConfigDataPla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDataPlaneNetworkPolicy) DeepCopyInto(out *GatewayConfigDataPlaneNetworkPolicy) {
+	*out = *in
+	if in.IngressPeers != nil {
+		in, out := &in.IngressPeers, &out.IngressPeers
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalEgress != nil {
+		in, out := &in.AdditionalEgress, &out.AdditionalEgress
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KonnectEgress != nil {
+		in, out := &in.KonnectEgress, &out.KonnectEgress
+		*out = new(GatewayConfigDataPlaneNetworkPolicyKonnectEgress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkPolicy.
+func (in *GatewayConfigDataPlaneNetworkPolicy) DeepCopy() *GatewayConfigDataPlaneNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDataPlaneNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDataPlaneNetworkPolicyKonnectEgress) DeepCopyInto(out *GatewayConfigDataPlaneNetworkPolicyKonnectEgress) {
+	*out = *in
+	if in.IPBlocks != nil {
+		in, out := &in.IPBlocks, &out.IPBlocks
+		*out = make([]networkingv1.IPBlock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDataPlaneNetworkPolicyKonnectEgress.
+func (in *GatewayConfigDataPlaneNetworkPolicyKonnectEgress) DeepCopy() *GatewayConfigDataPlaneNetworkPolicyKonnectEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDataPlaneNetworkPolicyKonnectEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigDataPlaneOptions) DeepCopyInto(out *GatewayConfigDataPlaneOptions) {
 	*out = *in
@@ -1125,6 +1252,11 @@ func (in *Scaling) DeepCopyInto(out *Scaling) {
 		*out = new(HorizontalScaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(VerticalScaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scaling.
@@ -1181,6 +1313,35 @@ func (in *ServiceOptions) DeepCopy() *ServiceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalScaling) DeepCopyInto(out *VerticalScaling) {
+	*out = *in
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalScaling.
+func (in *VerticalScaling) DeepCopy() *VerticalScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchNamespaces) DeepCopyInto(out *WatchNamespaces) {
 	*out = *in
@@ -49,6 +49,10 @@ func Resource(resource string) schema.GroupResource {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	// This is a manually maintained list of not generated CRD types.
 	scheme.AddKnownTypes(GroupVersion,
+		&APIImplementation{},
+		&APIImplementationList{},
+		&APIPublication{},
+		&APIPublicationList{},
 		&KonnectAPIAuthConfiguration{},
 		&KonnectAPIAuthConfigurationList{},
 		&KonnectCloudGatewayDataPlaneGroupConfiguration{},
@@ -61,6 +65,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KonnectExtensionList{},
 		&KonnectGatewayControlPlane{},
 		&KonnectGatewayControlPlaneList{},
+		&KonnectRoleAssignment{},
+		&KonnectRoleAssignmentList{},
+		&KonnectSystemAccountAccessToken{},
+		&KonnectSystemAccountAccessTokenList{},
 		&MCPServer{},
 		&MCPServerList{},
 	)
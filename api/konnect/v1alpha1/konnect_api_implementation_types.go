@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+)
+
+// APIImplementation links a KonnectAPI to the KongService implementing it.
+//
+// The implementation is created using the KonnectAPIAuthConfiguration of the
+// KonnectAPI. Konnect does not allow updating API implementations, hence the
+// spec is immutable.
+//
+// +genclient
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong;konnect
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="API",description="The API implemented by the Service",type=string,JSONPath=`.spec.apiRef.name`
+// +kubebuilder:printcolumn:name="Service",description="The Service implementing the API",type=string,JSONPath=`.spec.serviceRef.name`
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="ID",description="Konnect ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:printcolumn:name="OrgID",description="Konnect Organization ID this resource belongs to.",type=string,JSONPath=`.status.organizationID`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="self.spec == oldSelf.spec",message="spec is immutable"
+// +kong:channels=kong-operator
+type APIImplementation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of APIImplementation.
+	//
+	// +required
+	Spec APIImplementationSpec `json:"spec"`
+
+	// Status defines the observed state of APIImplementation.
+	//
+	// +optional
+	Status APIImplementationStatus `json:"status,omitempty"`
+}
+
+// APIImplementationSpec defines the desired state of APIImplementation.
+type APIImplementationSpec struct {
+	// APIRef is a reference to the KonnectAPI in the same namespace which is implemented.
+	//
+	// +required
+	APIRef commonv1alpha1.NameRef `json:"apiRef"`
+
+	// ServiceRef is a reference to the KongService in the same namespace which
+	// implements the API. The KongService has to be managed in a Konnect control plane.
+	//
+	// +required
+	ServiceRef commonv1alpha1.NameRef `json:"serviceRef"`
+}
+
+// APIImplementationStatus defines the observed state of APIImplementation.
+type APIImplementationStatus struct {
+	// Conditions describe the status of the API implementation.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// KonnectEntityStatus holds the ID of the API implementation in Konnect.
+	konnectv1alpha2.KonnectEntityStatus `json:",inline"`
+
+	// APIID is the Konnect ID of the implemented API.
+	//
+	// +optional
+	APIID string `json:"apiID,omitempty"`
+
+	// ControlPlaneID is the Konnect ID of the control plane of the implementing Service.
+	//
+	// +optional
+	ControlPlaneID string `json:"controlPlaneID,omitempty"`
+
+	// ServiceID is the Konnect ID of the implementing Service.
+	//
+	// +optional
+	ServiceID string `json:"serviceID,omitempty"`
+}
+
+// APIImplementationList contains a list of APIImplementation.
+//
+// +kubebuilder:object:root=true
+type APIImplementationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIImplementation `json:"items"`
+}
+
+// GetConditions returns the Status Conditions.
+func (r *APIImplementation) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (r *APIImplementation) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+)
+
+// APIPublication publishes a KonnectAPI to a Portal.
+//
+// The publication is managed using the KonnectAPIAuthConfiguration of the
+// KonnectAPI. Konnect identifies publications by the API and the Portal,
+// hence both references are immutable.
+//
+// +genclient
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong;konnect
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="API",description="The published API",type=string,JSONPath=`.spec.apiRef.name`
+// +kubebuilder:printcolumn:name="Portal",description="The Portal the API is published to",type=string,JSONPath=`.spec.portalRef.name`
+// +kubebuilder:printcolumn:name="Visibility",description="The visibility of the API in the Portal",type=string,JSONPath=`.spec.visibility`
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="OrgID",description="Konnect Organization ID this resource belongs to.",type=string,JSONPath=`.status.organizationID`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="self.spec.apiRef == oldSelf.spec.apiRef",message="spec.apiRef is immutable"
+// +kubebuilder:validation:XValidation:rule="self.spec.portalRef == oldSelf.spec.portalRef",message="spec.portalRef is immutable"
+// +kong:channels=kong-operator
+type APIPublication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of APIPublication.
+	//
+	// +required
+	Spec APIPublicationSpec `json:"spec"`
+
+	// Status defines the observed state of APIPublication.
+	//
+	// +optional
+	Status APIPublicationStatus `json:"status,omitempty"`
+}
+
+// APIPublicationSpec defines the desired state of APIPublication.
+type APIPublicationSpec struct {
+	// APIRef is a reference to the KonnectAPI in the same namespace which is published.
+	//
+	// +required
+	APIRef commonv1alpha1.NameRef `json:"apiRef"`
+
+	// PortalRef is a reference to the Portal in the same namespace the API is published to.
+	//
+	// +required
+	PortalRef commonv1alpha1.NameRef `json:"portalRef"`
+
+	// Visibility is the visibility of the API in the Portal.
+	// Public APIs are visible to anonymous users, private APIs only to
+	// authenticated developers.
+	//
+	// +optional
+	// +kubebuilder:default=private
+	Visibility APIPublicationVisibility `json:"visibility,omitempty"`
+
+	// AutoApproveRegistrations controls whether developer application
+	// registrations for the API are approved automatically.
+	//
+	// +optional
+	AutoApproveRegistrations *bool `json:"autoApproveRegistrations,omitempty"`
+
+	// AuthStrategyIDs are the Konnect IDs of the application auth strategies
+	// used by developer applications registering for the API.
+	// When unset, the default auth strategy of the Portal is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	AuthStrategyIDs []string `json:"authStrategyIDs,omitempty"`
+}
+
+// APIPublicationVisibility is the visibility of an API published to a Portal.
+//
+// +kubebuilder:validation:Enum=public;private
+type APIPublicationVisibility string
+
+const (
+	// APIPublicationVisibilityPublic makes the API visible to anonymous users of the Portal.
+	APIPublicationVisibilityPublic APIPublicationVisibility = "public"
+	// APIPublicationVisibilityPrivate makes the API visible to authenticated developers only.
+	APIPublicationVisibilityPrivate APIPublicationVisibility = "private"
+)
+
+// APIPublicationStatus defines the observed state of APIPublication.
+type APIPublicationStatus struct {
+	// Conditions describe the status of the API publication.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// KonnectEntityStatus holds the Konnect organization and server the API is published in.
+	// Publications do not have a Konnect ID of their own, they are identified
+	// by the API and Portal IDs.
+	konnectv1alpha2.KonnectEntityStatus `json:",inline"`
+
+	// APIID is the Konnect ID of the published API.
+	//
+	// +optional
+	APIID string `json:"apiID,omitempty"`
+
+	// PortalID is the Konnect ID of the Portal the API is published to.
+	//
+	// +optional
+	PortalID string `json:"portalID,omitempty"`
+}
+
+// APIPublicationList contains a list of APIPublication.
+//
+// +kubebuilder:object:root=true
+type APIPublicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIPublication `json:"items"`
+}
+
+// GetVisibility returns the visibility of the API, falling back to private when unset.
+func (r *APIPublication) GetVisibility() APIPublicationVisibility {
+	if r.Spec.Visibility == "" {
+		return APIPublicationVisibilityPrivate
+	}
+	return r.Spec.Visibility
+}
+
+// GetConditions returns the Status Conditions.
+func (r *APIPublication) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (r *APIPublication) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
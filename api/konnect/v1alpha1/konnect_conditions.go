@@ -344,3 +344,47 @@ const (
 	// allows the cross-namespace reference to the KonnectConfigStore.
 	ConfigStoreRefReasonRefNotPermitted = "RefNotPermitted"
 )
+
+const (
+	// KonnectRoleAssignmentReasonSubjectNotProgrammed is the reason used with the Programmed
+	// condition when the KonnectTeam or KonnectSystemAccount referenced by a KonnectRoleAssignment
+	// does not exist or is not Programmed in Konnect yet.
+	KonnectRoleAssignmentReasonSubjectNotProgrammed = "SubjectNotProgrammed"
+	// KonnectRoleAssignmentReasonControlPlaneNotProgrammed is the reason used with the Programmed
+	// condition when the KonnectGatewayControlPlane referenced by a KonnectRoleAssignment
+	// does not exist or is not Programmed in Konnect yet.
+	KonnectRoleAssignmentReasonControlPlaneNotProgrammed = "ControlPlaneNotProgrammed"
+)
+
+const (
+	// KonnectSystemAccountAccessTokenReasonSystemAccountNotProgrammed is the reason used with the
+	// Programmed condition when the referenced KonnectSystemAccount does not exist or is not
+	// Programmed in Konnect yet.
+	KonnectSystemAccountAccessTokenReasonSystemAccountNotProgrammed = "SystemAccountNotProgrammed"
+	// KonnectSystemAccountAccessTokenReasonSecretConflict is the reason used with the Programmed
+	// condition when the Secret the token should be stored in already exists and is not owned
+	// by the KonnectSystemAccountAccessToken.
+	KonnectSystemAccountAccessTokenReasonSecretConflict = "SecretConflict"
+)
+
+const (
+	// APIImplementationReasonAPINotProgrammed is the reason used with the Programmed
+	// condition when the KonnectAPI referenced by an APIImplementation does not exist
+	// or is not Programmed in Konnect yet.
+	APIImplementationReasonAPINotProgrammed = "APINotProgrammed"
+	// APIImplementationReasonServiceNotProgrammed is the reason used with the Programmed
+	// condition when the KongService referenced by an APIImplementation does not exist
+	// or is not Programmed in a Konnect control plane yet.
+	APIImplementationReasonServiceNotProgrammed = "ServiceNotProgrammed"
+)
+
+const (
+	// APIPublicationReasonAPINotProgrammed is the reason used with the Programmed
+	// condition when the KonnectAPI referenced by an APIPublication does not exist
+	// or is not Programmed in Konnect yet.
+	APIPublicationReasonAPINotProgrammed = "APINotProgrammed"
+	// APIPublicationReasonPortalNotProgrammed is the reason used with the Programmed
+	// condition when the Portal referenced by an APIPublication does not exist or is not
+	// Programmed in Konnect yet.
+	APIPublicationReasonPortalNotProgrammed = "PortalNotProgrammed"
+)
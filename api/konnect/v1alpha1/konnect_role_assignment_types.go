@@ -0,0 +1,163 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+)
+
+// KonnectRoleAssignment assigns a Konnect role scoped to a KonnectGatewayControlPlane
+// to a KonnectTeam or a KonnectSystemAccount.
+//
+// The role is assigned using the KonnectAPIAuthConfiguration of the subject.
+// Konnect does not allow updating role assignments, hence the spec is immutable.
+//
+// +genclient
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong;konnect
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Subject",description="The subject the role is assigned to",type=string,JSONPath=`.spec.subject.name`
+// +kubebuilder:printcolumn:name="Role",description="The assigned role",type=string,JSONPath=`.spec.role`
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="ID",description="Konnect ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:printcolumn:name="OrgID",description="Konnect Organization ID this resource belongs to.",type=string,JSONPath=`.status.organizationID`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kubebuilder:validation:XValidation:rule="self.spec == oldSelf.spec",message="spec is immutable"
+// +kong:channels=kong-operator
+type KonnectRoleAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of KonnectRoleAssignment.
+	//
+	// +required
+	Spec KonnectRoleAssignmentSpec `json:"spec"`
+
+	// Status defines the observed state of KonnectRoleAssignment.
+	//
+	// +optional
+	Status KonnectRoleAssignmentStatus `json:"status,omitempty"`
+}
+
+// KonnectRoleAssignmentSpec defines the desired state of KonnectRoleAssignment.
+//
+// +kubebuilder:validation:XValidation:rule="self.controlPlaneRef.type == 'konnectNamespacedRef'",message="only konnectNamespacedRef is supported currently"
+// +kubebuilder:validation:XValidation:rule="!has(self.controlPlaneRef.konnectNamespacedRef) || !has(self.controlPlaneRef.konnectNamespacedRef.namespace)",message="cross namespace references are not supported"
+type KonnectRoleAssignmentSpec struct {
+	// Subject is the KonnectTeam or KonnectSystemAccount the role is assigned to.
+	//
+	// +required
+	Subject KonnectRoleAssignmentSubject `json:"subject"`
+
+	// ControlPlaneRef is a reference to the KonnectGatewayControlPlane the role is scoped to.
+	//
+	// +required
+	ControlPlaneRef commonv1alpha1.ControlPlaneRef `json:"controlPlaneRef"`
+
+	// Role is the name of the control plane role to assign.
+	//
+	// +required
+	Role KonnectControlPlaneRole `json:"role"`
+}
+
+// KonnectRoleAssignmentSubjectKind is the kind of the subject of a KonnectRoleAssignment.
+//
+// +kubebuilder:validation:Enum=KonnectTeam;KonnectSystemAccount
+type KonnectRoleAssignmentSubjectKind string
+
+const (
+	// KonnectRoleAssignmentSubjectKindTeam is the kind used to assign a role to a KonnectTeam.
+	KonnectRoleAssignmentSubjectKindTeam KonnectRoleAssignmentSubjectKind = "KonnectTeam"
+	// KonnectRoleAssignmentSubjectKindSystemAccount is the kind used to assign a role to a KonnectSystemAccount.
+	KonnectRoleAssignmentSubjectKindSystemAccount KonnectRoleAssignmentSubjectKind = "KonnectSystemAccount"
+)
+
+// KonnectRoleAssignmentSubject is a reference to the subject of a KonnectRoleAssignment
+// in the same namespace.
+type KonnectRoleAssignmentSubject struct {
+	// Kind is the kind of the subject.
+	//
+	// +optional
+	// +kubebuilder:default=KonnectTeam
+	Kind KonnectRoleAssignmentSubjectKind `json:"kind,omitempty"`
+
+	// Name is the name of the subject.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// KonnectControlPlaneRole is the name of a Konnect role scoped to a control plane.
+//
+// +kubebuilder:validation:Enum=Admin;Viewer;Deployer;Creator;"Certificate Admin";"Consumer Admin";"Gateway Service Admin";"Plugins Admin";"Cloud Gateway Cluster Admin";"Cloud Gateway Cluster Viewer"
+type KonnectControlPlaneRole string
+
+const (
+	// KonnectControlPlaneRoleAdmin grants full access to the control plane.
+	KonnectControlPlaneRoleAdmin KonnectControlPlaneRole = "Admin"
+	// KonnectControlPlaneRoleViewer grants read only access to the control plane.
+	KonnectControlPlaneRoleViewer KonnectControlPlaneRole = "Viewer"
+	// KonnectControlPlaneRoleDeployer grants access to deploy configuration to the control plane.
+	KonnectControlPlaneRoleDeployer KonnectControlPlaneRole = "Deployer"
+)
+
+// KonnectRoleAssignmentStatus defines the observed state of KonnectRoleAssignment.
+type KonnectRoleAssignmentStatus struct {
+	// Conditions describe the status of the role assignment.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// KonnectEntityStatus holds the ID of the assigned role in Konnect.
+	konnectv1alpha2.KonnectEntityStatus `json:",inline"`
+
+	// SubjectID is the Konnect ID of the subject the role is assigned to.
+	//
+	// +optional
+	SubjectID string `json:"subjectID,omitempty"`
+
+	// ControlPlaneID is the Konnect ID of the control plane the role is scoped to.
+	//
+	// +optional
+	ControlPlaneID string `json:"controlPlaneID,omitempty"`
+}
+
+// KonnectRoleAssignmentList contains a list of KonnectRoleAssignment.
+//
+// +kubebuilder:object:root=true
+type KonnectRoleAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KonnectRoleAssignment `json:"items"`
+}
+
+// GetSubjectKind returns the kind of the subject, falling back to KonnectTeam when unset.
+func (r *KonnectRoleAssignment) GetSubjectKind() KonnectRoleAssignmentSubjectKind {
+	if r.Spec.Subject.Kind == "" {
+		return KonnectRoleAssignmentSubjectKindTeam
+	}
+	return r.Spec.Subject.Kind
+}
+
+// GetControlPlaneRef returns the ControlPlaneRef.
+func (r *KonnectRoleAssignment) GetControlPlaneRef() *commonv1alpha1.ControlPlaneRef {
+	return &r.Spec.ControlPlaneRef
+}
+
+// GetConditions returns the Status Conditions.
+func (r *KonnectRoleAssignment) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (r *KonnectRoleAssignment) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
@@ -0,0 +1,182 @@
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KonnectSystemAccountAccessToken mints a Konnect access token for a KonnectSystemAccount
+// and stores it in a Secret owned by this resource.
+//
+// A new token is minted before the current one expires and the Secret is updated
+// in place. Previous tokens are left to expire on their own so that clients
+// which have not picked up the new token yet keep working.
+// Tokens minted by the operator are revoked when this resource is deleted.
+//
+// +genclient
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories=kong;konnect
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SystemAccount",description="The system account the token is minted for",type=string,JSONPath=`.spec.systemAccountRef.name`
+// +kubebuilder:printcolumn:name="Secret",description="The Secret holding the current token",type=string,JSONPath=`.status.secretName`
+// +kubebuilder:printcolumn:name="Expiration",description="The expiration time of the current token",type=string,JSONPath=`.status.currentToken.expirationTime`
+// +kubebuilder:printcolumn:name="Programmed",description="The Resource is Programmed on Konnect",type=string,JSONPath=`.status.conditions[?(@.type=='Programmed')].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+// +kong:channels=kong-operator
+type KonnectSystemAccountAccessToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of KonnectSystemAccountAccessToken.
+	//
+	// +required
+	Spec KonnectSystemAccountAccessTokenSpec `json:"spec"`
+
+	// Status defines the observed state of KonnectSystemAccountAccessToken.
+	//
+	// +optional
+	Status KonnectSystemAccountAccessTokenStatus `json:"status,omitempty"`
+}
+
+// DefaultKonnectSystemAccountAccessTokenTTL is the default lifetime of a minted access token.
+const DefaultKonnectSystemAccountAccessTokenTTL = 30 * 24 * time.Hour
+
+// DefaultKonnectSystemAccountAccessTokenRenewBefore is the default time before
+// the expiration of the current token at which a new token is minted.
+const DefaultKonnectSystemAccountAccessTokenRenewBefore = 7 * 24 * time.Hour
+
+// KonnectSystemAccountAccessTokenSpec defines the desired state of KonnectSystemAccountAccessToken.
+//
+// +kubebuilder:validation:XValidation:rule="oldSelf.systemAccountRef == self.systemAccountRef",message="spec.systemAccountRef is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(oldSelf.secretName) && has(self.secretName) && oldSelf.secretName == self.secretName) || (!has(oldSelf.secretName) && !has(self.secretName))",message="spec.secretName is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.ttl) || !has(self.renewBefore) || duration(self.renewBefore) < duration(self.ttl)",message="renewBefore must be shorter than ttl"
+type KonnectSystemAccountAccessTokenSpec struct {
+	// SystemAccountRef is a reference to the KonnectSystemAccount in the same
+	// namespace the token is minted for.
+	//
+	// +required
+	SystemAccountRef corev1.LocalObjectReference `json:"systemAccountRef"`
+
+	// SecretName is the name of the Secret the current token is stored in under the "token" entry.
+	// The Secret is created and owned by the operator.
+	// When not set, the name of the KonnectSystemAccountAccessToken is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName,omitempty"`
+
+	// TTL is the lifetime of each minted token. Defaults to 720h (30 days).
+	//
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// RenewBefore is the time before the expiration of the current token at which
+	// a new token is minted. It has to be shorter than the TTL. Defaults to 168h (7 days).
+	//
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// KonnectSystemAccountAccessTokenStatus defines the observed state of KonnectSystemAccountAccessToken.
+type KonnectSystemAccountAccessTokenStatus struct {
+	// Conditions describe the status of the access token.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// SecretName is the name of the Secret holding the current token.
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// SystemAccountID is the Konnect ID of the system account the tokens are minted for.
+	//
+	// +optional
+	SystemAccountID string `json:"systemAccountID,omitempty"`
+
+	// CurrentToken is the token currently stored in the Secret.
+	//
+	// +optional
+	CurrentToken *KonnectSystemAccountAccessTokenInfo `json:"currentToken,omitempty"`
+
+	// PreviousTokens are the tokens that were replaced by the current one and have not expired yet.
+	// They are kept until they expire so that clients which have not picked up
+	// the current token yet keep working.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	// +kubebuilder:validation:MaxItems=8
+	PreviousTokens []KonnectSystemAccountAccessTokenInfo `json:"previousTokens,omitempty"`
+
+	// NextRotationTime is the time a new token is going to be minted.
+	//
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+}
+
+// KonnectSystemAccountAccessTokenInfo describes a token minted in Konnect.
+type KonnectSystemAccountAccessTokenInfo struct {
+	// ID is the Konnect ID of the token.
+	//
+	// +required
+	ID string `json:"id"`
+
+	// ExpirationTime is the time the token expires at.
+	//
+	// +required
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// KonnectSystemAccountAccessTokenList contains a list of KonnectSystemAccountAccessToken.
+//
+// +kubebuilder:object:root=true
+type KonnectSystemAccountAccessTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KonnectSystemAccountAccessToken `json:"items"`
+}
+
+// GetSecretName returns the name of the Secret the minted token is stored in.
+func (t *KonnectSystemAccountAccessToken) GetSecretName() string {
+	if t.Spec.SecretName != "" {
+		return t.Spec.SecretName
+	}
+	return t.Name
+}
+
+// GetTTL returns the lifetime of minted tokens, falling back to the default when unset.
+func (t *KonnectSystemAccountAccessToken) GetTTL() time.Duration {
+	if t.Spec.TTL == nil {
+		return DefaultKonnectSystemAccountAccessTokenTTL
+	}
+	return t.Spec.TTL.Duration
+}
+
+// GetRenewBefore returns the time before expiration at which a new token is minted,
+// falling back to the default when unset. The result never exceeds half of the TTL
+// so that a default renewal window cannot cause a token to be renewed right away.
+func (t *KonnectSystemAccountAccessToken) GetRenewBefore() time.Duration {
+	if t.Spec.RenewBefore != nil {
+		return t.Spec.RenewBefore.Duration
+	}
+	return min(DefaultKonnectSystemAccountAccessTokenRenewBefore, t.GetTTL()/2)
+}
+
+// GetConditions returns the Status Conditions.
+func (t *KonnectSystemAccountAccessToken) GetConditions() []metav1.Condition {
+	return t.Status.Conditions
+}
+
+// SetConditions sets the Status Conditions.
+func (t *KonnectSystemAccountAccessToken) SetConditions(conditions []metav1.Condition) {
+	t.Status.Conditions = conditions
+}
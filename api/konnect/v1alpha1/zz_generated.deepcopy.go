@@ -5557,6 +5557,334 @@ func (in *AIGatewayProxyURL) DeepCopy() *AIGatewayProxyURL {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIImplementation) DeepCopyInto(out *APIImplementation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIImplementation.
+func (in *APIImplementation) DeepCopy() *APIImplementation {
+	if in == nil {
+		return nil
+	}
+	out := new(APIImplementation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIImplementation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIImplementationList) DeepCopyInto(out *APIImplementationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIImplementation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIImplementationList.
+func (in *APIImplementationList) DeepCopy() *APIImplementationList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIImplementationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIImplementationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIImplementationSpec) DeepCopyInto(out *APIImplementationSpec) {
+	*out = *in
+	out.APIRef = in.APIRef
+	out.ServiceRef = in.ServiceRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIImplementationSpec.
+func (in *APIImplementationSpec) DeepCopy() *APIImplementationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIImplementationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIImplementationStatus) DeepCopyInto(out *APIImplementationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIImplementationStatus.
+func (in *APIImplementationStatus) DeepCopy() *APIImplementationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIImplementationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIPublication) DeepCopyInto(out *APIPublication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIPublication.
+func (in *APIPublication) DeepCopy() *APIPublication {
+	if in == nil {
+		return nil
+	}
+	out := new(APIPublication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIPublication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIPublicationList) DeepCopyInto(out *APIPublicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIPublication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIPublicationList.
+func (in *APIPublicationList) DeepCopy() *APIPublicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIPublicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIPublicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIPublicationSpec) DeepCopyInto(out *APIPublicationSpec) {
+	*out = *in
+	out.APIRef = in.APIRef
+	out.PortalRef = in.PortalRef
+	if in.AutoApproveRegistrations != nil {
+		in, out := &in.AutoApproveRegistrations, &out.AutoApproveRegistrations
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthStrategyIDs != nil {
+		in, out := &in.AuthStrategyIDs, &out.AuthStrategyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIPublicationSpec.
+func (in *APIPublicationSpec) DeepCopy() *APIPublicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIPublicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIPublicationStatus) DeepCopyInto(out *APIPublicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIPublicationStatus.
+func (in *APIPublicationStatus) DeepCopy() *APIPublicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIPublicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APISpecification) DeepCopyInto(out *APISpecification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpecification.
+func (in *APISpecification) DeepCopy() *APISpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(APISpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APISpecification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APISpecificationAPISpec) DeepCopyInto(out *APISpecificationAPISpec) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpecificationAPISpec.
+func (in *APISpecificationAPISpec) DeepCopy() *APISpecificationAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APISpecificationAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APISpecificationList) DeepCopyInto(out *APISpecificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APISpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpecificationList.
+func (in *APISpecificationList) DeepCopy() *APISpecificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(APISpecificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APISpecificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APISpecificationSpec) DeepCopyInto(out *APISpecificationSpec) {
+	*out = *in
+	in.APIRef.DeepCopyInto(&out.APIRef)
+	in.APISpec.DeepCopyInto(&out.APISpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpecificationSpec.
+func (in *APISpecificationSpec) DeepCopy() *APISpecificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APISpecificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APISpecificationStatus) DeepCopyInto(out *APISpecificationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.KonnectEntityStatus.DeepCopyInto(&out.KonnectEntityStatus)
+	if in.ApiID != nil {
+		in, out := &in.ApiID, &out.ApiID
+		*out = new(KonnectEntityRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpecificationStatus.
+func (in *APISpecificationStatus) DeepCopy() *APISpecificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APISpecificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIGatewayRedisAWSAuthentication) DeepCopyInto(out *AIGatewayRedisAWSAuthentication) {
 	*out = *in
@@ -6804,7 +7132,32 @@ func (in *Colors) DeepCopy() *Colors {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+func (in *ConfigMapDataSource) DeepCopyInto(out *ConfigMapDataSource) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapDataSource.
+func (in *ConfigMapDataSource) DeepCopy() *ConfigMapDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
 	*out = *in
 }
 
@@ -7196,6 +7549,70 @@ func (in *KonnectAIGatewayStatus) DeepCopy() *KonnectAIGatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectAPI) DeepCopyInto(out *KonnectAPI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectAPI.
+func (in *KonnectAPI) DeepCopy() *KonnectAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectAPI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectAPIAPISpec) DeepCopyInto(out *KonnectAPIAPISpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Slug != nil {
+		in, out := &in.Slug, &out.Slug
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectAPIAPISpec.
+func (in *KonnectAPIAPISpec) DeepCopy() *KonnectAPIAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectAPIAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonnectAPIAuthConfiguration) DeepCopyInto(out *KonnectAPIAuthConfiguration) {
 	*out = *in
@@ -7297,6 +7714,78 @@ func (in *KonnectAPIAuthConfigurationStatus) DeepCopy() *KonnectAPIAuthConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectAPIList) DeepCopyInto(out *KonnectAPIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonnectAPI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectAPIList.
+func (in *KonnectAPIList) DeepCopy() *KonnectAPIList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectAPIList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectAPIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectAPISpec) DeepCopyInto(out *KonnectAPISpec) {
+	*out = *in
+	in.KonnectConfiguration.DeepCopyInto(&out.KonnectConfiguration)
+	in.APISpec.DeepCopyInto(&out.APISpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectAPISpec.
+func (in *KonnectAPISpec) DeepCopy() *KonnectAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectAPIStatus) DeepCopyInto(out *KonnectAPIStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectAPIStatus.
+func (in *KonnectAPIStatus) DeepCopy() *KonnectAPIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectAPIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonnectCloudGatewayDataPlaneGroupConfiguration) DeepCopyInto(out *KonnectCloudGatewayDataPlaneGroupConfiguration) {
 	*out = *in
@@ -8294,6 +8783,495 @@ func (in *KonnectGatewayControlPlaneStatus) DeepCopy() *KonnectGatewayControlPla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectRoleAssignment) DeepCopyInto(out *KonnectRoleAssignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectRoleAssignment.
+func (in *KonnectRoleAssignment) DeepCopy() *KonnectRoleAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectRoleAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectRoleAssignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectRoleAssignmentList) DeepCopyInto(out *KonnectRoleAssignmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonnectRoleAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectRoleAssignmentList.
+func (in *KonnectRoleAssignmentList) DeepCopy() *KonnectRoleAssignmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectRoleAssignmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectRoleAssignmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectRoleAssignmentSpec) DeepCopyInto(out *KonnectRoleAssignmentSpec) {
+	*out = *in
+	out.Subject = in.Subject
+	in.ControlPlaneRef.DeepCopyInto(&out.ControlPlaneRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectRoleAssignmentSpec.
+func (in *KonnectRoleAssignmentSpec) DeepCopy() *KonnectRoleAssignmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectRoleAssignmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectRoleAssignmentStatus) DeepCopyInto(out *KonnectRoleAssignmentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectRoleAssignmentStatus.
+func (in *KonnectRoleAssignmentStatus) DeepCopy() *KonnectRoleAssignmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectRoleAssignmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectRoleAssignmentSubject) DeepCopyInto(out *KonnectRoleAssignmentSubject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectRoleAssignmentSubject.
+func (in *KonnectRoleAssignmentSubject) DeepCopy() *KonnectRoleAssignmentSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectRoleAssignmentSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccount) DeepCopyInto(out *KonnectSystemAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccount.
+func (in *KonnectSystemAccount) DeepCopy() *KonnectSystemAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectSystemAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAPISpec) DeepCopyInto(out *KonnectSystemAccountAPISpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAPISpec.
+func (in *KonnectSystemAccountAPISpec) DeepCopy() *KonnectSystemAccountAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAccessToken) DeepCopyInto(out *KonnectSystemAccountAccessToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAccessToken.
+func (in *KonnectSystemAccountAccessToken) DeepCopy() *KonnectSystemAccountAccessToken {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAccessToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectSystemAccountAccessToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAccessTokenInfo) DeepCopyInto(out *KonnectSystemAccountAccessTokenInfo) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAccessTokenInfo.
+func (in *KonnectSystemAccountAccessTokenInfo) DeepCopy() *KonnectSystemAccountAccessTokenInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAccessTokenInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAccessTokenList) DeepCopyInto(out *KonnectSystemAccountAccessTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonnectSystemAccountAccessToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAccessTokenList.
+func (in *KonnectSystemAccountAccessTokenList) DeepCopy() *KonnectSystemAccountAccessTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAccessTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectSystemAccountAccessTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAccessTokenSpec) DeepCopyInto(out *KonnectSystemAccountAccessTokenSpec) {
+	*out = *in
+	out.SystemAccountRef = in.SystemAccountRef
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAccessTokenSpec.
+func (in *KonnectSystemAccountAccessTokenSpec) DeepCopy() *KonnectSystemAccountAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountAccessTokenStatus) DeepCopyInto(out *KonnectSystemAccountAccessTokenStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CurrentToken != nil {
+		in, out := &in.CurrentToken, &out.CurrentToken
+		*out = new(KonnectSystemAccountAccessTokenInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousTokens != nil {
+		in, out := &in.PreviousTokens, &out.PreviousTokens
+		*out = make([]KonnectSystemAccountAccessTokenInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = new(metav1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountAccessTokenStatus.
+func (in *KonnectSystemAccountAccessTokenStatus) DeepCopy() *KonnectSystemAccountAccessTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountAccessTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountList) DeepCopyInto(out *KonnectSystemAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonnectSystemAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountList.
+func (in *KonnectSystemAccountList) DeepCopy() *KonnectSystemAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectSystemAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountSpec) DeepCopyInto(out *KonnectSystemAccountSpec) {
+	*out = *in
+	in.KonnectConfiguration.DeepCopyInto(&out.KonnectConfiguration)
+	out.APISpec = in.APISpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountSpec.
+func (in *KonnectSystemAccountSpec) DeepCopy() *KonnectSystemAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectSystemAccountStatus) DeepCopyInto(out *KonnectSystemAccountStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectSystemAccountStatus.
+func (in *KonnectSystemAccountStatus) DeepCopy() *KonnectSystemAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectSystemAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectTeam) DeepCopyInto(out *KonnectTeam) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectTeam.
+func (in *KonnectTeam) DeepCopy() *KonnectTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectTeam) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectTeamAPISpec) DeepCopyInto(out *KonnectTeamAPISpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectTeamAPISpec.
+func (in *KonnectTeamAPISpec) DeepCopy() *KonnectTeamAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectTeamAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectTeamList) DeepCopyInto(out *KonnectTeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KonnectTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectTeamList.
+func (in *KonnectTeamList) DeepCopy() *KonnectTeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectTeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KonnectTeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectTeamSpec) DeepCopyInto(out *KonnectTeamSpec) {
+	*out = *in
+	in.KonnectConfiguration.DeepCopyInto(&out.KonnectConfiguration)
+	in.APISpec.DeepCopyInto(&out.APISpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectTeamSpec.
+func (in *KonnectTeamSpec) DeepCopy() *KonnectTeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectTeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectTeamStatus) DeepCopyInto(out *KonnectTeamStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.KonnectEntityStatus = in.KonnectEntityStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectTeamStatus.
+func (in *KonnectTeamStatus) DeepCopy() *KonnectTeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectTeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KonnectTransitGatewayAPISpec) DeepCopyInto(out *KonnectTransitGatewayAPISpec) {
 	*out = *in
@@ -49,7 +49,9 @@ type ConfigMapKeyRef struct {
 	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key,omitzero"`
 
-	// Namespace is the namespace of the ConfigMap
+	// Namespace is the namespace of the ConfigMap. It defaults to the namespace
+	// of the referrer. Other namespaces must be permitted by a KongReferenceGrant
+	// in that namespace.
 	//
 	// +optional
 	// +kubebuilder:validation:MaxLength=63
@@ -103,6 +105,41 @@ type SensitiveDataSource struct {
 	SecretRef *SensitiveDataSecretRef `json:"secretRef,omitempty"`
 }
 
+// ConfigMapDataSourceType is the type of source for data that can be sourced from a ConfigMap.
+type ConfigMapDataSourceType string
+
+const (
+	// ConfigMapDataSourceTypeInline indicates that the data is provided inline in the APISpec.
+	ConfigMapDataSourceTypeInline ConfigMapDataSourceType = "inline"
+	// ConfigMapDataSourceTypeConfigMapRef indicates that the data is sourced from a Kubernetes ConfigMap.
+	ConfigMapDataSourceTypeConfigMapRef ConfigMapDataSourceType = "configMapRef"
+)
+
+// ConfigMapDataSource holds a string value that can be provided
+// either inline or sourced from a Kubernetes ConfigMap.
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'inline' ? has(self.value) : has(self.configMapRef)",message="value required when type=inline; configMapRef required when type=configMapRef"
+type ConfigMapDataSource struct {
+	// Type indicates the source of the data: 'inline' or 'configMapRef'.
+	//
+	// +kubebuilder:validation:Enum=inline;configMapRef
+	// +kubebuilder:default=inline
+	Type ConfigMapDataSourceType `json:"type"`
+
+	// Value contains the data provided inline.
+	// Required when type is 'inline'.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1048576
+	Value *string `json:"value,omitempty"`
+
+	// ConfigMapRef is a reference to a key in a Kubernetes ConfigMap containing the data.
+	// Required when type is 'configMapRef'.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapKeyRef `json:"configMapRef,omitempty"`
+}
+
 // flattenSDKUnions recursively flattens nested discriminated-union shapes.
 // Object-valued members are rewritten from {"<disc>": "X", "X": {...}}
 // to {"<disc>": "X", ...}, while scalar and array members are rewritten to
@@ -175,9 +212,9 @@ func nestedSDKUnionMemberForKey(object map[string]any, key string) (string, any,
 }
 
 // flattenSensitiveData recursively replaces any SensitiveDataSource (or
-// dedicated per-field DataSource) JSON object shape
-// {"type": "inline|secretRef", "value": X, ...} with the bare value X,
-// translating the CRD wire format to the Konnect SDK wire format which
+// dedicated per-field DataSource, or ConfigMapDataSource) JSON object shape
+// {"type": "inline|secretRef|configMapRef", "value": X, ...} with the bare
+// value X, translating the CRD wire format to the Konnect SDK wire format which
 // expects plain values (of whatever type X is) for sensitive fields.
 func flattenSensitiveData(v any) any {
 	switch x := v.(type) {
@@ -186,7 +223,7 @@ func flattenSensitiveData(v any) any {
 			x[k] = flattenSensitiveData(val)
 		}
 		typ, _ := x["type"].(string)
-		if typ != "inline" && typ != "secretRef" {
+		if typ != "inline" && typ != "secretRef" && typ != "configMapRef" {
 			return x
 		}
 		if rawVal, hasVal := x["value"]; hasVal {
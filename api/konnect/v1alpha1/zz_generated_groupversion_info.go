@@ -27,12 +27,20 @@ func addKnownTypesGenerated(scheme *runtime.Scheme) error {
 		&AIGatewayModelProviderList{},
 		&AIGatewayPolicy{},
 		&AIGatewayPolicyList{},
+		&APISpecification{},
+		&APISpecificationList{},
 		&KonnectAIGateway{},
 		&KonnectAIGatewayList{},
+		&KonnectAPI{},
+		&KonnectAPIList{},
 		&KonnectConfigStore{},
 		&KonnectConfigStoreList{},
 		&KonnectEventGateway{},
 		&KonnectEventGatewayList{},
+		&KonnectSystemAccount{},
+		&KonnectSystemAccountList{},
+		&KonnectTeam{},
+		&KonnectTeamList{},
 		&Portal{},
 		&PortalList{},
 		&PortalCustomDomain{},
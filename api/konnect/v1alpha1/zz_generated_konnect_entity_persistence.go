@@ -47,11 +47,21 @@ func (*AIGatewayPolicy) PersistsKonnectID() bool {
 	return true
 }
 
+// PersistsKonnectID reports whether APISpecification persists a Konnect ID in status.
+func (*APISpecification) PersistsKonnectID() bool {
+	return true
+}
+
 // PersistsKonnectID reports whether KonnectAIGateway persists a Konnect ID in status.
 func (*KonnectAIGateway) PersistsKonnectID() bool {
 	return true
 }
 
+// PersistsKonnectID reports whether KonnectAPI persists a Konnect ID in status.
+func (*KonnectAPI) PersistsKonnectID() bool {
+	return true
+}
+
 // PersistsKonnectID reports whether KonnectConfigStore persists a Konnect ID in status.
 func (*KonnectConfigStore) PersistsKonnectID() bool {
 	return true
@@ -62,6 +72,16 @@ func (*KonnectEventGateway) PersistsKonnectID() bool {
 	return true
 }
 
+// PersistsKonnectID reports whether KonnectSystemAccount persists a Konnect ID in status.
+func (*KonnectSystemAccount) PersistsKonnectID() bool {
+	return true
+}
+
+// PersistsKonnectID reports whether KonnectTeam persists a Konnect ID in status.
+func (*KonnectTeam) PersistsKonnectID() bool {
+	return true
+}
+
 // PersistsKonnectID reports whether Portal persists a Konnect ID in status.
 func (*Portal) PersistsKonnectID() bool {
 	return true
@@ -99,6 +99,22 @@ const (
 	// yet programmed in Konnect.
 	KonnectAIGatewayRefReasonNotProgrammed = "NotProgrammed"
 
+	// KonnectAPIRefValidConditionType is the type of the condition that indicates
+	// whether the KonnectAPI reference is valid and points to an existing
+	// KonnectAPI.
+	KonnectAPIRefValidConditionType = "KonnectAPIRefValid"
+
+	// KonnectAPIRefReasonValid is the reason used with the KonnectAPIRefValid
+	// condition type indicating that the KonnectAPI reference is valid.
+	KonnectAPIRefReasonValid = "Valid"
+	// KonnectAPIRefReasonInvalid is the reason used with the KonnectAPIRefValid
+	// condition type indicating that the KonnectAPI reference is invalid.
+	KonnectAPIRefReasonInvalid = "Invalid"
+	// KonnectAPIRefReasonNotProgrammed is the reason used with the KonnectAPIRefValid
+	// condition type indicating that the referenced KonnectAPI exists but is not
+	// yet programmed in Konnect.
+	KonnectAPIRefReasonNotProgrammed = "NotProgrammed"
+
 	// PortalRefValidConditionType is the type of the condition that indicates
 	// whether the Portal reference is valid and points to an existing
 	// Portal.
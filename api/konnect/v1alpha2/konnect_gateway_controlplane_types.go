@@ -84,6 +84,13 @@ type KonnectGatewayControlPlaneSpec struct {
 	//
 	// +optional
 	KonnectConfiguration ControlPlaneKonnectConfiguration `json:"konnect,omitempty"`
+
+	// OrphanedEntities configures the periodic detection (and optional removal)
+	// of entities in this control plane that were created by the operator but
+	// whose Kubernetes objects no longer exist.
+	//
+	// +optional
+	OrphanedEntities *OrphanedEntitiesConfiguration `json:"orphanedEntities,omitempty"`
 }
 
 // MirrorSpec contains the Konnect Mirror configuration.
@@ -137,6 +144,14 @@ type KonnectGatewayControlPlaneStatus struct {
 	//
 	// +optional
 	Endpoints *KonnectEndpoints `json:"konnectEndpoints,omitempty"`
+
+	// OrphanedEntities reports the entities found in the control plane that
+	// carry the operator's Kubernetes UID tag but are not backed by an existing
+	// Kubernetes object anymore. It is only populated when spec.orphanedEntities
+	// is enabled.
+	//
+	// +optional
+	OrphanedEntities *OrphanedEntitiesStatus `json:"orphanedEntities,omitempty"`
 }
 
 // GetKonnectLabels gets the Konnect Labels from object's spec.
@@ -0,0 +1,148 @@
+package v1alpha2
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrphanedEntitiesPolicy defines what the operator does with orphaned entities
+// found in a Konnect control plane.
+type OrphanedEntitiesPolicy string
+
+const (
+	// OrphanedEntitiesPolicyDisabled disables the orphaned entities sweeper.
+	OrphanedEntitiesPolicyDisabled OrphanedEntitiesPolicy = "Disabled"
+	// OrphanedEntitiesPolicyReport makes the operator only report orphaned entities
+	// in the control plane status and metrics.
+	OrphanedEntitiesPolicyReport OrphanedEntitiesPolicy = "Report"
+	// OrphanedEntitiesPolicyDelete makes the operator report orphaned entities and
+	// delete them from Konnect once the grace period has elapsed.
+	OrphanedEntitiesPolicyDelete OrphanedEntitiesPolicy = "Delete"
+)
+
+const (
+	// DefaultOrphanedEntitiesSweepInterval is the default interval between two
+	// consecutive sweeps of a control plane.
+	DefaultOrphanedEntitiesSweepInterval = 10 * time.Minute
+	// DefaultOrphanedEntitiesGracePeriod is the default time an entity has to be
+	// continuously detected as orphaned before it is deleted.
+	DefaultOrphanedEntitiesGracePeriod = 24 * time.Hour
+)
+
+// OrphanedEntitiesConfiguration configures the detection and removal of orphaned
+// entities in a Konnect control plane.
+//
+// An entity is considered orphaned when it carries the "k8s-uid" tag set by the
+// operator, but the Kubernetes object described by its "k8s-*" tags does not
+// exist anymore, has a different UID, or tracks a different Konnect ID in its status.
+type OrphanedEntitiesConfiguration struct {
+	// Policy defines what the operator does with orphaned entities.
+	//
+	// +optional
+	// +kubebuilder:default=Disabled
+	// +kubebuilder:validation:Enum=Disabled;Report;Delete
+	Policy OrphanedEntitiesPolicy `json:"policy,omitempty"`
+
+	// Interval is the interval between two consecutive sweeps of the control plane.
+	// Defaults to 10m.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:message="interval must be at least 1m",rule="duration(self) >= duration('1m')"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// GracePeriod is the time an entity has to be continuously detected as orphaned
+	// before it is deleted. Only applicable when policy is set to Delete.
+	// Defaults to 24h.
+	//
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// GetPolicy returns the configured policy, defaulting to OrphanedEntitiesPolicyDisabled.
+func (c *OrphanedEntitiesConfiguration) GetPolicy() OrphanedEntitiesPolicy {
+	if c == nil || c.Policy == "" {
+		return OrphanedEntitiesPolicyDisabled
+	}
+	return c.Policy
+}
+
+// GetInterval returns the configured sweep interval or its default.
+func (c *OrphanedEntitiesConfiguration) GetInterval() metav1.Duration {
+	if c == nil || c.Interval == nil {
+		return metav1.Duration{Duration: DefaultOrphanedEntitiesSweepInterval}
+	}
+	return *c.Interval
+}
+
+// GetGracePeriod returns the configured grace period or its default.
+func (c *OrphanedEntitiesConfiguration) GetGracePeriod() metav1.Duration {
+	if c == nil || c.GracePeriod == nil {
+		return metav1.Duration{Duration: DefaultOrphanedEntitiesGracePeriod}
+	}
+	return *c.GracePeriod
+}
+
+// OrphanedEntitiesStatus reports the orphaned entities found in a Konnect control plane.
+type OrphanedEntitiesStatus struct {
+	// LastSweepTime is the time of the last completed sweep.
+	//
+	// +optional
+	LastSweepTime *metav1.Time `json:"lastSweepTime,omitempty"`
+
+	// Count is the number of orphaned entities found during the last sweep.
+	//
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// Entities lists the orphaned entities found during the last sweep.
+	// The list is capped at 100 entries, Count always reflects the total.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Entities []OrphanedEntity `json:"entities,omitempty"`
+}
+
+// OrphanedEntity describes a single orphaned Konnect entity.
+type OrphanedEntity struct {
+	// Type is the Konnect entity type, e.g. "Service" or "Route".
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=64
+	Type string `json:"type"`
+
+	// ID is the Konnect ID of the entity.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=256
+	ID string `json:"id"`
+
+	// Kind is the kind of the Kubernetes object that the entity was created for.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace is the namespace of the Kubernetes object that the entity was created for.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the Kubernetes object that the entity was created for.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// UID is the UID of the Kubernetes object that the entity was created for.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=128
+	UID string `json:"uid,omitempty"`
+
+	// FirstDetectedTime is the time at which the entity was first detected as orphaned.
+	//
+	// +required
+	FirstDetectedTime metav1.Time `json:"firstDetectedTime"`
+}
@@ -613,6 +613,11 @@ func (in *KonnectGatewayControlPlaneSpec) DeepCopyInto(out *KonnectGatewayContro
 		copy(*out, *in)
 	}
 	in.KonnectConfiguration.DeepCopyInto(&out.KonnectConfiguration)
+	if in.OrphanedEntities != nil {
+		in, out := &in.OrphanedEntities, &out.OrphanedEntities
+		*out = new(OrphanedEntitiesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectGatewayControlPlaneSpec.
@@ -641,6 +646,11 @@ func (in *KonnectGatewayControlPlaneStatus) DeepCopyInto(out *KonnectGatewayCont
 		*out = new(KonnectEndpoints)
 		**out = **in
 	}
+	if in.OrphanedEntities != nil {
+		in, out := &in.OrphanedEntities, &out.OrphanedEntities
+		*out = new(OrphanedEntitiesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectGatewayControlPlaneStatus.
@@ -684,6 +694,73 @@ func (in *MirrorSpec) DeepCopy() *MirrorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedEntitiesConfiguration) DeepCopyInto(out *OrphanedEntitiesConfiguration) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedEntitiesConfiguration.
+func (in *OrphanedEntitiesConfiguration) DeepCopy() *OrphanedEntitiesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedEntitiesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedEntitiesStatus) DeepCopyInto(out *OrphanedEntitiesStatus) {
+	*out = *in
+	if in.LastSweepTime != nil {
+		in, out := &in.LastSweepTime, &out.LastSweepTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Entities != nil {
+		in, out := &in.Entities, &out.Entities
+		*out = make([]OrphanedEntity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedEntitiesStatus.
+func (in *OrphanedEntitiesStatus) DeepCopy() *OrphanedEntitiesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedEntitiesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedEntity) DeepCopyInto(out *OrphanedEntity) {
+	*out = *in
+	in.FirstDetectedTime.DeepCopyInto(&out.FirstDetectedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedEntity.
+func (in *OrphanedEntity) DeepCopy() *OrphanedEntity {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedEntity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
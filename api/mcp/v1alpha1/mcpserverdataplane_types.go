@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 )
 
 // MCPServerDataPlane is the Schema for the MCP Server data planes API.
@@ -102,6 +104,19 @@ type DeploymentOptions struct {
 	//
 	// +optional
 	PodTemplateSpec MCPServerDataPlanePodTemplateSpec `json:"podTemplateSpec,omitzero"`
+
+	// Hardened controls the security settings the operator applies to the
+	// MCP server Pods. With enabled, a hardened security context (non-root user,
+	// read-only root filesystem, dropped capabilities) is applied to the
+	// containers. With baseline and restricted, the Pods comply with the Pod
+	// Security Standard of the same name.
+	//
+	// Changing this on an existing MCPServerDataPlane causes a rolling restart of
+	// its Pods.
+	//
+	// +optional
+	// +kubebuilder:default=disabled
+	Hardened commonv1alpha1.HardeningState `json:"hardened,omitempty"`
 }
 
 // MCPServerDataPlanePodTemplateSpec defines the pod template spec for the
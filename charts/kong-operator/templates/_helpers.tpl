@@ -91,12 +91,31 @@ The dict maps raw env variable key to the suggested variable path.
 {{- $envsSetByVars := dict -}}
 {{- $_ := set $envsSetByVars "KONG_OPERATOR_ENABLE_CONTROLPLANE_CONFIG_DUMP" "Values.enableControlplaneConfigDump" -}}
 {{- $_ := set $envsSetByVars "KONG_OPERATOR_CONTROLPLANE_CONFIG_DUMP_BIND_ADDRESS" "Values.controlplaneConfigDumpPort" -}}
+{{- $_ := set $envsSetByVars "KONG_OPERATOR_ENABLE_METRICS_ADAPTER" "Values.metricsAdapter.enabled" -}}
+{{- $_ := set $envsSetByVars "KONG_OPERATOR_METRICS_ADAPTER_BIND_ADDRESS" "Values.metricsAdapter.port" -}}
+{{- $_ := set $envsSetByVars "KONG_OPERATOR_ENABLE_OPENTELEMETRY" "Values.opentelemetry.enabled" -}}
+{{- $_ := set $envsSetByVars "KONG_OPERATOR_OPENTELEMETRY_ENDPOINT" "Values.opentelemetry.endpoint" -}}
+{{- $_ := set $envsSetByVars "KONG_OPERATOR_OPENTELEMETRY_INSECURE" "Values.opentelemetry.insecure" -}}
 
 {{- if .Values.enableControlplaneConfigDump -}}
 {{- $_ := set $defaultEnv "KONG_OPERATOR_ENABLE_CONTROLPLANE_CONFIG_DUMP" "true" -}}
 {{- $_ := set $defaultEnv "KONG_OPERATOR_CONTROLPLANE_CONFIG_DUMP_BIND_ADDRESS" (print ":" .Values.controlplaneConfigDumpPort) -}}
 {{- end -}}
 
+{{- if .Values.metricsAdapter.enabled -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_ENABLE_METRICS_ADAPTER" "true" -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_METRICS_ADAPTER_BIND_ADDRESS" (print ":" .Values.metricsAdapter.port) -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_METRICS_ADAPTER_CERT_DIR" "/tmp/k8s-metrics-adapter/serving-certs" -}}
+{{- end -}}
+
+{{- if .Values.opentelemetry.enabled -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_ENABLE_OPENTELEMETRY" "true" -}}
+{{- if .Values.opentelemetry.endpoint -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_OPENTELEMETRY_ENDPOINT" .Values.opentelemetry.endpoint -}}
+{{- end -}}
+{{- $_ := set $defaultEnv "KONG_OPERATOR_OPENTELEMETRY_INSECURE" (toString .Values.opentelemetry.insecure) -}}
+{{- end -}}
+
 {{- range $key, $val := .Values.env -}}
   {{- $var := printf "KONG_OPERATOR_%s" ( upper $key ) -}}
   {{- if hasKey $envsSetByVars $var -}}
@@ -147,6 +166,14 @@ The dict maps raw env variable key to the suggested variable path.
 {{ template "kong.webhookServiceName" . }}-validating-server-cert
 {{- end -}}
 
+{{- define "kong.metricsAdapterServiceName" -}}
+{{ template "kong.fullname" . }}-metrics-adapter
+{{- end -}}
+
+{{- define "kong.metricsAdapterCertSecretName" -}}
+{{ template "kong.metricsAdapterServiceName" . }}-server-cert
+{{- end -}}
+
 # Leave kong-operator-ca without .Release.Name prefix for backward compatibility,
 # to reuse existing secret in case of upgrades. Ensure that namespace is configured
 # only when secretNamespace is set.
@@ -180,6 +207,15 @@ The dict maps raw env variable key to the suggested variable path.
     defaultMode: 420
     secretName: {{ template "kong.webhookValidatingCertSecretName" . }}
 {{ end }}
+{{ if .Values.metricsAdapter.enabled }}
+{{- /* Depending on the global.webhooks.options.certManager.enabled being true or false */ -}}
+{{- /* certificate below will either be sourced from chart generated Secret */ -}}
+{{- /* or from cert-manager generated Secret */ -}}
+- name: metrics-adapter-certs
+  secret:
+    defaultMode: 420
+    secretName: {{ template "kong.metricsAdapterCertSecretName" . }}
+{{ end }}
 - name: pod-labels
   downwardAPI:
     items:
@@ -202,6 +238,11 @@ The dict maps raw env variable key to the suggested variable path.
   mountPath: /tmp/k8s-webhook-server/serving-certs/validating-admission-webhook
   readOnly: true
 {{ end }}
+{{ if .Values.metricsAdapter.enabled }}
+- name: metrics-adapter-certs
+  mountPath: /tmp/k8s-metrics-adapter/serving-certs
+  readOnly: true
+{{ end }}
 - name: pod-labels
   mountPath: /etc/podinfo
 {{- end }}
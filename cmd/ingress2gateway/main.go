@@ -0,0 +1,188 @@
+/*
+Copyright 2026 Kong Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command ingress2gateway converts Ingresses configured with the konghq.com
+// annotations to Gateway API routes and Kong resources.
+//
+// Ingresses are read from the YAML files given with -f or from the cluster.
+// The converted resources are written as YAML and the report of what couldn't
+// be converted as-is is written to stderr. With -compare, the Kong
+// configurations translated from the Ingresses and from the converted
+// resources are compared and the command fails when they differ.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/pkg/ingress2gateway"
+	"github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/scheme"
+)
+
+type fileFlags []string
+
+func (f *fileFlags) String() string { return strings.Join(*f, ",") }
+
+func (f *fileFlags) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var (
+		files          fileFlags
+		kubeconfig     string
+		namespace      string
+		output         string
+		compare        bool
+		kongVersion    string
+		opts           ingress2gateway.Options
+		defaultVersion = ingress2gateway.DefaultKongVersion.String()
+	)
+	fs := flag.NewFlagSet("ingress2gateway", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Var(&files, "f", "YAML file with the resources to convert, - for stdin. Can be repeated. When not set, the resources are read from the cluster.")
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file used to read the resources from the cluster.")
+	fs.StringVar(&namespace, "namespace", "", "Namespace to read the resources from. Defaults to all namespaces.")
+	fs.StringVar(&opts.IngressClass, "ingress-class", "kong", "Class of the Ingresses to convert.")
+	fs.StringVar(&opts.GatewayName, "gateway-name", ingress2gateway.DefaultGatewayName, "Name of the Gateway the converted routes are attached to.")
+	fs.StringVar(&opts.GatewayNamespace, "gateway-namespace", "", "Namespace of the Gateway the converted routes are attached to. Defaults to the namespace of each route.")
+	fs.StringVar(&output, "o", "", "File to write the converted resources to. Defaults to stdout.")
+	fs.BoolVar(&compare, "compare", false, "Compare the Kong configurations translated from the Ingresses and from the converted resources and fail when they differ.")
+	fs.StringVar(&kongVersion, "kong-version", defaultVersion, "Version of Kong the configurations are translated for with -compare.")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	version, err := semver.Parse(kongVersion)
+	if err != nil {
+		return fmt.Errorf("invalid -kong-version: %w", err)
+	}
+	opts.KongVersion = version
+
+	objs, err := readObjects(ctx, files, stdin, kubeconfig, namespace)
+	if err != nil {
+		return err
+	}
+
+	result, err := ingress2gateway.Convert(objs, opts)
+	if err != nil {
+		return err
+	}
+
+	out := stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := ingress2gateway.WriteYAML(out, result.Objects()); err != nil {
+		return err
+	}
+	if len(result.Report) > 0 {
+		if err := result.Report.Write(stderr); err != nil {
+			return err
+		}
+	}
+
+	if !compare {
+		return nil
+	}
+	comparison, err := ingress2gateway.Compare(logr.Discard(), objs, result, opts)
+	if err != nil {
+		return err
+	}
+	if err := comparison.Write(stderr); err != nil {
+		return err
+	}
+	if !comparison.Equal() {
+		return errors.New("the Kong configurations translated from the Ingresses and from the converted resources differ")
+	}
+	return nil
+}
+
+func readObjects(ctx context.Context, files []string, stdin io.Reader, kubeconfig, namespace string) ([]client.Object, error) {
+	if len(files) == 0 {
+		restCfg, err := restConfig(kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+		cl, err := client.New(restCfg, client.Options{Scheme: scheme.Get()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+		}
+		return ingress2gateway.ListObjects(ctx, cl, namespace)
+	}
+
+	var objs []client.Object
+	for _, file := range files {
+		r := stdin
+		if file != "-" {
+			f, err := os.Open(file)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			r = f
+		}
+		fileObjs, err := ingress2gateway.ReadObjects(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		objs = append(objs, fileObjs...)
+	}
+	return objs, nil
+}
+
+func restConfig(kubeconfig string) (*rest.Config, error) {
+	if kubeconfig != "" {
+		restCfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build REST config from kubeconfig: %w", err)
+		}
+		return restCfg, nil
+	}
+	restCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST config: %w", err)
+	}
+	return restCfg, nil
+}
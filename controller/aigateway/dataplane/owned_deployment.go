@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/managedfields"
 
 	aigatewayv1alpha1 "github.com/kong/kong-operator/v2/api/aigateway/v1alpha1"
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
 	log "github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
@@ -48,6 +49,8 @@ func (r *Reconciler) ensureDeployment(
 	aigatewaycp *konnectv1alpha1.KonnectAIGateway,
 	certSecretName string,
 ) error {
+	ensurePodSecurityStatus(aigwdp)
+
 	image := resolveImage(aigwdp, consts.DefaultAIGatewayDataPlaneImage)
 	desired, err := buildDeployment(logger, r.TypeConverter, aigwdp, aigatewaycp, image, certSecretName)
 	if err != nil {
@@ -76,6 +79,15 @@ func (r *Reconciler) ensureDeployment(
 	return nil
 }
 
+// hardeningState returns the hardening level of the AIGatewayDataPlane's Pods.
+// It defaults to enabled, as these Pods have always been hardened.
+func hardeningState(aigwdp *aigatewayv1alpha1.AIGatewayDataPlane) commonv1alpha1.HardeningState {
+	if aigwdp.Spec.Deployment == nil || aigwdp.Spec.Deployment.Hardened == "" {
+		return commonv1alpha1.HardeningStateEnabled
+	}
+	return aigwdp.Spec.Deployment.Hardened
+}
+
 // resolveImage determines the AI Gateway container image using the following priority:
 //  1. User-specified image in spec.deployment.podTemplateSpec (container named "aigw")
 //  2. RELATED_IMAGE_AIGW environment variable
@@ -188,7 +200,7 @@ func generateBaseDeployment(
 		},
 		ReadinessProbe: k8sresources.GenerateDataPlaneReadinessProbe(consts.DataPlaneStatusReadyEndpoint),
 	}
-	container, volumes := k8sresources.HardenContainerWithSecurityContext(container, k8sresources.DataPlaneTypeAIGateway)
+	container, volumes := k8sresources.HardenContainer(container, k8sresources.DataPlaneTypeAIGateway, hardeningState(aigwdp))
 
 	volumes = append(
 		volumes,
@@ -233,6 +245,8 @@ func generateBaseDeployment(
 		},
 	}
 
+	k8sresources.HardenPodSpec(&d.Spec.Template.Spec, hardeningState(aigwdp))
+
 	k8sutils.SetOwnerForObject(d, aigwdp)
 	k8sresources.LabelObjectAsAIGatewayDataPlaneManaged(d)
 	k8sresources.LabelObjectAsAIGatewayDataPlaneManaged(&d.Spec.Template)
@@ -27,10 +27,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	aigatewayv1alpha1 "github.com/kong/kong-operator/v2/api/aigateway/v1alpha1"
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	log "github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
 	controllerpkgssa "github.com/kong/kong-operator/v2/controller/pkg/ssa"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
 )
 
 // ensureReadyStatus computes the Ready condition for an AIGatewayDataPlane.
@@ -44,6 +46,10 @@ func ensureReadyStatus(
 	aigwdp *aigatewayv1alpha1.AIGatewayDataPlane,
 ) error {
 	for _, c := range aigwdp.Status.Conditions {
+		// PodSecurityCompliant is informational and doesn't affect readiness.
+		if c.Type == string(commonv1alpha1.PodSecurityCompliantType) {
+			continue
+		}
 		if c.Type != string(aigatewayv1alpha1.ReadyType) && c.Status == metav1.ConditionFalse {
 			apimeta.SetStatusCondition(&aigwdp.Status.Conditions, metav1.Condition{
 				Type:               string(aigatewayv1alpha1.ReadyType),
@@ -96,6 +102,24 @@ func ensureReadyStatus(
 	return nil
 }
 
+// ensurePodSecurityStatus sets the PodSecurityCompliant condition reporting
+// whether the PodTemplateSpec patches of the AIGatewayDataPlane comply with the Pod
+// Security Standard of its hardening level, or removes it when the level
+// doesn't map to one.
+// Status is not patched here; the caller flushes via applyStatus.
+func ensurePodSecurityStatus(aigwdp *aigatewayv1alpha1.AIGatewayDataPlane) {
+	var pts *corev1.PodTemplateSpec
+	if aigwdp.Spec.Deployment != nil {
+		pts = aigwdp.Spec.Deployment.PodTemplateSpec
+	}
+	condition, ok := k8sresources.PodSecurityCondition(pts, hardeningState(aigwdp), aigwdp.Generation)
+	if !ok {
+		apimeta.RemoveStatusCondition(&aigwdp.Status.Conditions, string(commonv1alpha1.PodSecurityCompliantType))
+		return
+	}
+	apimeta.SetStatusCondition(&aigwdp.Status.Conditions, condition)
+}
+
 // applyStatus patches the AIGatewayDataPlane status subresource via SSA.
 func (r *Reconciler) applyStatus(
 	ctx context.Context,
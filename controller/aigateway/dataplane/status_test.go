@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	aigatewayv1alpha1 "github.com/kong/kong-operator/v2/api/aigateway/v1alpha1"
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	managerscheme "github.com/kong/kong-operator/v2/modules/manager/scheme"
 )
 
@@ -130,6 +131,23 @@ func Test_ensureReadyStatus(t *testing.T) {
 			},
 			wantReadyStatus: metav1.ConditionFalse,
 		},
+		{
+			// PodSecurityCompliant is informational: a violation must not flip Ready.
+			name: "PodSecurityCompliant False: Ready=True",
+			preConditions: []metav1.Condition{
+				{
+					Type:               string(commonv1alpha1.PodSecurityCompliantType),
+					Status:             metav1.ConditionFalse,
+					Reason:             string(commonv1alpha1.PodSecurityViolationReason),
+					Message:            "PodTemplateSpec violates the restricted Pod Security Standard: hostNetwork is not allowed",
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+			objects:           []client.Object{deploy(2, 2)},
+			wantReadyStatus:   metav1.ConditionTrue,
+			wantReplicas:      2,
+			wantReadyReplicas: 2,
+		},
 	}
 
 	for _, tc := range tests {
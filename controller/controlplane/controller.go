@@ -42,6 +42,7 @@ import (
 	"github.com/kong/kong-operator/v2/ingress-controller/pkg/manager"
 	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
 	"github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/multiinstance"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 	"github.com/kong/kong-operator/v2/internal/utils/index"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
@@ -117,7 +118,7 @@ func (r *Reconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error
 		)
 	}
 
-	return builder.Complete(reconcile.AsReconciler[*ControlPlane](r.Client, r))
+	return builder.Complete(reconcile.AsReconciler(r.Client, opentelemetry.ObjectReconciler[*ControlPlane]("ControlPlane", r)))
 }
 
 // Reconcile moves the current state of an object to the intended state.
@@ -241,6 +242,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, cp *ControlPlane) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	log.Trace(logger, "retrieving DataPlanes of configuration partitions")
+	partitions, err := r.dataPlanePartitions(ctx, cp)
+	if err != nil {
+		log.Debug(logger, "failed to retrieve dataplanes of configuration partitions", "error", err)
+		return ctrl.Result{}, err
+	}
+
 	log.Trace(logger, "configuring ControlPlane resource")
 
 	log.Trace(logger, "validating ControlPlane's DataPlane status")
@@ -347,7 +355,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, cp *ControlPlane) (ctrl.Resu
 		if _, ok := errors.AsType[multiinstance.InstanceNotFoundError](err); ok {
 			log.Debug(logger, "control plane instance not found, creating new instance")
 			cfgOpts, err := r.constructControlPlaneManagerConfigOptions(
-				logger, cp, &caSecret, mtlsSecret, dataplaneAdminServiceName, dataplaneIngressServiceName,
+				logger, cp, &caSecret, mtlsSecret, dataplaneAdminServiceName, dataplaneIngressServiceName, partitions,
 				r.RestConfig.Burst, r.RestConfig.QPS, validatedWatchNamespaces, konnectExtensionProcessor.GetKonnectConfig(),
 			)
 			if err != nil {
@@ -371,7 +379,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, cp *ControlPlane) (ctrl.Resu
 	} else {
 		// Calculate the hash of config from the ControlPlane spec.
 		cfgOpts, err := r.constructControlPlaneManagerConfigOptions(
-			logger, cp, &caSecret, mtlsSecret, dataplaneAdminServiceName, dataplaneIngressServiceName,
+			logger, cp, &caSecret, mtlsSecret, dataplaneAdminServiceName, dataplaneIngressServiceName, partitions,
 			r.RestConfig.Burst, r.RestConfig.QPS, validatedWatchNamespaces, konnectExtensionProcessor.GetKonnectConfig(),
 		)
 		if err != nil {
@@ -454,6 +462,7 @@ func (r *Reconciler) constructControlPlaneManagerConfigOptions(
 	mtlsSecret *corev1.Secret,
 	dataplaneAdminServiceName string,
 	dataplaneIngressServiceName string,
+	partitions []managercfg.ConfigPartition,
 	apiServerBurst int,
 	apiServerQPS float32,
 	validatedWatchNamespaces []string,
@@ -483,6 +492,7 @@ func (r *Reconciler) constructControlPlaneManagerConfigOptions(
 			Name:      dataplaneAdminServiceName,
 			Namespace: cp.Namespace,
 		}),
+		WithKongAdminServicePartitions(partitions),
 		WithKongAdminServicePortName(consts.DataPlaneAdminServicePortName),
 		WithKongAdminInitializationRetryDelay(5 * time.Second),
 		// We only want to retry once as the constructor can be called multiple times.
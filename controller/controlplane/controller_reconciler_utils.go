@@ -19,8 +19,10 @@ import (
 	operatorv2beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v2beta1"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
 	"github.com/kong/kong-operator/v2/controller/pkg/secrets"
+	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
 	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 	"github.com/kong/kong-operator/v2/pkg/consts"
+	gatewayutils "github.com/kong/kong-operator/v2/pkg/utils/gateway"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
 )
 
@@ -130,6 +132,50 @@ func (r *Reconciler) ensureAdminMTLSCertificateSecret(
 	)
 }
 
+// dataPlanePartitions resolves the admin Services of the DataPlanes of the
+// ControlPlane's configuration partitions.
+func (r *Reconciler) dataPlanePartitions(
+	ctx context.Context,
+	cp *ControlPlane,
+) ([]managercfg.ConfigPartition, error) {
+	partitions := make([]managercfg.ConfigPartition, 0, len(cp.Spec.DataPlane.Partitions))
+	for _, p := range cp.Spec.DataPlane.Partitions {
+		var (
+			dataplane operatorv1beta1.DataPlane
+			dpNN      = k8stypes.NamespacedName{
+				Name:      p.Ref.Name,
+				Namespace: cp.Namespace,
+			}
+		)
+		if err := r.Get(ctx, dpNN, &dataplane); err != nil {
+			return nil, fmt.Errorf("failed to get DataPlane %s of partition %s: %w", dpNN, p.Name, err)
+		}
+		adminServiceName, err := gatewayutils.GetDataPlaneServiceName(ctx, r.Client, &dataplane, consts.DataPlaneAdminServiceLabelValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get admin Service of DataPlane %s of partition %s: %w", dpNN, p.Name, err)
+		}
+		partitions = append(partitions, managercfg.ConfigPartition{
+			Name: p.Name,
+			KongAdminSvc: k8stypes.NamespacedName{
+				Name:      adminServiceName,
+				Namespace: cp.Namespace,
+			},
+			Namespaces: p.Namespaces,
+			Selector:   p.Selector,
+			Listeners: lo.Map(p.Listeners, func(l operatorv2beta1.ControlPlaneDataPlanePartitionListener, _ int) managercfg.GatewayListener {
+				return managercfg.GatewayListener{
+					Gateway: k8stypes.NamespacedName{
+						Name:      l.GatewayName,
+						Namespace: l.GatewayNamespace,
+					},
+					SectionName: lo.FromPtr(l.SectionName),
+				}
+			}),
+		})
+	}
+	return partitions, nil
+}
+
 func (r *Reconciler) validateWatchNamespaceGrants(
 	ctx context.Context,
 	cp *ControlPlane,
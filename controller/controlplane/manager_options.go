@@ -37,6 +37,14 @@ func WithKongAdminService(s types.NamespacedName) managercfg.Opt {
 	}
 }
 
+// WithKongAdminServicePartitions sets the configuration partitions, each with
+// the Kong Admin service of its DataPlane, for the manager.
+func WithKongAdminServicePartitions(partitions []managercfg.ConfigPartition) managercfg.Opt {
+	return func(c *managercfg.Config) {
+		c.KongAdminSvcPartitions = partitions
+	}
+}
+
 // WithKongAdminServicePortName sets the Kong Admin service port name for the manager.
 func WithKongAdminServicePortName(portName string) managercfg.Opt {
 	return func(c *managercfg.Config) {
@@ -171,10 +171,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 // ensureDataPlaneMetricsExtensions ensures that the metrics plugin is enabled for the services
-// specified in the DataPlanePluginOptions.Metrics.ServiceSelector.MatchNames
+// selected by the DataPlaneMetricsExtensions' ServiceSelector, by name or by labels,
 // and that the plugin config is up to date.
-// It also ensures that the plugin is disabled for services that are not in the
-// ServiceSelector.MatchNames.
+// It also ensures that the plugin is disabled for services that are not selected
+// anymore.
 func (r *Reconciler) ensureDataPlaneMetricsExtensions(ctx context.Context, controlplane *gwtypes.ControlPlane) error {
 	logger := log.GetLogger(ctx, "controlplane_dataplanemetrics_extension", r.LoggingMode)
 
@@ -195,11 +195,12 @@ func (r *Reconciler) ensureDataPlaneMetricsExtensions(ctx context.Context, contr
 
 	svcToExt := make(map[types.NamespacedName]*operatorv1alpha1.DataPlaneMetricsExtension)
 	for _, ext := range extensions {
-		for _, svcSS := range ext.Spec.ServiceSelector.MatchNames {
-			svcNN := types.NamespacedName{
-				Name:      svcSS.Name,
-				Namespace: controlplane.Namespace,
-			}
+		svcNNs, err := servicesSelectedByExtension(ctx, r.Client, controlplane.Namespace, &ext)
+		if err != nil {
+			logger.Error(err, "failed to list Services selected by metrics extension", "extension", client.ObjectKeyFromObject(&ext))
+			return errors.Join(append(errs, err)...)
+		}
+		for _, svcNN := range svcNNs {
 			if v, ok := svcToExt[svcNN]; ok {
 				err := fmt.Errorf(
 					"DataPlaneMetricsExtension %v contains service ref %v that is already managed by DataPlaneMetricsExtension %v",
@@ -219,12 +220,12 @@ func (r *Reconciler) ensureDataPlaneMetricsExtensions(ctx context.Context, contr
 
 	// Find all services with GatewayOperatorControlPlaneManagingPluginsLabel
 	// label set to the name of currently reconciled ControlPlane and if they
-	// are not in DataPlanePluginOptions.Metrics.ServiceSelector.MatchNames,
+	// are not selected by any DataPlaneMetricsExtension anymore,
 	// remove the label, annotation and delete the plugin if it still exits.
 	for _, svc := range svcListWithManagedLabel {
 		if _, ok := svcToExt[client.ObjectKeyFromObject(&svc)]; ok {
-			// If the Service name is in one of the extensions ServiceSelector.MatchNames we don't do anything.
-			// We'll enforce the plugin config below where we iterate over matchNames.
+			// If the Service is selected by one of the extensions we don't do anything.
+			// We'll enforce the plugin config below where we iterate over selected Services.
 			continue
 		}
 
@@ -252,7 +253,7 @@ func (r *Reconciler) ensureDataPlaneMetricsExtensions(ctx context.Context, contr
 		}
 	}
 
-	// For each service in DataPlaneMetricsExtensions' ServiceSelector.MatchNames,
+	// For each service selected by the DataPlaneMetricsExtensions,
 	// ensure the Kong Plugin exists and its config is up to date.
 	for svcNN, ext := range svcToExt {
 		svc := corev1.Service{}
@@ -286,6 +287,46 @@ func (r *Reconciler) ensureDataPlaneMetricsExtensions(ctx context.Context, contr
 	return errors.Join(errs...)
 }
 
+// servicesSelectedByExtension returns the Services in the provided namespace
+// which are selected by the DataPlaneMetricsExtension's ServiceSelector, either
+// by their name or by the label selector.
+func servicesSelectedByExtension(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	ext *operatorv1alpha1.DataPlaneMetricsExtension,
+) ([]types.NamespacedName, error) {
+	svcNNs := make([]types.NamespacedName, 0, len(ext.Spec.ServiceSelector.MatchNames))
+	for _, svcSS := range ext.Spec.ServiceSelector.MatchNames {
+		svcNNs = append(svcNNs, types.NamespacedName{
+			Name:      svcSS.Name,
+			Namespace: namespace,
+		})
+	}
+
+	if ext.Spec.ServiceSelector.Selector == nil {
+		return svcNNs, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ext.Spec.ServiceSelector.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Service selector in DataPlaneMetricsExtension %s: %w", client.ObjectKeyFromObject(ext), err)
+	}
+	var svcList corev1.ServiceList
+	if err := cl.List(ctx, &svcList,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list Services for DataPlaneMetricsExtension %s: %w", client.ObjectKeyFromObject(ext), err)
+	}
+	for _, svc := range svcList.Items {
+		svcNN := client.ObjectKeyFromObject(&svc)
+		if !slices.Contains(svcNNs, svcNN) {
+			svcNNs = append(svcNNs, svcNN)
+		}
+	}
+	return svcNNs, nil
+}
+
 func listServicesThatHavePluginsManagedByControlPlane(
 	ctx context.Context,
 	controlplane *gwtypes.ControlPlane,
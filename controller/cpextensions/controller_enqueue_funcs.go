@@ -6,6 +6,7 @@ import (
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -164,16 +165,14 @@ func enqueueControlPlaneForServicesThatHavePluginsConfigured(
 				exts = append(exts, &dpMetricExt)
 			}
 			for _, ext := range exts {
-				for _, svcMatchName := range ext.Spec.ServiceSelector.MatchNames {
-					if svcMatchName.Name == svc.Name && ext.Namespace == svc.Namespace {
-						return []ctrl.Request{
-							{
-								NamespacedName: types.NamespacedName{
-									Name:      controlplane.Name,
-									Namespace: controlplane.Namespace,
-								},
+				if serviceSelectedByExtension(svc, ext) {
+					return []ctrl.Request{
+						{
+							NamespacedName: types.NamespacedName{
+								Name:      controlplane.Name,
+								Namespace: controlplane.Namespace,
 							},
-						}
+						},
 					}
 				}
 			}
@@ -184,6 +183,27 @@ func enqueueControlPlaneForServicesThatHavePluginsConfigured(
 	}
 }
 
+// serviceSelectedByExtension returns true if the Service is selected by the
+// DataPlaneMetricsExtension's ServiceSelector, either by its name or by its labels.
+func serviceSelectedByExtension(svc *corev1.Service, ext *operatorv1alpha1.DataPlaneMetricsExtension) bool {
+	if ext.Namespace != svc.Namespace {
+		return false
+	}
+	for _, svcMatchName := range ext.Spec.ServiceSelector.MatchNames {
+		if svcMatchName.Name == svc.Name {
+			return true
+		}
+	}
+	if ext.Spec.ServiceSelector.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ext.Spec.ServiceSelector.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(svc.Labels))
+}
+
 func enqueueControlPlaneForDataPlaneMetricsExtension(
 	cl client.Client,
 ) handler.MapFunc {
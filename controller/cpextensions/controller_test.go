@@ -284,3 +284,93 @@ func TestReconcile_IgnoresNotFoundOnPluginDelete(t *testing.T) {
 	_, hasAnnotation := gotSvc.Annotations[consts.KongIngressControllerPluginsAnnotation]
 	require.False(t, hasAnnotation, "plugins annotation should be cleared")
 }
+
+func TestReconcile_EnablesPluginForServicesMatchingSelector(t *testing.T) {
+	ctx := t.Context()
+	testScheme := scheme.Get()
+
+	selected := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "selected",
+			Namespace: "default",
+			Labels:    map[string]string{"metrics": "enabled"},
+		},
+	}
+	byName := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "by-name",
+			Namespace: "default",
+		},
+	}
+	notSelected := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "not-selected",
+			Namespace: "default",
+			Labels:    map[string]string{"metrics": "disabled"},
+		},
+	}
+	ext := &operatorv1alpha1.DataPlaneMetricsExtension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "metrics-ext",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.DataPlaneMetricsExtensionSpec{
+			ServiceSelector: operatorv1alpha1.ServiceSelector{
+				// The Service selected both by name and by labels is not a conflict.
+				MatchNames: []operatorv1alpha1.ServiceSelectorEntry{{Name: byName.Name}, {Name: selected.Name}},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"metrics": "enabled"},
+				},
+			},
+			Config: operatorv1alpha1.MetricsConfig{Latency: true, PerConsumer: true},
+		},
+	}
+	cp := &gwtypes.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cp",
+			Namespace: "default",
+			UID:       types.UID("cp-uid"),
+		},
+		Spec: gwtypes.ControlPlaneSpec{
+			Extensions: []commonv1alpha1.ExtensionRef{
+				{
+					Group: operatorv1alpha1.SchemeGroupVersion.Group,
+					Kind:  operatorv1alpha1.DataPlaneMetricsExtensionKind,
+					NamespacedRef: commonv1alpha1.NamespacedRef{
+						Name: ext.Name,
+					},
+				},
+			},
+		},
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(cp, selected, byName, notSelected, ext).
+		Build()
+
+	r := &Reconciler{
+		Client:                          cl,
+		DataPlaneScraperManagerNotifier: noopScraperNotifier{},
+	}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cp)})
+	require.NoError(t, err)
+
+	for _, svc := range []*corev1.Service{selected, byName} {
+		var gotSvc corev1.Service
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(svc), &gotSvc))
+		require.Equal(t, prometheusPluginNameForSvc(svc), gotSvc.Annotations[consts.KongIngressControllerPluginsAnnotation])
+
+		var plugin configurationv1.KongPlugin
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: svc.Namespace, Name: prometheusPluginNameForSvc(svc)}, &plugin))
+		require.JSONEq(t,
+			`{"latency_metrics":true,"bandwidth_metrics":false,"upstream_health_metrics":false,"status_code_metrics":false,"per_consumer":true}`,
+			string(plugin.Config.Raw),
+		)
+	}
+
+	var gotSvc corev1.Service
+	require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(notSelected), &gotSvc))
+	require.NotContains(t, gotSvc.Annotations, consts.KongIngressControllerPluginsAnnotation)
+}
@@ -0,0 +1,304 @@
+package metricsscraper
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// KongMetricNameKongUpstreamLatencyMs is the name of the kong_upstream_latency_ms metric.
+	KongMetricNameKongUpstreamLatencyMs = "kong_upstream_latency_ms"
+	// KongMetricNameKongRequestLatencyMs is the name of the kong_request_latency_ms metric.
+	KongMetricNameKongRequestLatencyMs = "kong_request_latency_ms"
+	// KongMetricNameKongKongLatencyMs is the name of the kong_kong_latency_ms metric.
+	KongMetricNameKongKongLatencyMs = "kong_kong_latency_ms"
+	// KongMetricNameKongBandwidthBytes is the name of the kong_bandwidth_bytes metric.
+	KongMetricNameKongBandwidthBytes = "kong_bandwidth_bytes"
+	// KongMetricNameKongHTTPRequestsTotal is the name of the kong_http_requests_total metric.
+	KongMetricNameKongHTTPRequestsTotal = "kong_http_requests_total"
+	// KongMetricNameKongUpstreamTargetHealth is the name of the kong_upstream_target_health metric.
+	KongMetricNameKongUpstreamTargetHealth = "kong_upstream_target_health"
+
+	// MetricNameDataPlaneMetricsDroppedSeries is the name of the metric counting
+	// the series of a DataPlane dropped because of the cardinality limit.
+	MetricNameDataPlaneMetricsDroppedSeries = "gateway_operator_dataplane_metrics_dropped_series"
+
+	// DefaultCardinalityLimit is the default maximum number of series exposed
+	// for the metrics of a DataPlane.
+	DefaultCardinalityLimit = 10000
+)
+
+// enrichedMetricLabels are the labels set on all the metrics exposed by the
+// operator, before the labels specific to each metric family.
+var enrichedMetricLabels = []string{
+	"namespace",
+	"service",
+	"kubernetes_apiversion",
+	"kubernetes_kind",
+	"kubernetes_name",
+	"kubernetes_namespace",
+	"dataplane_url",
+	"route",
+	"consumer",
+}
+
+// enrichedMetricFamily describes a Kong metric family which is exposed by the
+// operator enriched with Kubernetes metadata.
+type enrichedMetricFamily struct {
+	name      string
+	valueType prometheus.ValueType
+	histogram bool
+	// labels are the labels of the Kong metric which are kept as is.
+	labels []string
+	desc   *prometheus.Desc
+}
+
+func newEnrichedMetricFamily(name, help string, valueType prometheus.ValueType, histogram bool, labels ...string) *enrichedMetricFamily {
+	return &enrichedMetricFamily{
+		name:      name,
+		valueType: valueType,
+		histogram: histogram,
+		labels:    labels,
+		desc:      prometheus.NewDesc(name, help, append(slices.Clone(enrichedMetricLabels), labels...), nil),
+	}
+}
+
+// enrichedMetricFamilies are the Kong metric families exposed by the operator.
+var enrichedMetricFamilies = []*enrichedMetricFamily{
+	newEnrichedMetricFamily(KongMetricNameKongUpstreamLatencyMs,
+		"Provides kong_upstream_latency_ms histogram enriched with dataplane metadata",
+		prometheus.UntypedValue, true,
+	),
+	newEnrichedMetricFamily(KongMetricNameKongRequestLatencyMs,
+		"Provides kong_request_latency_ms histogram enriched with dataplane metadata",
+		prometheus.UntypedValue, true,
+	),
+	newEnrichedMetricFamily(KongMetricNameKongKongLatencyMs,
+		"Provides kong_kong_latency_ms histogram enriched with dataplane metadata",
+		prometheus.UntypedValue, true,
+	),
+	newEnrichedMetricFamily(KongMetricNameKongBandwidthBytes,
+		"Provides kong_bandwidth_bytes counter enriched with dataplane metadata",
+		prometheus.CounterValue, false,
+		"direction",
+	),
+	newEnrichedMetricFamily(KongMetricNameKongHTTPRequestsTotal,
+		"Provides kong_http_requests_total counter enriched with dataplane metadata",
+		prometheus.CounterValue, false,
+		"code", "source",
+	),
+	newEnrichedMetricFamily(KongMetricNameKongUpstreamTargetHealth,
+		"Provides kong_upstream_target_health gauge enriched with dataplane metadata",
+		prometheus.GaugeValue, false,
+		"target", "address", "state", "subsystem",
+	),
+}
+
+// EnrichedMetricsCollector is a prometheus.Collector that collects the Kong
+// metrics of DataPlanes enriched with Kubernetes metadata.
+type EnrichedMetricsCollector struct {
+	lock        sync.RWMutex
+	dataplanes  map[types.UID]dataplaneSeries
+	droppedDesc *prometheus.Desc
+}
+
+// dataplaneSeries holds the series exposed for a DataPlane.
+type dataplaneSeries struct {
+	dataplane types.NamespacedName
+	metrics   []prometheus.Metric
+	dropped   int
+}
+
+var _ prometheus.Collector = &EnrichedMetricsCollector{}
+
+// KongEnrichedMetrics is a prometheus.Collector that collects the enriched
+// Kong metrics of all the scraped DataPlanes.
+var KongEnrichedMetrics = NewEnrichedMetricsCollector()
+
+// NewEnrichedMetricsCollector creates a new EnrichedMetricsCollector.
+func NewEnrichedMetricsCollector() *EnrichedMetricsCollector {
+	return &EnrichedMetricsCollector{
+		dataplanes: make(map[types.UID]dataplaneSeries),
+		droppedDesc: prometheus.NewDesc(
+			MetricNameDataPlaneMetricsDroppedSeries,
+			"Number of series of the DataPlane metrics dropped because of the cardinality limit",
+			[]string{"namespace", "name"},
+			nil,
+		),
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *EnrichedMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, dp := range c.dataplanes {
+		for _, m := range dp.metrics {
+			ch <- m
+		}
+		ch <- prometheus.MustNewConstMetric(c.droppedDesc, prometheus.GaugeValue, float64(dp.dropped),
+			dp.dataplane.Namespace, dp.dataplane.Name,
+		)
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *EnrichedMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, f := range enrichedMetricFamilies {
+		ch <- f.desc
+	}
+	ch <- c.droppedDesc
+}
+
+// set replaces the series exposed for the DataPlane with the provided UID.
+func (c *EnrichedMetricsCollector) set(dpUID types.UID, dpNN types.NamespacedName, s *seriesSet) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.dataplanes[dpUID] = dataplaneSeries{
+		dataplane: dpNN,
+		metrics:   s.metrics(),
+		dropped:   len(s.dropped),
+	}
+}
+
+// Remove removes the series exposed for the DataPlane with the provided UID.
+func (c *EnrichedMetricsCollector) Remove(dpUID types.UID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.dataplanes, dpUID)
+}
+
+// seriesKey identifies a series by its family and label values.
+type seriesKey struct {
+	family string
+	labels string
+}
+
+// series aggregates the samples of all the Kong metrics with the same
+// enriched label values, e.g. the ones of the Routes of a Service when
+// metrics are not broken down per Route.
+type series struct {
+	family      *enrichedMetricFamily
+	labelValues []string
+
+	value   float64
+	count   uint64
+	sum     float64
+	buckets map[float64]uint64
+}
+
+// seriesSet holds the series of a DataPlane, up to limit series.
+type seriesSet struct {
+	limit   int
+	series  map[seriesKey]*series
+	keys    []seriesKey
+	dropped map[seriesKey]struct{}
+}
+
+func newSeriesSet(limit int) *seriesSet {
+	return &seriesSet{
+		limit:   limit,
+		series:  make(map[seriesKey]*series),
+		dropped: make(map[seriesKey]struct{}),
+	}
+}
+
+// add adds the sample of the Kong metric m to the series with the provided
+// label values. When the series doesn't exist and the limit is reached, the
+// sample is dropped.
+func (s *seriesSet) add(f *enrichedMetricFamily, labelValues []string, m *dto.Metric) {
+	key := seriesKey{family: f.name, labels: strings.Join(labelValues, "\xff")}
+	ser, ok := s.series[key]
+	if !ok {
+		if len(s.series) >= s.limit {
+			s.dropped[key] = struct{}{}
+			return
+		}
+		ser = &series{family: f, labelValues: labelValues}
+		s.series[key] = ser
+		s.keys = append(s.keys, key)
+	}
+
+	if !f.histogram {
+		switch f.valueType {
+		case prometheus.CounterValue:
+			ser.value += m.GetCounter().GetValue()
+		case prometheus.GaugeValue:
+			ser.value += m.GetGauge().GetValue()
+		default:
+			ser.value += m.GetUntyped().GetValue()
+		}
+		return
+	}
+
+	h := m.GetHistogram()
+	ser.count += h.GetSampleCount()
+	ser.sum += h.GetSampleSum()
+	if ser.buckets == nil {
+		ser.buckets = make(map[float64]uint64, len(h.GetBucket()))
+	}
+	for _, b := range h.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			continue
+		}
+		ser.buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+	}
+}
+
+// metrics returns the series of the set as Prometheus metrics.
+func (s *seriesSet) metrics() []prometheus.Metric {
+	return lo.Map(s.keys, func(k seriesKey, _ int) prometheus.Metric {
+		ser := s.series[k]
+		if ser.family.histogram {
+			return prometheus.MustNewConstHistogram(ser.family.desc, ser.count, ser.sum, ser.buckets, ser.labelValues...)
+		}
+		return prometheus.MustNewConstMetric(ser.family.desc, ser.family.valueType, ser.value, ser.labelValues...)
+	})
+}
+
+// labelValues returns the values of the labels of the family for the sample m
+// of a Kong metric associated with the Kubernetes Service svc.
+func (f *enrichedMetricFamily) labelValues(
+	svc types.NamespacedName, dataplaneURL adminAPIEndpointURL, m *dto.Metric, cfg metricsConfig,
+) []string {
+	var route, consumer string
+	if cfg.perRoute {
+		route = labelValue(m, "route")
+	}
+	if cfg.perConsumer {
+		consumer = labelValue(m, "consumer")
+	}
+	// Below values have to match enrichedMetricLabels.
+	values := make([]string, 0, len(enrichedMetricLabels)+len(f.labels))
+	values = append(values,
+		svc.Namespace,
+		svc.Name,
+		"v1",
+		"service",
+		svc.Name,
+		svc.Namespace,
+		string(dataplaneURL),
+		route,
+		consumer,
+	)
+	for _, l := range f.labels {
+		values = append(values, labelValue(m, l))
+	}
+	return values
+}
+
+// labelValue returns the value of the label of the metric with the provided
+// name or an empty string when the metric doesn't have it.
+func labelValue(m *dto.Metric, name string) string {
+	l, _ := lo.Find(m.GetLabel(), func(l *dto.LabelPair) bool {
+		return l.GetName() == name
+	})
+	return l.GetValue()
+}
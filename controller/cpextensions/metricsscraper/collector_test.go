@@ -0,0 +1,132 @@
+package metricsscraper
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEnrichedMetricsCollector(t *testing.T) {
+	var (
+		dpUID = types.UID("dp-uid")
+		dpNN  = types.NamespacedName{Namespace: "default", Name: "dp"}
+		svcNN = types.NamespacedName{Namespace: "default", Name: "echo"}
+		url   = adminAPIEndpointURL("https://10.0.0.1:8444")
+	)
+	family := func(name string) *enrichedMetricFamily {
+		f, ok := lo.Find(enrichedMetricFamilies, func(f *enrichedMetricFamily) bool {
+			return f.name == name
+		})
+		require.True(t, ok)
+		return f
+	}
+	labels := func(kv ...string) []*dto.LabelPair {
+		var l []*dto.LabelPair
+		for i := 0; i < len(kv); i += 2 {
+			l = append(l, &dto.LabelPair{Name: new(kv[i]), Value: new(kv[i+1])})
+		}
+		return l
+	}
+	requests := func(route, consumer, code string, v float64) *dto.Metric {
+		return &dto.Metric{
+			Label:   labels("service", "kong-svc", "route", route, "consumer", consumer, "code", code, "source", "service"),
+			Counter: &dto.Counter{Value: new(v)},
+		}
+	}
+	latency := func(route string, count uint64, sum float64, buckets map[float64]uint64) *dto.Metric {
+		h := &dto.Histogram{SampleCount: new(count), SampleSum: new(sum)}
+		for _, ub := range []float64{10, 100, math.Inf(1)} {
+			h.Bucket = append(h.Bucket, &dto.Bucket{UpperBound: new(ub), CumulativeCount: new(buckets[ub])})
+		}
+		return &dto.Metric{Label: labels("service", "kong-svc", "route", route), Histogram: h}
+	}
+	gather := func(t *testing.T, c *EnrichedMetricsCollector) map[string]*dto.MetricFamily {
+		t.Helper()
+		reg := prometheus.NewPedanticRegistry()
+		require.NoError(t, reg.Register(c))
+		families, err := reg.Gather()
+		require.NoError(t, err)
+		return lo.SliceToMap(families, func(f *dto.MetricFamily) (string, *dto.MetricFamily) {
+			return f.GetName(), f
+		})
+	}
+	fill := func(cfg metricsConfig) *seriesSet {
+		set := newSeriesSet(cfg.cardinalityLimit)
+		for _, m := range []*dto.Metric{
+			requests("route-a", "alice", "200", 10),
+			requests("route-b", "alice", "200", 5),
+			requests("route-b", "bob", "500", 1),
+		} {
+			f := family(KongMetricNameKongHTTPRequestsTotal)
+			set.add(f, f.labelValues(svcNN, url, m, cfg), m)
+		}
+		for _, m := range []*dto.Metric{
+			latency("route-a", 10, 200, map[float64]uint64{10: 5, 100: 10, math.Inf(1): 10}),
+			latency("route-b", 4, 500, map[float64]uint64{10: 0, 100: 2, math.Inf(1): 4}),
+		} {
+			f := family(KongMetricNameKongUpstreamLatencyMs)
+			set.add(f, f.labelValues(svcNN, url, m, cfg), m)
+		}
+		return set
+	}
+
+	t.Run("routes and consumers are aggregated by default", func(t *testing.T) {
+		c := NewEnrichedMetricsCollector()
+		c.set(dpUID, dpNN, fill(metricsConfig{cardinalityLimit: DefaultCardinalityLimit}))
+		families := gather(t, c)
+
+		reqs := families[KongMetricNameKongHTTPRequestsTotal].GetMetric()
+		require.Len(t, reqs, 2, "one series per status code")
+		values := lo.SliceToMap(reqs, func(m *dto.Metric) (string, float64) {
+			return labelValue(m, "code"), m.GetCounter().GetValue()
+		})
+		assert.Equal(t, map[string]float64{"200": 15, "500": 1}, values)
+		assert.Equal(t, "echo", labelValue(reqs[0], "service"))
+		assert.Equal(t, "default", labelValue(reqs[0], "namespace"))
+		assert.Equal(t, string(url), labelValue(reqs[0], "dataplane_url"))
+		assert.Empty(t, labelValue(reqs[0], "route"))
+
+		lat := families[KongMetricNameKongUpstreamLatencyMs].GetMetric()
+		require.Len(t, lat, 1)
+		h := lat[0].GetHistogram()
+		assert.Equal(t, uint64(14), h.GetSampleCount())
+		assert.InDelta(t, 700, h.GetSampleSum(), 0.001)
+		buckets := lo.SliceToMap(h.GetBucket(), func(b *dto.Bucket) (float64, uint64) {
+			return b.GetUpperBound(), b.GetCumulativeCount()
+		})
+		assert.Equal(t, map[float64]uint64{10: 5, 100: 12}, buckets)
+
+		dropped := families[MetricNameDataPlaneMetricsDroppedSeries].GetMetric()
+		require.Len(t, dropped, 1)
+		assert.Zero(t, dropped[0].GetGauge().GetValue())
+	})
+
+	t.Run("per route and per consumer breakdowns", func(t *testing.T) {
+		c := NewEnrichedMetricsCollector()
+		c.set(dpUID, dpNN, fill(metricsConfig{perRoute: true, perConsumer: true, cardinalityLimit: DefaultCardinalityLimit}))
+		families := gather(t, c)
+		assert.Len(t, families[KongMetricNameKongHTTPRequestsTotal].GetMetric(), 3)
+		assert.Len(t, families[KongMetricNameKongUpstreamLatencyMs].GetMetric(), 2)
+	})
+
+	t.Run("series above the cardinality limit are dropped", func(t *testing.T) {
+		c := NewEnrichedMetricsCollector()
+		c.set(dpUID, dpNN, fill(metricsConfig{perRoute: true, perConsumer: true, cardinalityLimit: 2}))
+		families := gather(t, c)
+		assert.Len(t, families[KongMetricNameKongHTTPRequestsTotal].GetMetric(), 2)
+		assert.NotContains(t, families, KongMetricNameKongUpstreamLatencyMs)
+		dropped := families[MetricNameDataPlaneMetricsDroppedSeries].GetMetric()
+		require.Len(t, dropped, 1)
+		assert.InDelta(t, 3, dropped[0].GetGauge().GetValue(), 0.001)
+		assert.Equal(t, "dp", labelValue(dropped[0], "name"))
+
+		c.Remove(dpUID)
+		assert.Empty(t, gather(t, c))
+	})
+}
@@ -4,23 +4,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/pkg/extensions"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 )
 
 func init() {
 	collectors := []prometheus.Collector{
-		KongUpstreamLatencyMsHistogram,
+		KongEnrichedMetrics,
 	}
 
 	for _, c := range collectors {
@@ -45,15 +53,21 @@ type metricsEnricher struct {
 	httpClient              *http.Client
 	cl                      client.Client
 	logger                  logr.Logger
+	store                   *MetricsStore
+	controlPlane            types.NamespacedName
 }
 
 // NewEnricher creates a new MetricsEnricher.
+// When store is not nil, the request metrics of the DataPlane are recorded in it.
+// The metrics configuration is read from the extensions of the ControlPlane.
 func NewEnricher(
 	logger logr.Logger,
+	controlPlane types.NamespacedName,
 	dataplane *operatorv1beta1.DataPlane,
 	cl client.Client,
 	certs certs,
 	adminAPIAddressProvider AdminAPIAddressProvider,
+	store *MetricsStore,
 ) (metricsEnricher, error) {
 	return metricsEnricher{
 		dataplane:               dataplane,
@@ -61,6 +75,8 @@ func NewEnricher(
 		httpClient:              httpClientWithCerts(certs),
 		cl:                      cl,
 		logger:                  logger,
+		store:                   store,
+		controlPlane:            controlPlane,
 	}, nil
 }
 
@@ -75,6 +91,47 @@ const (
 	KongMetricTagK8sNamespace = "k8s-namespace"
 )
 
+// metricsConfig is the configuration of the enriched metrics of a DataPlane
+// resolved from the DataPlaneMetricsExtensions attached to its ControlPlane.
+type metricsConfig struct {
+	perRoute         bool
+	perConsumer      bool
+	cardinalityLimit int
+}
+
+// metricsConfig returns the configuration of the enriched metrics.
+// The breakdowns are enabled when any of the extensions enables them and
+// the lowest cardinality limit is used.
+func (me metricsEnricher) metricsConfig(ctx context.Context) metricsConfig {
+	cfg := metricsConfig{
+		cardinalityLimit: DefaultCardinalityLimit,
+	}
+
+	var cp gwtypes.ControlPlane
+	if err := me.cl.Get(ctx, me.controlPlane, &cp); err != nil {
+		log.Debug(me.logger, "failed to get ControlPlane, using default metrics config", "controlplane", me.controlPlane, "error", err)
+		return cfg
+	}
+	exts, err := extensions.GetAllDataPlaneMetricExtensionsForControlPlane(ctx, me.cl, &cp)
+	if err != nil {
+		log.Debug(me.logger, "failed to get DataPlaneMetricsExtensions, using default metrics config", "controlplane", me.controlPlane, "error", err)
+	}
+
+	limit := 0
+	for _, ext := range exts {
+		c := ext.Spec.Config
+		cfg.perRoute = cfg.perRoute || c.PerRoute
+		cfg.perConsumer = cfg.perConsumer || c.PerConsumer
+		if c.CardinalityLimit > 0 && (limit == 0 || int(c.CardinalityLimit) < limit) {
+			limit = int(c.CardinalityLimit)
+		}
+	}
+	if limit > 0 {
+		cfg.cardinalityLimit = limit
+	}
+	return cfg
+}
+
 // Consume consumes the metrics and enriches them with kubernetes metadata.
 func (me metricsEnricher) Consume(ctx context.Context, m Metrics) error {
 	// TODO: Potentially, create a watch which will get notifications on new
@@ -101,90 +158,137 @@ func (me metricsEnricher) Consume(ctx context.Context, m Metrics) error {
 		return fmt.Errorf("failed listing Services for DataPlane %s error: %w", client.ObjectKeyFromObject(me.dataplane), err)
 	}
 
-	for dataplaneURL, metricFamily := range m.metrics {
-		for name, metric := range metricFamily {
-			if name != KongMetricNameKongUpstreamLatencyMs {
-				continue
-			}
-
-			for _, m := range metric.GetMetric() {
-				// Extract the name of the service from the metric labels.
-				// This has the name of the service in the Kong configuration.
-				serviceLabel, ok := lo.Find(m.GetLabel(),
-					func(p *dto.LabelPair) bool {
-						return p.GetName() == "service"
-					},
-				)
-				if !ok || serviceLabel.Value == nil {
-					me.logger.Info("'service' label not found", "metric", name)
-					continue
-				}
+	cfg := me.metricsConfig(ctx)
+	set := newSeriesSet(cfg.cardinalityLimit)
+	samples := make(map[adminAPIEndpointURL]podSamples, len(m.metrics))
+	// Iterate in a stable order so that the same series are dropped when
+	// the cardinality limit is reached.
+	for _, dataplaneURL := range slices.Sorted(maps.Keys(m.metrics)) {
+		metricFamily := m.metrics[dataplaneURL]
+		pod := make(podSamples)
+		samples[dataplaneURL] = pod
 
-				svc, ok := lo.Find(services, func(s *kong.Service) bool {
-					return s.Name != nil && serviceLabel.GetValue() == *s.Name
-				})
+		for _, f := range enrichedMetricFamilies {
+			for _, m := range metricFamily[metricName(f.name)].GetMetric() {
+				svc, ok := me.k8sServiceForMetric(f.name, m, services)
 				if !ok {
-					me.logger.Info("service not found in config", "service", serviceLabel.GetValue())
 					continue
 				}
-
-				tagK8sName, ok := extractAndTrimPrefix(svc.Tags, KongMetricTagK8sName)
-				if !ok {
-					me.logger.Info(KongMetricTagK8sName + " tag not found for service " + *svc.Name)
-					continue
+				switch f.name {
+				case KongMetricNameKongHTTPRequestsTotal:
+					pod.add(svc, m)
+				case KongMetricNameKongUpstreamLatencyMs:
+					pod.addLatency(svc, m)
 				}
-
-				tagK8sNamespace, ok := extractAndTrimPrefix(svc.Tags, KongMetricTagK8sNamespace)
-				if !ok {
-					me.logger.Info(KongMetricTagK8sNamespace + " tag not found for service " + *svc.Name)
-					continue
-				}
-
-				// Below labels have to match the ones in HistogramPassthroughMetric.Desc.
-				m.Label = []*dto.LabelPair{
-					{
-						Name:  new("namespace"),
-						Value: new(tagK8sNamespace),
-					},
-					{
-						Name:  new("service"),
-						Value: new(tagK8sName),
-					},
-					{
-						Name:  new("kubernetes_apiversion"),
-						Value: new("v1"),
-					},
-					{
-						Name:  new("kubernetes_kind"),
-						Value: new("service"),
-					},
-					{
-						Name:  new("kubernetes_name"),
-						Value: new(tagK8sName),
-					},
-					{
-						Name:  new("kubernetes_namespace"),
-						Value: new(tagK8sNamespace),
-					},
-					{
-						Name:  new("dataplane_url"),
-						Value: new(string(dataplaneURL)),
-					},
-				}
-
-				KongUpstreamLatencyMsHistogram.Observe(m, dataplaneURL)
+				set.add(f, f.labelValues(svc, dataplaneURL, m, cfg), m)
 			}
 		}
 	}
 
-	// NOTE: to consider
-	// Now that we've observed the metrics, we can remove the time series about
-	// dataplanes instances that do not exist anymore.
-	// KongUpstreamLatencyHistogram.Prune(addrs)
+	dpNN := client.ObjectKeyFromObject(me.dataplane)
+	if len(set.dropped) > 0 {
+		log.Debug(me.logger, "dropped DataPlane metrics series above the cardinality limit",
+			"dataplane", dpNN, "limit", cfg.cardinalityLimit, "dropped", len(set.dropped),
+		)
+	}
+	KongEnrichedMetrics.set(me.dataplane.UID, dpNN, set)
+
+	if me.store != nil {
+		me.store.update(me.dataplane.UID, dpNN, samples, time.Now())
+	}
 
 	return nil
 }
 
+// k8sServiceForMetric returns the Kubernetes Service associated with the Kong
+// Service set in the 'service' label of the provided metric or, for upstream
+// health metrics, with the Kong Service whose host is the 'upstream' label.
+func (me metricsEnricher) k8sServiceForMetric(name string, m *dto.Metric, services []*kong.Service) (types.NamespacedName, bool) {
+	// Extract the name of the service from the metric labels.
+	// This has the name of the service in the Kong configuration.
+	label, match := "service", func(s *kong.Service) *string { return s.Name }
+	if name == KongMetricNameKongUpstreamTargetHealth {
+		label, match = "upstream", func(s *kong.Service) *string { return s.Host }
+	}
+	serviceLabel, ok := lo.Find(m.GetLabel(),
+		func(p *dto.LabelPair) bool {
+			return p.GetName() == label
+		},
+	)
+	if !ok || serviceLabel.Value == nil {
+		log.Debug(me.logger, "'"+label+"' label not found", "metric", name)
+		return types.NamespacedName{}, false
+	}
+
+	svc, ok := lo.Find(services, func(s *kong.Service) bool {
+		v := match(s)
+		return v != nil && serviceLabel.GetValue() == *v
+	})
+	if !ok {
+		log.Debug(me.logger, "service not found in config", label, serviceLabel.GetValue())
+		return types.NamespacedName{}, false
+	}
+
+	tagK8sName, ok := extractAndTrimPrefix(svc.Tags, KongMetricTagK8sName)
+	if !ok {
+		log.Debug(me.logger, KongMetricTagK8sName+" tag not found for service "+lo.FromPtr(svc.Name))
+		return types.NamespacedName{}, false
+	}
+
+	tagK8sNamespace, ok := extractAndTrimPrefix(svc.Tags, KongMetricTagK8sNamespace)
+	if !ok {
+		log.Debug(me.logger, KongMetricTagK8sNamespace+" tag not found for service "+lo.FromPtr(svc.Name))
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: tagK8sNamespace, Name: tagK8sName}, true
+}
+
+// add adds the value of a kong_http_requests_total metric to the samples of the Service.
+// Responses are counted per status code class when the metric has the 'code' label.
+func (p podSamples) add(svc types.NamespacedName, m *dto.Metric) {
+	s := p.get(svc)
+	value := m.GetCounter().GetValue()
+	s.requests += value
+	code, ok := lo.Find(m.GetLabel(), func(l *dto.LabelPair) bool {
+		return l.GetName() == "code"
+	})
+	if !ok || len(code.GetValue()) != 3 {
+		return
+	}
+	if s.responses == nil {
+		s.responses = make(map[string]float64)
+	}
+	s.responses[code.GetValue()[:1]+"xx"] += value
+}
+
+// addLatency adds the histogram of a kong_upstream_latency_ms metric to the samples of the Service.
+func (p podSamples) addLatency(svc types.NamespacedName, m *dto.Metric) {
+	s := p.get(svc)
+	h := m.GetHistogram()
+	s.latencyCount += float64(h.GetSampleCount())
+	if s.latencyBuckets == nil {
+		s.latencyBuckets = make(map[float64]float64)
+	}
+	for _, b := range h.GetBucket() {
+		s.latencyBuckets[b.GetUpperBound()] += float64(b.GetCumulativeCount())
+	}
+	if _, ok := lo.Find(h.GetBucket(), func(b *dto.Bucket) bool {
+		return math.IsInf(b.GetUpperBound(), 1)
+	}); !ok {
+		s.latencyBuckets[math.Inf(1)] += float64(h.GetSampleCount())
+	}
+}
+
+func (p podSamples) get(svc types.NamespacedName) *serviceSamples {
+	s, ok := p[svc]
+	if !ok {
+		s = &serviceSamples{}
+		p[svc] = s
+	}
+	return s
+}
+
 // extractAndTrimPrefix looks for a tag with the given prefix and returns the
 // value with the prefix + ":" trimmed.
 func extractAndTrimPrefix(tags []*string, prefix string) (string, bool) {
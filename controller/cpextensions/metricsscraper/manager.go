@@ -60,6 +60,7 @@ type Manager struct {
 	pipelinesLock            sync.RWMutex
 	pipelines                map[types.UID]MetricsScrapePipeline
 	cpNNToDpUID              map[types.NamespacedName]types.UID
+	store                    *MetricsStore
 }
 
 // NewManager creates new MetricsScrapeManager.
@@ -81,9 +82,17 @@ func NewManager(
 		pipelinesNotificationsCh: make(chan scrapeUpdateNotification),
 		pipelines:                make(map[types.UID]MetricsScrapePipeline),
 		cpNNToDpUID:              make(map[types.NamespacedName]types.UID),
+		// Metrics not refreshed by a few consecutive scrapes are considered stale.
+		store: NewMetricsStore(3 * interval),
 	}
 }
 
+// Store returns the MetricsStore holding the request metrics computed from
+// the scraped DataPlanes.
+func (msm *Manager) Store() *MetricsStore {
+	return msm.store
+}
+
 // initMTLSCerts creates mTLS certs for the manager so that it can use them for
 // secure communication with DataPlane's AdminAPI endpoints.
 // When successful, it sets the certs on the manager.
@@ -331,6 +340,8 @@ func (msm *Manager) Add(cp *gwtypes.ControlPlane, pipeline MetricsScrapePipeline
 		// scraper.
 		if oldDpDUID != dpUID {
 			delete(msm.pipelines, oldDpDUID)
+			msm.store.Remove(oldDpDUID)
+			KongEnrichedMetrics.Remove(oldDpDUID)
 		}
 	}
 	msm.cpNNToDpUID[cpNN] = dpUID
@@ -349,6 +360,8 @@ func (msm *Manager) RemoveForControlPlaneNN(cpNN types.NamespacedName) {
 
 	delete(msm.pipelines, dpUID)
 	delete(msm.cpNNToDpUID, cpNN)
+	msm.store.Remove(dpUID)
+	KongEnrichedMetrics.Remove(dpUID)
 	log.Debug(msm.logger, "removed metrics scraper for ControlPlane", "controlplane", cpNN, "dataplane_uid", dpUID)
 }
 
@@ -399,7 +412,7 @@ func (msm *Manager) enableMetricsScraperForControlPlanesDataPlane(
 
 	httpClient := httpClientWithCerts(*msm.certs)
 
-	enricher, err := NewEnricher(msm.logger, &dp, msm.client, *msm.certs, adminAPIAddressProvider)
+	enricher, err := NewEnricher(msm.logger, client.ObjectKeyFromObject(controlplane), &dp, msm.client, *msm.certs, adminAPIAddressProvider, msm.store)
 	if err != nil {
 		return fmt.Errorf("failed to create metrics enricher: %w", err)
 	}
@@ -0,0 +1,263 @@
+package metricsscraper
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UpstreamLatencyPercentiles are the percentiles of the upstream latency
+// computed for RequestMetrics.
+var UpstreamLatencyPercentiles = []float64{0.5, 0.9, 0.99}
+
+// RequestMetrics holds the metrics of the requests proxied by a DataPlane to
+// a Kubernetes Service, computed over the window between two scrapes.
+// RequestMetrics merged with MergeRequestMetrics may cover several DataPlanes
+// or Services, in which case the respective fields are left empty.
+type RequestMetrics struct {
+	DataPlane types.NamespacedName
+	Service   types.NamespacedName
+	Timestamp time.Time
+	Window    time.Duration
+
+	// RequestsPerSecond is the rate of requests.
+	RequestsPerSecond float64
+	// ResponsesPerSecond is the rate of responses per status code class, e.g. "5xx".
+	ResponsesPerSecond map[string]float64
+
+	// latencyCount and latencyBuckets hold the upstream latency histogram
+	// observed over the window, with the cumulative count of every bucket
+	// indexed by its upper bound in milliseconds.
+	latencyCount   float64
+	latencyBuckets map[float64]float64
+}
+
+// UpstreamLatencyMs returns the given percentile, e.g. 0.99, of the upstream
+// latency in milliseconds. It returns 0 when no latency was observed.
+func (m RequestMetrics) UpstreamLatencyMs(percentile float64) float64 {
+	if m.latencyCount <= 0 || len(m.latencyBuckets) == 0 {
+		return 0
+	}
+
+	// Linear interpolation within the bucket holding the rank, like Prometheus'
+	// histogram_quantile does.
+	bounds := slices.Sorted(maps.Keys(m.latencyBuckets))
+	rank := percentile * m.latencyCount
+	var lowerBound, lowerCount float64
+	for _, bound := range bounds {
+		count := m.latencyBuckets[bound]
+		if count >= rank {
+			if math.IsInf(bound, 1) || count == lowerCount {
+				return lowerBound
+			}
+			return lowerBound + (bound-lowerBound)*(rank-lowerCount)/(count-lowerCount)
+		}
+		lowerBound, lowerCount = bound, count
+	}
+	return lowerBound
+}
+
+// MergeRequestMetrics merges the provided RequestMetrics: rates are summed and
+// latency histograms are combined. DataPlane and Service are only kept when
+// they're the same for all of them.
+func MergeRequestMetrics(metrics ...RequestMetrics) RequestMetrics {
+	var merged RequestMetrics
+	for i, m := range metrics {
+		if i == 0 {
+			merged.DataPlane, merged.Service = m.DataPlane, m.Service
+		}
+		if merged.DataPlane != m.DataPlane {
+			merged.DataPlane = types.NamespacedName{}
+		}
+		if merged.Service != m.Service {
+			merged.Service = types.NamespacedName{}
+		}
+		if m.Timestamp.After(merged.Timestamp) {
+			merged.Timestamp = m.Timestamp
+		}
+		merged.Window = max(merged.Window, m.Window)
+		merged.RequestsPerSecond += m.RequestsPerSecond
+		for class, rate := range m.ResponsesPerSecond {
+			if merged.ResponsesPerSecond == nil {
+				merged.ResponsesPerSecond = make(map[string]float64)
+			}
+			merged.ResponsesPerSecond[class] += rate
+		}
+		merged.latencyCount += m.latencyCount
+		for bound, count := range m.latencyBuckets {
+			if merged.latencyBuckets == nil {
+				merged.latencyBuckets = make(map[float64]float64)
+			}
+			merged.latencyBuckets[bound] += count
+		}
+	}
+	return merged
+}
+
+// serviceSamples holds the cumulative values scraped from a DataPlane Pod
+// for a Kubernetes Service.
+type serviceSamples struct {
+	requests       float64
+	responses      map[string]float64
+	latencyCount   float64
+	latencyBuckets map[float64]float64
+}
+
+// podSamples holds the samples scraped from the Admin API endpoint of a
+// DataPlane Pod, indexed by Kubernetes Service.
+type podSamples map[types.NamespacedName]*serviceSamples
+
+// dataPlaneSamples holds the samples scraped from a DataPlane.
+type dataPlaneSamples struct {
+	dataplane types.NamespacedName
+	timestamp time.Time
+	pods      map[adminAPIEndpointURL]podSamples
+	metrics   []RequestMetrics
+}
+
+// MetricsStore keeps the request metrics computed from the samples scraped
+// from DataPlanes, so that they can be served through the Kubernetes metrics APIs.
+type MetricsStore struct {
+	lock       sync.RWMutex
+	maxAge     time.Duration
+	now        func() time.Time
+	dataplanes map[types.UID]*dataPlaneSamples
+}
+
+// NewMetricsStore creates a new MetricsStore. Metrics older than maxAge are
+// considered stale and are not returned.
+func NewMetricsStore(maxAge time.Duration) *MetricsStore {
+	return &MetricsStore{
+		maxAge:     maxAge,
+		now:        time.Now,
+		dataplanes: make(map[types.UID]*dataPlaneSamples),
+	}
+}
+
+// List returns the fresh RequestMetrics of every DataPlane and Service pair.
+func (s *MetricsStore) List() []RequestMetrics {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var ret []RequestMetrics
+	for _, dp := range s.dataplanes {
+		if s.now().Sub(dp.timestamp) > s.maxAge {
+			continue
+		}
+		ret = append(ret, dp.metrics...)
+	}
+	return ret
+}
+
+// Remove removes the metrics of the DataPlane with the provided UID.
+func (s *MetricsStore) Remove(dpUID types.UID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.dataplanes, dpUID)
+}
+
+// update records the samples scraped from a DataPlane at the provided time
+// and computes its RequestMetrics from the difference with the previous ones.
+func (s *MetricsStore) update(
+	dpUID types.UID,
+	dataplane types.NamespacedName,
+	pods map[adminAPIEndpointURL]podSamples,
+	timestamp time.Time,
+) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	current := &dataPlaneSamples{
+		dataplane: dataplane,
+		timestamp: timestamp,
+		pods:      pods,
+	}
+	previous, ok := s.dataplanes[dpUID]
+	s.dataplanes[dpUID] = current
+	if !ok || !timestamp.After(previous.timestamp) {
+		return
+	}
+
+	window := timestamp.Sub(previous.timestamp)
+	perService := make(map[types.NamespacedName][]RequestMetrics)
+	for url, services := range pods {
+		previousServices, ok := previous.pods[url]
+		if !ok {
+			continue
+		}
+		for svc, samples := range services {
+			previousSamples, ok := previousServices[svc]
+			if !ok {
+				continue
+			}
+			perService[svc] = append(perService[svc], samplesDelta(previousSamples, samples, window))
+		}
+	}
+
+	for _, svc := range slices.SortedFunc(maps.Keys(perService), func(a, b types.NamespacedName) int {
+		return compareNamespacedNames(a, b)
+	}) {
+		m := MergeRequestMetrics(perService[svc]...)
+		m.DataPlane = dataplane
+		m.Service = svc
+		m.Timestamp = timestamp
+		m.Window = window
+		current.metrics = append(current.metrics, m)
+	}
+}
+
+// samplesDelta computes RequestMetrics from two consecutive samples.
+// Counters going down mean the Pod has been restarted, in which case
+// the current values are taken as the delta.
+func samplesDelta(previous, current *serviceSamples, window time.Duration) RequestMetrics {
+	delta := func(prev, cur float64) float64 {
+		if cur < prev {
+			return cur
+		}
+		return cur - prev
+	}
+	reset := current.requests < previous.requests || current.latencyCount < previous.latencyCount
+	if reset {
+		previous = &serviceSamples{}
+	}
+
+	seconds := window.Seconds()
+	m := RequestMetrics{
+		RequestsPerSecond: delta(previous.requests, current.requests) / seconds,
+		latencyCount:      delta(previous.latencyCount, current.latencyCount),
+	}
+	for class, count := range current.responses {
+		if m.ResponsesPerSecond == nil {
+			m.ResponsesPerSecond = make(map[string]float64)
+		}
+		m.ResponsesPerSecond[class] = delta(previous.responses[class], count) / seconds
+	}
+	for bound, count := range current.latencyBuckets {
+		if m.latencyBuckets == nil {
+			m.latencyBuckets = make(map[float64]float64)
+		}
+		m.latencyBuckets[bound] = delta(previous.latencyBuckets[bound], count)
+	}
+	return m
+}
+
+func compareNamespacedNames(a, b types.NamespacedName) int {
+	if a.Namespace != b.Namespace {
+		if a.Namespace < b.Namespace {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case a.Name < b.Name:
+		return -1
+	case a.Name > b.Name:
+		return 1
+	default:
+		return 0
+	}
+}
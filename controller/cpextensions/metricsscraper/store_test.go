@@ -0,0 +1,102 @@
+package metricsscraper
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestMetricsStore(t *testing.T) {
+	var (
+		dpUID = types.UID("dp-uid")
+		dpNN  = types.NamespacedName{Namespace: "default", Name: "dp"}
+		svcNN = types.NamespacedName{Namespace: "default", Name: "echo"}
+		now   = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	samples := func(requests, ok, errors float64, latencyBuckets map[float64]float64) map[adminAPIEndpointURL]podSamples {
+		return map[adminAPIEndpointURL]podSamples{
+			"https://10.0.0.1:8444": {
+				svcNN: {
+					requests:       requests,
+					responses:      map[string]float64{"2xx": ok, "5xx": errors},
+					latencyCount:   latencyBuckets[math.Inf(1)],
+					latencyBuckets: latencyBuckets,
+				},
+			},
+		}
+	}
+
+	store := NewMetricsStore(time.Minute)
+	store.now = func() time.Time { return now.Add(10 * time.Second) }
+
+	store.update(dpUID, dpNN, samples(100, 90, 10, map[float64]float64{10: 50, 100: 90, math.Inf(1): 100}), now)
+	assert.Empty(t, store.List(), "rates need two scrapes")
+
+	store.update(dpUID, dpNN, samples(200, 170, 30, map[float64]float64{10: 100, 100: 180, math.Inf(1): 200}), now.Add(10*time.Second))
+	metrics := store.List()
+	require.Len(t, metrics, 1)
+	m := metrics[0]
+	assert.Equal(t, dpNN, m.DataPlane)
+	assert.Equal(t, svcNN, m.Service)
+	assert.Equal(t, 10*time.Second, m.Window)
+	assert.InDelta(t, 10, m.RequestsPerSecond, 0.001)
+	assert.InDelta(t, 8, m.ResponsesPerSecond["2xx"], 0.001)
+	assert.InDelta(t, 2, m.ResponsesPerSecond["5xx"], 0.001)
+	assert.InDelta(t, 10, m.UpstreamLatencyMs(0.5), 0.001)
+	assert.InDelta(t, 55, m.UpstreamLatencyMs(0.7), 0.001)
+	assert.InDelta(t, 100, m.UpstreamLatencyMs(0.99), 0.001, "ranks in the +Inf bucket get the highest finite bound")
+
+	t.Run("counter reset", func(t *testing.T) {
+		store.update(dpUID, dpNN, samples(20, 20, 0, map[float64]float64{10: 20, math.Inf(1): 20}), now.Add(20*time.Second))
+		store.now = func() time.Time { return now.Add(20 * time.Second) }
+		metrics := store.List()
+		require.Len(t, metrics, 1)
+		assert.InDelta(t, 2, metrics[0].RequestsPerSecond, 0.001)
+	})
+
+	t.Run("stale metrics", func(t *testing.T) {
+		store.now = func() time.Time { return now.Add(5 * time.Minute) }
+		assert.Empty(t, store.List())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		store.now = func() time.Time { return now.Add(20 * time.Second) }
+		require.NotEmpty(t, store.List())
+		store.Remove(dpUID)
+		assert.Empty(t, store.List())
+	})
+}
+
+func TestMergeRequestMetrics(t *testing.T) {
+	svc := types.NamespacedName{Namespace: "default", Name: "echo"}
+	merged := MergeRequestMetrics(
+		RequestMetrics{
+			DataPlane:          types.NamespacedName{Namespace: "default", Name: "dp-1"},
+			Service:            svc,
+			RequestsPerSecond:  1,
+			ResponsesPerSecond: map[string]float64{"2xx": 1},
+			latencyCount:       10,
+			latencyBuckets:     map[float64]float64{10: 10, math.Inf(1): 10},
+		},
+		RequestMetrics{
+			DataPlane:          types.NamespacedName{Namespace: "default", Name: "dp-2"},
+			Service:            svc,
+			RequestsPerSecond:  2,
+			ResponsesPerSecond: map[string]float64{"2xx": 1, "4xx": 1},
+			latencyCount:       10,
+			latencyBuckets:     map[float64]float64{10: 0, math.Inf(1): 10},
+		},
+	)
+
+	assert.Empty(t, merged.DataPlane)
+	assert.Equal(t, svc, merged.Service)
+	assert.InDelta(t, 3, merged.RequestsPerSecond, 0.001)
+	assert.Equal(t, map[string]float64{"2xx": 2, "4xx": 1}, merged.ResponsesPerSecond)
+	assert.InDelta(t, 10, merged.UpstreamLatencyMs(0.5), 0.001)
+	assert.InDelta(t, 10, merged.UpstreamLatencyMs(0.9), 0.001)
+	assert.Zero(t, RequestMetrics{}.UpstreamLatencyMs(0.5))
+}
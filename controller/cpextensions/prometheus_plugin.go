@@ -60,6 +60,7 @@ func convertDataPlanePluginMetricsExtensionConfigToPrometheusPluginConfig(
 		Bandwidth:      ext.Spec.Config.Bandwidth,
 		UpstreamHealth: ext.Spec.Config.UpstreamHealth,
 		StatusCode:     ext.Spec.Config.StatusCode,
+		PerConsumer:    ext.Spec.Config.PerConsumer,
 	}
 }
 
@@ -71,4 +72,5 @@ type PrometheusPluginConfig struct {
 	Bandwidth      bool `json:"bandwidth_metrics"`
 	UpstreamHealth bool `json:"upstream_health_metrics"`
 	StatusCode     bool `json:"status_code_metrics"`
+	PerConsumer    bool `json:"per_consumer,omitempty"`
 }
@@ -34,6 +34,7 @@ import (
 	extensionskonnect "github.com/kong/kong-operator/v2/controller/pkg/extensions/konnect"
 	"github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
@@ -87,7 +88,7 @@ func (r *BlueGreenReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	delegate.eventRecorder = mgr.GetEventRecorder("dataplane")
 	return DataPlaneWatchBuilder(mgr, r.KonnectEnabled).
 		WithOptions(r.ControllerOptions).
-		Complete(reconcile.AsReconciler[*operatorv1beta1.DataPlane](r.Client, r))
+		Complete(reconcile.AsReconciler(r.Client, opentelemetry.ObjectReconciler[*operatorv1beta1.DataPlane]("DataPlane", r)))
 }
 
 // -----------------------------------------------------------------------------
@@ -214,6 +215,14 @@ func (r *BlueGreenReconciler) Reconcile(ctx context.Context, dataplane *operator
 		return ctrl.Result{}, nil
 	}
 
+	// Ensure vertical scaling recommendations are in status before the "preview"
+	// Deployment is built, so that they're applied with the rollout.
+	if updated, err := ensureDataPlaneVerticalScalingStatus(ctx, r.Client, logger, dataplane); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed updating vertical scaling status: %w", err)
+	} else if updated {
+		return ctrl.Result{}, nil
+	}
+
 	// Ensure "preview" Deployment.
 	deployment, res, err := r.ensureDeploymentForDataPlane(ctx, logger, dataplane, certSecret)
 	if err != nil {
@@ -322,6 +331,11 @@ func (r *BlueGreenReconciler) Reconcile(ctx context.Context, dataplane *operator
 		return ctrl.Result{}, fmt.Errorf("failed to reduce live deployments: %w", err)
 	}
 
+	// Make the VPA follow the promoted Deployment.
+	if _, err := ensureVPAForDataPlane(ctx, r.Client, logger, dataplane, deployment.Name); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed ensuring VerticalPodAutoscaler for the promoted Deployment: %w", err)
+	}
+
 	log.Debug(logger, "BlueGreen reconciliation complete for DataPlane resource")
 	return ctrl.Result{}, nil
 }
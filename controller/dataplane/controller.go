@@ -24,6 +24,7 @@ import (
 	extensionskonnect "github.com/kong/kong-operator/v2/controller/pkg/extensions/konnect"
 	"github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
@@ -61,7 +62,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 
 	return DataPlaneWatchBuilder(mgr, r.KonnectEnabled).
 		WithOptions(r.ControllerOptions).
-		Complete(reconcile.AsReconciler[*operatorv1beta1.DataPlane](r.Client, r))
+		Complete(reconcile.AsReconciler(r.Client, opentelemetry.ObjectReconciler[*operatorv1beta1.DataPlane]("DataPlane", r)))
 }
 
 // -----------------------------------------------------------------------------
@@ -193,6 +194,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, dataplane *operatorv1beta1.D
 		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
 	}
 
+	log.Trace(logger, "ensuring DataPlane has vertical scaling recommendations in status")
+	if updated, err := ensureDataPlaneVerticalScalingStatus(ctx, r.Client, logger, dataplane); err != nil {
+		return ctrl.Result{}, err
+	} else if updated {
+		log.Debug(logger, "dataplane status.VerticalScaling updated")
+		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
+	}
+
+	log.Trace(logger, "ensuring DataPlane has Pod Security Standard compliance in status")
+	if updated, err := ensureDataPlanePodSecurityStatus(ctx, r.Client, logger, dataplane); err != nil {
+		return ctrl.Result{}, err
+	} else if updated {
+		log.Debug(logger, "dataplane PodSecurityCompliant condition updated")
+		return ctrl.Result{}, nil // no need to requeue, the update will trigger.
+	}
+
 	deploymentLabels := client.MatchingLabels{
 		consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive,
 	}
@@ -222,19 +239,64 @@ func (r *Reconciler) Reconcile(ctx context.Context, dataplane *operatorv1beta1.D
 		WithAdditionalLabels(deploymentLabels).
 		WithSecretLabelSelector(r.SecretLabelSelector)
 
-	deployment, res, err := deploymentBuilder.BuildAndDeploy(ctx, dataplane, r.EnforceConfig, r.ValidateDataPlaneImage)
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("could not build Deployment for DataPlane %s: %w", client.ObjectKeyFromObject(dataplane), err)
+	var workloadName string
+	switch {
+	case dataPlaneUsesTopology(dataplane):
+		deployments, res, err := deploymentBuilder.BuildAndDeployZones(ctx, dataplane, r.EnforceConfig, r.ValidateDataPlaneImage)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not build zone Deployments for DataPlane %s: %w", client.ObjectKeyFromObject(dataplane), err)
+		}
+		if res != op.Noop {
+			return ctrl.Result{}, nil
+		}
+
+		res, err = ensureHPAsForDataPlaneZones(ctx, r.Client, logger, dataplane, deployments)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if res != op.Noop {
+			return ctrl.Result{}, nil
+		}
+	case dataPlaneUsesDaemonSet(dataplane):
+		daemonSet, res, err := deploymentBuilder.BuildAndDeployDaemonSet(ctx, dataplane, r.EnforceConfig, r.ValidateDataPlaneImage)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not build DaemonSet for DataPlane %s: %w", client.ObjectKeyFromObject(dataplane), err)
+		}
+		if res != op.Noop {
+			return ctrl.Result{}, nil
+		}
+		workloadName = daemonSet.Name
+	default:
+		deployment, res, err := deploymentBuilder.BuildAndDeploy(ctx, dataplane, r.EnforceConfig, r.ValidateDataPlaneImage)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not build Deployment for DataPlane %s: %w", client.ObjectKeyFromObject(dataplane), err)
+		}
+		if res != op.Noop {
+			return ctrl.Result{}, nil
+		}
+		workloadName = deployment.Name
 	}
-	if res != op.Noop {
-		return ctrl.Result{}, nil
+
+	// NOTE: DaemonSets cannot be scaled, the scaling options are rejected by the
+	// API for them, which makes this remove any HPA left behind by a Deployment.
+	if !dataPlaneUsesTopology(dataplane) {
+		res, _, err = ensureHPAForDataPlane(ctx, r.Client, logger, dataplane, workloadName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if res != op.Noop {
+			return ctrl.Result{}, nil
+		}
 	}
 
-	res, _, err = ensureHPAForDataPlane(ctx, r.Client, logger, dataplane, deployment.Name)
+	// NOTE: vertical scaling is rejected by the API for DataPlanes using a topology
+	// or a DaemonSet, which makes this only remove any VPA left behind for them.
+	res, err = ensureVPAForDataPlane(ctx, r.Client, logger, dataplane, workloadName)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, fmt.Errorf("could not ensure VerticalPodAutoscaler for DataPlane %s: %w", client.ObjectKeyFromObject(dataplane), err)
 	}
 	if res != op.Noop {
+		log.Debug(logger, "VerticalPodAutoscaler created/updated/deleted")
 		return ctrl.Result{}, nil
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -11,6 +12,8 @@ import (
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -74,6 +77,20 @@ func (r *Reconciler) ensureDataPlaneAddressesStatus(
 		return false, fmt.Errorf("failed getting addresses for service %s: %w", dataplaneService, err)
 	}
 
+	// DataPlane Pods bound to the network of the nodes they run on are reachable
+	// through these nodes' addresses, so they take precedence over the Service ones.
+	if dataPlaneUsesHostBinding(dataplane) {
+		nodes, err := listDataPlaneIngressServiceNodes(ctx, r.Client, dataplaneService)
+		if err != nil {
+			return false, err
+		}
+		nodeAddresses, err := address.AddressesFromNodes(nodes)
+		if err != nil {
+			return false, fmt.Errorf("failed getting node addresses for service %s: %w", dataplaneService, err)
+		}
+		addresses = append(nodeAddresses, addresses...)
+	}
+
 	// Compare the lengths prior to cmp.Equal() because cmp.Equal() will return
 	// false when comparing nil slice and 0 length slice.
 	if len(addresses) != len(dataplane.Status.Addresses) ||
@@ -86,6 +103,46 @@ func (r *Reconciler) ensureDataPlaneAddressesStatus(
 	return false, nil
 }
 
+// listDataPlaneIngressServiceNodes lists the nodes running the ready endpoints of
+// the provided DataPlane ingress Service, sorted by name.
+func listDataPlaneIngressServiceNodes(
+	ctx context.Context,
+	cl client.Client,
+	ingressService *corev1.Service,
+) ([]corev1.Node, error) {
+	var endpointSlices discoveryv1.EndpointSliceList
+	if err := cl.List(ctx, &endpointSlices,
+		client.InNamespace(ingressService.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: ingressService.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed listing EndpointSlices for service %s: %w", client.ObjectKeyFromObject(ingressService), err)
+	}
+
+	nodeNames := make(map[string]struct{})
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.NodeName == nil ||
+				(endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready) {
+				continue
+			}
+			nodeNames[*endpoint.NodeName] = struct{}{}
+		}
+	}
+
+	nodes := make([]corev1.Node, 0, len(nodeNames))
+	for _, nodeName := range slices.Sorted(maps.Keys(nodeNames)) {
+		var node corev1.Node
+		if err := cl.Get(ctx, client.ObjectKey{Name: nodeName}, &node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting node %s: %w", nodeName, err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
 // ensureMappedConfigMapToKongPluginInstallationForDataPlane ensures that the KongPluginInstallation
 // resources referenced by the DataPlane are resolved and DataPlane is configured to use them.
 // During resolving for each DataPlane based on each instance of KongPluginInstallation
@@ -416,6 +473,7 @@ func patchDataPlaneStatus(ctx context.Context, cl client.Client, logger logr.Log
 	if k8sutils.ConditionsNeedsUpdate(current, updated) ||
 		addressesChanged(current, updated) ||
 		readinessChanged(current, updated) ||
+		verticalScalingChanged(current, updated) ||
 		current.Status.Service != updated.Status.Service ||
 		current.Status.Selector != updated.Status.Selector {
 
@@ -432,7 +490,15 @@ func addressesChanged(current, updated *operatorv1beta1.DataPlane) bool {
 	return !cmp.Equal(current.Status.Addresses, updated.Status.Addresses)
 }
 
+// verticalScalingChanged returns a boolean indicating whether the vertical scaling
+// statuses of the provided DataPlanes differ.
+func verticalScalingChanged(current, updated *operatorv1beta1.DataPlane) bool {
+	// NOTE: cmp.Equal cannot be used here as it panics on resource.Quantity.
+	return !equality.Semantic.DeepEqual(current.Status.VerticalScaling, updated.Status.VerticalScaling)
+}
+
 func readinessChanged(current, updated *operatorv1beta1.DataPlane) bool {
 	return current.Status.ReadyReplicas != updated.Status.ReadyReplicas ||
-		current.Status.Replicas != updated.Status.Replicas
+		current.Status.Replicas != updated.Status.Replicas ||
+		!cmp.Equal(current.Status.Zones, updated.Status.Zones)
 }
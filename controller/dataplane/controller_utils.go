@@ -6,14 +6,17 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	kcfgdataplane "github.com/kong/kong-operator/v2/api/gateway-operator/dataplane"
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
 	"github.com/kong/kong-operator/v2/controller/pkg/log"
@@ -21,6 +24,7 @@ import (
 	"github.com/kong/kong-operator/v2/internal/versions"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
 )
 
 // -----------------------------------------------------------------------------
@@ -181,10 +185,52 @@ func extractOutdatedDataPlaneDeploymentAnnotations(
 	return outdatedAnnotations, nil
 }
 
+// ensureDataPlanePodSecurityStatus ensures that the provided DataPlane gets an
+// up to date PodSecurityCompliant status condition when its hardening level maps
+// to a Pod Security Standard, and that the condition is removed otherwise.
+// It returns true if the status has been patched.
+func ensureDataPlanePodSecurityStatus(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+) (bool, error) {
+	condition, ok := k8sresources.PodSecurityCondition(
+		dataPlanePodSecurityTemplate(dataplane),
+		dataplane.Spec.Deployment.Hardened,
+		dataplane.Generation,
+	)
+	if !ok {
+		if !k8sutils.RemoveCondition(commonv1alpha1.PodSecurityCompliantType, dataplane) {
+			return false, nil
+		}
+	} else {
+		k8sutils.SetCondition(condition, dataplane)
+	}
+	return patchDataPlaneStatus(ctx, cl, logger, dataplane)
+}
+
+// dataPlanePodSecurityTemplate returns the DataPlane's PodTemplateSpec patches
+// with its host binding applied, so that the host networking and host ports
+// set by the operator are checked against the Pod Security Standard as well.
+func dataPlanePodSecurityTemplate(dataplane *operatorv1beta1.DataPlane) *corev1.PodTemplateSpec {
+	pts := dataplane.Spec.Deployment.PodTemplateSpec
+	if !dataPlaneUsesHostBinding(dataplane) {
+		return pts
+	}
+	if pts == nil {
+		pts = &corev1.PodTemplateSpec{}
+	} else {
+		pts = pts.DeepCopy()
+	}
+	k8sresources.SetDataPlaneHostBinding(dataplane, pts)
+	return pts
+}
+
 // ensureDataPlaneReadyStatus ensures that the provided DataPlane gets an up to
 // date Ready status condition.
-// It sets the condition based on the readiness of DataPlane's Deployment and
-// its ingress Service receiving an address.
+// It sets the condition based on the readiness of DataPlane's Deployment (or
+// DaemonSet) and its ingress Service receiving an address.
 func ensureDataPlaneReadyStatus(
 	ctx context.Context,
 	cl client.Client,
@@ -198,14 +244,19 @@ func ensureDataPlaneReadyStatus(
 		return ctrl.Result{}, fmt.Errorf("failed getting DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
 	}
 
-	deployments, err := listDataPlaneLiveDeployments(ctx, cl, dataplane)
+	workloads, err := listDataPlaneLiveWorkloads(ctx, cl, dataplane)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed listing deployments for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		return ctrl.Result{}, fmt.Errorf("failed listing workloads for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	dataplane.Status.Zones = nil
+	if dataPlaneUsesTopology(dataplane) {
+		dataplane.Status.Zones = dataPlaneZonesStatus(dataplane, workloads)
+		workloads = mergeDataPlaneZoneWorkloads(dataplane, workloads)
 	}
 
-	switch len(deployments) {
+	switch len(workloads) {
 	case 0:
-		log.Debug(logger, "Deployment for DataPlane not present yet")
+		log.Debug(logger, "workload for DataPlane not present yet")
 
 		// Set Ready to false for dataplane as the underlying deployment is not ready.
 		k8sutils.SetCondition(
@@ -237,9 +288,9 @@ func ensureDataPlaneReadyStatus(
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	deployment := deployments[0]
-	if _, ready := isDeploymentReady(deployment.Status); !ready {
-		log.Debug(logger, "Deployment for DataPlane not ready yet")
+	workload := workloads[0]
+	if _, ready := isDeploymentReady(workload.Status); !ready {
+		log.Debug(logger, "workload for DataPlane not ready yet", "kind", workload.Kind)
 
 		// Set Ready to false for dataplane as the underlying deployment is not ready.
 		k8sutils.SetCondition(
@@ -247,12 +298,12 @@ func ensureDataPlaneReadyStatus(
 				kcfgdataplane.ReadyType,
 				metav1.ConditionFalse,
 				kcfgdataplane.WaitingToBecomeReadyReason,
-				fmt.Sprintf("%s: Deployment %s is not ready yet", kcfgdataplane.WaitingToBecomeReadyMessage, deployment.Name),
+				fmt.Sprintf("%s: %s %s is not ready yet", kcfgdataplane.WaitingToBecomeReadyMessage, workload.Kind, workload.Name),
 				generation,
 			),
 			dataplane,
 		)
-		ensureDataPlaneReadinessStatus(dataplane, deployment.Status)
+		ensureDataPlaneReadinessStatus(dataplane, workload.Status)
 		if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed patching status (Deployment not ready) for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
 		}
@@ -279,7 +330,7 @@ func ensureDataPlaneReadyStatus(
 			),
 			dataplane,
 		)
-		ensureDataPlaneReadinessStatus(dataplane, deployment.Status)
+		ensureDataPlaneReadinessStatus(dataplane, workload.Status)
 		_, err := patchDataPlaneStatus(ctx, cl, logger, dataplane)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed patching status (ingress Service not present) for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
@@ -308,7 +359,7 @@ func ensureDataPlaneReadyStatus(
 			),
 			dataplane,
 		)
-		ensureDataPlaneReadinessStatus(dataplane, deployment.Status)
+		ensureDataPlaneReadinessStatus(dataplane, workload.Status)
 		_, err := patchDataPlaneStatus(ctx, cl, logger, dataplane)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed patching status (ingress Service not ready) for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
@@ -317,7 +368,7 @@ func ensureDataPlaneReadyStatus(
 	}
 
 	k8sutils.SetReadyWithGeneration(dataplane, generation)
-	ensureDataPlaneReadinessStatus(dataplane, deployment.Status)
+	ensureDataPlaneReadinessStatus(dataplane, workload.Status)
 
 	if _, err := patchDataPlaneStatus(ctx, cl, logger, dataplane); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed patching status for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
@@ -326,6 +377,125 @@ func ensureDataPlaneReadyStatus(
 	return ctrl.Result{}, nil
 }
 
+// dataPlaneWorkload is the Deployment or DaemonSet running the DataPlane's Pods.
+// DaemonSet status is translated into its DeploymentStatus equivalent so that
+// readiness and status replicas can be derived from both in the same way.
+type dataPlaneWorkload struct {
+	Kind   string
+	Name   string
+	Zone   string
+	Status appsv1.DeploymentStatus
+}
+
+// listDataPlaneLiveWorkloads lists the live workloads of the DataPlane, depending
+// on its workload type.
+func listDataPlaneLiveWorkloads(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+) ([]dataPlaneWorkload, error) {
+	if !dataPlaneUsesDaemonSet(dataplane) {
+		deployments, err := listDataPlaneLiveDeployments(ctx, cl, dataplane)
+		if err != nil {
+			return nil, err
+		}
+		// The Deployments of the DataPlane's zones are only taken into account
+		// when the DataPlane uses a topology, and the other way around.
+		deployments = lo.Filter(deployments, func(d appsv1.Deployment, _ int) bool {
+			return (d.Labels[consts.DataPlaneZoneLabel] != "") == dataPlaneUsesTopology(dataplane)
+		})
+		return lo.Map(deployments, func(d appsv1.Deployment, _ int) dataPlaneWorkload {
+			return dataPlaneWorkload{Kind: "Deployment", Name: d.Name, Zone: d.Labels[consts.DataPlaneZoneLabel], Status: d.Status}
+		}), nil
+	}
+
+	daemonSets, err := k8sutils.ListDaemonSetsForOwner(ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		client.MatchingLabels{
+			"app":                                dataplane.Name,
+			consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(daemonSets, func(ds appsv1.DaemonSet, _ int) dataPlaneWorkload {
+		return dataPlaneWorkload{Kind: "DaemonSet", Name: ds.Name, Status: deploymentStatusFromDaemonSetStatus(ds.Status)}
+	}), nil
+}
+
+// dataPlaneZonesStatus returns the replicas and ready replicas of every zone of
+// the DataPlane's topology, based on the zones' workloads.
+func dataPlaneZonesStatus(
+	dataplane *operatorv1beta1.DataPlane,
+	workloads []dataPlaneWorkload,
+) []operatorv1beta1.DataPlaneZoneStatus {
+	zones := dataplane.Spec.Deployment.Topology.Zones
+	status := make([]operatorv1beta1.DataPlaneZoneStatus, 0, len(zones))
+	for _, zone := range zones {
+		zoneStatus := operatorv1beta1.DataPlaneZoneStatus{Name: zone.Name}
+		for _, w := range workloads {
+			if w.Zone != zone.Name {
+				continue
+			}
+			zoneStatus.Replicas += w.Status.Replicas
+			zoneStatus.ReadyReplicas += w.Status.ReadyReplicas
+		}
+		status = append(status, zoneStatus)
+	}
+	return status
+}
+
+// mergeDataPlaneZoneWorkloads merges the workloads of the DataPlane's zones into a
+// single workload, which is ready only when every zone is. No workload is returned
+// until every zone has one, and the workloads are returned unchanged when a zone
+// has more than one, so that the DataPlane is not considered ready in both cases.
+func mergeDataPlaneZoneWorkloads(
+	dataplane *operatorv1beta1.DataPlane,
+	workloads []dataPlaneWorkload,
+) []dataPlaneWorkload {
+	merged := dataPlaneWorkload{Kind: "Deployment"}
+	names := make([]string, 0, len(workloads))
+	for _, zone := range dataplane.Spec.Deployment.Topology.Zones {
+		zoneWorkloads := lo.Filter(workloads, func(w dataPlaneWorkload, _ int) bool {
+			return w.Zone == zone.Name
+		})
+		switch len(zoneWorkloads) {
+		case 0:
+			return nil
+		case 1:
+		default:
+			return workloads
+		}
+
+		w := zoneWorkloads[0]
+		names = append(names, w.Name)
+		merged.Status.Replicas += w.Status.Replicas
+		merged.Status.UpdatedReplicas += w.Status.UpdatedReplicas
+		merged.Status.ReadyReplicas += w.Status.ReadyReplicas
+		merged.Status.AvailableReplicas += w.Status.AvailableReplicas
+		merged.Status.UnavailableReplicas += w.Status.UnavailableReplicas
+	}
+	merged.Name = strings.Join(names, ", ")
+	return []dataPlaneWorkload{merged}
+}
+
+// deploymentStatusFromDaemonSetStatus translates the DaemonSet status into its
+// DeploymentStatus equivalent: each node scheduled to run a DataPlane Pod counts
+// as a replica.
+func deploymentStatusFromDaemonSetStatus(status appsv1.DaemonSetStatus) appsv1.DeploymentStatus {
+	return appsv1.DeploymentStatus{
+		ObservedGeneration:  status.ObservedGeneration,
+		Replicas:            status.DesiredNumberScheduled,
+		UpdatedReplicas:     status.UpdatedNumberScheduled,
+		ReadyReplicas:       status.NumberReady,
+		AvailableReplicas:   status.NumberAvailable,
+		UnavailableReplicas: status.NumberUnavailable,
+	}
+}
+
 func listDataPlaneLiveDeployments(
 	ctx context.Context,
 	cl client.Client,
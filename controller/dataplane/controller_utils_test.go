@@ -18,10 +18,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	kcfgdataplane "github.com/kong/kong-operator/v2/api/gateway-operator/dataplane"
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
 )
 
 func TestEnsureDataPlaneReadyStatus(t *testing.T) {
@@ -781,3 +783,56 @@ func TestAddLabelsForDataPlaneDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestDataPlanePodSecurityTemplate(t *testing.T) {
+	proxyTemplate := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: consts.DataPlaneProxyContainerName}},
+			},
+		}
+	}
+	dataPlaneWith := func(
+		workloadType commonv1alpha1.WorkloadType, hostBinding commonv1alpha1.HostBinding, pts *corev1.PodTemplateSpec,
+	) *operatorv1beta1.DataPlane {
+		return &operatorv1beta1.DataPlane{
+			Spec: operatorv1beta1.DataPlaneSpec{
+				DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+					Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+						DeploymentOptions: operatorv1beta1.DeploymentOptions{PodTemplateSpec: pts},
+						Hardened:          commonv1alpha1.HardeningStateBaseline,
+						WorkloadType:      workloadType,
+						HostBinding:       hostBinding,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("without host binding the patches are returned as is", func(t *testing.T) {
+		pts := proxyTemplate()
+		dataplane := dataPlaneWith(commonv1alpha1.WorkloadTypeDaemonSet, commonv1alpha1.HostBindingNone, pts)
+		assert.Same(t, pts, dataPlanePodSecurityTemplate(dataplane))
+	})
+
+	t.Run("host network is reported as a violation", func(t *testing.T) {
+		pts := proxyTemplate()
+		dataplane := dataPlaneWith(commonv1alpha1.WorkloadTypeDaemonSet, commonv1alpha1.HostBindingHostNetwork, pts)
+		rendered := dataPlanePodSecurityTemplate(dataplane)
+		assert.True(t, rendered.Spec.HostNetwork)
+		assert.False(t, pts.Spec.HostNetwork, "the DataPlane spec must not be modified")
+		assert.Contains(t,
+			k8sresources.PodSecurityViolations(&rendered.Spec, commonv1alpha1.HardeningStateBaseline),
+			"hostNetwork is not allowed",
+		)
+	})
+
+	t.Run("host ports are reported as violations", func(t *testing.T) {
+		dataplane := dataPlaneWith(commonv1alpha1.WorkloadTypeDaemonSet, commonv1alpha1.HostBindingHostPort, proxyTemplate())
+		rendered := dataPlanePodSecurityTemplate(dataplane)
+		assert.Contains(t,
+			k8sresources.PodSecurityViolations(&rendered.Spec, commonv1alpha1.HardeningStateBaseline),
+			fmt.Sprintf("container %q: hostPort %d is not allowed", consts.DataPlaneProxyContainerName, consts.DefaultHTTPPort),
+		)
+	})
+}
@@ -0,0 +1,286 @@
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/dataplane/certificates"
+	dataplanepkg "github.com/kong/kong-operator/v2/controller/pkg/dataplane"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/controller/pkg/patch"
+	"github.com/kong/kong-operator/v2/controller/pkg/utils"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
+)
+
+// dataPlaneUsesDaemonSet returns true if the DataPlane's Pods are run by a DaemonSet
+// instead of a Deployment.
+func dataPlaneUsesDaemonSet(dataplane *operatorv1beta1.DataPlane) bool {
+	return dataplane.Spec.Deployment.WorkloadType == commonv1alpha1.WorkloadTypeDaemonSet
+}
+
+// dataPlaneUsesHostBinding returns true if the DataPlane's Pods are reachable
+// through the addresses of the nodes they run on.
+func dataPlaneUsesHostBinding(dataplane *operatorv1beta1.DataPlane) bool {
+	if !dataPlaneUsesDaemonSet(dataplane) {
+		return false
+	}
+	switch dataplane.Spec.Deployment.HostBinding {
+	case commonv1alpha1.HostBindingHostNetwork, commonv1alpha1.HostBindingHostPort:
+		return true
+	default:
+		return false
+	}
+}
+
+// BuildAndDeployDaemonSet builds and deploys a DataPlane DaemonSet, or reduces DaemonSets if there are more than one.
+// The DaemonSet runs the same Pods as the Deployment generated by BuildAndDeploy would, with the DataPlane's
+// host binding applied. Once the DaemonSet is in place, Deployments left behind by the Deployment workload type
+// are removed.
+func (d *DeploymentBuilder) BuildAndDeployDaemonSet(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	enforceConfig bool,
+	validateDataPlaneImage bool,
+) (*appsv1.DaemonSet, op.Result, error) {
+	opts := []certificates.CertOpt{}
+	if d.secretLabelSelector != "" {
+		opts = append(opts, certificates.WithSecretLabel(d.secretLabelSelector, "true"))
+	}
+	if err := certificates.CreateKonnectCert(ctx, d.logger, dataplane, d.client, opts...); err != nil {
+		return nil, op.Noop, fmt.Errorf("failed creating konnect cert: %w", err)
+	}
+
+	// if there is more than one DaemonSet, delete the extras
+	reduced, existingDaemonSet, err := listOrReduceDataPlaneDaemonSets(ctx, d.client, dataplane, d.additionalLabels)
+	if err != nil {
+		return nil, op.Noop, fmt.Errorf("failed listing existing DaemonSets: %w", err)
+	}
+	if reduced {
+		return nil, op.Noop, nil
+	}
+
+	desiredDeployment, err := d.generateDesiredDeployment(ctx, dataplane, validateDataPlaneImage)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	desiredDaemonSet := k8sresources.GenerateDaemonSetFromDataPlaneDeployment(desiredDeployment.Unwrap())
+	k8sresources.SetDataPlaneHostBinding(dataplane, &desiredDaemonSet.Spec.Template)
+
+	res, daemonSet, err := reconcileDataPlaneDaemonSet(ctx, d.client, d.logger, enforceConfig,
+		dataplane, existingDaemonSet, desiredDaemonSet)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	if res != op.Noop {
+		return daemonSet, res, nil
+	}
+
+	// Remove the Deployment left behind when the DataPlane has been switched from
+	// the Deployment workload type, only after its DaemonSet is in place.
+	res, err = deleteDataPlaneDeployments(ctx, d.client, dataplane)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	return daemonSet, res, nil
+}
+
+// listOrReduceDataPlaneDaemonSets lists existing DataPlane DaemonSets. If only one is present, it returns it. If
+// multiple are present, it keeps the oldest one, deletes the others and notifies the caller it reduced, so that
+// the caller can try its operation again once there's only a single DaemonSet to work with.
+func listOrReduceDataPlaneDaemonSets(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+	additionalLabels client.MatchingLabels,
+) (reduced bool, daemonSet *appsv1.DaemonSet, err error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	maps.Copy(matchingLabels, additionalLabels)
+
+	daemonSets, err := k8sutils.ListDaemonSetsForOwner(
+		ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		matchingLabels,
+	)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed listing DaemonSets for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+
+	count := len(daemonSets)
+	if count > 1 {
+		sort.SliceStable(daemonSets, func(i, j int) bool {
+			return daemonSets[i].CreationTimestamp.Before(&daemonSets[j].CreationTimestamp)
+		})
+		if err := deleteDataPlaneOwnedObjects(ctx, cl, daemonSets[1:]); err != nil {
+			return false, nil, err
+		}
+		return true, nil, errors.New("number of daemonsets reduced")
+	}
+	if count == 0 {
+		return false, nil, nil
+	}
+
+	return false, &daemonSets[0], nil
+}
+
+// deleteDataPlaneDaemonSets deletes all the DaemonSets owned by the DataPlane.
+func deleteDataPlaneDaemonSets(ctx context.Context, cl client.Client, dataplane *operatorv1beta1.DataPlane) (op.Result, error) {
+	daemonSets, err := k8sutils.ListDaemonSetsForOwner(
+		ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		client.MatchingLabels(k8sresources.GetManagedLabelForOwner(dataplane)),
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing DaemonSets for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	if len(daemonSets) == 0 {
+		return op.Noop, nil
+	}
+	if err := deleteDataPlaneOwnedObjects(ctx, cl, daemonSets); err != nil {
+		return op.Noop, err
+	}
+	return op.Deleted, nil
+}
+
+// deleteDataPlaneDeployments deletes all the Deployments owned by the DataPlane.
+func deleteDataPlaneDeployments(ctx context.Context, cl client.Client, dataplane *operatorv1beta1.DataPlane) (op.Result, error) {
+	deployments, err := k8sutils.ListDeploymentsForOwner(
+		ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		client.MatchingLabels(k8sresources.GetManagedLabelForOwner(dataplane)),
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing Deployments for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	if len(deployments) == 0 {
+		return op.Noop, nil
+	}
+	if err := deleteDataPlaneOwnedObjects(ctx, cl, deployments); err != nil {
+		return op.Noop, err
+	}
+	return op.Deleted, nil
+}
+
+// deleteDataPlaneOwnedObjects deletes the provided DataPlane owned objects,
+// removing the finalizer that protects them from accidental deletion first.
+func deleteDataPlaneOwnedObjects[T any, PT interface {
+	*T
+	client.Object
+}](ctx context.Context, cl client.Client, objs []T) error {
+	for i := range objs {
+		obj := PT(&objs[i])
+		if err := dataplanepkg.OwnedObjectPreDeleteHook(ctx, cl, obj); err != nil {
+			return fmt.Errorf("failed to execute pre delete hook: %w", err)
+		}
+		if err := cl.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+	return nil
+}
+
+// reconcileDataPlaneDaemonSet takes any existing DataPlane DaemonSet and a desired DataPlane DaemonSet and
+// reconciles the existing state to the desired state by either updating an existing DaemonSet, creating a new one,
+// or doing nothing.
+func reconcileDataPlaneDaemonSet(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	enforceConfig bool,
+	dataplane *operatorv1beta1.DataPlane,
+	existing *appsv1.DaemonSet,
+	desired *appsv1.DaemonSet,
+) (res op.Result, daemonSet *appsv1.DaemonSet, err error) {
+	k8sresources.SetDefaultsPodTemplateSpec(&desired.Spec.Template)
+
+	if existing == nil {
+		if err = cl.Create(ctx, desired); err != nil {
+			return op.Noop, nil, fmt.Errorf("failed creating DaemonSet for DataPlane %s: %w", dataplane.Name, err)
+		}
+
+		log.Debug(logger, "daemonset for DataPlane created", "daemonset", desired.Name)
+		return op.Created, desired, nil
+	}
+
+	// If the enforceConfig flag is not set, we compare the spec hash of the
+	// existing DaemonSet with the spec hash of the desired DaemonSet. If
+	// the hashes match, we skip the update.
+	if !enforceConfig {
+		match, err := k8sresources.SpecHashMatchesAnnotation(deploymentRelevantDataPlaneSpec(dataplane), existing)
+		if err != nil {
+			return op.Noop, nil, err
+		}
+		if match {
+			log.Debug(logger, "DataPlane DaemonSet spec hash matches existing DaemonSet, skipping update")
+			return op.Noop, existing, nil
+		}
+	}
+
+	var updated bool
+	original := existing.DeepCopy()
+
+	// See reconcileDataPlaneDeployment for why the last applied annotations need to be preserved.
+	originalLastApplied := existing.Annotations[consts.AnnotationLastAppliedAnnotations]
+	updated, existing.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existing.ObjectMeta, desired.ObjectMeta,
+		func(existingMeta metav1.ObjectMeta, generatedMeta metav1.ObjectMeta) (bool, metav1.ObjectMeta) {
+			if existingMeta.Annotations != nil && originalLastApplied != "" {
+				existingMeta.Annotations[consts.AnnotationLastAppliedAnnotations] = originalLastApplied
+			}
+			metaToUpdate, updatedAnnotations, err := ensureDataPlaneDeploymentAnnotationsUpdated(
+				dataplane, existingMeta.Annotations, generatedMeta.Annotations,
+			)
+			if err != nil {
+				log.Error(logger, err, "failed to update annotations of existing DaemonSet for DataPlane",
+					"dataplane", fmt.Sprintf("%s/%s", dataplane.Namespace, dataplane.Name),
+					"daemonset", fmt.Sprintf("%s/%s", existing.Namespace, existing.Name))
+				return true, existingMeta
+			}
+			existingMeta.Annotations = updatedAnnotations
+			return metaToUpdate, existingMeta
+		},
+	)
+
+	opts := []cmp.Option{
+		cmp.Comparer(k8sresources.ResourceRequirementsEqual),
+		utils.IgnoreAnnotationKeysComparer(restartAnnotationKey),
+	}
+	if !cmp.Equal(existing.Spec.Template, desired.Spec.Template, opts...) {
+		if restartTimeStr, isRestartOperation := isRecentDeploymentRestart(&existing.Spec.Template, logger); isRestartOperation {
+			log.Debug(logger, "found restart annotation", "timestamp", restartTimeStr)
+			if desired.Spec.Template.Annotations == nil {
+				desired.Spec.Template.Annotations = make(map[string]string)
+			}
+			desired.Spec.Template.Annotations[restartAnnotationKey] = restartTimeStr
+		}
+
+		log.Trace(logger, "DataPlane DaemonSet diff detected", "diff", cmp.Diff(existing.Spec.Template, desired.Spec.Template, opts...))
+		existing.Spec.Template = desired.Spec.Template
+		updated = true
+	}
+
+	if !cmp.Equal(existing.Spec.UpdateStrategy, desired.Spec.UpdateStrategy) {
+		existing.Spec.UpdateStrategy = desired.Spec.UpdateStrategy
+		updated = true
+	}
+
+	return patch.ApplyPatchIfNotEmpty(ctx, cl, logger, existing, original, updated)
+}
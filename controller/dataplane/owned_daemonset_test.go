@@ -0,0 +1,133 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+func TestDeploymentBuilder_BuildAndDeployDaemonSet(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataplane",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: operatorv1beta1.DataPlaneSpec{
+			DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+				Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+					DeploymentOptions: operatorv1beta1.DeploymentOptions{
+						PodTemplateSpec: &corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								NodeSelector: map[string]string{"edge": "true"},
+								Containers: []corev1.Container{
+									{
+										Name:  consts.DataPlaneProxyContainerName,
+										Image: "kong/kong-gateway:3.11",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	logger := logr.Discard()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, operatorv1beta1.AddToScheme(scheme))
+
+	fakeClient := fakectrlruntimeclient.
+		NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dataplane).
+		Build()
+
+	builder := NewDeploymentBuilder(logger, fakeClient).
+		WithDefaultImage("kong:3.0").
+		WithClusterCertificate("test-cert").
+		WithOpts(
+			labelSelectorFromDataPlaneStatusSelectorDeploymentOpt(dataplane),
+		)
+
+	_, res, err := builder.BuildAndDeploy(t.Context(), dataplane, true, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+
+	dataplane.Spec.Deployment.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+	dataplane.Spec.Deployment.HostBinding = commonv1alpha1.HostBindingHostNetwork
+
+	t.Log("switching to the DaemonSet workload type creates the DaemonSet first")
+	daemonSet, res, err := builder.BuildAndDeployDaemonSet(t.Context(), dataplane, true, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+	assert.Equal(t, map[string]string{"edge": "true"}, daemonSet.Spec.Template.Spec.NodeSelector)
+	assert.True(t, daemonSet.Spec.Template.Spec.HostNetwork)
+	assert.Equal(t, corev1.DNSClusterFirstWithHostNet, daemonSet.Spec.Template.Spec.DNSPolicy)
+
+	var deployments appsv1.DeploymentList
+	require.NoError(t, fakeClient.List(t.Context(), &deployments))
+	require.Len(t, deployments.Items, 1, "the Deployment must be kept until the DaemonSet is in place")
+
+	t.Log("the Deployment left behind is removed once the DaemonSet is in place")
+	_, res, err = builder.BuildAndDeployDaemonSet(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Deleted, res)
+	require.NoError(t, fakeClient.List(t.Context(), &deployments))
+	require.Empty(t, deployments.Items)
+
+	_, res, err = builder.BuildAndDeployDaemonSet(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Noop, res)
+
+	t.Log("switching back to the Deployment workload type removes the DaemonSet")
+	dataplane.Spec.Deployment.WorkloadType = commonv1alpha1.WorkloadTypeDeployment
+	dataplane.Spec.Deployment.HostBinding = commonv1alpha1.HostBindingNone
+	_, res, err = builder.BuildAndDeploy(t.Context(), dataplane, true, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+	_, res, err = builder.BuildAndDeploy(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Deleted, res)
+
+	var daemonSets appsv1.DaemonSetList
+	require.NoError(t, fakeClient.List(t.Context(), &daemonSets))
+	require.Empty(t, daemonSets.Items)
+}
+
+func TestDeploymentStatusFromDaemonSetStatus(t *testing.T) {
+	status := deploymentStatusFromDaemonSetStatus(appsv1.DaemonSetStatus{
+		ObservedGeneration:     2,
+		DesiredNumberScheduled: 3,
+		UpdatedNumberScheduled: 3,
+		NumberReady:            2,
+		NumberAvailable:        2,
+		NumberUnavailable:      1,
+	})
+	assert.Equal(t, appsv1.DeploymentStatus{
+		ObservedGeneration:  2,
+		Replicas:            3,
+		UpdatedReplicas:     3,
+		ReadyReplicas:       2,
+		AvailableReplicas:   2,
+		UnavailableReplicas: 1,
+	}, status)
+
+	_, ready := isDeploymentReady(status)
+	assert.False(t, ready, "a DaemonSet with unavailable Pods is not ready")
+}
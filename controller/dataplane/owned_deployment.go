@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -118,6 +119,41 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 		return nil, op.Noop, fmt.Errorf("failed creating konnect cert: %w", err)
 	}
 
+	deployment, res, err := d.deploy(ctx, dataplane, enforceConfig, validateDataPlaneImage)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	if res != op.Noop {
+		return deployment, res, nil
+	}
+
+	// Remove the DaemonSet left behind when the DataPlane has been switched from
+	// the DaemonSet workload type, only after its Deployment is in place.
+	res, err = deleteDataPlaneDaemonSets(ctx, d.client, dataplane)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	if res != op.Noop {
+		return deployment, res, nil
+	}
+
+	// Likewise, remove the zones' Deployments left behind when the DataPlane's
+	// topology has been removed. The Deployment built here has no zone.
+	res, err = deleteDataPlaneLiveDeploymentsOutsideZones(ctx, d.client, dataplane, "")
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	return deployment, res, nil
+}
+
+// deploy reduces the Deployments matching the builder's additional labels if there
+// are more than one, then creates or updates the remaining one.
+func (d *DeploymentBuilder) deploy(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	enforceConfig bool,
+	validateDataPlaneImage bool,
+) (*appsv1.Deployment, op.Result, error) {
 	// if there is more than one Deployment, delete the extras
 	reduced, existingDeployment, err := listOrReduceDataPlaneDeployments(ctx, d.client, dataplane, d.additionalLabels)
 	if err != nil {
@@ -127,19 +163,40 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 		return nil, op.Noop, nil
 	}
 
+	desiredDeployment, err := d.generateDesiredDeployment(ctx, dataplane, validateDataPlaneImage)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+
+	// push the complete Deployment to Kubernetes
+	res, deployment, err := reconcileDataPlaneDeployment(ctx, d.client, d.logger, enforceConfig,
+		dataplane, existingDeployment, desiredDeployment.Unwrap())
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	return deployment, res, nil
+}
+
+// generateDesiredDeployment generates the complete DataPlane Deployment, including the cluster
+// certificate, user patches, default environment variables and the spec hash annotation.
+func (d *DeploymentBuilder) generateDesiredDeployment(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	validateDataPlaneImage bool,
+) (*k8sresources.Deployment, error) {
 	// generate the initial Deployment struct
 	desiredDeployment, err := generateDataPlaneDeployment(
 		d.logger, validateDataPlaneImage, dataplane, d.defaultImage, d.additionalLabels, d.opts...,
 	)
 	if err != nil {
-		return nil, op.Noop, fmt.Errorf("could not generate Deployment: %w", err)
+		return nil, fmt.Errorf("could not generate Deployment: %w", err)
 	}
 
 	// Add the cluster certificate to the generated Deployment
 	desiredDeployment = setClusterCertVars(desiredDeployment, d.clusterCertificateName)
 
 	if err := certificates.MountAndUseKonnectCert(ctx, d.logger, dataplane, d.client, desiredDeployment); err != nil {
-		return nil, op.Noop, fmt.Errorf("failed to mount konnect cert: %w", err)
+		return nil, fmt.Errorf("failed to mount konnect cert: %w", err)
 	}
 
 	// TODO https://github.com/kong/kong-operator/issues/128
@@ -150,22 +207,17 @@ func (d *DeploymentBuilder) BuildAndDeploy(
 	// apply user patches and set any default environment variables that aren't already set
 	desiredDeployment, err = applyDeploymentUserPatchesForDataPlane(dataplane, desiredDeployment)
 	if err != nil {
-		return nil, op.Noop, err
+		return nil, err
 	}
 	// apply default envvars and restore the hacked-out ones
 	desiredDeployment = applyEnvForDataPlane(existingEnvVars, desiredDeployment, config.KongDefaults)
+	// apply the resources recommended by vertical scaling, if any
+	applyVerticalScalingRequests(dataplane, desiredDeployment.Unwrap())
 
 	if err := k8sresources.AnnotateObjWithHash(desiredDeployment.Unwrap(), deploymentRelevantDataPlaneSpec(dataplane)); err != nil {
-		return nil, op.Noop, err
-	}
-
-	// push the complete Deployment to Kubernetes
-	res, deployment, err := reconcileDataPlaneDeployment(ctx, d.client, d.logger, enforceConfig,
-		dataplane, existingDeployment, desiredDeployment.Unwrap())
-	if err != nil {
-		return nil, op.Noop, err
+		return nil, err
 	}
-	return deployment, res, nil
+	return desiredDeployment, nil
 }
 
 // generateDataPlaneDeployment generates the base Deployment for a DataPlane. It determines the image to use and
@@ -268,6 +320,11 @@ func listOrReduceDataPlaneDeployments(
 	if err != nil {
 		return false, nil, fmt.Errorf("failed listing Deployments for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
 	}
+	// The Deployments of the DataPlane's zones are reconciled separately,
+	// each of them only considers the ones of its own zone.
+	deployments = lo.Filter(deployments, func(d appsv1.Deployment, _ int) bool {
+		return d.Labels[consts.DataPlaneZoneLabel] == additionalDeploymentLabels[consts.DataPlaneZoneLabel]
+	})
 
 	count := len(deployments)
 	if count > 1 {
@@ -376,6 +433,20 @@ func isRecentDeploymentRestart(template *corev1.PodTemplateSpec, logger logr.Log
 func deploymentRelevantDataPlaneSpec(dataplane *operatorv1beta1.DataPlane) operatorv1beta1.DataPlaneSpec {
 	spec := *dataplane.Spec.DeepCopy()
 	spec.Deployment.Scaling = nil
+	// The resources applied by vertical scaling are part of the status, include
+	// them so that a change causes the Deployment to be updated.
+	if requests := appliedVerticalScalingRequests(dataplane); len(requests) > 0 {
+		if spec.Deployment.PodTemplateSpec == nil {
+			spec.Deployment.PodTemplateSpec = &corev1.PodTemplateSpec{}
+		}
+		podSpec := &spec.Deployment.PodTemplateSpec.Spec
+		container := k8sutils.GetPodContainerByName(podSpec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			podSpec.Containers = append(podSpec.Containers, corev1.Container{Name: consts.DataPlaneProxyContainerName})
+			container = &podSpec.Containers[len(podSpec.Containers)-1]
+		}
+		container.Resources.Requests = requests.DeepCopy()
+	}
 	return spec
 }
 
@@ -30,7 +30,7 @@ import (
 
 // DataPlaneOwnedResource is a type that represents a Kubernetes resource that is owned by a DataPlane.
 type DataPlaneOwnedResource interface {
-	corev1.Service | appsv1.Deployment | corev1.Secret
+	corev1.Service | appsv1.Deployment | appsv1.DaemonSet | corev1.Secret
 }
 
 // DataPlaneOwnedResourcePointer is a type that represents a pointer to a DataPlaneOwnedResource that
@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -78,6 +80,16 @@ func ensureHPAForDataPlane(
 		return op.Noop, nil, fmt.Errorf("failed listing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
 	}
 
+	// Remove the HPAs left behind by the zones of the DataPlane's topology.
+	zoneHPAs, hpas := lo.FilterReject(hpas, func(hpa autoscalingv2.HorizontalPodAutoscaler, _ int) bool {
+		return hpa.Labels[consts.DataPlaneZoneLabel] != ""
+	})
+	if len(zoneHPAs) > 0 {
+		if err := k8sreduce.ReduceHPAs(ctx, cl, zoneHPAs, k8sreduce.FilterNone); err != nil {
+			return op.Noop, nil, fmt.Errorf("failed reducing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		}
+	}
+
 	if scaling := dataplane.Spec.Deployment.Scaling; scaling == nil || scaling.HorizontalScaling == nil {
 		if err := k8sreduce.ReduceHPAs(ctx, cl, hpas, k8sreduce.FilterNone); err != nil {
 			return op.Noop, nil, fmt.Errorf("failed reducing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
@@ -387,6 +399,16 @@ func ensureIngressServiceForDataPlane(
 			updated = true
 		}
 
+		if existingService.Spec.LoadBalancerIP != generatedService.Spec.LoadBalancerIP {
+			existingService.Spec.LoadBalancerIP = generatedService.Spec.LoadBalancerIP
+			updated = true
+		}
+
+		if !cmp.Equal(existingService.Spec.ExternalIPs, generatedService.Spec.ExternalIPs, cmpopts.EquateEmpty()) {
+			existingService.Spec.ExternalIPs = generatedService.Spec.ExternalIPs
+			updated = true
+		}
+
 		if !cmp.Equal(existingService.Spec.Selector, generatedService.Spec.Selector) {
 			existingService.Spec.Selector = generatedService.Spec.Selector
 			updated = true
@@ -0,0 +1,237 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/dataplane/certificates"
+	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/controller/pkg/patch"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sreduce "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/reduce"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
+)
+
+// dataPlaneUsesTopology returns true if the DataPlane is spread across availability
+// zones, with a Deployment per zone.
+func dataPlaneUsesTopology(dataplane *operatorv1beta1.DataPlane) bool {
+	return dataplane.Spec.Deployment.Topology != nil && !dataPlaneUsesDaemonSet(dataplane)
+}
+
+// BuildAndDeployZones builds and deploys a DataPlane Deployment for every zone of the DataPlane's topology,
+// reducing each zone's Deployments if there are more than one. It returns the zones' Deployments, keyed by
+// zone name. Once every zone's Deployment is in place, the Deployments left behind by removed zones (or by
+// the DataPlane not using a topology before) and DaemonSets are removed.
+func (d *DeploymentBuilder) BuildAndDeployZones(
+	ctx context.Context,
+	dataplane *operatorv1beta1.DataPlane,
+	enforceConfig bool,
+	validateDataPlaneImage bool,
+) (map[string]*appsv1.Deployment, op.Result, error) {
+	opts := []certificates.CertOpt{}
+	if d.secretLabelSelector != "" {
+		opts = append(opts, certificates.WithSecretLabel(d.secretLabelSelector, "true"))
+	}
+	if err := certificates.CreateKonnectCert(ctx, d.logger, dataplane, d.client, opts...); err != nil {
+		return nil, op.Noop, fmt.Errorf("failed creating konnect cert: %w", err)
+	}
+
+	zones := dataplane.Spec.Deployment.Topology.Zones
+	deployments := make(map[string]*appsv1.Deployment, len(zones))
+	for _, zone := range zones {
+		deployment, res, err := d.forZone(dataplane, zone).deploy(ctx, dataplane, enforceConfig, validateDataPlaneImage)
+		if err != nil {
+			return nil, op.Noop, fmt.Errorf("failed deploying zone %s: %w", zone.Name, err)
+		}
+		if res != op.Noop {
+			return nil, res, nil
+		}
+		deployments[zone.Name] = deployment
+	}
+
+	zoneNames := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		zoneNames = append(zoneNames, zone.Name)
+	}
+	res, err := deleteDataPlaneLiveDeploymentsOutsideZones(ctx, d.client, dataplane, zoneNames...)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	if res != op.Noop {
+		return deployments, res, nil
+	}
+
+	res, err = deleteDataPlaneDaemonSets(ctx, d.client, dataplane)
+	if err != nil {
+		return nil, op.Noop, err
+	}
+	return deployments, res, nil
+}
+
+// forZone returns a copy of the DeploymentBuilder which builds the Deployment of the provided zone.
+func (d *DeploymentBuilder) forZone(
+	dataplane *operatorv1beta1.DataPlane,
+	zone commonv1alpha1.DataPlaneTopologyZone,
+) *DeploymentBuilder {
+	zd := *d
+	zd.logger = d.logger.WithValues("zone", zone.Name)
+	zd.additionalLabels = client.MatchingLabels{}
+	maps.Copy(zd.additionalLabels, d.additionalLabels)
+	zd.additionalLabels[consts.DataPlaneZoneLabel] = zone.Name
+	zd.opts = append(slices.Clone(d.opts), zoneDeploymentOpt(dataplane, zone))
+	return &zd
+}
+
+// zoneDeploymentOpt returns a DeploymentOpt which schedules the Deployment's Pods
+// on the zone's nodes and sets the zone's replicas.
+func zoneDeploymentOpt(
+	dataplane *operatorv1beta1.DataPlane,
+	zone commonv1alpha1.DataPlaneTopologyZone,
+) k8sresources.DeploymentOpt {
+	return func(d *appsv1.Deployment) {
+		d.GenerateName = k8sutils.TrimGenerateName(fmt.Sprintf("%s-%s-%s-", consts.DataPlanePrefix, dataplane.Name, zone.Name))
+		d.Labels[consts.DataPlaneZoneLabel] = zone.Name
+		d.Spec.Selector.MatchLabels[consts.DataPlaneZoneLabel] = zone.Name
+		d.Spec.Template.Labels[consts.DataPlaneZoneLabel] = zone.Name
+
+		if d.Spec.Template.Spec.NodeSelector == nil {
+			d.Spec.Template.Spec.NodeSelector = make(map[string]string)
+		}
+		d.Spec.Template.Spec.NodeSelector[corev1.LabelTopologyZone] = zone.Name
+
+		if zone.Replicas != nil {
+			d.Spec.Replicas = zone.Replicas
+		}
+	}
+}
+
+// deleteDataPlaneLiveDeploymentsOutsideZones deletes the live Deployments owned by the DataPlane
+// which zone is not one of the provided zones. Deployments without a zone label have an empty zone.
+func deleteDataPlaneLiveDeploymentsOutsideZones(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+	zones ...string,
+) (op.Result, error) {
+	matchingLabels := k8sresources.GetManagedLabelForOwner(dataplane)
+	matchingLabels[consts.DataPlaneDeploymentStateLabel] = consts.DataPlaneStateLabelValueLive
+
+	deployments, err := k8sutils.ListDeploymentsForOwner(
+		ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		matchingLabels,
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing Deployments for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+	deployments = slices.DeleteFunc(deployments, func(d appsv1.Deployment) bool {
+		return slices.Contains(zones, d.Labels[consts.DataPlaneZoneLabel])
+	})
+	if len(deployments) == 0 {
+		return op.Noop, nil
+	}
+	if err := deleteDataPlaneOwnedObjects(ctx, cl, deployments); err != nil {
+		return op.Noop, err
+	}
+	return op.Deleted, nil
+}
+
+// ensureHPAsForDataPlaneZones ensures that every zone of the DataPlane's topology
+// has an HPA scaling its Deployment when the DataPlane has horizontal scaling
+// enabled, and removes the HPAs of zones which are not part of the topology anymore.
+func ensureHPAsForDataPlaneZones(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	deployments map[string]*appsv1.Deployment,
+) (op.Result, error) {
+	hpas, err := k8sutils.ListHPAsForOwner(
+		ctx,
+		cl,
+		dataplane.Namespace,
+		dataplane.UID,
+		k8sresources.GetManagedLabelForOwner(dataplane),
+	)
+	if err != nil {
+		return op.Noop, fmt.Errorf("failed listing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+
+	if scaling := dataplane.Spec.Deployment.Scaling; scaling == nil || scaling.HorizontalScaling == nil {
+		if err := k8sreduce.ReduceHPAs(ctx, cl, hpas, k8sreduce.FilterNone); err != nil {
+			return op.Noop, fmt.Errorf("failed reducing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		}
+		return op.Noop, nil
+	}
+
+	// Keep the first HPA found for every zone of the topology, the others are removed.
+	existingHPAs := make(map[string]*autoscalingv2.HorizontalPodAutoscaler, len(deployments))
+	var staleHPAs []autoscalingv2.HorizontalPodAutoscaler
+	for i := range hpas {
+		zone := hpas[i].Labels[consts.DataPlaneZoneLabel]
+		if _, ok := deployments[zone]; !ok || existingHPAs[zone] != nil {
+			staleHPAs = append(staleHPAs, hpas[i])
+			continue
+		}
+		existingHPAs[zone] = &hpas[i]
+	}
+
+	result := op.Noop
+	for _, zone := range dataplane.Spec.Deployment.Topology.Zones {
+		generatedHPA, err := k8sresources.GenerateHPAForDataPlane(dataplane, deployments[zone.Name].Name)
+		if err != nil {
+			return op.Noop, err
+		}
+		generatedHPA.Name = fmt.Sprintf("%s-%s", dataplane.Name, zone.Name)
+		generatedHPA.Labels[consts.DataPlaneZoneLabel] = zone.Name
+
+		existingHPA, ok := existingHPAs[zone.Name]
+		if !ok {
+			if err := cl.Create(ctx, generatedHPA); err != nil {
+				return op.Noop, fmt.Errorf("failed creating HPA for zone %s of DataPlane %s: %w", zone.Name, dataplane.Name, err)
+			}
+			result = op.Created
+			continue
+		}
+
+		var updated bool
+		oldExistingHPA := existingHPA.DeepCopy()
+		updated, existingHPA.ObjectMeta = k8sutils.EnsureObjectMetaIsUpdated(existingHPA.ObjectMeta, generatedHPA.ObjectMeta)
+		if !cmp.Equal(existingHPA.Spec, generatedHPA.Spec) {
+			existingHPA.Spec = generatedHPA.Spec
+			updated = true
+		}
+		res, _, err := patch.ApplyPatchIfNotEmpty(ctx, cl, logger, existingHPA, oldExistingHPA, updated)
+		if err != nil {
+			return op.Noop, err
+		}
+		if res != op.Noop {
+			result = res
+		}
+	}
+
+	if len(staleHPAs) > 0 {
+		if err := k8sreduce.ReduceHPAs(ctx, cl, staleHPAs, k8sreduce.FilterNone); err != nil {
+			return op.Noop, fmt.Errorf("failed reducing HPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+		}
+		if result == op.Noop {
+			result = op.Deleted
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+func TestDeploymentBuilder_BuildAndDeployZones(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataplane",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: operatorv1beta1.DataPlaneSpec{
+			DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+				Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+					DeploymentOptions: operatorv1beta1.DeploymentOptions{
+						PodTemplateSpec: &corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name:  consts.DataPlaneProxyContainerName,
+										Image: "kong/kong-gateway:3.11",
+									},
+								},
+							},
+						},
+					},
+					Topology: &commonv1alpha1.DataPlaneTopology{
+						Zones: []commonv1alpha1.DataPlaneTopologyZone{
+							{Name: "zone-a", Replicas: new(int32(2))},
+							{Name: "zone-b"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	logger := logr.Discard()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, operatorv1beta1.AddToScheme(scheme))
+
+	fakeClient := fakectrlruntimeclient.
+		NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dataplane).
+		Build()
+
+	builder := NewDeploymentBuilder(logger, fakeClient).
+		WithDefaultImage("kong:3.0").
+		WithClusterCertificate("test-cert").
+		WithAdditionalLabels(client.MatchingLabels{
+			consts.DataPlaneDeploymentStateLabel: consts.DataPlaneStateLabelValueLive,
+		}).
+		WithOpts(
+			labelSelectorFromDataPlaneStatusSelectorDeploymentOpt(dataplane),
+		)
+
+	t.Log("the zones' Deployments are created one at a time")
+	_, res, err := builder.BuildAndDeployZones(t.Context(), dataplane, true, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+	_, res, err = builder.BuildAndDeployZones(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+
+	deployments, res, err := builder.BuildAndDeployZones(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Noop, res)
+	require.Len(t, deployments, 2)
+
+	zoneA := deployments["zone-a"]
+	require.NotNil(t, zoneA)
+	assert.Equal(t, "zone-a", zoneA.Labels[consts.DataPlaneZoneLabel])
+	assert.Equal(t, "zone-a", zoneA.Spec.Selector.MatchLabels[consts.DataPlaneZoneLabel])
+	assert.Equal(t, "zone-a", zoneA.Spec.Template.Spec.NodeSelector[corev1.LabelTopologyZone])
+	assert.Equal(t, int32(2), *zoneA.Spec.Replicas)
+
+	zoneB := deployments["zone-b"]
+	require.NotNil(t, zoneB)
+	assert.Equal(t, "zone-b", zoneB.Spec.Template.Spec.NodeSelector[corev1.LabelTopologyZone])
+	assert.Equal(t, int32(1), *zoneB.Spec.Replicas)
+
+	t.Log("removing a zone deletes its Deployment")
+	dataplane.Spec.Deployment.Topology.Zones = dataplane.Spec.Deployment.Topology.Zones[:1]
+	_, res, err = builder.BuildAndDeployZones(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Deleted, res)
+
+	var list appsv1.DeploymentList
+	require.NoError(t, fakeClient.List(t.Context(), &list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "zone-a", list.Items[0].Labels[consts.DataPlaneZoneLabel])
+
+	t.Log("removing the topology deletes the zones' Deployments once the DataPlane's Deployment is in place")
+	dataplane.Spec.Deployment.Topology = nil
+	_, res, err = builder.BuildAndDeploy(t.Context(), dataplane, true, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Created, res)
+	_, res, err = builder.BuildAndDeploy(t.Context(), dataplane, false, false)
+	require.NoError(t, err)
+	require.Equal(t, op.Deleted, res)
+
+	require.NoError(t, fakeClient.List(t.Context(), &list))
+	require.Len(t, list.Items, 1)
+	assert.Empty(t, list.Items[0].Labels[consts.DataPlaneZoneLabel])
+}
+
+func TestMergeDataPlaneZoneWorkloads(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		Spec: operatorv1beta1.DataPlaneSpec{
+			DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+				Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+					Topology: &commonv1alpha1.DataPlaneTopology{
+						Zones: []commonv1alpha1.DataPlaneTopologyZone{
+							{Name: "zone-a"},
+							{Name: "zone-b"},
+						},
+					},
+				},
+			},
+		},
+	}
+	zoneA := dataPlaneWorkload{
+		Kind: "Deployment",
+		Name: "dataplane-a",
+		Zone: "zone-a",
+		Status: appsv1.DeploymentStatus{
+			Replicas:          2,
+			UpdatedReplicas:   2,
+			ReadyReplicas:     2,
+			AvailableReplicas: 2,
+		},
+	}
+	zoneB := dataPlaneWorkload{
+		Kind: "Deployment",
+		Name: "dataplane-b",
+		Zone: "zone-b",
+		Status: appsv1.DeploymentStatus{
+			Replicas:            1,
+			UpdatedReplicas:     1,
+			UnavailableReplicas: 1,
+		},
+	}
+
+	t.Run("a zone without a workload", func(t *testing.T) {
+		assert.Empty(t, mergeDataPlaneZoneWorkloads(dataplane, []dataPlaneWorkload{zoneA}))
+	})
+
+	t.Run("a zone with more than one workload", func(t *testing.T) {
+		workloads := []dataPlaneWorkload{zoneA, zoneA, zoneB}
+		assert.Equal(t, workloads, mergeDataPlaneZoneWorkloads(dataplane, workloads))
+	})
+
+	t.Run("every zone with a workload", func(t *testing.T) {
+		merged := mergeDataPlaneZoneWorkloads(dataplane, []dataPlaneWorkload{zoneB, zoneA})
+		require.Len(t, merged, 1)
+		assert.Equal(t, "dataplane-a, dataplane-b", merged[0].Name)
+		assert.Equal(t, appsv1.DeploymentStatus{
+			Replicas:            3,
+			UpdatedReplicas:     3,
+			ReadyReplicas:       2,
+			AvailableReplicas:   2,
+			UnavailableReplicas: 1,
+		}, merged[0].Status)
+
+		_, ready := isDeploymentReady(merged[0].Status)
+		assert.False(t, ready, "a DataPlane with an unavailable zone is not ready")
+	})
+
+	t.Run("zones status", func(t *testing.T) {
+		assert.Equal(t, []operatorv1beta1.DataPlaneZoneStatus{
+			{Name: "zone-a", Replicas: 2, ReadyReplicas: 2},
+			{Name: "zone-b", Replicas: 1},
+		}, dataPlaneZonesStatus(dataplane, []dataPlaneWorkload{zoneA, zoneB}))
+	})
+}
@@ -0,0 +1,261 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/controller/pkg/op"
+	"github.com/kong/kong-operator/v2/controller/pkg/patch"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
+)
+
+var verticalPodAutoscalerGVR = schema.GroupVersionResource{
+	Group:    k8sresources.VerticalPodAutoscalerGVK.Group,
+	Version:  k8sresources.VerticalPodAutoscalerGVK.Version,
+	Resource: "verticalpodautoscalers",
+}
+
+// dataPlaneUsesVerticalScaling returns true if the DataPlane has vertical scaling enabled.
+func dataPlaneUsesVerticalScaling(dataplane *operatorv1beta1.DataPlane) bool {
+	scaling := dataplane.Spec.Deployment.Scaling
+	return scaling != nil && scaling.VerticalScaling != nil
+}
+
+// verticalPodAutoscalerCRDInstalled returns true if the VerticalPodAutoscaler CRD is installed.
+func verticalPodAutoscalerCRDInstalled(cl client.Client) (bool, error) {
+	exist, err := k8sutils.CRDChecker{Client: cl}.CRDExists(verticalPodAutoscalerGVR)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if VerticalPodAutoscaler CRD is installed: %w", err)
+	}
+	return exist, nil
+}
+
+// listVPAsForDataPlane lists the VPAs owned by the DataPlane.
+func listVPAsForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	dataplane *operatorv1beta1.DataPlane,
+) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(k8sresources.VerticalPodAutoscalerGVK.GroupVersion().WithKind("VerticalPodAutoscalerList"))
+	if err := cl.List(ctx, list,
+		client.InNamespace(dataplane.Namespace),
+		client.MatchingLabels(k8sresources.GetManagedLabelForOwner(dataplane)),
+	); err != nil {
+		return nil, fmt.Errorf("failed listing VPAs for DataPlane %s/%s: %w", dataplane.Namespace, dataplane.Name, err)
+	}
+
+	vpas := make([]unstructured.Unstructured, 0, len(list.Items))
+	for _, vpa := range list.Items {
+		if k8sutils.IsOwnedByRefUID(&vpa, dataplane.UID) {
+			vpas = append(vpas, vpa)
+		}
+	}
+	return vpas, nil
+}
+
+// ensureVPAForDataPlane ensures that the DataPlane has a VPA recommending the resources
+// of its proxy container when vertical scaling is enabled, and that it has none otherwise.
+// The VPA targets the Deployment with the provided name.
+func ensureVPAForDataPlane(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+	deploymentName string,
+) (op.Result, error) {
+	installed, err := verticalPodAutoscalerCRDInstalled(cl)
+	if err != nil {
+		return op.Noop, err
+	}
+	if !installed {
+		if dataPlaneUsesVerticalScaling(dataplane) {
+			return op.Noop, fmt.Errorf("DataPlane %s/%s uses vertical scaling but the VerticalPodAutoscaler CRD is not installed",
+				dataplane.Namespace, dataplane.Name)
+		}
+		return op.Noop, nil
+	}
+
+	vpas, err := listVPAsForDataPlane(ctx, cl, dataplane)
+	if err != nil {
+		return op.Noop, err
+	}
+
+	// Keep the first VPA found if vertical scaling is enabled, the others are removed.
+	var staleVPAs []unstructured.Unstructured
+	if !dataPlaneUsesVerticalScaling(dataplane) {
+		staleVPAs = vpas
+	} else if len(vpas) > 1 {
+		staleVPAs = vpas[1:]
+	}
+	if len(staleVPAs) > 0 {
+		for i := range staleVPAs {
+			if err := cl.Delete(ctx, &staleVPAs[i]); client.IgnoreNotFound(err) != nil {
+				return op.Noop, fmt.Errorf("failed deleting VPA %s/%s: %w", staleVPAs[i].GetNamespace(), staleVPAs[i].GetName(), err)
+			}
+		}
+		return op.Deleted, nil
+	}
+	if !dataPlaneUsesVerticalScaling(dataplane) {
+		return op.Noop, nil
+	}
+
+	generatedVPA, err := k8sresources.GenerateVPAForDataPlane(dataplane, deploymentName)
+	if err != nil {
+		return op.Noop, err
+	}
+
+	if len(vpas) == 0 {
+		if err := cl.Create(ctx, generatedVPA); err != nil {
+			return op.Noop, fmt.Errorf("failed creating VPA for DataPlane %s: %w", dataplane.Name, err)
+		}
+		return op.Created, nil
+	}
+
+	var updated bool
+	existingVPA := &vpas[0]
+	oldExistingVPA := existingVPA.DeepCopy()
+
+	labels := existingVPA.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range generatedVPA.GetLabels() {
+		if labels[k] != v {
+			labels[k] = v
+			updated = true
+		}
+	}
+	existingVPA.SetLabels(labels)
+
+	if !reflect.DeepEqual(existingVPA.Object["spec"], generatedVPA.Object["spec"]) {
+		existingVPA.Object["spec"] = generatedVPA.Object["spec"]
+		updated = true
+	}
+
+	res, _, err := patch.ApplyPatchIfNotEmpty(ctx, cl, logger, existingVPA, oldExistingVPA, updated)
+	return res, err
+}
+
+// ensureDataPlaneVerticalScalingStatus reports the resources recommended by the DataPlane's
+// VPA in its status. With the ApplyOnRollout mode, the recommendation is also taken as the
+// resources applied to the proxy container, but only once per DataPlane generation: this way
+// they reach the Pods only when those are rolled out because of a spec change.
+// It returns true if the status has been patched.
+func ensureDataPlaneVerticalScalingStatus(
+	ctx context.Context,
+	cl client.Client,
+	logger logr.Logger,
+	dataplane *operatorv1beta1.DataPlane,
+) (bool, error) {
+	old := dataplane.Status.VerticalScaling
+	if !dataPlaneUsesVerticalScaling(dataplane) {
+		if old == nil {
+			return false, nil
+		}
+		dataplane.Status.VerticalScaling = nil
+		return patchDataPlaneStatus(ctx, cl, logger, dataplane)
+	}
+
+	status := &operatorv1beta1.DataPlaneVerticalScalingStatus{}
+	if old != nil {
+		status = old.DeepCopy()
+	}
+
+	installed, err := verticalPodAutoscalerCRDInstalled(cl)
+	if err != nil {
+		return false, err
+	}
+	if installed {
+		vpas, err := listVPAsForDataPlane(ctx, cl, dataplane)
+		if err != nil {
+			return false, err
+		}
+		if len(vpas) > 0 {
+			recommendation, err := k8sresources.GetVPARecommendationForContainer(&vpas[0], consts.DataPlaneProxyContainerName)
+			if err != nil {
+				return false, err
+			}
+			if recommendation != nil {
+				status.Recommendation = recommendation.Target
+				status.LowerBound = recommendation.LowerBound
+				status.UpperBound = recommendation.UpperBound
+			}
+		}
+	}
+
+	switch dataplane.Spec.Deployment.Scaling.VerticalScaling.Mode {
+	case operatorv1beta1.VerticalScalingModeApplyOnRollout:
+		// The generation is recorded even without a recommendation, so that a
+		// recommendation coming later doesn't roll out Pods on its own. Previously
+		// applied resources are kept so they're not dropped with the next rollout.
+		if status.AppliedGeneration != dataplane.Generation {
+			if len(status.Recommendation) > 0 {
+				status.Applied = status.Recommendation
+			}
+			status.AppliedGeneration = dataplane.Generation
+		}
+	default:
+		status.Applied = nil
+		status.AppliedGeneration = 0
+	}
+
+	if old != nil && equality.Semantic.DeepEqual(*old, *status) {
+		return false, nil
+	}
+	log.Debug(logger, "updating DataPlane vertical scaling status")
+	dataplane.Status.VerticalScaling = status
+	return patchDataPlaneStatus(ctx, cl, logger, dataplane)
+}
+
+// appliedVerticalScalingRequests returns the resources recommended for the DataPlane's
+// proxy container which should be set as its requests.
+func appliedVerticalScalingRequests(dataplane *operatorv1beta1.DataPlane) corev1.ResourceList {
+	if !dataPlaneUsesVerticalScaling(dataplane) ||
+		dataplane.Spec.Deployment.Scaling.VerticalScaling.Mode != operatorv1beta1.VerticalScalingModeApplyOnRollout ||
+		dataplane.Status.VerticalScaling == nil {
+		return nil
+	}
+	return dataplane.Status.VerticalScaling.Applied
+}
+
+// applyVerticalScalingRequests sets the resources recommended for the proxy container
+// as its requests, raising its limits to them where they're lower.
+func applyVerticalScalingRequests(dataplane *operatorv1beta1.DataPlane, deployment *appsv1.Deployment) {
+	requests := appliedVerticalScalingRequests(dataplane)
+	if len(requests) == 0 {
+		return
+	}
+	container := k8sutils.GetPodContainerByName(&deployment.Spec.Template.Spec, consts.DataPlaneProxyContainerName)
+	if container == nil {
+		return
+	}
+
+	container.Resources.Requests = maps.Clone(container.Resources.Requests)
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = make(corev1.ResourceList, len(requests))
+	}
+	if container.Resources.Limits != nil {
+		container.Resources.Limits = maps.Clone(container.Resources.Limits)
+	}
+	for name, request := range requests {
+		container.Resources.Requests[name] = request
+		if limit, ok := container.Resources.Limits[name]; ok && limit.Cmp(request) < 0 {
+			container.Resources.Limits[name] = request
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
+)
+
+func TestApplyVerticalScalingRequests(t *testing.T) {
+	dataPlane := func(mode operatorv1beta1.VerticalScalingMode) *operatorv1beta1.DataPlane {
+		dp := &operatorv1beta1.DataPlane{}
+		dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{
+			VerticalScaling: &operatorv1beta1.VerticalScaling{
+				Mode: mode,
+			},
+		}
+		dp.Status.VerticalScaling = &operatorv1beta1.DataPlaneVerticalScalingStatus{
+			Recommendation: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+			Applied: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		}
+		return dp
+	}
+	deployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: consts.DataPlaneProxyContainerName,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU: resource.MustParse("100m"),
+									},
+									Limits: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("2"),
+										corev1.ResourceMemory: resource.MustParse("256Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("recommend only", func(t *testing.T) {
+		d := deployment()
+		applyVerticalScalingRequests(dataPlane(operatorv1beta1.VerticalScalingModeRecommendOnly), d)
+		assert.Equal(t, deployment(), d)
+	})
+
+	t.Run("apply on rollout", func(t *testing.T) {
+		d := deployment()
+		applyVerticalScalingRequests(dataPlane(operatorv1beta1.VerticalScalingModeApplyOnRollout), d)
+		resources := d.Spec.Template.Spec.Containers[0].Resources
+		assert.True(t, resource.MustParse("500m").Equal(resources.Requests[corev1.ResourceCPU]))
+		assert.True(t, resource.MustParse("512Mi").Equal(resources.Requests[corev1.ResourceMemory]))
+		assert.True(t, resource.MustParse("2").Equal(resources.Limits[corev1.ResourceCPU]), "higher limits are kept")
+		assert.True(t, resource.MustParse("512Mi").Equal(resources.Limits[corev1.ResourceMemory]), "lower limits are raised to the requests")
+	})
+}
+
+func TestDeploymentRelevantDataPlaneSpec_VerticalScaling(t *testing.T) {
+	dp := &operatorv1beta1.DataPlane{}
+	dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{
+		VerticalScaling: &operatorv1beta1.VerticalScaling{
+			Mode: operatorv1beta1.VerticalScalingModeApplyOnRollout,
+		},
+	}
+	dp.Status.VerticalScaling = &operatorv1beta1.DataPlaneVerticalScalingStatus{}
+	hash := func() string {
+		h, err := k8sresources.CalculateHash(deploymentRelevantDataPlaneSpec(dp))
+		require.NoError(t, err)
+		return h
+	}
+
+	before := hash()
+	dp.Status.VerticalScaling.Recommendation = corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("500m"),
+	}
+	assert.Equal(t, before, hash(), "a recommendation alone doesn't change the Deployment")
+
+	dp.Status.VerticalScaling.Applied = dp.Status.VerticalScaling.Recommendation
+	assert.NotEqual(t, before, hash(), "applied resources change the Deployment")
+	assert.Nil(t, dp.Spec.Deployment.PodTemplateSpec, "the DataPlane's spec is left untouched")
+}
@@ -8,6 +8,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,8 +19,10 @@ import (
 
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
 	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/internal/utils/index"
 	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sresources "github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
 )
 
 // DataPlaneWatchBuilder creates a controller builder pre-configured with
@@ -35,6 +38,8 @@ func DataPlaneWatchBuilder(mgr ctrl.Manager, konnectEnabled bool) *builder.Build
 		Owns(&corev1.Service{}).
 		// Watch for changes in Deployments created by the dataplane controller.
 		Owns(&appsv1.Deployment{}).
+		// Watch for changes in DaemonSets created by the dataplane controller.
+		Owns(&appsv1.DaemonSet{}).
 		// Watch for changes in HPA created by the dataplane controller.
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		// Watch for changes in PodDisruptionBudgets created by the dataplane controller.
@@ -51,6 +56,18 @@ func DataPlaneWatchBuilder(mgr ctrl.Manager, konnectEnabled bool) *builder.Build
 			),
 		)
 
+	// Watch for changes in VPAs created by the dataplane controller, only if the
+	// VerticalPodAutoscaler CRD is present in the cluster as it's optional.
+	if vpaExist, err := verticalPodAutoscalerCRDInstalled(mgr.GetClient()); err != nil {
+		log.Error(mgr.GetLogger(), err, "failed to check if VerticalPodAutoscaler CRD exists, skipping watch for VerticalPodAutoscaler resources")
+	} else if vpaExist {
+		vpa := &unstructured.Unstructured{}
+		vpa.SetGroupVersionKind(k8sresources.VerticalPodAutoscalerGVK)
+		controller.Owns(vpa)
+	} else {
+		log.Info(mgr.GetLogger(), "VerticalPodAutoscaler CRD not found in cluster, skipping watch for VerticalPodAutoscaler resources")
+	}
+
 	if konnectEnabled {
 		// Watch for changes in KonnectExtension objects that are referenced by DataPlane objects.
 		// They may trigger reconciliation of DataPlane resources.
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/managedfields"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	eventgatewayv1alpha1 "github.com/kong/kong-operator/v2/api/eventgateway/v1alpha1"
 	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
 	"github.com/kong/kong-operator/v2/controller/konnect/server"
@@ -51,6 +52,8 @@ func (r *Reconciler) ensureDeployment(
 	keg *konnectv1alpha1.KonnectEventGateway,
 	certSecretName string,
 ) error {
+	ensurePodSecurityStatus(egdp)
+
 	image := resolveImage(egdp, consts.DefaultKEGImage)
 	desired, err := buildDeployment(logger, r.TypeConverter, egdp, keg, image, certSecretName)
 	if err != nil {
@@ -79,6 +82,15 @@ func (r *Reconciler) ensureDeployment(
 	return nil
 }
 
+// hardeningState returns the hardening level of the KegDataPlane's Pods.
+// It defaults to enabled, as these Pods have always been hardened.
+func hardeningState(egdp *eventgatewayv1alpha1.KegDataPlane) commonv1alpha1.HardeningState {
+	if egdp.Spec.Deployment == nil || egdp.Spec.Deployment.Hardened == "" {
+		return commonv1alpha1.HardeningStateEnabled
+	}
+	return egdp.Spec.Deployment.Hardened
+}
+
 // resolveImage determines the keg container image using the following priority:
 //  1. User-specified image in spec.deployment.podTemplateSpec (container named "keg")
 //  2. RELATED_IMAGE_KEG environment variable
@@ -210,7 +222,7 @@ func generateBaseDeployment(
 			},
 		},
 	}
-	container, volumes := resources.HardenContainerWithSecurityContext(container, resources.DataPlaneTypeKeg)
+	container, volumes := resources.HardenContainer(container, resources.DataPlaneTypeKeg, hardeningState(egdp))
 
 	volumes = append(
 		volumes,
@@ -255,6 +267,8 @@ func generateBaseDeployment(
 		},
 	}
 
+	resources.HardenPodSpec(&d.Spec.Template.Spec, hardeningState(egdp))
+
 	k8sutils.SetOwnerForObject(d, egdp)
 
 	addAnnotationsForKegDataPlaneDeployment(logger, d, egdp)
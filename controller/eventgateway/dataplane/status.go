@@ -26,11 +26,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	eventgatewayv1alpha1 "github.com/kong/kong-operator/v2/api/eventgateway/v1alpha1"
 	log "github.com/kong/kong-operator/v2/controller/pkg/log"
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
 	controllerpkgssa "github.com/kong/kong-operator/v2/controller/pkg/ssa"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	"github.com/kong/kong-operator/v2/pkg/utils/kubernetes/resources"
 )
 
 // ensureReadyStatus computes the Ready condition for a KegDataPlane.
@@ -44,6 +46,10 @@ func ensureReadyStatus(
 	egdp *eventgatewayv1alpha1.KegDataPlane,
 ) error {
 	for _, c := range egdp.Status.Conditions {
+		// PodSecurityCompliant is informational and doesn't affect readiness.
+		if c.Type == string(commonv1alpha1.PodSecurityCompliantType) {
+			continue
+		}
 		if c.Type != string(eventgatewayv1alpha1.ReadyType) && c.Status == metav1.ConditionFalse {
 			apimeta.SetStatusCondition(&egdp.Status.Conditions, metav1.Condition{
 				Type:               string(eventgatewayv1alpha1.ReadyType),
@@ -96,6 +102,24 @@ func ensureReadyStatus(
 	return nil
 }
 
+// ensurePodSecurityStatus sets the PodSecurityCompliant condition reporting
+// whether the PodTemplateSpec patches of the KegDataPlane comply with the Pod
+// Security Standard of its hardening level, or removes it when the level
+// doesn't map to one.
+// Status is not patched here; the caller flushes via applyStatus.
+func ensurePodSecurityStatus(egdp *eventgatewayv1alpha1.KegDataPlane) {
+	var pts *corev1.PodTemplateSpec
+	if egdp.Spec.Deployment != nil {
+		pts = egdp.Spec.Deployment.PodTemplateSpec
+	}
+	condition, ok := resources.PodSecurityCondition(pts, hardeningState(egdp), egdp.Generation)
+	if !ok {
+		apimeta.RemoveStatusCondition(&egdp.Status.Conditions, string(commonv1alpha1.PodSecurityCompliantType))
+		return
+	}
+	apimeta.SetStatusCondition(&egdp.Status.Conditions, condition)
+}
+
 // applyStatus patches the KegDataPlane status subresource via SSA.
 func (r *Reconciler) applyStatus(
 	ctx context.Context,
@@ -47,9 +47,11 @@ import (
 	"github.com/kong/kong-operator/v2/controller/pkg/secrets/ref"
 	"github.com/kong/kong-operator/v2/controller/pkg/watch"
 	operatorerrors "github.com/kong/kong-operator/v2/internal/errors"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 	"github.com/kong/kong-operator/v2/internal/utils/gatewayclass"
 	gwconfigutils "github.com/kong/kong-operator/v2/internal/utils/gatewayconfig"
+	"github.com/kong/kong-operator/v2/internal/utils/index"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	gatewayutils "github.com/kong/kong-operator/v2/pkg/utils/gateway"
@@ -77,6 +79,12 @@ type Reconciler struct {
 	AnonymousReportsEnabled bool
 	LoggingMode             logging.Mode
 	WatchNamespaces         []string
+
+	// listenerSetsSupported is set when the ListenerSet CRD is installed in the cluster.
+	listenerSetsSupported bool
+	// gatewaysForBackendService list the Gateways attached by the routes referencing
+	// a Service, one for each route type installed in the cluster.
+	gatewaysForBackendService []gatewaysForBackendServiceFunc
 }
 
 // provisionDataPlaneFailRequeueAfter is the time duration after which we retry provisioning
@@ -152,6 +160,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 		)
 	}
 
+	r.gatewaysForBackendService = []gatewaysForBackendServiceFunc{
+		listGatewaysAttachedByRoutesForService[gwtypes.HTTPRoute, gwtypes.HTTPRouteList](index.BackendServicesOnHTTPRouteIndex),
+	}
+
 	crdChecker := k8sutils.CRDChecker{Client: r.Client}
 	// Add TLSRoute watch only if TLSRoute CRD is present in the cluster, to avoid watching for a resource that doesn't exist and that would trigger reconciliation for all the Gateways on every event in the cluster.
 	tlsRouteGVR := schema.GroupVersionResource{
@@ -169,6 +181,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByTLSRoute),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		)
+		r.gatewaysForBackendService = append(r.gatewaysForBackendService,
+			listGatewaysAttachedByRoutesForService[gwtypes.TLSRoute, gwtypes.TLSRouteList](index.BackendServicesOnTLSRouteIndex),
+		)
 	} else {
 		log.Info(mgr.GetLogger(), "TLSRoute CRD not found in cluster, skipping watch for TLSRoute resources")
 	}
@@ -188,6 +203,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByGRPCRoute),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		)
+		r.gatewaysForBackendService = append(r.gatewaysForBackendService,
+			listGatewaysAttachedByRoutesForService[gwtypes.GRPCRoute, gwtypes.GRPCRouteList](index.BackendServicesOnGRPCRouteIndex),
+		)
 	} else {
 		log.Info(mgr.GetLogger(), "GRPCRoute CRD not found in cluster, skipping watch for GRPCRoute resources")
 	}
@@ -207,6 +225,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByUDPRoute),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		)
+		r.gatewaysForBackendService = append(r.gatewaysForBackendService,
+			listGatewaysAttachedByRoutesForService[gwtypes.UDPRoute, gwtypes.UDPRouteList](index.BackendServicesOnUDPRouteIndex),
+		)
 	} else {
 		log.Info(mgr.GetLogger(), "UDPRoute CRD not found in cluster, skipping watch for UDPRoute resources")
 	}
@@ -226,8 +247,53 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 			handler.EnqueueRequestsFromMapFunc(r.listGatewaysAttachedByTCPRoute),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		)
+		r.gatewaysForBackendService = append(r.gatewaysForBackendService,
+			listGatewaysAttachedByRoutesForService[gwtypes.TCPRoute, gwtypes.TCPRouteList](index.BackendServicesOnTCPRouteIndex),
+		)
+	}
+
+	listenerSetGVR := schema.GroupVersionResource{
+		Group:    gatewayv1.GroupVersion.Group,
+		Version:  gatewayv1.GroupVersion.Version,
+		Resource: "listenersets",
+	}
+	r.listenerSetsSupported, err = crdChecker.CRDExists(listenerSetGVR)
+	if err != nil {
+		return fmt.Errorf("failed to check if ListenerSet CRD exists: %w", err)
+	}
+	if r.listenerSetsSupported {
+		// Status updates of ListenerSets are performed by this controller, hence
+		// only generation changes are taken into account.
+		blder.Watches(
+			&gwtypes.ListenerSet{},
+			handler.EnqueueRequestsFromMapFunc(r.listGatewaysForListenerSet),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+	} else {
+		log.Info(mgr.GetLogger(), "ListenerSet CRD not found in cluster, skipping watch for ListenerSet resources")
 	}
 
+	// Watch Services to requeue Gateways whose routes use them as backends, so that the
+	// egress rules of their NetworkPolicies follow the Services' selectors and ports.
+	blder.WatchesRawSource(
+		source.Kind(
+			mgr.GetCache(),
+			&corev1.Service{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.listGatewaysForBackendService),
+			backendServiceChangedPredicate,
+		),
+	)
+
+	// Watch ReferenceGrants to requeue Gateways whose routes use Services in other namespaces
+	// as backends, so that the egress rules of their NetworkPolicies follow the grants.
+	blder.WatchesRawSource(
+		source.Kind(
+			mgr.GetCache(),
+			&gwtypes.ReferenceGrant{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.listGatewaysForBackendReferenceGrant),
+		),
+	)
+
 	// Watch Secrets to requeue Gateways that reference them via listeners.tls.certificateRefs.
 	blder.WatchesRawSource(
 		source.Kind(
@@ -237,7 +303,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) err
 		),
 	)
 
-	return blder.Complete(reconcile.AsReconciler(r.Client, r))
+	return blder.Complete(reconcile.AsReconciler(r.Client, opentelemetry.ObjectReconciler[*gwtypes.Gateway]("Gateway", r)))
 }
 
 // Reconcile moves the current state of an object to the intended state.
@@ -343,6 +409,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, gateway *gwtypes.Gateway) (c
 		return ctrl.Result{}, nil
 	}
 
+	log.Trace(logger, "reconciling ListenerSets attached to the gateway")
+	listenerSetListeners, err := r.reconcileListenerSets(ctx, logger, gateway, k8sutils.IsProgrammed(oldGwConditionsAware))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	log.Trace(logger, "determining configuration")
 	gatewayConfig, err := r.getOrCreateGatewayConfiguration(ctx, gwc.GatewayClass, gateway)
 	if err != nil {
@@ -430,13 +502,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, gateway *gwtypes.Gateway) (c
 	// Provision dataplane creates a dataplane and adds the DataPlaneReady=True
 	// condition to the Gateway status if the dataplane is ready. If not ready
 	// the status DataPlaneReady=False will be set instead.
-	dataplane, provisionErr := r.provisionDataPlane(ctx, logger, gateway, gatewayConfig, konnectExtension)
+	spanCtx, span := opentelemetry.StartSpan(ctx, "ProvisionDataPlane", opentelemetry.ObjectAttributes("Gateway", gateway)...)
+	dataplane, provisionErr := r.provisionDataPlane(spanCtx, logger, gateway, gatewayConfig, konnectExtension,
+		dataPlaneListenersForGateway(gateway, listenerSetListeners),
+	)
+	opentelemetry.EndSpan(span, provisionErr)
 	if provisionErr == nil && k8sutils.RunningOnKubernetes() {
 		// DataPlane NetworkPolicies
 		// Only create network policies if KO is running inside k8s.
 		// If the code is run outside of k8s (like in envtest or integration test), do not create network policies.
 		log.Trace(logger, "ensuring DataPlane's NetworkPolicy exists")
-		createdOrUpdated, err := r.ensureDataPlaneHasNetworkPolicy(ctx, gateway, dataplane)
+		createdOrUpdated, err := r.ensureDataPlaneHasNetworkPolicy(ctx, gateway, gatewayConfig, dataplane)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -577,7 +653,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, gateway *gwtypes.Gateway) (c
 	if !isHybridGateway {
 		// Provision controlplane creates a controlplane and adds the ControlPlaneReady condition to the Gateway status
 		// if the controlplane is ready, the ControlPlaneReady status is set to true, otherwise false.
-		controlplane := r.provisionControlPlane(ctx, logger, gateway, gatewayConfig)
+		spanCtx, span := opentelemetry.StartSpan(ctx, "ProvisionControlPlane", opentelemetry.ObjectAttributes("Gateway", gateway)...)
+		controlplane := r.provisionControlPlane(spanCtx, logger, gateway, gatewayConfig)
+		opentelemetry.EndSpan(span, nil)
 		// Set the ControlPlaneReady Condition to False. This happens only if:
 		// * the new status is false and there was no ControlPlaneReady condition in the gateway
 		// * the new status is false and the previous status was true
@@ -632,6 +710,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, gateway *gwtypes.Gateway) (c
 	}
 
 	gwConditionAware.setProgrammed(metav1.ConditionTrue)
+	// Requested addresses that were not bound to the Gateway make it not Programmed.
+	if reason, msg, ok := gatewaySpecAddressesCondition(gateway.Spec.Addresses, gateway.Status.Addresses, dataplane); !ok {
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				kcfgconsts.ConditionType(gatewayv1.GatewayConditionProgrammed),
+				metav1.ConditionFalse,
+				kcfgconsts.ConditionReason(reason),
+				msg,
+				gateway.Generation,
+			),
+			gwConditionAware,
+		)
+	}
 	_, err = patch.ApplyStatusPatchIfNotEmpty(ctx, r.Client, logger, gateway, oldGateway)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -651,6 +742,7 @@ func (r *Reconciler) provisionDataPlane(
 	gateway *gwtypes.Gateway,
 	gatewayConfig *GatewayConfiguration,
 	konnectExtension *konnectv1alpha2.KonnectExtension,
+	listeners []gatewayv1.Listener,
 ) (*operatorv1beta1.DataPlane, error) {
 	logger = logger.WithName("dataplaneProvisioning")
 
@@ -684,7 +776,7 @@ func (r *Reconciler) provisionDataPlane(
 		return nil, err
 	}
 	if count == 0 {
-		dataplane, err := r.createDataPlane(ctx, gateway, gatewayConfig, konnectExtension)
+		dataplane, err := r.createDataPlane(ctx, gateway, gatewayConfig, konnectExtension, listeners)
 		if err != nil {
 			errWrap := fmt.Errorf("dataplane creation failed - error: %w", err)
 			k8sutils.SetCondition(
@@ -716,8 +808,9 @@ func (r *Reconciler) provisionDataPlane(
 	// Add the GEP-1762 gateway-name label unconditionally (after mergeInfrastructureIntoDataPlane
 	// so it cannot be overridden by spec.infrastructure).
 	setGatewayNameLabelInDataPlane(expectedDataPlaneOptions, gateway.Name)
+	setDataPlaneIngressServiceAddresses(expectedDataPlaneOptions, gateway.Spec.Addresses)
 
-	err = setDataPlaneOptionsForListeners(expectedDataPlaneOptions, gateway.Spec.Listeners, gatewayConfig.Spec.ListenersOptions)
+	err = setDataPlaneOptionsForListeners(expectedDataPlaneOptions, listeners, gatewayConfig.Spec.ListenersOptions)
 	if err != nil {
 		errWrap := fmt.Errorf("dataplane creation failed - error: %w", err)
 		k8sutils.SetCondition(
@@ -1102,8 +1195,15 @@ func setDataPlaneOptionsDefaults(opts *operatorv1beta1.DataPlaneOptions, default
 		})
 	}
 
-	// If no replicas are set, set it to default 1, but only if Scaling is not set as well.
-	if opts.Deployment.Replicas == nil && opts.Deployment.Scaling == nil {
+	// If no replicas are set, set it to default 1, but only if horizontal scaling
+	// is not set as well and the DataPlane runs a single Deployment: replicas
+	// are rejected by the API for DaemonSets and topologies.
+	if opts.Deployment.Replicas == nil &&
+		(opts.Deployment.Scaling == nil || opts.Deployment.Scaling.HorizontalScaling == nil) &&
+		// With a topology every zone's Deployment has its own replicas or scaling.
+		opts.Deployment.Topology == nil &&
+		// A DaemonSet runs one Pod on every matching node.
+		opts.Deployment.WorkloadType != commonv1alpha1.WorkloadTypeDaemonSet {
 		opts.Deployment.Replicas = new(int32(1))
 	}
 }
@@ -1187,6 +1287,14 @@ func deploymentOptionsDeepEqual(o1, o2 *operatorv1beta1.DataPlaneDeploymentOptio
 		return false
 	}
 
+	if o1.WorkloadType != o2.WorkloadType || o1.HostBinding != o2.HostBinding {
+		return false
+	}
+
+	if !reflect.DeepEqual(o1.Topology, o2.Topology) {
+		return false
+	}
+
 	opts := []cmp.Option{
 		cmp.Comparer(k8sresources.ResourceRequirementsEqual),
 		cmp.Comparer(func(a, b []corev1.EnvVar) bool {
@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +36,7 @@ import (
 	operatorv2beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v2beta1"
 	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
 	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	hybridgatewayroute "github.com/kong/kong-operator/v2/controller/hybridgateway/route"
 	"github.com/kong/kong-operator/v2/controller/pkg/extensions"
 	"github.com/kong/kong-operator/v2/controller/pkg/secrets"
 	"github.com/kong/kong-operator/v2/controller/pkg/secrets/ref"
@@ -56,6 +58,7 @@ func (r *Reconciler) createDataPlane(
 	gateway *gwtypes.Gateway,
 	gatewayConfig *GatewayConfiguration,
 	konnectExtension *konnectv1alpha2.KonnectExtension,
+	listeners []gatewayv1.Listener,
 ) (*operatorv1beta1.DataPlane, error) {
 	dataplane := &operatorv1beta1.DataPlane{}
 	setObjectNamespaceName(gateway, dataplane)
@@ -68,11 +71,12 @@ func (r *Reconciler) createDataPlane(
 	// Add the GEP-1762 gateway-name label unconditionally (after mergeInfrastructureIntoDataPlane
 	// so it cannot be overridden by spec.infrastructure).
 	setGatewayNameLabelInDataPlane(&dataplane.Spec.DataPlaneOptions, gateway.Name)
+	setDataPlaneIngressServiceAddresses(&dataplane.Spec.DataPlaneOptions, gateway.Spec.Addresses)
 	setDataPlaneOptionsDefaults(&dataplane.Spec.DataPlaneOptions, r.DefaultDataPlaneImage)
 
 	if err := setDataPlaneOptionsForListeners(
 		&dataplane.Spec.DataPlaneOptions,
-		gateway.Spec.Listeners,
+		listeners,
 		gatewayConfig.Spec.ListenersOptions,
 	); err != nil {
 		return nil, err
@@ -445,6 +449,119 @@ func setGatewayNameLabelInDataPlane(spec *operatorv1beta1.DataPlaneOptions, gate
 	spec.Network.Services.Ingress.Labels[operatorv1beta1.LabelName(consts.GatewayNameLabel)] = operatorv1beta1.LabelValue(gatewayName)
 }
 
+// setDataPlaneIngressServiceAddresses translates the static IP addresses requested
+// in Gateway's spec.addresses into the DataPlane ingress Service options.
+// A LoadBalancer Service can only request a single IP from the cloud provider,
+// so the first requested IP is used as its loadBalancerIP while the other ones
+// are exposed as its externalIPs. Services of other types expose all the
+// requested IPs as externalIPs.
+// Hostname addresses cannot be requested through the Service: they are only
+// verified against the addresses assigned to it (see gatewaySpecAddressesCondition).
+func setDataPlaneIngressServiceAddresses(
+	spec *operatorv1beta1.DataPlaneOptions,
+	addresses []gatewayv1.GatewaySpecAddress,
+) {
+	var ips []string
+	for _, addr := range addresses {
+		if gatewaySpecAddressType(addr) == gatewayv1.IPAddressType && addr.Value != "" {
+			ips = append(ips, addr.Value)
+		}
+	}
+	if len(ips) == 0 {
+		return
+	}
+
+	if spec.Network.Services == nil {
+		spec.Network.Services = &operatorv1beta1.DataPlaneServices{}
+	}
+	if spec.Network.Services.Ingress == nil {
+		spec.Network.Services.Ingress = &operatorv1beta1.DataPlaneServiceOptions{}
+	}
+	ingress := spec.Network.Services.Ingress
+	switch ingress.Type {
+	case corev1.ServiceTypeLoadBalancer, "":
+		ingress.LoadBalancerIP = new(ips[0])
+		if len(ips) > 1 {
+			ingress.ExternalIPs = ips[1:]
+		}
+	default:
+		ingress.ExternalIPs = ips
+	}
+}
+
+// gatewaySpecAddressesCondition verifies that all the addresses requested in
+// Gateway's spec.addresses have been bound to the Gateway. When that's not the
+// case it returns false along with the reason and the message that should be
+// used for the Gateway's Programmed condition.
+func gatewaySpecAddressesCondition(
+	specAddresses []gatewayv1.GatewaySpecAddress,
+	statusAddresses []gwtypes.GatewayStatusAddress,
+	dataplane *operatorv1beta1.DataPlane,
+) (gatewayv1.GatewayConditionReason, string, bool) {
+	var requestedIPs []string
+	if dataplane.Spec.Network.Services != nil && dataplane.Spec.Network.Services.Ingress != nil {
+		ingress := dataplane.Spec.Network.Services.Ingress
+		if ingress.LoadBalancerIP != nil {
+			requestedIPs = append(requestedIPs, *ingress.LoadBalancerIP)
+		}
+		requestedIPs = append(requestedIPs, ingress.ExternalIPs...)
+	}
+
+	var notUsable, notAssigned []string
+	for _, addr := range specAddresses {
+		addrType := gatewaySpecAddressType(addr)
+		assigned := lo.Filter(statusAddresses, func(a gwtypes.GatewayStatusAddress, _ int) bool {
+			return a.Type != nil && *a.Type == addrType
+		})
+		// Addresses assigned for other requested addresses (e.g. the externalIPs of
+		// a LoadBalancer Service) don't mean this one has been assigned another value.
+		unrequested := lo.Reject(assigned, func(a gwtypes.GatewayStatusAddress, _ int) bool {
+			return lo.ContainsBy(specAddresses, func(specAddr gatewayv1.GatewaySpecAddress) bool {
+				return specAddr.Value == a.Value
+			})
+		})
+
+		switch {
+		case addrType != gatewayv1.IPAddressType && addrType != gatewayv1.HostnameAddressType:
+			notUsable = append(notUsable, fmt.Sprintf("address type %s is not supported", addrType))
+		case addr.Value == "":
+			if len(assigned) == 0 {
+				notAssigned = append(notAssigned, fmt.Sprintf("no %s address has been assigned yet", addrType))
+			}
+		case lo.ContainsBy(assigned, func(a gwtypes.GatewayStatusAddress) bool { return a.Value == addr.Value }):
+			// The requested address has been bound to the Gateway.
+		case addrType == gatewayv1.HostnameAddressType:
+			notUsable = append(notUsable, fmt.Sprintf("%s %s cannot be requested for the DataPlane ingress Service, "+
+				"only hostnames assigned to it by the load balancer can be used", addrType, addr.Value))
+		case addrType == gatewayv1.IPAddressType && !lo.Contains(requestedIPs, addr.Value):
+			notUsable = append(notUsable, fmt.Sprintf("%s %s cannot be requested for the DataPlane ingress Service", addrType, addr.Value))
+		case len(unrequested) > 0:
+			notUsable = append(notUsable, fmt.Sprintf("%s %s has not been assigned, the DataPlane ingress Service got %s instead",
+				addrType, addr.Value, strings.Join(lo.Map(unrequested, func(a gwtypes.GatewayStatusAddress, _ int) string { return a.Value }), ", ")))
+		default:
+			notAssigned = append(notAssigned, fmt.Sprintf("%s %s has not been assigned yet", addrType, addr.Value))
+		}
+	}
+
+	switch {
+	case len(notUsable) > 0:
+		return gatewayv1.GatewayReasonAddressNotUsable, strings.Join(notUsable, "; "), false
+	case len(notAssigned) > 0:
+		return gatewayv1.GatewayReasonAddressNotAssigned, strings.Join(notAssigned, "; "), false
+	default:
+		return gatewayv1.GatewayReasonProgrammed, "", true
+	}
+}
+
+// gatewaySpecAddressType returns the type of the requested address, defaulting
+// to IPAddress as defined by the Gateway API.
+func gatewaySpecAddressType(addr gatewayv1.GatewaySpecAddress) gatewayv1.AddressType {
+	if addr.Type == nil {
+		return gatewayv1.IPAddressType
+	}
+	return *addr.Type
+}
+
 func gatewayAddressesFromService(svc corev1.Service) ([]gwtypes.GatewayStatusAddress, error) {
 	addresses := make([]gwtypes.GatewayStatusAddress, 0, len(svc.Status.LoadBalancer.Ingress))
 
@@ -464,6 +581,12 @@ func gatewayAddressesFromService(svc corev1.Service) ([]gwtypes.GatewayStatusAdd
 				})
 			}
 		}
+		for _, externalIP := range svc.Spec.ExternalIPs {
+			addresses = append(addresses, gwtypes.GatewayStatusAddress{
+				Value: externalIP,
+				Type:  new(gatewayv1.IPAddressType),
+			})
+		}
 	default:
 		// if the Service is not a LoadBalancer, it will never have any public addresses and its status address list
 		// will always be empty, so we use its internal IP instead
@@ -474,6 +597,12 @@ func gatewayAddressesFromService(svc corev1.Service) ([]gwtypes.GatewayStatusAdd
 			Value: svc.Spec.ClusterIP,
 			Type:  new(gatewayv1.IPAddressType),
 		})
+		for _, externalIP := range svc.Spec.ExternalIPs {
+			addresses = append(addresses, gwtypes.GatewayStatusAddress{
+				Value: externalIP,
+				Type:  new(gatewayv1.IPAddressType),
+			})
+		}
 	}
 	sort.SliceStable(addresses, func(i, j int) bool {
 		left := addresses[i]
@@ -580,6 +709,7 @@ func (r *Reconciler) getOrCreateGatewayConfiguration(
 func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 	ctx context.Context,
 	gateway *gwtypes.Gateway,
+	gatewayConfig *GatewayConfiguration,
 	dataplane *operatorv1beta1.DataPlane,
 ) (createdOrUpdate bool, err error) {
 	networkPolicies, err := gatewayutils.ListNetworkPoliciesForGateway(ctx, r.Client, gateway)
@@ -587,6 +717,20 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 		return false, err
 	}
 
+	opts := dataPlaneNetworkPolicyOptions{
+		konnectEgress: gwconfigutils.IsGatewayHybrid(gatewayConfig),
+	}
+	if gatewayConfig.Spec.DataPlaneOptions != nil &&
+		gatewayConfig.Spec.DataPlaneOptions.Network.NetworkPolicy != nil {
+		opts.config = gatewayConfig.Spec.DataPlaneOptions.Network.NetworkPolicy
+	}
+	if opts.profile() == operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist {
+		opts.backendEgress, err = r.backendEgressRulesForGateway(ctx, gateway)
+		if err != nil {
+			return false, fmt.Errorf("failed deriving backend egress rules for DataPlane %s: %w", dataplane.Name, err)
+		}
+	}
+
 	count := len(networkPolicies)
 	if count > 1 {
 		if err := k8sreduce.ReduceNetworkPolicies(ctx, r.Client, networkPolicies); err != nil {
@@ -596,7 +740,7 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 	}
 
 	// generate the network policy that allows the KO pod to access the admin APIs of dataplane pods.
-	generatedPolicy, err := generateDataPlaneNetworkPolicy(r.Namespace, dataplane, r.PodLabels)
+	generatedPolicy, err := generateDataPlaneNetworkPolicy(r.Namespace, dataplane, r.PodLabels, opts)
 	if err != nil {
 		return false, fmt.Errorf("failed generating network policy for DataPlane %s: %w", dataplane.Name, err)
 	}
@@ -623,12 +767,33 @@ func (r *Reconciler) ensureDataPlaneHasNetworkPolicy(
 	return true, r.Create(ctx, generatedPolicy)
 }
 
+// dataPlaneNetworkPolicyOptions holds the options of the NetworkPolicy generated
+// for a Gateway's DataPlane which are not derived from the DataPlane itself.
+type dataPlaneNetworkPolicyOptions struct {
+	// config is the NetworkPolicy configuration from the GatewayConfiguration.
+	config *operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy
+	// backendEgress are the egress rules allowing the DataPlane to reach the
+	// backends of the routes attached to the Gateway.
+	backendEgress []networkingv1.NetworkPolicyEgressRule
+	// konnectEgress allows the DataPlane to reach Konnect when egress traffic is restricted.
+	konnectEgress bool
+}
+
+func (o dataPlaneNetworkPolicyOptions) profile() operatorv2beta1.NetworkPolicyProfile {
+	if o.config == nil || o.config.Profile == "" {
+		return operatorv2beta1.NetworkPolicyProfilePermissive
+	}
+	return o.config.Profile
+}
+
 // generateDataPlaneNetworkPolicy generates the NetworkPolicy that allows the KO pod to access admin API of dataplane pods.
 // the params `namespace` and `podLabels` are namespace and labels of the KO pod itself, and `dataplane` is the target dataplane.
+// `opts` configure the profile of the policy, see operatorv2beta1.NetworkPolicyProfile.
 func generateDataPlaneNetworkPolicy(
 	namespace string,
 	dataplane *operatorv1beta1.DataPlane,
 	podLabels map[string]string,
+	opts dataPlaneNetworkPolicyOptions,
 ) (*networkingv1.NetworkPolicy, error) {
 	var (
 		protocolTCP          = corev1.ProtocolTCP
@@ -749,33 +914,59 @@ func generateDataPlaneNetworkPolicy(
 		}),
 	}
 
+	// With the DefaultDenyWithAllowlist profile the proxy ports only accept traffic
+	// from the configured peers and the metrics port from the operator and those peers.
+	// A rule without peers allows traffic from ANYWHERE so the proxy rules are
+	// dropped altogether when no peers are configured.
+	profile := opts.profile()
+	var ingressPeers []networkingv1.NetworkPolicyPeer
+	if profile == operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist {
+		ingressPeers = opts.config.IngressPeers
+		allowProxyIngress.From = ingressPeers
+		allowMetricsIngress.From = append([]networkingv1.NetworkPolicyPeer{policyPeerForControllerPod}, ingressPeers...)
+	}
+	allowProxyTraffic := profile != operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist || len(ingressPeers) > 0
+
 	ingressRules := []networkingv1.NetworkPolicyIngressRule{
 		limitAdminAPIIngress,
-		allowProxyIngress,
-		allowMetricsIngress,
 	}
+	if allowProxyTraffic {
+		ingressRules = append(ingressRules, allowProxyIngress)
+	}
+	ingressRules = append(ingressRules, allowMetricsIngress)
 
 	// Add a rule to allow ingress traffics to listened ports for stream proxy on dataplane pods.
 	// Only add the rule when there are at least one stream proxy port because a rule with an empty port list allows ingress traffics to ALL ports,
 	// then the whole NetworkPolicy allows ALL ingress traffics to the pods.
-	if len(streamListenPorts) > 0 {
+	if len(streamListenPorts) > 0 && allowProxyTraffic {
 		allowStreamIngress := networkingv1.NetworkPolicyIngressRule{
 			Ports: lo.Map(streamListenPorts, func(port intstr.IntOrString, _ int) networkingv1.NetworkPolicyPort {
 				return networkingv1.NetworkPolicyPort{Protocol: &protocolTCP, Port: &port}
 			}),
+			From: ingressPeers,
 		}
 		ingressRules = append(ingressRules, allowStreamIngress)
 	}
 
-	if len(streamUDPListenPorts) > 0 {
+	if len(streamUDPListenPorts) > 0 && allowProxyTraffic {
 		allowStreamUDPIngress := networkingv1.NetworkPolicyIngressRule{
 			Ports: lo.Map(streamUDPListenPorts, func(port intstr.IntOrString, _ int) networkingv1.NetworkPolicyPort {
 				return networkingv1.NetworkPolicyPort{Protocol: &protocolUDP, Port: &port}
 			}),
+			From: ingressPeers,
 		}
 		ingressRules = append(ingressRules, allowStreamUDPIngress)
 	}
 
+	policyTypes := []networkingv1.PolicyType{
+		networkingv1.PolicyTypeIngress,
+	}
+	var egressRules []networkingv1.NetworkPolicyEgressRule
+	if profile != operatorv2beta1.NetworkPolicyProfilePermissive {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+		egressRules = generateDataPlaneNetworkPolicyEgressRules(profile, opts)
+	}
+
 	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    dataplane.Namespace,
@@ -787,14 +978,301 @@ func generateDataPlaneNetworkPolicy(
 					"app": dataplane.Name,
 				},
 			},
-			PolicyTypes: []networkingv1.PolicyType{
-				networkingv1.PolicyTypeIngress,
-			},
-			Ingress: ingressRules,
+			PolicyTypes: policyTypes,
+			Ingress:     ingressRules,
+			Egress:      egressRules,
 		},
 	}, nil
 }
 
+// generateDataPlaneNetworkPolicyEgressRules generates the egress rules of a
+// DataPlane's NetworkPolicy for the profiles restricting egress traffic.
+func generateDataPlaneNetworkPolicyEgressRules(
+	profile operatorv2beta1.NetworkPolicyProfile,
+	opts dataPlaneNetworkPolicyOptions,
+) []networkingv1.NetworkPolicyEgressRule {
+	var (
+		protocolTCP = corev1.ProtocolTCP
+		protocolUDP = corev1.ProtocolUDP
+		dnsPort     = intstr.FromInt(53)
+		httpsPort   = intstr.FromInt(443)
+	)
+
+	// DNS resolution through kube-dns is always allowed, otherwise the DataPlane
+	// can't resolve neither the upstreams nor Konnect.
+	egressRules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &protocolUDP, Port: &dnsPort},
+				{Protocol: &protocolTCP, Port: &dnsPort},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"kubernetes.io/metadata.name": metav1.NamespaceSystem,
+						},
+					},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"k8s-app": "kube-dns",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	switch profile {
+	case operatorv2beta1.NetworkPolicyProfileRestricted:
+		egressRules = append(egressRules, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{}},
+			},
+		})
+	case operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist:
+		egressRules = append(egressRules, opts.backendEgress...)
+	case operatorv2beta1.NetworkPolicyProfilePermissive:
+		// Egress traffic is not restricted.
+	}
+
+	if opts.konnectEgress {
+		var konnectEgress operatorv2beta1.GatewayConfigDataPlaneNetworkPolicyKonnectEgress
+		if opts.config != nil && opts.config.KonnectEgress != nil {
+			konnectEgress = *opts.config.KonnectEgress
+		}
+		if !konnectEgress.Disabled {
+			konnectRule := networkingv1.NetworkPolicyEgressRule{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &protocolTCP, Port: &httpsPort},
+				},
+			}
+			// Without IP blocks, Konnect is allowed at any destination.
+			for _, ipBlock := range konnectEgress.IPBlocks {
+				konnectRule.To = append(konnectRule.To, networkingv1.NetworkPolicyPeer{IPBlock: ipBlock.DeepCopy()})
+			}
+			egressRules = append(egressRules, konnectRule)
+		}
+	}
+
+	if opts.config != nil {
+		egressRules = append(egressRules, opts.config.AdditionalEgress...)
+	}
+
+	return egressRules
+}
+
+// backendEgressRulesForGateway returns the egress rules allowing the DataPlane
+// of the Gateway to reach the Pods backing the Services referenced by the
+// routes attached to the Gateway. The rules are sorted so that the generated
+// NetworkPolicy is stable across reconciliations.
+func (r *Reconciler) backendEgressRulesForGateway(
+	ctx context.Context,
+	gateway *gwtypes.Gateway,
+) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	backendRefs, err := listBackendRefsForGateway(ctx, r.Client, gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[types.NamespacedName]*networkingv1.NetworkPolicyEgressRule)
+	for _, ref := range backendRefs {
+		var svc corev1.Service
+		if err := r.Get(ctx, ref.service, &svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting backend Service %s: %w", ref.service, err)
+		}
+		// Services without selector (e.g. ExternalName Services or Services with
+		// manually managed EndpointSlices) can't be matched by a peer.
+		if len(svc.Spec.Selector) == 0 {
+			continue
+		}
+
+		rule, ok := rules[ref.service]
+		if !ok {
+			rule = &networkingv1.NetworkPolicyEgressRule{
+				To: []networkingv1.NetworkPolicyPeer{
+					{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"kubernetes.io/metadata.name": svc.Namespace,
+							},
+						},
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: maps.Clone(svc.Spec.Selector),
+						},
+					},
+				},
+			}
+			rules[ref.service] = rule
+		}
+		for _, port := range svc.Spec.Ports {
+			if ref.port != nil && port.Port != *ref.port {
+				continue
+			}
+			targetPort := port.TargetPort
+			if targetPort == (intstr.IntOrString{}) {
+				targetPort = intstr.FromInt32(port.Port)
+			}
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			if lo.ContainsBy(rule.Ports, func(p networkingv1.NetworkPolicyPort) bool {
+				return *p.Protocol == protocol && *p.Port == targetPort
+			}) {
+				continue
+			}
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &targetPort})
+		}
+	}
+
+	keys := lo.Keys(rules)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	egressRules := make([]networkingv1.NetworkPolicyEgressRule, 0, len(keys))
+	for _, key := range keys {
+		rule := rules[key]
+		// A rule without ports would allow all the ports of the backend Pods,
+		// this happens when the referenced port doesn't exist on the Service.
+		if len(rule.Ports) == 0 {
+			continue
+		}
+		sort.Slice(rule.Ports, func(i, j int) bool {
+			if *rule.Ports[i].Protocol != *rule.Ports[j].Protocol {
+				return *rule.Ports[i].Protocol < *rule.Ports[j].Protocol
+			}
+			return rule.Ports[i].Port.String() < rule.Ports[j].Port.String()
+		})
+		egressRules = append(egressRules, *rule)
+	}
+	return egressRules, nil
+}
+
+// serviceBackendRef is a reference to a core Service used as a backend by a route.
+type serviceBackendRef struct {
+	service types.NamespacedName
+	port    *gatewayv1.PortNumber
+}
+
+// listBackendRefsForGateway returns the references to the Services used as
+// backends by the routes attached to the Gateway.
+// References to Services in another namespace than the route are only returned
+// when a ReferenceGrant permits them.
+// Routes whose CRDs are not installed in the cluster are skipped.
+func listBackendRefsForGateway(
+	ctx context.Context,
+	cl client.Client,
+	gateway *gwtypes.Gateway,
+) ([]serviceBackendRef, error) {
+	var refs []serviceBackendRef
+	collect := func(routeKind, routeNamespace string, objRef gatewayv1.BackendObjectReference) error {
+		if objRef.Group != nil && *objRef.Group != "" && *objRef.Group != "core" {
+			return nil
+		}
+		if objRef.Kind != nil && *objRef.Kind != "Service" {
+			return nil
+		}
+		namespace := routeNamespace
+		if objRef.Namespace != nil && *objRef.Namespace != "" {
+			namespace = string(*objRef.Namespace)
+		}
+		if namespace != routeNamespace {
+			permitted, _, err := hybridgatewayroute.CheckReferenceGrant(
+				ctx, cl, &gwtypes.BackendRef{BackendObjectReference: objRef}, routeKind, routeNamespace,
+			)
+			if err != nil {
+				return err
+			}
+			if !permitted {
+				return nil
+			}
+		}
+		refs = append(refs, serviceBackendRef{
+			service: types.NamespacedName{Namespace: namespace, Name: string(objRef.Name)},
+			port:    objRef.Port,
+		})
+		return nil
+	}
+	ignoreNoMatch := func(err error) error {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	httpRoutes, err := gatewayutils.ListHTTPRoutesForGateway(ctx, cl, gateway)
+	if ignoreNoMatch(err) != nil {
+		return nil, err
+	}
+	for _, route := range httpRoutes {
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if err := collect("HTTPRoute", route.Namespace, ref.BackendObjectReference); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	grpcRoutes, err := gatewayutils.ListGRPCRoutesForGateway(ctx, cl, gateway)
+	if ignoreNoMatch(err) != nil {
+		return nil, err
+	}
+	for _, route := range grpcRoutes {
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if err := collect("GRPCRoute", route.Namespace, ref.BackendObjectReference); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	tcpRoutes, err := gatewayutils.ListTCPRoutesForGateway(ctx, cl, gateway)
+	if ignoreNoMatch(err) != nil {
+		return nil, err
+	}
+	for _, route := range tcpRoutes {
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if err := collect("TCPRoute", route.Namespace, ref.BackendObjectReference); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	tlsRoutes, err := gatewayutils.ListTLSRoutesForGateway(ctx, cl, gateway)
+	if ignoreNoMatch(err) != nil {
+		return nil, err
+	}
+	for _, route := range tlsRoutes {
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if err := collect("TLSRoute", route.Namespace, ref.BackendObjectReference); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	udpRoutes, err := gatewayutils.ListUDPRoutesForGateway(ctx, cl, gateway)
+	if ignoreNoMatch(err) != nil {
+		return nil, err
+	}
+	for _, route := range udpRoutes {
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if err := collect("UDPRoute", route.Namespace, ref.BackendObjectReference); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return refs, nil
+}
+
 // -----------------------------------------------------------------------------
 // GatewayReconciler - Private type status-related utilities/wrappers
 // -----------------------------------------------------------------------------
@@ -899,7 +1377,7 @@ func (g *gatewayConditionsAndListenersAwareT) initProgrammedAndListenersStatus()
 
 func (g *gatewayConditionsAndListenersAwareT) setResolvedRefsAndSupportedKinds(ctx context.Context, c client.Client) error {
 	for i, listener := range g.Spec.Listeners {
-		supportedKinds, resolvedRefsCondition, err := getSupportedKindsWithResolvedRefsCondition(ctx, c, *g.Gateway, g.Generation, listener)
+		supportedKinds, resolvedRefsCondition, err := getSupportedKindsWithResolvedRefsCondition(ctx, c, g.Gateway, g.Generation, listener)
 		if err != nil {
 			return err
 		}
@@ -1552,8 +2030,9 @@ func setDataPlaneIngressServicePorts(
 }
 
 // getSupportedKindsWithResolvedRefsCondition returns all the route kinds supported by the listener, along with the resolvedRefs
-// condition, that is based on the presence of errors in such a field.
-func getSupportedKindsWithResolvedRefsCondition(ctx context.Context, c client.Client, gateway gatewayv1.Gateway, generation int64, listener gatewayv1.Listener) (supportedKinds []gatewayv1.RouteGroupKind, resolvedRefsCondition metav1.Condition, err error) {
+// condition, that is based on the presence of errors in such a field. The referencer is the object defining the listener
+// (a Gateway or a ListenerSet) and is used to resolve certificate references and ReferenceGrants.
+func getSupportedKindsWithResolvedRefsCondition(ctx context.Context, c client.Client, referencer client.Object, generation int64, listener gatewayv1.Listener) (supportedKinds []gatewayv1.RouteGroupKind, resolvedRefsCondition metav1.Condition, err error) {
 	supportedKinds = make([]gatewayv1.RouteGroupKind, 0)
 	resolvedRefsCondition = metav1.Condition{
 		Type:               string(gatewayv1.ListenerConditionResolvedRefs),
@@ -1584,8 +2063,8 @@ func getSupportedKindsWithResolvedRefsCondition(ctx context.Context, c client.Cl
 		} else if len(listener.TLS.CertificateRefs) == 1 {
 			isValidGroupKind := true
 			certificateRef := listener.TLS.CertificateRefs[0]
-			gatewayNamespace := gatewayv1.Namespace(gateway.Namespace)
-			ref.EnsureNamespaceInSecretRef(&certificateRef, gatewayNamespace)
+			referencerNamespace := gatewayv1.Namespace(referencer.GetNamespace())
+			ref.EnsureNamespaceInSecretRef(&certificateRef, referencerNamespace)
 
 			if err := ref.DoesFieldReferenceCoreV1Secret(certificateRef, "CertificateRef"); err != nil {
 				resolvedRefsCondition.Reason = string(gatewayv1.ListenerReasonInvalidCertificateRef)
@@ -1593,7 +2072,7 @@ func getSupportedKindsWithResolvedRefsCondition(ctx context.Context, c client.Cl
 				isValidGroupKind = false
 			}
 
-			msg, isReferenceGranted, err := ref.CheckReferenceGrantForSecret(ctx, c, &gateway, certificateRef)
+			msg, isReferenceGranted, err := ref.CheckReferenceGrantForSecret(ctx, c, referencer, certificateRef)
 			if err != nil {
 				return nil, metav1.Condition{}, fmt.Errorf("failed to resolve reference: %w", err)
 			}
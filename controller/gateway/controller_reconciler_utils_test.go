@@ -147,6 +147,27 @@ func TestGatewayAddressesFromService(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "NodePort Service with external IPs",
+			svc: corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:        "NodePort",
+					ClusterIP:   "198.51.100.1",
+					ExternalIPs: []string{"203.0.113.10"},
+				},
+			},
+			addresses: []gwtypes.GatewayStatusAddress{
+				{
+					Value: "198.51.100.1",
+					Type:  new(gatewayv1.IPAddressType),
+				},
+				{
+					Value: "203.0.113.10",
+					Type:  new(gatewayv1.IPAddressType),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "ClusterIP Service without ClusterIP",
 			svc: corev1.Service{
@@ -189,6 +210,36 @@ func TestGatewayAddressesFromService(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "LoadBalancer with external IPs",
+			svc: corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:        "LoadBalancer",
+					ClusterIP:   "198.51.100.1",
+					ExternalIPs: []string{"203.0.113.11"},
+				},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{
+							{
+								IP: "203.0.113.10",
+							},
+						},
+					},
+				},
+			},
+			addresses: []gwtypes.GatewayStatusAddress{
+				{
+					Value: "203.0.113.10",
+					Type:  new(gatewayv1.IPAddressType),
+				},
+				{
+					Value: "203.0.113.11",
+					Type:  new(gatewayv1.IPAddressType),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "LoadBalancer with hostnames",
 			svc: corev1.Service{
@@ -1921,7 +1972,7 @@ func TestGetSupportedKindsWithResolvedRefsCondition(t *testing.T) {
 			supportedKinds, resolvedRefsCondition, err := getSupportedKindsWithResolvedRefsCondition(
 				ctx,
 				client,
-				gatewayv1.Gateway{
+				&gatewayv1.Gateway{
 					TypeMeta: metav1.TypeMeta{
 						APIVersion: gatewayv1.GroupVersion.String(),
 						Kind:       "Gateway",
@@ -4846,6 +4897,222 @@ func TestSetGatewayNameLabelInDataPlane(t *testing.T) {
 	})
 }
 
+func TestSetDataPlaneIngressServiceAddresses(t *testing.T) {
+	addresses := []gatewayv1.GatewaySpecAddress{
+		{Value: "203.0.113.10"},
+		{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.11"},
+		{Type: new(gatewayv1.HostnameAddressType), Value: "gw.example.com"},
+		{Type: new(gatewayv1.IPAddressType)},
+	}
+
+	t.Run("no IP addresses requested", func(t *testing.T) {
+		spec := &operatorv1beta1.DataPlaneOptions{}
+		setDataPlaneIngressServiceAddresses(spec, []gatewayv1.GatewaySpecAddress{
+			{Type: new(gatewayv1.HostnameAddressType), Value: "gw.example.com"},
+		})
+		require.Nil(t, spec.Network.Services)
+	})
+
+	t.Run("LoadBalancer Service requests a single IP address", func(t *testing.T) {
+		spec := &operatorv1beta1.DataPlaneOptions{}
+		setDataPlaneIngressServiceAddresses(spec, addresses[:1])
+
+		require.NotNil(t, spec.Network.Services)
+		require.NotNil(t, spec.Network.Services.Ingress)
+		require.Equal(t, new("203.0.113.10"), spec.Network.Services.Ingress.LoadBalancerIP)
+		require.Empty(t, spec.Network.Services.Ingress.ExternalIPs)
+	})
+
+	t.Run("LoadBalancer Service requests the first IP address and gets the other ones as external IPs", func(t *testing.T) {
+		spec := &operatorv1beta1.DataPlaneOptions{}
+		setDataPlaneIngressServiceAddresses(spec, addresses)
+
+		require.NotNil(t, spec.Network.Services)
+		require.NotNil(t, spec.Network.Services.Ingress)
+		require.Equal(t, new("203.0.113.10"), spec.Network.Services.Ingress.LoadBalancerIP)
+		require.Equal(t, []string{"203.0.113.11"}, spec.Network.Services.Ingress.ExternalIPs)
+	})
+
+	t.Run("NodePort Service gets all IP addresses as external IPs", func(t *testing.T) {
+		spec := &operatorv1beta1.DataPlaneOptions{
+			Network: operatorv1beta1.DataPlaneNetworkOptions{
+				Services: &operatorv1beta1.DataPlaneServices{
+					Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+						ServiceOptions: operatorv1beta1.ServiceOptions{
+							Type: corev1.ServiceTypeNodePort,
+						},
+					},
+				},
+			},
+		}
+		setDataPlaneIngressServiceAddresses(spec, addresses)
+
+		require.Nil(t, spec.Network.Services.Ingress.LoadBalancerIP)
+		require.Equal(t, []string{"203.0.113.10", "203.0.113.11"}, spec.Network.Services.Ingress.ExternalIPs)
+	})
+}
+
+func TestGatewaySpecAddressesCondition(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		Spec: operatorv1beta1.DataPlaneSpec{
+			DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+				Network: operatorv1beta1.DataPlaneNetworkOptions{
+					Services: &operatorv1beta1.DataPlaneServices{
+						Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+							ServiceOptions: operatorv1beta1.ServiceOptions{
+								Type: corev1.ServiceTypeLoadBalancer,
+							},
+							LoadBalancerIP: new("203.0.113.10"),
+							ExternalIPs:    []string{"203.0.113.11"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		specAddresses   []gatewayv1.GatewaySpecAddress
+		statusAddresses []gwtypes.GatewayStatusAddress
+		expectedReason  gatewayv1.GatewayConditionReason
+		expectedOK      bool
+	}{
+		{
+			name: "no addresses requested",
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.1"},
+			},
+			expectedReason: gatewayv1.GatewayReasonProgrammed,
+			expectedOK:     true,
+		},
+		{
+			name: "requested IP address assigned",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.10"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.10"},
+			},
+			expectedReason: gatewayv1.GatewayReasonProgrammed,
+			expectedOK:     true,
+		},
+		{
+			name: "requested IP address not assigned yet",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.10"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotAssigned,
+		},
+		{
+			name: "load balancer assigned a different IP address",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.10"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.1"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotUsable,
+		},
+		{
+			name: "IP addresses requested as load balancer IP and external IP assigned",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.10"},
+				{Value: "203.0.113.11"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.10"},
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.11"},
+			},
+			expectedReason: gatewayv1.GatewayReasonProgrammed,
+			expectedOK:     true,
+		},
+		{
+			name: "load balancer IP address not assigned yet while external IP is",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.10"},
+				{Value: "203.0.113.11"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.11"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotAssigned,
+		},
+		{
+			name: "IP address not requested for the DataPlane ingress Service",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Value: "203.0.113.12"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.10"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotUsable,
+		},
+		{
+			name: "requested hostname assigned",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.HostnameAddressType), Value: "lb.example.com"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.HostnameAddressType), Value: "lb.example.com"},
+			},
+			expectedReason: gatewayv1.GatewayReasonProgrammed,
+			expectedOK:     true,
+		},
+		{
+			name: "requested hostname cannot be requested for the DataPlane ingress Service",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.HostnameAddressType), Value: "lb.example.com"},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.10"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotUsable,
+		},
+		{
+			name: "empty hostname waits for a hostname to be assigned",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.HostnameAddressType)},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.10"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotAssigned,
+		},
+		{
+			name: "empty value is satisfied by any address of the requested type",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.IPAddressType)},
+			},
+			statusAddresses: []gwtypes.GatewayStatusAddress{
+				{Type: new(gatewayv1.IPAddressType), Value: "203.0.113.1"},
+			},
+			expectedReason: gatewayv1.GatewayReasonProgrammed,
+			expectedOK:     true,
+		},
+		{
+			name: "named addresses are not supported",
+			specAddresses: []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.NamedAddressType), Value: "my-address"},
+			},
+			expectedReason: gatewayv1.GatewayReasonAddressNotUsable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, msg, ok := gatewaySpecAddressesCondition(tc.specAddresses, tc.statusAddresses, dataplane)
+			require.Equal(t, tc.expectedOK, ok)
+			require.Equal(t, tc.expectedReason, reason)
+			if ok {
+				require.Empty(t, msg)
+			} else {
+				require.NotEmpty(t, msg)
+			}
+		})
+	}
+}
+
 // TestGatewayManagedLabelOnCreatedResources verifies that every resource object
 // created by the gateway controller carries the GEP-1762
 // gateway.networking.k8s.io/gateway-name label set to the owning Gateway name.
@@ -4881,7 +5148,7 @@ func TestGatewayManagedLabelOnCreatedResources(t *testing.T) {
 	emptyGatewayConfig := &GatewayConfiguration{}
 
 	t.Run("DataPlane carries gateway-name label", func(t *testing.T) {
-		dp, err := reconciler.createDataPlane(ctx, gateway, emptyGatewayConfig, nil)
+		dp, err := reconciler.createDataPlane(ctx, gateway, emptyGatewayConfig, nil, gateway.Spec.Listeners)
 		require.NoError(t, err)
 		require.Equal(t, gwName, dp.Labels[consts.GatewayNameLabel],
 			"DataPlane object must carry the GEP-1762 gateway-name label")
@@ -5000,12 +5267,71 @@ func TestGenerateDataPlaneNetworkPolicy(t *testing.T) {
 				},
 			},
 		}
+		egressRuleDNS = networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: new(corev1.ProtocolUDP),
+					Port:     new(intstr.FromInt(53)),
+				},
+				{
+					Protocol: &protocolTCP,
+					Port:     new(intstr.FromInt(53)),
+				},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"kubernetes.io/metadata.name": "kube-system",
+						},
+					},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"k8s-app": "kube-dns",
+						},
+					},
+				},
+			},
+		}
+		ingressPeer = networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": "ingress",
+				},
+			},
+		}
+		backendEgressRule = networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &protocolTCP,
+					Port:     new(intstr.FromInt(8080)),
+				},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "backend",
+						},
+					},
+				},
+			},
+		}
+		additionalEgressRule = networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"},
+				},
+			},
+		}
 	)
 
 	testCases := []struct {
 		name                  string
 		proxyContainerOptions func(c *corev1.Container)
+		opts                  dataPlaneNetworkPolicyOptions
 		expectedIngressRules  []networkingv1.NetworkPolicyIngressRule
+		expectedEgressRules   []networkingv1.NetworkPolicyEgressRule
 	}{
 		{
 			name: "default DataPlane",
@@ -5091,6 +5417,181 @@ func TestGenerateDataPlaneNetworkPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Permissive profile doesn't restrict egress",
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile: operatorv2beta1.NetworkPolicyProfilePermissive,
+				},
+				konnectEgress: true,
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				defaultIngressRuleProxy,
+				defaultIngressRuleMetrics,
+			},
+		},
+		{
+			name: "Restricted profile allows egress to DNS, the cluster and the additional rules",
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile:          operatorv2beta1.NetworkPolicyProfileRestricted,
+					AdditionalEgress: []networkingv1.NetworkPolicyEgressRule{additionalEgressRule},
+				},
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				defaultIngressRuleProxy,
+				defaultIngressRuleMetrics,
+			},
+			expectedEgressRules: []networkingv1.NetworkPolicyEgressRule{
+				egressRuleDNS,
+				{
+					To: []networkingv1.NetworkPolicyPeer{
+						{NamespaceSelector: &metav1.LabelSelector{}},
+					},
+				},
+				additionalEgressRule,
+			},
+		},
+		{
+			name: "DefaultDenyWithAllowlist profile restricts ingress to the peers and egress to the backends",
+			proxyContainerOptions: func(c *corev1.Container) {
+				c.Env = append(c.Env, corev1.EnvVar{
+					Name:  "KONG_STREAM_LISTEN",
+					Value: "0.0.0.0:8888 reuseport",
+				})
+			},
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile:          operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist,
+					IngressPeers:     []networkingv1.NetworkPolicyPeer{ingressPeer},
+					AdditionalEgress: []networkingv1.NetworkPolicyEgressRule{additionalEgressRule},
+				},
+				backendEgress: []networkingv1.NetworkPolicyEgressRule{backendEgressRule},
+				konnectEgress: true,
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				{
+					Ports: defaultIngressRuleProxy.Ports,
+					From:  []networkingv1.NetworkPolicyPeer{ingressPeer},
+				},
+				{
+					Ports: defaultIngressRuleMetrics.Ports,
+					From:  []networkingv1.NetworkPolicyPeer{defaultIngressRuleAdminAPI.From[0], ingressPeer},
+				},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &protocolTCP,
+							Port:     new(intstr.FromInt(8888)),
+						},
+					},
+					From: []networkingv1.NetworkPolicyPeer{ingressPeer},
+				},
+			},
+			expectedEgressRules: []networkingv1.NetworkPolicyEgressRule{
+				egressRuleDNS,
+				backendEgressRule,
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &protocolTCP,
+							Port:     new(intstr.FromInt(443)),
+						},
+					},
+				},
+				additionalEgressRule,
+			},
+		},
+		{
+			name: "Konnect egress rule can be restricted to IP blocks",
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile: operatorv2beta1.NetworkPolicyProfileRestricted,
+					KonnectEgress: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicyKonnectEgress{
+						IPBlocks: []networkingv1.IPBlock{{CIDR: "203.0.113.0/24"}},
+					},
+				},
+				konnectEgress: true,
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				defaultIngressRuleProxy,
+				defaultIngressRuleMetrics,
+			},
+			expectedEgressRules: []networkingv1.NetworkPolicyEgressRule{
+				egressRuleDNS,
+				{
+					To: []networkingv1.NetworkPolicyPeer{
+						{NamespaceSelector: &metav1.LabelSelector{}},
+					},
+				},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &protocolTCP,
+							Port:     new(intstr.FromInt(443)),
+						},
+					},
+					To: []networkingv1.NetworkPolicyPeer{
+						{IPBlock: &networkingv1.IPBlock{CIDR: "203.0.113.0/24"}},
+					},
+				},
+			},
+		},
+		{
+			name: "Konnect egress rule can be disabled",
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile:          operatorv2beta1.NetworkPolicyProfileRestricted,
+					AdditionalEgress: []networkingv1.NetworkPolicyEgressRule{additionalEgressRule},
+					KonnectEgress: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicyKonnectEgress{
+						Disabled: true,
+					},
+				},
+				konnectEgress: true,
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				defaultIngressRuleProxy,
+				defaultIngressRuleMetrics,
+			},
+			expectedEgressRules: []networkingv1.NetworkPolicyEgressRule{
+				egressRuleDNS,
+				{
+					To: []networkingv1.NetworkPolicyPeer{
+						{NamespaceSelector: &metav1.LabelSelector{}},
+					},
+				},
+				additionalEgressRule,
+			},
+		},
+		{
+			name: "DefaultDenyWithAllowlist profile without peers denies proxy traffic",
+			proxyContainerOptions: func(c *corev1.Container) {
+				c.Env = append(c.Env, corev1.EnvVar{
+					Name:  "KONG_STREAM_LISTEN",
+					Value: "0.0.0.0:8888 reuseport",
+				})
+			},
+			opts: dataPlaneNetworkPolicyOptions{
+				config: &operatorv2beta1.GatewayConfigDataPlaneNetworkPolicy{
+					Profile: operatorv2beta1.NetworkPolicyProfileDefaultDenyWithAllowlist,
+				},
+			},
+			expectedIngressRules: []networkingv1.NetworkPolicyIngressRule{
+				defaultIngressRuleAdminAPI,
+				{
+					Ports: defaultIngressRuleMetrics.Ports,
+					From:  defaultIngressRuleAdminAPI.From,
+				},
+			},
+			expectedEgressRules: []networkingv1.NetworkPolicyEgressRule{
+				egressRuleDNS,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5101,7 +5602,7 @@ func TestGenerateDataPlaneNetworkPolicy(t *testing.T) {
 				tc.proxyContainerOptions(container)
 			}
 
-			policy, err := generateDataPlaneNetworkPolicy(testNamespace, dp, podLabels)
+			policy, err := generateDataPlaneNetworkPolicy(testNamespace, dp, podLabels, tc.opts)
 			require.NoError(t, err)
 			// compare expected NetworkPolicy and the generated one.
 			require.Equal(t, testNamespace, policy.Namespace)
@@ -5113,10 +5614,165 @@ func TestGenerateDataPlaneNetworkPolicy(t *testing.T) {
 			for i, ingressRule := range tc.expectedIngressRules {
 				require.Equal(t, ingressRule, policy.Spec.Ingress[i])
 			}
+			// compare egress policies.
+			require.Equal(t, tc.expectedEgressRules, policy.Spec.Egress)
+			if tc.expectedEgressRules != nil {
+				require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+			} else {
+				require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+			}
 		})
 	}
 }
 
+func TestBackendEgressRulesForGateway(t *testing.T) {
+	var (
+		protocolTCP = corev1.ProtocolTCP
+		protocolUDP = corev1.ProtocolUDP
+		gateway     = &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "gw",
+			},
+		}
+		parentRefs = []gatewayv1.ParentReference{{Name: "gw"}}
+		service    = func(namespace, name string, selector map[string]string, ports ...corev1.ServicePort) *corev1.Service {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: corev1.ServiceSpec{
+					Selector: selector,
+					Ports:    ports,
+				},
+			}
+		}
+	)
+
+	objects := []client.Object{
+		service("default", "echo", map[string]string{"app": "echo"},
+			corev1.ServicePort{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+			corev1.ServicePort{Name: "admin", Port: 81, TargetPort: intstr.FromString("admin")},
+		),
+		service("backends", "dns", map[string]string{"app": "dns"},
+			corev1.ServicePort{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+		),
+		service("default", "external", nil,
+			corev1.ServicePort{Name: "http", Port: 80},
+		),
+		service("backends", "not-granted", map[string]string{"app": "not-granted"},
+			corev1.ServicePort{Name: "http", Port: 80},
+		),
+		&gatewayv1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "backends",
+				Name:      "dns",
+			},
+			Spec: gatewayv1.ReferenceGrantSpec{
+				From: []gatewayv1.ReferenceGrantFrom{
+					{Group: gatewayv1.GroupName, Kind: "UDPRoute", Namespace: "default"},
+				},
+				To: []gatewayv1.ReferenceGrantTo{
+					{Kind: "Service", Name: new(gatewayv1.ObjectName("dns"))},
+				},
+			},
+		},
+		&gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "echo",
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "echo", Port: new(gatewayv1.PortNumber(80))}}},
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "external", Port: new(gatewayv1.PortNumber(80))}}},
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "missing", Port: new(gatewayv1.PortNumber(80))}}},
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "not-granted", Namespace: new(gatewayv1.Namespace("backends")), Port: new(gatewayv1.PortNumber(80))}}},
+						},
+					},
+				},
+			},
+		},
+		&gatewayv1.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "dns",
+			},
+			Spec: gatewayv1.UDPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules: []gatewayv1.UDPRouteRule{
+					{
+						BackendRefs: []gatewayv1.BackendRef{
+							{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "dns", Namespace: new(gatewayv1.Namespace("backends"))}},
+						},
+					},
+				},
+			},
+		},
+		&gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "other-gateway",
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "other"}}},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "echo", Port: new(gatewayv1.PortNumber(81))}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reconciler := &Reconciler{
+		Client: fakectrlruntimeclient.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects...).
+			Build(),
+	}
+	rules, err := reconciler.backendEgressRulesForGateway(t.Context(), gateway)
+	require.NoError(t, err)
+	require.Equal(t, []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &protocolUDP, Port: new(intstr.FromInt(53))},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": "backends"},
+					},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "dns"},
+					},
+				},
+			},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &protocolTCP, Port: new(intstr.FromInt(8080))},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": "default"},
+					},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "echo"},
+					},
+				},
+			},
+		},
+	}, rules)
+}
+
 // TestSetAcceptedAndAttachedRoutes verifies the per-listener Accepted condition
 // computed by setAcceptedAndAttachedRoutes, including the spec-mandated rule
 // that conflicting listeners must not be accepted (Gateway API v1.5,
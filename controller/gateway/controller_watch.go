@@ -9,10 +9,15 @@ import (
 
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -251,6 +256,42 @@ func (r *Reconciler) listReferenceGrantsForGateway(ctx context.Context, obj clie
 	return recs
 }
 
+// listGatewaysForListenerSet is a watch predicate which finds the Gateway referenced
+// by a ListenerSet's parentRef.
+func (r *Reconciler) listGatewaysForListenerSet(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
+	listenerSet, ok := obj.(*gwtypes.ListenerSet)
+	if !ok {
+		logger.Error(
+			fmt.Errorf("unexpected object type"),
+			"ListenerSet watch predicate received unexpected object type",
+			"expected", "*gatewayapi.ListenerSet", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+
+	parentRef := listenerSet.Spec.ParentRef
+	if parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName {
+		return nil
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		return nil
+	}
+	namespace := listenerSet.Namespace
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		namespace = string(*parentRef.Namespace)
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Namespace: namespace,
+				Name:      string(parentRef.Name),
+			},
+		},
+	}
+}
+
 // listGatewaysForKongReferenceGrant returns reconcile requests for Gateways that might be affected by
 // a KongReferenceGrant that allows GatewayConfiguration -> KonnectAPIAuthConfiguration references.
 func (r *Reconciler) listGatewaysForKongReferenceGrant(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -423,6 +464,40 @@ func listGatewaysAttachedByRoute[T gwtypes.SupportedRoute, TPtr gwtypes.Supporte
 	return recs
 }
 
+// listGatewaysForRouteListenerSets finds the parent Gateways of the ListenerSets
+// referenced by a route's parentRefs, so that the AttachedRoutes of the ListenerSets'
+// listener entries are recomputed when the route changes.
+func (r *Reconciler) listGatewaysForRouteListenerSets(
+	ctx context.Context, routeNamespace string, parentRefs []gatewayv1.ParentReference,
+) []reconcile.Request {
+	if !r.listenerSetsSupported {
+		return nil
+	}
+
+	var recs []reconcile.Request
+	for _, parentRef := range parentRefs {
+		if parentRef.Group != nil && string(*parentRef.Group) != gatewayv1.GroupName {
+			continue
+		}
+		if parentRef.Kind == nil || string(*parentRef.Kind) != "ListenerSet" {
+			continue
+		}
+		namespace := routeNamespace
+		if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+			namespace = string(*parentRef.Namespace)
+		}
+		var listenerSet gwtypes.ListenerSet
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}, &listenerSet); err != nil {
+			if !apierrors.IsNotFound(err) {
+				ctrllog.FromContext(ctx).Error(err, "failed to get ListenerSet referenced by route", "listenerset", parentRef.Name)
+			}
+			continue
+		}
+		recs = append(recs, r.listGatewaysForListenerSet(ctx, &listenerSet)...)
+	}
+	return recs
+}
+
 // listGatewaysAttachedByHTTPRoute is a watch predicate which finds all Gateways mentioned
 // in HTTPRoutes' Parents field.
 func (r *Reconciler) listGatewaysAttachedByHTTPRoute(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -437,7 +512,7 @@ func (r *Reconciler) listGatewaysAttachedByHTTPRoute(ctx context.Context, obj cl
 		)
 		return nil
 	}
-	return listGatewaysAttachedByRoute(httpRoute)
+	return append(listGatewaysAttachedByRoute(httpRoute), r.listGatewaysForRouteListenerSets(ctx, httpRoute.Namespace, httpRoute.Spec.ParentRefs)...)
 }
 
 func (r *Reconciler) listGatewaysAttachedByTLSRoute(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -452,7 +527,7 @@ func (r *Reconciler) listGatewaysAttachedByTLSRoute(ctx context.Context, obj cli
 		)
 		return nil
 	}
-	return listGatewaysAttachedByRoute(tlsRoute)
+	return append(listGatewaysAttachedByRoute(tlsRoute), r.listGatewaysForRouteListenerSets(ctx, tlsRoute.Namespace, tlsRoute.Spec.ParentRefs)...)
 }
 
 func (r *Reconciler) listGatewaysAttachedByGRPCRoute(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -467,7 +542,7 @@ func (r *Reconciler) listGatewaysAttachedByGRPCRoute(ctx context.Context, obj cl
 		)
 		return nil
 	}
-	return listGatewaysAttachedByRoute(grpcRoute)
+	return append(listGatewaysAttachedByRoute(grpcRoute), r.listGatewaysForRouteListenerSets(ctx, grpcRoute.Namespace, grpcRoute.Spec.ParentRefs)...)
 }
 
 // listGatewaysAttachedByUDPRoute is a watch predicate which finds all Gateways mentioned
@@ -484,7 +559,7 @@ func (r *Reconciler) listGatewaysAttachedByUDPRoute(ctx context.Context, obj cli
 		)
 		return nil
 	}
-	return listGatewaysAttachedByRoute(udpRoute)
+	return append(listGatewaysAttachedByRoute(udpRoute), r.listGatewaysForRouteListenerSets(ctx, udpRoute.Namespace, udpRoute.Spec.ParentRefs)...)
 }
 
 // listGatewaysAttachedByTCPRoute is a watch predicate which finds all Gateways mentioned
@@ -502,7 +577,7 @@ func (r *Reconciler) listGatewaysAttachedByTCPRoute(ctx context.Context, obj cli
 		return nil
 	}
 
-	return listGatewaysAttachedByRoute(tcpRoute)
+	return append(listGatewaysAttachedByRoute(tcpRoute), r.listGatewaysForRouteListenerSets(ctx, tcpRoute.Namespace, tcpRoute.Spec.ParentRefs)...)
 }
 
 // -----------------------------------------------------------------------------
@@ -514,3 +589,118 @@ func (r *Reconciler) setDataPlaneGatewayConfigDefaults(gatewayConfig *GatewayCon
 		gatewayConfig.Spec.DataPlaneOptions = new(GatewayConfigDataPlaneOptions)
 	}
 }
+
+// gatewaysForBackendServiceFunc lists the Gateways attached by the routes
+// referencing the Service with the provided key in their backendRefs.
+type gatewaysForBackendServiceFunc func(ctx context.Context, cl client.Client, serviceKey string) ([]reconcile.Request, error)
+
+// listGatewaysAttachedByRoutesForService returns a gatewaysForBackendServiceFunc
+// looking up the routes of the provided type by the index of the Services
+// referenced in their backendRefs.
+func listGatewaysAttachedByRoutesForService[
+	T gwtypes.SupportedRoute,
+	TList gwtypes.SupportedRouteList,
+	TPtr gwtypes.SupportedRoutePtr[T],
+	TListPtr gwtypes.SupportedRouteListPtr[TList],
+](serviceIndex string) gatewaysForBackendServiceFunc {
+	return func(ctx context.Context, cl client.Client, serviceKey string) ([]reconcile.Request, error) {
+		var list TList
+		if err := cl.List(ctx, TListPtr(&list), client.MatchingFields{serviceIndex: serviceKey}); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(TListPtr(&list))
+		if err != nil {
+			return nil, err
+		}
+		var recs []reconcile.Request
+		for _, item := range items {
+			if route, ok := item.(TPtr); ok {
+				recs = append(recs, listGatewaysAttachedByRoute[T, TPtr](route)...)
+			}
+		}
+		return recs, nil
+	}
+}
+
+// listGatewaysForBackendService is a watch predicate which finds all Gateways
+// attached by the routes referencing the Service in their backendRefs, so that
+// the egress rules allowing their DataPlanes to reach the Service's Pods follow
+// the Service, e.g. when it's created after the routes.
+func (r *Reconciler) listGatewaysForBackendService(ctx context.Context, svc *corev1.Service) []reconcile.Request {
+	return r.listGatewaysForBackendServiceKeys(ctx, client.ObjectKeyFromObject(svc).String())
+}
+
+// listGatewaysForBackendReferenceGrant is a watch predicate which finds all Gateways
+// attached by the routes referencing the Services a ReferenceGrant permits references
+// to, so that the egress rules allowing their DataPlanes to reach Services in other
+// namespaces follow the ReferenceGrant.
+func (r *Reconciler) listGatewaysForBackendReferenceGrant(ctx context.Context, grant *gwtypes.ReferenceGrant) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
+	var serviceKeys []string
+	for _, to := range grant.Spec.To {
+		if (to.Group != "" && to.Group != "core") || to.Kind != "Service" {
+			continue
+		}
+		if to.Name != nil {
+			serviceKeys = append(serviceKeys, types.NamespacedName{Namespace: grant.Namespace, Name: string(*to.Name)}.String())
+			continue
+		}
+		var services corev1.ServiceList
+		if err := r.List(ctx, &services, client.InNamespace(grant.Namespace)); err != nil {
+			logger.Error(err, "failed to list Services for ReferenceGrant watch", "referencegrant", client.ObjectKeyFromObject(grant))
+			return nil
+		}
+		for _, svc := range services.Items {
+			serviceKeys = append(serviceKeys, client.ObjectKeyFromObject(&svc).String())
+		}
+	}
+	return r.listGatewaysForBackendServiceKeys(ctx, serviceKeys...)
+}
+
+// listGatewaysForBackendServiceKeys finds all Gateways attached by the routes
+// referencing the provided Services (in namespace/name form) in their backendRefs.
+func (r *Reconciler) listGatewaysForBackendServiceKeys(ctx context.Context, serviceKeys ...string) []reconcile.Request {
+	logger := ctrllog.FromContext(ctx)
+
+	seen := make(map[types.NamespacedName]struct{})
+	var recs []reconcile.Request
+	for _, serviceKey := range serviceKeys {
+		for _, listGateways := range r.gatewaysForBackendService {
+			gatewayRecs, err := listGateways(ctx, r.Client, serviceKey)
+			if err != nil {
+				logger.Error(err, "failed to list routes for Service watch", "service", serviceKey)
+				continue
+			}
+			for _, rec := range gatewayRecs {
+				if _, ok := seen[rec.NamespacedName]; ok {
+					continue
+				}
+				seen[rec.NamespacedName] = struct{}{}
+
+				var gateway gwtypes.Gateway
+				if err := r.Get(ctx, rec.NamespacedName, &gateway); err != nil {
+					if !apierrors.IsNotFound(err) {
+						logger.Error(err, "failed to get Gateway for Service watch", "gateway", rec.NamespacedName)
+					}
+					continue
+				}
+				if !r.gatewayHasMatchingGatewayClass(&gateway) {
+					continue
+				}
+				recs = append(recs, rec)
+			}
+		}
+	}
+	return recs
+}
+
+// backendServiceChangedPredicate filters out the updates of Services which
+// don't affect the egress rules generated for them: only the changes of their
+// selector and ports do.
+var backendServiceChangedPredicate = predicate.TypedFuncs[*corev1.Service]{
+	UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Service]) bool {
+		return !equality.Semantic.DeepEqual(e.ObjectOld.Spec.Selector, e.ObjectNew.Spec.Selector) ||
+			!equality.Semantic.DeepEqual(e.ObjectOld.Spec.Ports, e.ObjectNew.Spec.Ports)
+	},
+}
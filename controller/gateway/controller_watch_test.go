@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -8,8 +9,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -982,3 +985,200 @@ func TestReconciler_listManagedGatewaysInNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestReconciler_listGatewaysForBackendService(t *testing.T) {
+	gatewayClass := func(name, controllerName string) *gatewayv1.GatewayClass {
+		return &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: gatewayv1.GatewayController(controllerName),
+			},
+			Status: gatewayv1.GatewayClassStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(gatewayv1.GatewayClassConditionStatusAccepted),
+						Status:             metav1.ConditionTrue,
+						Reason:             string(gatewayv1.GatewayClassReasonAccepted),
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+	}
+	gateway := func(name, gatewayClassName string) *gwtypes.Gateway {
+		return &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID(uuid.NewString()),
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: gatewayv1.ObjectName(gatewayClassName),
+			},
+		}
+	}
+	backendRef := func(namespace, name string) gatewayv1.BackendRef {
+		ref := gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name: gatewayv1.ObjectName(name),
+				Port: new(gatewayv1.PortNumber(80)),
+			},
+		}
+		if namespace != "" {
+			ref.Namespace = new(gatewayv1.Namespace(namespace))
+		}
+		return ref
+	}
+	httpRoute := func(name, gatewayName string, refs ...gatewayv1.BackendRef) *gatewayv1.HTTPRoute {
+		route := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(gatewayName)}},
+				},
+			},
+		}
+		for _, ref := range refs {
+			route.Spec.Rules = append(route.Spec.Rules, gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: ref}},
+			})
+		}
+		return route
+	}
+	udpRoute := &gatewayv1.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dns",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.UDPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "managed"}},
+			},
+			Rules: []gatewayv1.UDPRouteRule{
+				{BackendRefs: []gatewayv1.BackendRef{backendRef("backends", "echo")}},
+			},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "backends",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "echo"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)}},
+		},
+	}
+
+	referenceGrant := &gwtypes.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "backends",
+		},
+		Spec: gatewayv1.ReferenceGrantSpec{
+			From: []gatewayv1.ReferenceGrantFrom{
+				{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "default"},
+			},
+			To: []gatewayv1.ReferenceGrantTo{
+				{Kind: "Service", Name: new(gatewayv1.ObjectName("echo"))},
+			},
+		},
+	}
+
+	clientBuilder := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(
+			gatewayClass("test-gatewayclass", vars.ControllerName()),
+			gatewayClass("other-gatewayclass", "example.com/other-controller"),
+			gateway("managed", "test-gatewayclass"),
+			gateway("unmanaged", "other-gatewayclass"),
+			httpRoute("echo", "managed", backendRef("backends", "echo")),
+			httpRoute("echo-unmanaged", "unmanaged", backendRef("backends", "echo")),
+			httpRoute("other", "managed", backendRef("", "other")),
+			udpRoute,
+		)
+	for _, opt := range slices.Concat(index.OptionsForHTTPRoute(), index.OptionsForUDPRoute()) {
+		clientBuilder.WithIndex(opt.Object, opt.Field, opt.ExtractValueFn)
+	}
+	reconciler := &Reconciler{
+		Client: clientBuilder.Build(),
+		gatewaysForBackendService: []gatewaysForBackendServiceFunc{
+			listGatewaysAttachedByRoutesForService[gwtypes.HTTPRoute, gwtypes.HTTPRouteList](index.BackendServicesOnHTTPRouteIndex),
+			listGatewaysAttachedByRoutesForService[gwtypes.UDPRoute, gwtypes.UDPRouteList](index.BackendServicesOnUDPRouteIndex),
+		},
+	}
+	managedGateway := client.ObjectKey{Namespace: "default", Name: "managed"}
+
+	t.Run("Service created after the routes requeues their managed Gateways", func(t *testing.T) {
+		ctx := t.Context()
+
+		var gw gwtypes.Gateway
+		require.NoError(t, reconciler.Get(ctx, managedGateway, &gw))
+		rules, err := reconciler.backendEgressRulesForGateway(ctx, &gw)
+		require.NoError(t, err)
+		require.Empty(t, rules)
+
+		require.NoError(t, reconciler.Create(ctx, service.DeepCopy()))
+
+		requests := reconciler.listGatewaysForBackendService(ctx, service)
+		require.Equal(t, []reconcile.Request{{NamespacedName: managedGateway}}, requests)
+
+		rules, err = reconciler.backendEgressRulesForGateway(ctx, &gw)
+		require.NoError(t, err)
+		require.Empty(t, rules, "the Service in another namespace isn't allowed without a ReferenceGrant")
+	})
+
+	t.Run("ReferenceGrant permitting references to the Service requeues the Gateways of the routes", func(t *testing.T) {
+		ctx := t.Context()
+
+		require.NoError(t, reconciler.Create(ctx, referenceGrant.DeepCopy()))
+
+		requests := reconciler.listGatewaysForBackendReferenceGrant(ctx, referenceGrant)
+		require.Equal(t, []reconcile.Request{{NamespacedName: managedGateway}}, requests)
+
+		var gw gwtypes.Gateway
+		require.NoError(t, reconciler.Get(ctx, managedGateway, &gw))
+		rules, err := reconciler.backendEgressRulesForGateway(ctx, &gw)
+		require.NoError(t, err)
+		require.Len(t, rules, 1, "only the HTTPRoute is permitted to reference the Service")
+		require.Equal(t, map[string]string{"app": "echo"}, rules[0].To[0].PodSelector.MatchLabels)
+	})
+
+	t.Run("Service not referenced by any route doesn't requeue Gateways", func(t *testing.T) {
+		unreferenced := service.DeepCopy()
+		unreferenced.Namespace = "default"
+		require.Empty(t, reconciler.listGatewaysForBackendService(t.Context(), unreferenced))
+	})
+}
+
+func TestBackendServiceChangedPredicate(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "default",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "echo"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+
+	relabeled := service.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "a"}
+	require.False(t, backendServiceChangedPredicate.Update(event.TypedUpdateEvent[*corev1.Service]{ObjectOld: service, ObjectNew: relabeled}))
+
+	reselected := service.DeepCopy()
+	reselected.Spec.Selector = map[string]string{"app": "echo-v2"}
+	require.True(t, backendServiceChangedPredicate.Update(event.TypedUpdateEvent[*corev1.Service]{ObjectOld: service, ObjectNew: reselected}))
+
+	reported := service.DeepCopy()
+	reported.Spec.Ports[0].TargetPort = intstr.FromInt(8080)
+	require.True(t, backendServiceChangedPredicate.Update(event.TypedUpdateEvent[*corev1.Service]{ObjectOld: service, ObjectNew: reported}))
+
+	require.True(t, backendServiceChangedPredicate.Create(event.TypedCreateEvent[*corev1.Service]{Object: service}))
+}
@@ -0,0 +1,630 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kcfgconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/controller/pkg/patch"
+	gwtypes "github.com/kong/kong-operator/v2/internal/types"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// -----------------------------------------------------------------------------
+// ListenerSet - Reconciliation
+// -----------------------------------------------------------------------------
+
+// reconcileListenerSets computes and patches the status of all the ListenerSets
+// attached to the provided Gateway and sets the Gateway's status.attachedListenerSets.
+// It returns the listeners of the accepted ListenerSets' entries, which have to be
+// served by the Gateway's DataPlane alongside the Gateway's own listeners.
+func (r *Reconciler) reconcileListenerSets(
+	ctx context.Context,
+	logger logr.Logger,
+	gateway *gwtypes.Gateway,
+	gatewayProgrammed bool,
+) ([]gatewayv1.Listener, error) {
+	if !r.listenerSetsSupported {
+		return nil, nil
+	}
+
+	listenerSets, err := listListenerSetsForGateway(ctx, r.Client, gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	// Listeners defined in the Gateway always take precedence over the ones
+	// defined in ListenerSets, which in turn are processed from the oldest
+	// to the newest.
+	acceptedListeners := make([]gatewayv1.Listener, 0, len(gateway.Spec.Listeners))
+	acceptedListeners = append(acceptedListeners, gateway.Spec.Listeners...)
+	var (
+		listenerSetListeners []gatewayv1.Listener
+		attachedListenerSets int32
+	)
+	for i := range listenerSets {
+		listenerSet := &listenerSets[i]
+		oldListenerSet := listenerSet.DeepCopy()
+
+		allowed, err := listenerSetAllowedByGateway(ctx, r.Client, gateway, listenerSet)
+		if err != nil {
+			return nil, err
+		}
+
+		var accepted []gatewayv1.Listener
+		if allowed {
+			accepted, err = setListenerSetEntriesStatus(ctx, r.Client, listenerSet, acceptedListeners, gatewayProgrammed)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listenerSet.Status.Listeners = nil
+		}
+		setListenerSetConditions(listenerSet, allowed, len(accepted), gatewayProgrammed)
+
+		if len(accepted) > 0 {
+			attachedListenerSets++
+			acceptedListeners = append(acceptedListeners, accepted...)
+			listenerSetListeners = append(listenerSetListeners, accepted...)
+		}
+
+		if _, err := patch.ApplyStatusPatchIfNotEmpty(ctx, r.Client, logger, listenerSet, oldListenerSet); err != nil {
+			return nil, fmt.Errorf("failed patching status of ListenerSet %s: %w", client.ObjectKeyFromObject(listenerSet), err)
+		}
+	}
+
+	if gateway.Spec.AllowedListeners != nil || attachedListenerSets > 0 {
+		gateway.Status.AttachedListenerSets = new(attachedListenerSets)
+	} else {
+		gateway.Status.AttachedListenerSets = nil
+	}
+	log.Trace(logger, "ListenerSets reconciled", "attached", attachedListenerSets, "total", len(listenerSets))
+
+	return listenerSetListeners, nil
+}
+
+// listListenerSetsForGateway returns all the ListenerSets which target the provided
+// Gateway through their spec.parentRef, ordered by creation timestamp and then
+// alphabetically by namespace/name as mandated by the Gateway API specification.
+func listListenerSetsForGateway(ctx context.Context, cl client.Client, gateway *gwtypes.Gateway) ([]gwtypes.ListenerSet, error) {
+	var listenerSetList gwtypes.ListenerSetList
+	if err := cl.List(ctx, &listenerSetList); err != nil {
+		return nil, fmt.Errorf("failed listing ListenerSets: %w", err)
+	}
+
+	listenerSets := make([]gwtypes.ListenerSet, 0, len(listenerSetList.Items))
+	for _, listenerSet := range listenerSetList.Items {
+		if listenerSetTargetsGateway(&listenerSet, gateway) {
+			listenerSets = append(listenerSets, listenerSet)
+		}
+	}
+	sort.SliceStable(listenerSets, func(i, j int) bool {
+		ti, tj := listenerSets[i].CreationTimestamp, listenerSets[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		if listenerSets[i].Namespace != listenerSets[j].Namespace {
+			return listenerSets[i].Namespace < listenerSets[j].Namespace
+		}
+		return listenerSets[i].Name < listenerSets[j].Name
+	})
+	return listenerSets, nil
+}
+
+// listenerSetTargetsGateway returns true if the ListenerSet's parentRef points
+// at the provided Gateway.
+func listenerSetTargetsGateway(listenerSet *gwtypes.ListenerSet, gateway *gwtypes.Gateway) bool {
+	parentRef := listenerSet.Spec.ParentRef
+	if parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		return false
+	}
+	namespace := listenerSet.Namespace
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		namespace = string(*parentRef.Namespace)
+	}
+	return namespace == gateway.Namespace && string(parentRef.Name) == gateway.Name
+}
+
+// listenerSetAllowedByGateway checks whether the Gateway's spec.allowedListeners
+// permits the ListenerSet to attach. When unset, no ListenerSet is allowed.
+func listenerSetAllowedByGateway(
+	ctx context.Context,
+	cl client.Client,
+	gateway *gwtypes.Gateway,
+	listenerSet *gwtypes.ListenerSet,
+) (bool, error) {
+	if gateway.Spec.AllowedListeners == nil ||
+		gateway.Spec.AllowedListeners.Namespaces == nil ||
+		gateway.Spec.AllowedListeners.Namespaces.From == nil {
+		return false, nil
+	}
+
+	namespaces := gateway.Spec.AllowedListeners.Namespaces
+	switch *namespaces.From {
+	case gatewayv1.NamespacesFromAll:
+		return true, nil
+	case gatewayv1.NamespacesFromSame:
+		return listenerSet.Namespace == gateway.Namespace, nil
+	case gatewayv1.NamespacesFromSelector:
+		if namespaces.Selector == nil {
+			return false, nil
+		}
+		selector, err := metav1.LabelSelectorAsSelector(namespaces.Selector)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse allowedListeners namespace selector: %w", err)
+		}
+		var namespace corev1.Namespace
+		if err := cl.Get(ctx, client.ObjectKey{Name: listenerSet.Namespace}, &namespace); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return selector.Matches(labels.Set(namespace.Labels)), nil
+	default:
+		return false, nil
+	}
+}
+
+// setListenerSetEntriesStatus computes the status of every listener entry of the
+// ListenerSet, validating it against the already accepted listeners. It returns the
+// listeners of the entries that have been accepted.
+func setListenerSetEntriesStatus(
+	ctx context.Context,
+	cl client.Client,
+	listenerSet *gwtypes.ListenerSet,
+	acceptedListeners []gatewayv1.Listener,
+	gatewayProgrammed bool,
+) ([]gatewayv1.Listener, error) {
+	oldStatuses := make(map[gatewayv1.SectionName]gatewayv1.ListenerEntryStatus, len(listenerSet.Status.Listeners))
+	for _, status := range listenerSet.Status.Listeners {
+		oldStatuses[status.Name] = status
+	}
+
+	// The referencer used for ReferenceGrants checks must have its type set.
+	referencer := listenerSet.DeepCopy()
+	referencer.SetGroupVersionKind(gatewayv1.SchemeGroupVersion.WithKind("ListenerSet"))
+
+	generation := listenerSet.Generation
+	statuses := make([]gatewayv1.ListenerEntryStatus, 0, len(listenerSet.Spec.Listeners))
+	var accepted []gatewayv1.Listener
+	for _, entry := range listenerSet.Spec.Listeners {
+		listener := listenerEntryToListener(entry)
+		status, ok := oldStatuses[entry.Name]
+		if !ok {
+			status = gatewayv1.ListenerEntryStatus{
+				Name:       entry.Name,
+				Conditions: []metav1.Condition{},
+			}
+		}
+		status.AttachedRoutes = 0
+		status.SupportedKinds = nil
+		entryStatus := listenerEntryConditionsAware(&status)
+
+		acceptedCondition := k8sutils.NewConditionWithGeneration(
+			kcfgconsts.ConditionType(gatewayv1.ListenerEntryConditionAccepted),
+			metav1.ConditionTrue,
+			kcfgconsts.ConditionReason(gatewayv1.ListenerEntryReasonAccepted),
+			"",
+			generation,
+		)
+		conflictedCondition := k8sutils.NewConditionWithGeneration(
+			kcfgconsts.ConditionType(gatewayv1.ListenerEntryConditionConflicted),
+			metav1.ConditionFalse,
+			kcfgconsts.ConditionReason(gatewayv1.ListenerReasonNoConflicts),
+			"",
+			generation,
+		)
+		if _, supported := supportedRoutesByProtocol()[listener.Protocol]; !supported {
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(gatewayv1.ListenerEntryReasonUnsupportedProtocol)
+			acceptedCondition.Message = fmt.Sprintf("Protocol %s is not supported", listener.Protocol)
+		} else if reason, conflicting := listenerConflictReason(listener, accepted, acceptedListeners); conflicting {
+			conflictedCondition.Status = metav1.ConditionTrue
+			conflictedCondition.Reason = string(reason)
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(reason)
+			acceptedCondition.Message = fmt.Sprintf("Listener conflicts with another listener on port %d", listener.Port)
+		}
+		k8sutils.SetCondition(acceptedCondition, entryStatus)
+		k8sutils.SetCondition(conflictedCondition, entryStatus)
+
+		supportedKinds, resolvedRefsCondition, err := getSupportedKindsWithResolvedRefsCondition(ctx, cl, referencer, generation, listener)
+		if err != nil {
+			return nil, err
+		}
+		k8sutils.SetCondition(resolvedRefsCondition, entryStatus)
+
+		isAccepted := acceptedCondition.Status == metav1.ConditionTrue
+		if isAccepted {
+			status.SupportedKinds = supportedKinds
+			accepted = append(accepted, listener)
+			attachedRoutes, err := countAttachedRoutesForListenerSetEntry(ctx, cl, listenerSet, listener, supportedKinds)
+			if err != nil {
+				return nil, err
+			}
+			status.AttachedRoutes = attachedRoutes
+		}
+
+		programmedCondition := k8sutils.NewConditionWithGeneration(
+			kcfgconsts.ConditionType(gatewayv1.ListenerEntryConditionProgrammed),
+			metav1.ConditionTrue,
+			kcfgconsts.ConditionReason(gatewayv1.ListenerEntryReasonProgrammed),
+			"",
+			generation,
+		)
+		switch {
+		case !isAccepted:
+			programmedCondition.Status = metav1.ConditionFalse
+			programmedCondition.Reason = string(gatewayv1.ListenerEntryReasonInvalid)
+			programmedCondition.Message = "Listener is not accepted."
+		case resolvedRefsCondition.Status == metav1.ConditionFalse:
+			programmedCondition.Status = metav1.ConditionFalse
+			programmedCondition.Reason = string(gatewayv1.ListenerEntryReasonPending)
+			programmedCondition.Message = "Listener references are not resolved yet."
+		case !gatewayProgrammed:
+			programmedCondition.Status = metav1.ConditionFalse
+			programmedCondition.Reason = string(gatewayv1.ListenerEntryReasonPending)
+			programmedCondition.Message = "Parent Gateway is not programmed yet."
+		}
+		k8sutils.SetCondition(programmedCondition, entryStatus)
+
+		statuses = append(statuses, status)
+	}
+	listenerSet.Status.Listeners = statuses
+
+	return accepted, nil
+}
+
+// countAttachedRoutesForListenerSetEntry counts the routes attached to the listener
+// entry of the ListenerSet: routes of the supported kinds, from the namespaces allowed
+// by the entry, with a parentRef targeting the ListenerSet and the entry.
+func countAttachedRoutesForListenerSetEntry(
+	ctx context.Context,
+	cl client.Client,
+	listenerSet *gwtypes.ListenerSet,
+	listener gatewayv1.Listener,
+	supportedKinds []gatewayv1.RouteGroupKind,
+) (int32, error) {
+	namespaceAllowed, err := listenerSetEntryNamespaceFilter(ctx, cl, listenerSet, listener)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int32
+	for _, kind := range supportedKinds {
+		var (
+			list       client.ObjectList
+			parentRefs func() [][]gatewayv1.ParentReference
+		)
+		switch kind.Kind {
+		case "HTTPRoute":
+			routes := &gwtypes.HTTPRouteList{}
+			list, parentRefs = routes, func() [][]gatewayv1.ParentReference {
+				return attachableParentRefs(routes.Items, namespaceAllowed, listener, func(r gwtypes.HTTPRoute) []gatewayv1.Hostname {
+					return r.Spec.Hostnames
+				})
+			}
+		case "GRPCRoute":
+			routes := &gwtypes.GRPCRouteList{}
+			list, parentRefs = routes, func() [][]gatewayv1.ParentReference {
+				return attachableParentRefs(routes.Items, namespaceAllowed, listener, func(r gwtypes.GRPCRoute) []gatewayv1.Hostname {
+					return r.Spec.Hostnames
+				})
+			}
+		case "TLSRoute":
+			routes := &gwtypes.TLSRouteList{}
+			list, parentRefs = routes, func() [][]gatewayv1.ParentReference {
+				return attachableParentRefs(routes.Items, namespaceAllowed, listener, func(r gwtypes.TLSRoute) []gatewayv1.Hostname {
+					return r.Spec.Hostnames
+				})
+			}
+		case "TCPRoute":
+			routes := &gwtypes.TCPRouteList{}
+			list, parentRefs = routes, func() [][]gatewayv1.ParentReference {
+				return attachableParentRefs(routes.Items, namespaceAllowed, listener, nil)
+			}
+		case "UDPRoute":
+			routes := &gwtypes.UDPRouteList{}
+			list, parentRefs = routes, func() [][]gatewayv1.ParentReference {
+				return attachableParentRefs(routes.Items, namespaceAllowed, listener, nil)
+			}
+		default:
+			continue
+		}
+
+		if err := cl.List(ctx, list); err != nil {
+			return 0, fmt.Errorf("failed to list %ss when counting AttachedRoutes for ListenerSet %s: %w",
+				kind.Kind, client.ObjectKeyFromObject(listenerSet), err,
+			)
+		}
+		for _, refs := range parentRefs() {
+			if lo.ContainsBy(refs, func(parentRef gatewayv1.ParentReference) bool {
+				return parentRefMatchesListenerSetEntry(parentRef, listenerSet, listener)
+			}) {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// attachableParentRefs returns the parentRefs of the routes which are allowed to attach
+// to the listener based on their namespace and, when hostnames is not nil, on the
+// intersection of their hostnames with the listener's hostname.
+func attachableParentRefs[T any, TPtr interface {
+	*T
+	client.Object
+}](
+	routes []T,
+	namespaceAllowed func(string) bool,
+	listener gatewayv1.Listener,
+	hostnames func(T) []gatewayv1.Hostname,
+) [][]gatewayv1.ParentReference {
+	var refs [][]gatewayv1.ParentReference
+	for _, route := range routes {
+		obj := TPtr(&route)
+		if !namespaceAllowed(obj.GetNamespace()) {
+			continue
+		}
+		if hostnames != nil && !listenerHostnameIntersectsRouteHostnames(listener.Hostname, hostnames(route)) {
+			continue
+		}
+		refs = append(refs, routeParentRefsWithNamespace(obj))
+	}
+	return refs
+}
+
+// routeParentRefsWithNamespace returns the parentRefs of the route with their
+// namespace defaulted to the route's namespace.
+func routeParentRefsWithNamespace(route client.Object) []gatewayv1.ParentReference {
+	var refs []gatewayv1.ParentReference
+	switch r := route.(type) {
+	case *gwtypes.HTTPRoute:
+		refs = r.Spec.ParentRefs
+	case *gwtypes.GRPCRoute:
+		refs = r.Spec.ParentRefs
+	case *gwtypes.TLSRoute:
+		refs = r.Spec.ParentRefs
+	case *gwtypes.TCPRoute:
+		refs = r.Spec.ParentRefs
+	case *gwtypes.UDPRoute:
+		refs = r.Spec.ParentRefs
+	}
+	return lo.Map(refs, func(parentRef gatewayv1.ParentReference, _ int) gatewayv1.ParentReference {
+		if parentRef.Namespace == nil || *parentRef.Namespace == "" {
+			parentRef.Namespace = new(gatewayv1.Namespace(route.GetNamespace()))
+		}
+		return parentRef
+	})
+}
+
+// parentRefMatchesListenerSetEntry reports whether the parentRef, with its namespace
+// already defaulted, targets the listener entry of the ListenerSet.
+func parentRefMatchesListenerSetEntry(parentRef gatewayv1.ParentReference, listenerSet *gwtypes.ListenerSet, listener gatewayv1.Listener) bool {
+	if parentRef.Group != nil && *parentRef.Group != "" && string(*parentRef.Group) != gatewayv1.GroupName {
+		return false
+	}
+	return parentRef.Kind != nil && *parentRef.Kind == "ListenerSet" &&
+		string(parentRef.Name) == listenerSet.Name &&
+		string(lo.FromPtr(parentRef.Namespace)) == listenerSet.Namespace &&
+		(parentRef.SectionName == nil || *parentRef.SectionName == listener.Name) &&
+		(parentRef.Port == nil || *parentRef.Port == listener.Port)
+}
+
+// listenerSetEntryNamespaceFilter returns a function reporting whether routes from a
+// namespace are allowed to attach to the listener entry of the ListenerSet. Namespaces
+// are evaluated relative to the ListenerSet, and only routes from the ListenerSet's
+// namespace are allowed when the entry does not specify AllowedRoutes.
+func listenerSetEntryNamespaceFilter(
+	ctx context.Context,
+	cl client.Client,
+	listenerSet *gwtypes.ListenerSet,
+	listener gatewayv1.Listener,
+) (func(string) bool, error) {
+	from := gatewayv1.NamespacesFromSame
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil && listener.AllowedRoutes.Namespaces.From != nil {
+		from = *listener.AllowedRoutes.Namespaces.From
+	}
+
+	switch from {
+	case gatewayv1.NamespacesFromAll:
+		return func(string) bool { return true }, nil
+	case gatewayv1.NamespacesFromSelector:
+		selector, err := metav1.LabelSelectorAsSelector(listener.AllowedRoutes.Namespaces.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create namespace selector for ListenerSet %s: %w",
+				client.ObjectKeyFromObject(listenerSet), err,
+			)
+		}
+		var nsList corev1.NamespaceList
+		if err := cl.List(ctx, &nsList, &client.ListOptions{LabelSelector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces for ListenerSet %s: %w",
+				client.ObjectKeyFromObject(listenerSet), err,
+			)
+		}
+		namespaces := lo.SliceToMap(nsList.Items, func(ns corev1.Namespace) (string, struct{}) {
+			return ns.Name, struct{}{}
+		})
+		return func(namespace string) bool {
+			_, ok := namespaces[namespace]
+			return ok
+		}, nil
+	case gatewayv1.NamespacesFromNone:
+		return func(string) bool { return false }, nil
+	default:
+		return func(namespace string) bool { return namespace == listenerSet.Namespace }, nil
+	}
+}
+
+// setListenerSetConditions sets the Accepted and Programmed conditions of the ListenerSet.
+func setListenerSetConditions(listenerSet *gwtypes.ListenerSet, allowed bool, acceptedCount int, gatewayProgrammed bool) {
+	aware := listenerSetConditionsAware(listenerSet)
+	generation := listenerSet.Generation
+
+	acceptedCondition := k8sutils.NewConditionWithGeneration(
+		kcfgconsts.ConditionType(gatewayv1.ListenerSetConditionAccepted),
+		metav1.ConditionTrue,
+		kcfgconsts.ConditionReason(gatewayv1.ListenerSetReasonAccepted),
+		"",
+		generation,
+	)
+	programmedCondition := k8sutils.NewConditionWithGeneration(
+		kcfgconsts.ConditionType(gatewayv1.ListenerSetConditionProgrammed),
+		metav1.ConditionTrue,
+		kcfgconsts.ConditionReason(gatewayv1.ListenerSetReasonProgrammed),
+		"",
+		generation,
+	)
+	switch {
+	case !allowed:
+		acceptedCondition.Status = metav1.ConditionFalse
+		acceptedCondition.Reason = string(gatewayv1.ListenerSetReasonNotAllowed)
+		acceptedCondition.Message = "ListenerSet is not allowed by the parent Gateway's spec.allowedListeners."
+	case acceptedCount == 0:
+		acceptedCondition.Status = metav1.ConditionFalse
+		acceptedCondition.Reason = string(gatewayv1.ListenerSetReasonListenersNotValid)
+		acceptedCondition.Message = "None of the ListenerSet's listeners have been accepted."
+	}
+	switch {
+	case acceptedCondition.Status == metav1.ConditionFalse:
+		programmedCondition.Status = metav1.ConditionFalse
+		programmedCondition.Reason = string(gatewayv1.ListenerSetReasonInvalid)
+		programmedCondition.Message = "ListenerSet is not accepted."
+	case !gatewayProgrammed:
+		programmedCondition.Status = metav1.ConditionFalse
+		programmedCondition.Reason = string(gatewayv1.ListenerSetReasonPending)
+		programmedCondition.Message = "Parent Gateway is not programmed yet."
+	}
+	k8sutils.SetCondition(acceptedCondition, aware)
+	k8sutils.SetCondition(programmedCondition, aware)
+}
+
+// listenerConflictReason checks whether the listener conflicts with any of the
+// provided listeners and returns the reason of the conflict if it does.
+func listenerConflictReason(listener gatewayv1.Listener, others ...[]gatewayv1.Listener) (gatewayv1.ListenerEntryConditionReason, bool) {
+	for _, group := range others {
+		for _, other := range group {
+			if listener.Port != other.Port {
+				continue
+			}
+			// Listeners sharing a port must use the same protocol.
+			if listener.Protocol != other.Protocol {
+				return gatewayv1.ListenerEntryReasonProtocolConflict, true
+			}
+			// TODO: support multiple TLS listeners sharing the same port (e.g. via SNI).
+			// Tracked in https://github.com/Kong/kong-operator/issues/3511.
+			if listener.Protocol == gatewayv1.TLSProtocolType {
+				return gatewayv1.ListenerEntryReasonProtocolConflict, true
+			}
+			if listenerHostname(listener) == listenerHostname(other) {
+				return gatewayv1.ListenerEntryReasonHostnameConflict, true
+			}
+		}
+	}
+	return "", false
+}
+
+func listenerHostname(listener gatewayv1.Listener) gatewayv1.Hostname {
+	if listener.Hostname == nil {
+		return ""
+	}
+	return *listener.Hostname
+}
+
+// listenerEntryToListener converts a ListenerSet's listener entry to a Gateway listener.
+func listenerEntryToListener(entry gatewayv1.ListenerEntry) gatewayv1.Listener {
+	return gatewayv1.Listener{
+		Name:          entry.Name,
+		Hostname:      entry.Hostname,
+		Port:          entry.Port,
+		Protocol:      entry.Protocol,
+		TLS:           entry.TLS,
+		AllowedRoutes: entry.AllowedRoutes,
+	}
+}
+
+// dataPlaneListenersForGateway returns the listeners the Gateway's DataPlane has to
+// serve: the Gateway's own listeners followed by one listener for every port used
+// only by ListenerSets. Listeners on ports already served by the DataPlane do not
+// need any change in its configuration, and appending the new ones at the end, with
+// names derived from their protocol and port, keeps the ports and environment of
+// the existing listeners unchanged so that they are not restarted.
+func dataPlaneListenersForGateway(gateway *gwtypes.Gateway, listenerSetListeners []gatewayv1.Listener) []gatewayv1.Listener {
+	if len(listenerSetListeners) == 0 {
+		return gateway.Spec.Listeners
+	}
+
+	servedPorts := make(map[gatewayv1.PortNumber]struct{}, len(gateway.Spec.Listeners))
+	for _, listener := range gateway.Spec.Listeners {
+		servedPorts[listener.Port] = struct{}{}
+	}
+
+	var additional []gatewayv1.Listener
+	for _, listener := range listenerSetListeners {
+		if _, ok := servedPorts[listener.Port]; ok {
+			continue
+		}
+		servedPorts[listener.Port] = struct{}{}
+		additional = append(additional, gatewayv1.Listener{
+			Name:     gatewayv1.SectionName(fmt.Sprintf("ls-%s-%d", strings.ToLower(string(listener.Protocol)), listener.Port)),
+			Port:     listener.Port,
+			Protocol: listener.Protocol,
+		})
+	}
+	// Sort the additional listeners by port so that the order in which ListenerSets
+	// are attached does not affect the generated DataPlane configuration.
+	sort.Slice(additional, func(i, j int) bool { return additional[i].Port < additional[j].Port })
+
+	listeners := make([]gatewayv1.Listener, 0, len(gateway.Spec.Listeners)+len(additional))
+	listeners = append(listeners, gateway.Spec.Listeners...)
+	return append(listeners, additional...)
+}
+
+// -----------------------------------------------------------------------------
+// ListenerSet - Conditions Aware helpers
+// -----------------------------------------------------------------------------
+
+type listenerSetConditionsAwareT struct {
+	*gwtypes.ListenerSet
+}
+
+func listenerSetConditionsAware(listenerSet *gwtypes.ListenerSet) listenerSetConditionsAwareT {
+	return listenerSetConditionsAwareT{ListenerSet: listenerSet}
+}
+
+func (l listenerSetConditionsAwareT) GetConditions() []metav1.Condition {
+	return l.Status.Conditions
+}
+
+func (l listenerSetConditionsAwareT) SetConditions(conditions []metav1.Condition) {
+	l.Status.Conditions = conditions
+}
+
+type listenerEntryConditionsAwareT struct {
+	*gatewayv1.ListenerEntryStatus
+}
+
+func listenerEntryConditionsAware(status *gatewayv1.ListenerEntryStatus) listenerEntryConditionsAwareT {
+	return listenerEntryConditionsAwareT{ListenerEntryStatus: status}
+}
+
+func (l listenerEntryConditionsAwareT) GetConditions() []metav1.Condition {
+	return l.Conditions
+}
+
+func (l listenerEntryConditionsAwareT) SetConditions(conditions []metav1.Condition) {
+	l.Conditions = conditions
+}
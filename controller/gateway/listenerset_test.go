@@ -0,0 +1,262 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kcfgconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	gwtypes "github.com/kong/kong-operator/v2/internal/types"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+func TestListenerConflictReason(t *testing.T) {
+	gatewayListeners := []gatewayv1.Listener{
+		{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+		{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("platform.example.com"))},
+		{Name: "tls", Port: 8443, Protocol: gatewayv1.TLSProtocolType},
+	}
+
+	testCases := []struct {
+		name             string
+		listener         gatewayv1.Listener
+		expectedConflict bool
+		expectedReason   gatewayv1.ListenerEntryConditionReason
+	}{
+		{
+			name:     "https listener with a distinct hostname on a shared port",
+			listener: gatewayv1.Listener{Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("team.example.com"))},
+		},
+		{
+			name:     "listener on a new port",
+			listener: gatewayv1.Listener{Port: 9443, Protocol: gatewayv1.HTTPSProtocolType},
+		},
+		{
+			name:             "different protocol on a shared port",
+			listener:         gatewayv1.Listener{Port: 80, Protocol: gatewayv1.HTTPSProtocolType},
+			expectedConflict: true,
+			expectedReason:   gatewayv1.ListenerEntryReasonProtocolConflict,
+		},
+		{
+			name:             "tls listener on a shared port",
+			listener:         gatewayv1.Listener{Port: 8443, Protocol: gatewayv1.TLSProtocolType, Hostname: new(gatewayv1.Hostname("team.example.com"))},
+			expectedConflict: true,
+			expectedReason:   gatewayv1.ListenerEntryReasonProtocolConflict,
+		},
+		{
+			name:             "same hostname on a shared port",
+			listener:         gatewayv1.Listener{Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("platform.example.com"))},
+			expectedConflict: true,
+			expectedReason:   gatewayv1.ListenerEntryReasonHostnameConflict,
+		},
+		{
+			name:             "no hostname on a shared port without hostname",
+			listener:         gatewayv1.Listener{Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+			expectedConflict: true,
+			expectedReason:   gatewayv1.ListenerEntryReasonHostnameConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, conflict := listenerConflictReason(tc.listener, gatewayListeners)
+			assert.Equal(t, tc.expectedConflict, conflict)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
+
+func TestDataPlaneListenersForGateway(t *testing.T) {
+	gateway := &gwtypes.Gateway{
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+			},
+		},
+	}
+
+	t.Run("no ListenerSet listeners", func(t *testing.T) {
+		assert.Equal(t, gateway.Spec.Listeners, dataPlaneListenersForGateway(gateway, nil))
+	})
+
+	t.Run("ListenerSet listeners on served and new ports", func(t *testing.T) {
+		listeners := dataPlaneListenersForGateway(gateway, []gatewayv1.Listener{
+			{Name: "team-b", Port: 9443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("b.example.com"))},
+			{Name: "team-a", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("a.example.com"))},
+			{Name: "team-c", Port: 9443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("c.example.com"))},
+			{Name: "team-d", Port: 5432, Protocol: gatewayv1.TCPProtocolType},
+		})
+		assert.Equal(t, []gatewayv1.Listener{
+			{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+			{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+			{Name: "ls-tcp-5432", Port: 5432, Protocol: gatewayv1.TCPProtocolType},
+			{Name: "ls-https-9443", Port: 9443, Protocol: gatewayv1.HTTPSProtocolType},
+		}, listeners)
+	})
+}
+
+func TestReconcileListenerSets(t *testing.T) {
+	ctx := t.Context()
+
+	gateway := &gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "platform",
+			Namespace: "infra",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("platform.example.com"))},
+			},
+			AllowedListeners: &gatewayv1.AllowedListeners{
+				Namespaces: &gatewayv1.ListenerNamespaces{
+					From: new(gatewayv1.NamespacesFromSelector),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"platform": "allowed"},
+					},
+				},
+			},
+		},
+	}
+	allowedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"platform": "allowed"},
+		},
+	}
+	otherNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-b",
+		},
+	}
+	listenerSet := func(namespace, name string, created metav1.Time, entries ...gatewayv1.ListenerEntry) *gwtypes.ListenerSet {
+		return &gwtypes.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: created,
+				Generation:        1,
+			},
+			Spec: gatewayv1.ListenerSetSpec{
+				ParentRef: gatewayv1.ParentGatewayReference{
+					Name:      "platform",
+					Namespace: new(gatewayv1.Namespace("infra")),
+				},
+				Listeners: entries,
+			},
+		}
+	}
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	newer := metav1.Now()
+	listenerSets := []client.Object{
+		listenerSet("team-a", "apps", older,
+			gatewayv1.ListenerEntry{Name: "apps", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("apps.example.com"))},
+			gatewayv1.ListenerEntry{Name: "admin", Port: 9443, Protocol: gatewayv1.HTTPSProtocolType},
+		),
+		listenerSet("team-a", "conflicting", newer,
+			gatewayv1.ListenerEntry{Name: "apps", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("apps.example.com"))},
+		),
+		listenerSet("team-b", "not-allowed", older,
+			gatewayv1.ListenerEntry{Name: "web", Port: 8080, Protocol: gatewayv1.HTTPProtocolType},
+		),
+	}
+	httpRoute := func(namespace, name string, hostname gatewayv1.Hostname, sectionName *gatewayv1.SectionName) client.Object {
+		return &gwtypes.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Kind:        new(gatewayv1.Kind("ListenerSet")),
+							Name:        "apps",
+							Namespace:   new(gatewayv1.Namespace("team-a")),
+							SectionName: sectionName,
+						},
+					},
+				},
+				Hostnames: []gatewayv1.Hostname{hostname},
+			},
+		}
+	}
+	routes := []client.Object{
+		// Attached to the "apps" entry only.
+		httpRoute("team-a", "apps", "apps.example.com", new(gatewayv1.SectionName("apps"))),
+		// Attached to the "admin" entry only, as its hostname doesn't match the "apps" entry.
+		httpRoute("team-a", "other", "other.example.com", nil),
+		// Not attached, as the entries only allow routes from the ListenerSet's namespace.
+		httpRoute("team-b", "foreign", "apps.example.com", nil),
+	}
+
+	cl := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(allowedNamespace, otherNamespace).
+		WithObjects(routes...).
+		WithObjects(listenerSets...).
+		WithStatusSubresource(listenerSets...).
+		Build()
+	r := &Reconciler{
+		Client:                cl,
+		listenerSetsSupported: true,
+	}
+
+	listeners, err := r.reconcileListenerSets(ctx, logr.Discard(), gateway, true)
+	require.NoError(t, err)
+	assert.Equal(t, []gatewayv1.Listener{
+		{Name: "apps", Port: 443, Protocol: gatewayv1.HTTPSProtocolType, Hostname: new(gatewayv1.Hostname("apps.example.com"))},
+		{Name: "admin", Port: 9443, Protocol: gatewayv1.HTTPSProtocolType},
+	}, listeners)
+	require.NotNil(t, gateway.Status.AttachedListenerSets)
+	assert.Equal(t, int32(1), *gateway.Status.AttachedListenerSets)
+
+	getListenerSet := func(namespace, name string) *gwtypes.ListenerSet {
+		ls := &gwtypes.ListenerSet{}
+		require.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, ls))
+		return ls
+	}
+	conditionStatus := func(aware k8sutils.ConditionsAware, conditionType string) (metav1.ConditionStatus, string) {
+		cond, ok := k8sutils.GetCondition(kcfgconsts.ConditionType(conditionType), aware)
+		require.True(t, ok, "condition %s not found", conditionType)
+		return cond.Status, cond.Reason
+	}
+
+	apps := getListenerSet("team-a", "apps")
+	status, reason := conditionStatus(listenerSetConditionsAware(apps), string(gatewayv1.ListenerSetConditionAccepted))
+	assert.Equal(t, metav1.ConditionTrue, status)
+	assert.Equal(t, string(gatewayv1.ListenerSetReasonAccepted), reason)
+	status, _ = conditionStatus(listenerSetConditionsAware(apps), string(gatewayv1.ListenerSetConditionProgrammed))
+	assert.Equal(t, metav1.ConditionTrue, status)
+	require.Len(t, apps.Status.Listeners, 2)
+	for _, l := range apps.Status.Listeners {
+		status, _ = conditionStatus(listenerEntryConditionsAware(&l), string(gatewayv1.ListenerEntryConditionProgrammed))
+		assert.Equal(t, metav1.ConditionTrue, status, "listener %s", l.Name)
+		assert.NotEmpty(t, l.SupportedKinds)
+		assert.Equal(t, int32(1), l.AttachedRoutes, "listener %s", l.Name)
+	}
+
+	conflicting := getListenerSet("team-a", "conflicting")
+	status, reason = conditionStatus(listenerSetConditionsAware(conflicting), string(gatewayv1.ListenerSetConditionAccepted))
+	assert.Equal(t, metav1.ConditionFalse, status)
+	assert.Equal(t, string(gatewayv1.ListenerSetReasonListenersNotValid), reason)
+	require.Len(t, conflicting.Status.Listeners, 1)
+	status, reason = conditionStatus(listenerEntryConditionsAware(&conflicting.Status.Listeners[0]), string(gatewayv1.ListenerEntryConditionConflicted))
+	assert.Equal(t, metav1.ConditionTrue, status)
+	assert.Equal(t, string(gatewayv1.ListenerEntryReasonHostnameConflict), reason)
+
+	notAllowed := getListenerSet("team-b", "not-allowed")
+	status, reason = conditionStatus(listenerSetConditionsAware(notAllowed), string(gatewayv1.ListenerSetConditionAccepted))
+	assert.Equal(t, metav1.ConditionFalse, status)
+	assert.Equal(t, string(gatewayv1.ListenerSetReasonNotAllowed), reason)
+	assert.Empty(t, notAllowed.Status.Listeners)
+}
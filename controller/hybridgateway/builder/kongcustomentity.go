@@ -0,0 +1,107 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/metadata"
+	gwtypes "github.com/kong/kong-operator/v2/internal/types"
+)
+
+// KongCustomEntityBuilder is a builder for configurationv1alpha1.KongCustomEntity resources.
+type KongCustomEntityBuilder struct {
+	entity configurationv1alpha1.KongCustomEntity
+	errors []error
+}
+
+// NewKongCustomEntity creates and returns a new KongCustomEntityBuilder instance.
+func NewKongCustomEntity() *KongCustomEntityBuilder {
+	return &KongCustomEntityBuilder{
+		entity: configurationv1alpha1.KongCustomEntity{},
+		errors: make([]error, 0),
+	}
+}
+
+// WithName sets the name for the KongCustomEntity being built.
+func (b *KongCustomEntityBuilder) WithName(name string) *KongCustomEntityBuilder {
+	b.entity.Name = name
+	return b
+}
+
+// WithNamespace sets the namespace for the KongCustomEntity being built.
+func (b *KongCustomEntityBuilder) WithNamespace(namespace string) *KongCustomEntityBuilder {
+	b.entity.Namespace = namespace
+	return b
+}
+
+// WithLabels sets the labels for the KongCustomEntity resource based on the given route.
+func (b *KongCustomEntityBuilder) WithLabels(route client.Object, parentRef *gwtypes.ParentReference) *KongCustomEntityBuilder {
+	labels := metadata.BuildLabels(route, parentRef)
+	if b.entity.Labels == nil {
+		b.entity.Labels = make(map[string]string)
+	}
+	maps.Copy(b.entity.Labels, labels)
+	return b
+}
+
+// WithAnnotations sets the annotations for the KongCustomEntity resource based on the given route and parent reference.
+func (b *KongCustomEntityBuilder) WithAnnotations(route client.Object, parentRef *gwtypes.ParentReference) *KongCustomEntityBuilder {
+	annotations := metadata.BuildAnnotations(route, parentRef)
+	if b.entity.Annotations == nil {
+		b.entity.Annotations = make(map[string]string)
+	}
+	maps.Copy(b.entity.Annotations, annotations)
+	return b
+}
+
+// WithEntityType sets the type of the Kong entity.
+func (b *KongCustomEntityBuilder) WithEntityType(entityType string) *KongCustomEntityBuilder {
+	if entityType == "" {
+		b.errors = append(b.errors, errors.New("entity type cannot be empty"))
+		return b
+	}
+	b.entity.Spec.EntityType = entityType
+	return b
+}
+
+// WithFields sets the fields of the Kong entity.
+func (b *KongCustomEntityBuilder) WithFields(fields apiextensionsv1.JSON) *KongCustomEntityBuilder {
+	b.entity.Spec.Fields = *fields.DeepCopy()
+	return b
+}
+
+// WithControlPlaneRef sets the ControlPlaneRef for the KongCustomEntity being built.
+func (b *KongCustomEntityBuilder) WithControlPlaneRef(cpr commonv1alpha1.ControlPlaneRef) *KongCustomEntityBuilder {
+	b.entity.Spec.ControlPlaneRef = &cpr
+	return b
+}
+
+// WithRouteRef sets the KongRoute the KongCustomEntity is attached to.
+func (b *KongCustomEntityBuilder) WithRouteRef(name string) *KongCustomEntityBuilder {
+	b.entity.Spec.RouteRef = &configurationv1alpha1.TargetRef{Name: name}
+	return b
+}
+
+// Build returns the constructed KongCustomEntity resource and any accumulated errors.
+func (b *KongCustomEntityBuilder) Build() (configurationv1alpha1.KongCustomEntity, error) {
+	if len(b.errors) > 0 {
+		return configurationv1alpha1.KongCustomEntity{}, errors.Join(b.errors...)
+	}
+	return b.entity, nil
+}
+
+// MustBuild returns the constructed KongCustomEntity resource, panicking on any errors.
+// Useful for tests or when you're certain the build will succeed.
+func (b *KongCustomEntityBuilder) MustBuild() configurationv1alpha1.KongCustomEntity {
+	entity, err := b.Build()
+	if err != nil {
+		panic(fmt.Errorf("failed to build KongCustomEntity: %w", err))
+	}
+	return entity
+}
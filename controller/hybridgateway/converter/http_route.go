@@ -17,6 +17,7 @@ import (
 	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
 	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
 	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/customentity"
 	"github.com/kong/kong-operator/v2/controller/hybridgateway/kongroute"
 	"github.com/kong/kong-operator/v2/controller/hybridgateway/namegen"
 	"github.com/kong/kong-operator/v2/controller/hybridgateway/plugin"
@@ -59,6 +60,7 @@ func newHTTPRouteConverter(httpRoute *gwtypes.HTTPRoute, cl client.Client, fqdnM
 		expectedGVKs: []schema.GroupVersionKind{
 			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongRoute"},
 			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongTarget"},
+			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongCustomEntity"},
 			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongPluginBinding"},
 			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongService"},
 			{Group: configurationv1alpha1.GroupVersion.Group, Version: configurationv1alpha1.GroupVersion.Version, Kind: "KongCertificate"},
@@ -412,6 +414,38 @@ func (c *httpRouteConverter) translate(ctx context.Context, logger logr.Logger)
 				}
 			}
 
+			// Attach the KongCustomEntities whose parent is a user-managed KongPlugin referenced
+			// through an ExtensionRef filter to each KongRoute generated for the rule.
+			for _, filter := range rule.Filters {
+				if filter.Type != gatewayv1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil ||
+					filter.ExtensionRef.Kind != "KongPlugin" {
+					continue
+				}
+				pluginName := string(filter.ExtensionRef.Name)
+				for _, r := range routes {
+					entities, err := customentity.CustomEntitiesForPluginAndRoute(
+						ctx,
+						logger,
+						c.Client,
+						c.route,
+						&pRef,
+						cp,
+						pluginName,
+						r.Name,
+					)
+					if err != nil {
+						log.Error(logger, err, "Failed to translate KongCustomEntity resources, skipping them",
+							"plugin", pluginName,
+							"kongRoute", r.Name)
+						translationErrors = append(translationErrors, fmt.Errorf("failed to translate KongCustomEntities for plugin %s: %w", pluginName, err))
+						continue
+					}
+					for i := range entities {
+						filterOutputs = append(filterOutputs, &entities[i])
+					}
+				}
+			}
+
 			upstreamPtr, err := upstream.UpstreamForRule(ctx, logger, c.Client, c.route, rule, &pRef, cp)
 			if err != nil {
 				log.Error(logger, err, "Failed to translate KongUpstream resource for rule, skipping rule",
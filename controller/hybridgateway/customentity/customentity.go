@@ -0,0 +1,112 @@
+package customentity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/builder"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/metadata"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/namegen"
+	"github.com/kong/kong-operator/v2/controller/hybridgateway/translator"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	gwtypes "github.com/kong/kong-operator/v2/internal/types"
+)
+
+// CustomEntitiesForPluginAndRoute creates or updates the KongCustomEntities attached to the given KongRoute
+// for the user-managed KongCustomEntities which reference the given KongPlugin as their parent.
+//
+// KongCustomEntities attached to a KongPlugin (through spec.parentRef) are translated by the ingress controller
+// for every entity the plugin is attached to. Hybrid Gateway mirrors that behavior by generating a copy of each
+// such KongCustomEntity for every KongRoute the plugin is bound to, with the ControlPlaneRef of the Gateway
+// and the routeRef pointing to the KongRoute, so that the copies are synced to Konnect.
+//
+// Parameters:
+//   - ctx: The context for API calls and cancellation
+//   - logger: Logger for structured logging
+//   - cl: Kubernetes client for API operations
+//   - route: The route resource from which the KongCustomEntities are derived
+//   - pRef: The parent reference (Gateway) for the route
+//   - cp: The control plane reference for the KongCustomEntities
+//   - pluginName: The name of the user-managed KongPlugin, in the route's namespace
+//   - routeName: The name of the KongRoute to attach the KongCustomEntities to
+//
+// Returns:
+//   - entities: The created or updated KongCustomEntity resources
+//   - err: Any error that occurred during the process
+func CustomEntitiesForPluginAndRoute(
+	ctx context.Context,
+	logger logr.Logger,
+	cl client.Client,
+	route client.Object,
+	pRef *gwtypes.ParentReference,
+	cp *commonv1alpha1.ControlPlaneRef,
+	pluginName string,
+	routeName string,
+) (entities []configurationv1alpha1.KongCustomEntity, err error) {
+	var list configurationv1alpha1.KongCustomEntityList
+	if err := cl.List(ctx, &list, client.InNamespace(route.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("failed to list KongCustomEntities: %w", err)
+	}
+
+	for _, source := range list.Items {
+		if !isAttachedToKongPlugin(&source, pluginName) {
+			continue
+		}
+
+		entityName := namegen.NewKongCustomEntityName(routeName, source.Name)
+		logger := logger.WithValues("kongcustomentity", entityName)
+		log.Debug(logger, "Generating KongCustomEntity for KongPlugin and KongRoute")
+
+		entity, err := builder.NewKongCustomEntity().
+			WithName(entityName).
+			WithNamespace(metadata.NamespaceFromParentRef(route, pRef)).
+			WithLabels(route, pRef).
+			WithAnnotations(route, pRef).
+			WithEntityType(source.Spec.EntityType).
+			WithFields(source.Spec.Fields).
+			WithControlPlaneRef(*cp).
+			WithRouteRef(routeName).
+			Build()
+		if err != nil {
+			log.Error(logger, err, "Failed to build KongCustomEntity resource")
+			return nil, fmt.Errorf("failed to build KongCustomEntity %s: %w", entityName, err)
+		}
+
+		if _, err = translator.VerifyAndUpdate(ctx, logger, cl, &entity, route, true); err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+// isAttachedToKongPlugin returns true if the user-managed KongCustomEntity references
+// the KongPlugin with the given name, in its own namespace, as its parent.
+func isAttachedToKongPlugin(entity *configurationv1alpha1.KongCustomEntity, pluginName string) bool {
+	// KongCustomEntities bound to a Konnect ControlPlane are synced as they are.
+	if cpRef := entity.Spec.ControlPlaneRef; cpRef != nil && cpRef.Type != commonv1alpha1.ControlPlaneRefKIC {
+		return false
+	}
+
+	parentRef := entity.Spec.ParentRef
+	if parentRef == nil || parentRef.Name != pluginName {
+		return false
+	}
+	if parentRef.Kind == nil || *parentRef.Kind != "KongPlugin" {
+		return false
+	}
+	if parentRef.Group != nil && *parentRef.Group != configurationv1.GroupVersion.Group {
+		return false
+	}
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" && *parentRef.Namespace != entity.Namespace {
+		return false
+	}
+	return true
+}
@@ -19,14 +19,22 @@ import (
 const MaxKongServiceTimeout int64 = consts.MaxKongServiceTimeout
 
 // BackendRequestTimeoutMilliseconds returns the Kong service timeout (in milliseconds) derived
-// from an HTTPRoute rule's spec.timeouts.backendRequest, or nil when the rule sets no
-// backendRequest timeout (or it cannot be parsed). A zero duration maps to MaxKongServiceTimeout
-// per the Gateway API semantics where "0s" disables the timeout.
+// from an HTTPRoute rule's spec.timeouts.backendRequest, falling back to spec.timeouts.request
+// as it bounds the request sent to the backend too. It returns nil when the rule sets neither
+// timeout (or it cannot be parsed). A zero duration maps to MaxKongServiceTimeout per the
+// Gateway API semantics where "0s" disables the timeout.
 func BackendRequestTimeoutMilliseconds(rule gatewayv1.HTTPRouteRule) *int64 {
-	if rule.Timeouts == nil || rule.Timeouts.BackendRequest == nil {
+	if rule.Timeouts == nil {
 		return nil
 	}
-	duration, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
+	timeout := rule.Timeouts.BackendRequest
+	if timeout == nil {
+		timeout = rule.Timeouts.Request
+	}
+	if timeout == nil {
+		return nil
+	}
+	duration, err := time.ParseDuration(string(*timeout))
 	// The value is CEL-validated to a strict subset of time.ParseDuration, so this should not happen.
 	if err != nil {
 		return nil
@@ -449,6 +457,11 @@ func NewKongPluginBindingName(routeID, pluginID string) string {
 	return newName(routeID, pluginID)
 }
 
+// NewKongCustomEntityName generates a KongCustomEntity name based on the KongRoute and the source KongCustomEntity names.
+func NewKongCustomEntityName(routeID, entityID string) string {
+	return newName(routeID, entityID)
+}
+
 // NewKongTargetName generates the Kong target name based on the KongUpstream name, the Service Endpoint IP, and the backendRef.
 func NewKongTargetName[T gwtypes.SupportedBackendRef](upstreamID, endpointID string, port int, br *T) string {
 	switch b := any(br).(type) {
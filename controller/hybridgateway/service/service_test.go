@@ -3449,10 +3449,12 @@ func TestServiceForRule_BackendRequestTimeout(t *testing.T) {
 	backendRef := gatewayv1.HTTPBackendRef{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "my-svc", Port: &port80}}}
 	timeout500ms := gatewayv1.Duration("500ms")
 	timeout0s := gatewayv1.Duration("0s")
+	timeout2s := gatewayv1.Duration("2s")
 
 	tests := []struct {
 		name            string
 		backendRequest  *gatewayv1.Duration
+		request         *gatewayv1.Duration
 		annotations     map[string]string
 		expectedConnect *int64
 		expectedRead    *int64
@@ -3481,6 +3483,21 @@ func TestServiceForRule_BackendRequestTimeout(t *testing.T) {
 			expectedRead:    new(int64(30000)),
 			expectedWrite:   new(int64(500)),
 		},
+		{
+			name:            "request timeout applies when backendRequest timeout is unset",
+			request:         &timeout2s,
+			expectedConnect: new(int64(2000)),
+			expectedRead:    new(int64(2000)),
+			expectedWrite:   new(int64(2000)),
+		},
+		{
+			name:            "backendRequest timeout takes precedence over request timeout",
+			backendRequest:  &timeout500ms,
+			request:         &timeout2s,
+			expectedConnect: new(int64(500)),
+			expectedRead:    new(int64(500)),
+			expectedWrite:   new(int64(500)),
+		},
 		{
 			name:            "no backendRequest timeout and no annotation leaves fields unset",
 			backendRequest:  nil,
@@ -3504,8 +3521,8 @@ func TestServiceForRule_BackendRequestTimeout(t *testing.T) {
 					{Path: &gatewayv1.HTTPPathMatch{Type: new(gatewayv1.PathMatchPathPrefix), Value: new("/test")}},
 				},
 			}
-			if tt.backendRequest != nil {
-				rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{BackendRequest: tt.backendRequest}
+			if tt.backendRequest != nil || tt.request != nil {
+				rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{BackendRequest: tt.backendRequest, Request: tt.request}
 			}
 			svc := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-svc", Namespace: "test-namespace", Annotations: tt.annotations}}
 			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&svc).Build()
@@ -39,6 +39,7 @@ type kongResource interface {
 		*configurationv1.KongPlugin |
 		*configurationv1alpha1.KongPluginBinding |
 		*configurationv1alpha1.KongCertificate |
+		*configurationv1alpha1.KongCustomEntity |
 		*configurationv1alpha1.KongReferenceGrant
 }
 
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
 	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 	"github.com/kong/kong-operator/v2/internal/utils/index"
 )
@@ -106,3 +107,41 @@ func MapHTTPRouteForKongPlugin(cl client.Client) handler.MapFunc {
 		return append(requests, indexRequests...)
 	}
 }
+
+// MapHTTPRouteForKongCustomEntity returns a handler.MapFunc that maps KongCustomEntities to the HTTPRoutes
+// referencing, through an ExtensionRef filter, the KongPlugin the KongCustomEntity is attached to, and to the
+// HTTPRoutes the KongCustomEntity has been generated for.
+func MapHTTPRouteForKongCustomEntity(cl client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		entity, ok := obj.(*configurationv1alpha1.KongCustomEntity)
+		if !ok {
+			return nil
+		}
+
+		// Add requests for HTTPRoutes the KongCustomEntity has been generated for via annotation.
+		requests := MapRouteForKongResource[*configurationv1alpha1.KongCustomEntity](kindHTTPRoute)(ctx, obj)
+
+		parentRef := entity.Spec.ParentRef
+		if parentRef == nil || parentRef.Kind == nil || *parentRef.Kind != "KongPlugin" {
+			return requests
+		}
+
+		// List all HTTPRoutes that reference the parent plugin using the index.
+		httpRoutes := &gwtypes.HTTPRouteList{}
+		err := cl.List(ctx, httpRoutes, client.MatchingFields{
+			index.KongPluginsOnHTTPRouteIndex: entity.Namespace + "/" + parentRef.Name,
+		})
+		if err != nil {
+			return requests
+		}
+		for _, httpRoute := range httpRoutes.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: httpRoute.Namespace,
+					Name:      httpRoute.Name,
+				},
+			})
+		}
+		return requests
+	}
+}
@@ -20,6 +20,7 @@ func Owns(obj client.Object) []client.Object {
 			&configurationv1alpha1.KongTarget{},
 			&configurationv1alpha1.KongPluginBinding{},
 			&configurationv1.KongPlugin{},
+			&configurationv1alpha1.KongCustomEntity{},
 		}
 	case *gwtypes.TLSRoute:
 		return []client.Object{
@@ -21,7 +21,7 @@ func TestOwns(t *testing.T) {
 		{
 			name:    "HTTPRoute",
 			obj:     &gwtypes.HTTPRoute{},
-			wantLen: 6,
+			wantLen: 7,
 			want: []any{
 				&configurationv1alpha1.KongRoute{},
 				&configurationv1alpha1.KongService{},
@@ -29,6 +29,7 @@ func TestOwns(t *testing.T) {
 				&configurationv1alpha1.KongTarget{},
 				&configurationv1alpha1.KongPluginBinding{},
 				&configurationv1.KongPlugin{},
+				&configurationv1alpha1.KongCustomEntity{},
 			},
 		},
 		{
@@ -66,6 +66,10 @@ func Watches(obj client.Object, cl client.Client) []Watcher {
 				MapRouteForKongResource[*configurationv1alpha1.KongPluginBinding](kindHTTPRoute),
 				&configurationv1alpha1.KongPluginBinding{},
 			},
+			{
+				MapHTTPRouteForKongCustomEntity(cl),
+				&configurationv1alpha1.KongCustomEntity{},
+			},
 			{
 				MapHTTPRouteForReferenceGrant(cl),
 				&gwtypes.ReferenceGrant{},
@@ -26,7 +26,7 @@ func TestWatches(t *testing.T) {
 		{
 			name:    "HTTPRoute with ReferenceGrant enabled",
 			obj:     &gwtypes.HTTPRoute{},
-			wantLen: 15,
+			wantLen: 16,
 			wantType: []any{
 				&gwtypes.Gateway{},
 				&gwtypes.GatewayClass{},
@@ -38,6 +38,7 @@ func TestWatches(t *testing.T) {
 				&configurationv1alpha1.KongRoute{},
 				&configurationv1.KongPlugin{},
 				&configurationv1alpha1.KongPluginBinding{},
+				&configurationv1alpha1.KongCustomEntity{},
 				&gwtypes.ReferenceGrant{},
 				&configurationv1beta1.KongUpstreamPolicy{},
 				&corev1.Secret{},
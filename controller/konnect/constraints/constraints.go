@@ -19,7 +19,9 @@ type SupportedCredentialType interface {
 		configurationv1alpha1.KongCredentialAPIKey |
 		configurationv1alpha1.KongCredentialACL |
 		configurationv1alpha1.KongCredentialJWT |
-		configurationv1alpha1.KongCredentialHMAC
+		configurationv1alpha1.KongCredentialHMAC |
+		configurationv1alpha1.KongCredentialOAuth2 |
+		configurationv1alpha1.KongCredentialMTLS
 
 	GetTypeName() string
 }
@@ -50,6 +52,8 @@ type SupportedHandwrittenKonnectEntityType interface {
 		configurationv1alpha1.KongCredentialACL |
 		configurationv1alpha1.KongCredentialJWT |
 		configurationv1alpha1.KongCredentialHMAC |
+		configurationv1alpha1.KongCredentialOAuth2 |
+		configurationv1alpha1.KongCredentialMTLS |
 		configurationv1alpha1.KongUpstream |
 		configurationv1alpha1.KongCACertificate |
 		configurationv1alpha1.KongCertificate |
@@ -59,6 +63,7 @@ type SupportedHandwrittenKonnectEntityType interface {
 		configurationv1alpha1.KongKeySet |
 		configurationv1alpha1.KongSNI |
 		configurationv1alpha1.KongDataPlaneClientCertificate |
+		configurationv1alpha1.KongCustomEntity |
 		konnectv1alpha1.MCPServer
 }
 
@@ -20,6 +20,7 @@ type SupportedGeneratedKonnectEntityType interface {
 		konnectv1alpha1.AIGatewayModel |
 		konnectv1alpha1.AIGatewayModelProvider |
 		konnectv1alpha1.AIGatewayPolicy |
+		konnectv1alpha1.APISpecification |
 		configurationv1alpha1.EventGatewayBackendCluster |
 		configurationv1alpha1.EventGatewayDataPlaneCertificate |
 		configurationv1alpha1.EventGatewayListener |
@@ -30,8 +31,11 @@ type SupportedGeneratedKonnectEntityType interface {
 		configurationv1alpha1.EventGatewayVirtualClusterPolicy |
 		configurationv1alpha1.EventGatewayVirtualClusterProducePolicy |
 		konnectv1alpha1.KonnectAIGateway |
+		konnectv1alpha1.KonnectAPI |
 		konnectv1alpha1.KonnectConfigStore |
 		konnectv1alpha1.KonnectEventGateway |
+		konnectv1alpha1.KonnectSystemAccount |
+		konnectv1alpha1.KonnectTeam |
 		konnectv1alpha1.Portal |
 		konnectv1alpha1.PortalCustomDomain |
 		konnectv1alpha1.PortalCustomization |
@@ -108,6 +108,10 @@ func Create[
 		err = createKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		err = createKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		err = createKongCredentialOAuth2(ctx, sdk.GetOAuth2CredentialsSDK(), cl, ent)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		err = createKongCredentialMTLS(ctx, sdk.GetMTLSAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCACertificate:
 		err = createCACertificate(ctx, cl, sdk.GetCACertificatesSDK(), ent)
 	case *configurationv1alpha1.KongCertificate:
@@ -124,6 +128,8 @@ func Create[
 		err = createSNI(ctx, sdk.GetSNIsSDK(), ent)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
 		err = CreateKongDataPlaneClientCertificate(ctx, sdk.GetDataPlaneCertificatesSDK(), ent)
+	case *configurationv1alpha1.KongCustomEntity:
+		err = createCustomEntity(ctx, cl, sdk.GetCustomEntitiesSDK(), ent)
 	case *konnectv1alpha1.MCPServer:
 		// MCPServer is mirror-only, so we use Konnect as the source of truth for it.
 		err = ensureMCPServer(ctx, sdk.GetMCPServersSDK(), ent)
@@ -270,6 +276,10 @@ func getKonnectIDForUID[
 		return getKongCredentialHMACForUID(ctx, sdk.GetHMACCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialJWT:
 		return getKongCredentialJWTForUID(ctx, sdk.GetJWTCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		return getKongCredentialOAuth2ForUID(ctx, sdk.GetOAuth2CredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		return getKongCredentialMTLSForUID(ctx, sdk.GetMTLSAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialBasicAuth:
 		return getKongCredentialBasicAuthForUID(ctx, sdk.GetBasicAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialAPIKey:
@@ -280,6 +290,8 @@ func getKonnectIDForUID[
 		return getKongCertificateForUID(ctx, sdk.GetCertificatesSDK(), ent)
 	case *configurationv1alpha1.KongCACertificate:
 		return getKongCACertificateForUID(ctx, sdk.GetCACertificatesSDK(), ent)
+	case *configurationv1alpha1.KongCustomEntity:
+		return getKongCustomEntityForUID(ctx, sdk.GetCustomEntitiesSDK(), ent)
 	case *konnectv1alpha1.AIGatewayModel:
 		return getAIGatewayModelForUID(ctx, sdk.GetAIGatewayModelsSDK(), ent)
 	case *konnectv1alpha1.AIGatewayMCPServer:
@@ -376,6 +388,10 @@ func Delete[
 		err = deleteKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), e)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		err = deleteKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), e)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		err = deleteKongCredentialOAuth2(ctx, sdk.GetOAuth2CredentialsSDK(), e)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		err = deleteKongCredentialMTLS(ctx, sdk.GetMTLSAuthCredentialsSDK(), e)
 	case *configurationv1alpha1.KongCACertificate:
 		err = deleteCACertificate(ctx, sdk.GetCACertificatesSDK(), e)
 	case *configurationv1alpha1.KongCertificate:
@@ -392,6 +408,8 @@ func Delete[
 		err = deleteSNI(ctx, sdk.GetSNIsSDK(), e)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
 		err = DeleteKongDataPlaneClientCertificate(ctx, sdk.GetDataPlaneCertificatesSDK(), e)
+	case *configurationv1alpha1.KongCustomEntity:
+		err = deleteCustomEntity(ctx, sdk.GetCustomEntitiesSDK(), e)
 	case *konnectv1alpha1.MCPServer:
 		// MCPServer is mirror-only, so we use Konnect as the source of truth for it.
 		break
@@ -537,6 +555,10 @@ func Update[
 		err = updateKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		err = updateKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		err = updateKongCredentialOAuth2(ctx, sdk.GetOAuth2CredentialsSDK(), cl, ent)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		err = updateKongCredentialMTLS(ctx, sdk.GetMTLSAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCACertificate:
 		err = updateCACertificate(ctx, cl, sdk.GetCACertificatesSDK(), ent)
 	case *configurationv1alpha1.KongCertificate:
@@ -553,6 +575,8 @@ func Update[
 		err = updateSNI(ctx, sdk.GetSNIsSDK(), ent)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
 		err = nil // DataPlaneCertificates are immutable.
+	case *configurationv1alpha1.KongCustomEntity:
+		err = updateCustomEntity(ctx, cl, sdk.GetCustomEntitiesSDK(), ent)
 	case *konnectv1alpha1.AIGatewayConsumerCredential:
 		err = nil // AIGatewayConsumerCredentials are immutable.
 	case *konnectv1alpha1.MCPServer:
@@ -685,6 +709,10 @@ func Adopt[
 		err = adoptKongCredentialJWT(ctx, sdk.GetJWTCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		err = adoptKongCredentialHMAC(ctx, sdk.GetHMACCredentialsSDK(), ent)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		err = adoptKongCredentialOAuth2(ctx, sdk.GetOAuth2CredentialsSDK(), cl, ent)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		err = adoptKongCredentialMTLS(ctx, sdk.GetMTLSAuthCredentialsSDK(), ent)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
 		err = adoptKongDataPlaneCertificate(ctx, sdk.GetDataPlaneCertificatesSDK(), ent)
 	case *konnectv1alpha1.Portal:
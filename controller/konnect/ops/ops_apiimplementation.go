@@ -0,0 +1,67 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// CreateAPIImplementation links the Konnect API with the provided ID to the
+// Service with the provided control plane and Service IDs and returns the ID
+// of the created API implementation.
+func CreateAPIImplementation(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	apiID string,
+	controlPlaneID string,
+	serviceID string,
+) (string, error) {
+	resp, err := sdk.GetAPIImplementationSDK().CreateAPIImplementation(ctx, apiID, sdkkonnectcomp.APIImplementation{
+		Service: &sdkkonnectcomp.APIImplementationService{
+			ControlPlaneID: controlPlaneID,
+			ID:             serviceID,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create implementation of API %s by service %s: %w", apiID, serviceID, err)
+	}
+	if resp == nil || resp.APIImplementationResponse == nil || resp.APIImplementationResponse.ID == "" {
+		return "", fmt.Errorf("failed to create implementation of API %s: %w", apiID, ErrNilResponse)
+	}
+	return resp.APIImplementationResponse.ID, nil
+}
+
+// APIImplementationExists returns true if the API implementation with the
+// provided ID still exists for the Konnect API with the provided ID.
+// Implementations of APIs which don't exist anymore don't exist either.
+func APIImplementationExists(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	apiID string,
+	id string,
+) (bool, error) {
+	if _, err := sdk.GetAPIImplementationSDK().FetchAPIImplementation(ctx, apiID, id); err != nil {
+		if ErrIsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get implementation %s of API %s: %w", id, apiID, err)
+	}
+	return true, nil
+}
+
+// DeleteAPIImplementation deletes the API implementation with the provided ID.
+// Implementations which are already deleted are ignored.
+func DeleteAPIImplementation(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	apiID string,
+	id string,
+) error {
+	if _, err := sdk.GetAPIImplementationSDK().DeleteAPIImplementation(ctx, apiID, id); err != nil && !ErrIsNotFound(err) {
+		return fmt.Errorf("failed to delete implementation %s of API %s: %w", id, apiID, err)
+	}
+	return nil
+}
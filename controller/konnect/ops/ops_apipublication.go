@@ -0,0 +1,53 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// PublishAPI publishes the Konnect API with the provided ID to the Portal with
+// the provided ID using the settings of the APIPublication.
+// Publications are created or replaced, so publishing an already published API
+// applies the settings again.
+func PublishAPI(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	apiID string,
+	portalID string,
+	pub *konnectv1alpha1.APIPublication,
+) error {
+	_, err := sdk.GetAPIPublicationSDK().PublishAPIToPortal(ctx, sdkkonnectops.PublishAPIToPortalRequest{
+		APIID:    apiID,
+		PortalID: portalID,
+		APIPublication: sdkkonnectcomp.APIPublication{
+			AuthStrategyIds:          pub.Spec.AuthStrategyIDs,
+			AutoApproveRegistrations: pub.Spec.AutoApproveRegistrations,
+			Visibility:               new(sdkkonnectcomp.APIPublicationVisibility(pub.GetVisibility())),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish API %s to portal %s: %w", apiID, portalID, err)
+	}
+	return nil
+}
+
+// UnpublishAPI removes the publication of the Konnect API with the provided ID
+// from the Portal with the provided ID.
+// Publications which are already removed are ignored.
+func UnpublishAPI(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	apiID string,
+	portalID string,
+) error {
+	if _, err := sdk.GetAPIPublicationSDK().DeletePublication(ctx, apiID, portalID); err != nil && !ErrIsNotFound(err) {
+		return fmt.Errorf("failed to unpublish API %s from portal %s: %w", apiID, portalID, err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+)
+
+func createKongCredentialMTLS(
+	ctx context.Context,
+	sdk sdkkonnectgo.MTLSAuthCredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: cred, Op: CreateOp}
+	}
+
+	resp, err := sdk.CreateMtlsAuthWithConsumer(ctx,
+		sdkkonnectops.CreateMtlsAuthWithConsumerRequest{
+			ControlPlaneID:              cpID,
+			ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+			MTLSAuthWithoutParents:      kongCredentialMTLSToMTLSAuthWithoutParents(cred),
+		},
+	)
+	if errWrap := wrapErrIfKonnectOpFailed(err, CreateOp, cred); errWrap != nil {
+		return errWrap
+	}
+
+	if resp == nil || resp.MTLSAuth == nil || resp.MTLSAuth.ID == nil {
+		return fmt.Errorf("failed creating %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	cred.SetKonnectID(*resp.MTLSAuth.ID)
+
+	return nil
+}
+
+// updateKongCredentialMTLS updates the Konnect mTLS auth entity.
+// It is assumed that the provided mTLS credential has Konnect ID set in status.
+// It returns an error if the mTLS credential does not have a ControlPlaneRef or
+// if the operation fails.
+func updateKongCredentialMTLS(
+	ctx context.Context,
+	sdk sdkkonnectgo.MTLSAuthCredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: cred, Op: UpdateOp}
+	}
+
+	_, err := sdk.UpsertMtlsAuthWithConsumer(ctx,
+		sdkkonnectops.UpsertMtlsAuthWithConsumerRequest{
+			ControlPlaneID:              cpID,
+			ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+			MTLSAuthID:                  cred.GetKonnectStatus().GetKonnectID(),
+			MTLSAuthWithoutParents:      kongCredentialMTLSToMTLSAuthWithoutParents(cred),
+		})
+	if errWrap := wrapErrIfKonnectOpFailed(err, UpdateOp, cred); errWrap != nil {
+		return errWrap
+	}
+
+	return nil
+}
+
+// deleteKongCredentialMTLS deletes an mTLS credential in Konnect.
+// It is assumed that the provided mTLS credential has Konnect ID set in status.
+// It returns an error if the operation fails.
+func deleteKongCredentialMTLS(
+	ctx context.Context,
+	sdk sdkkonnectgo.MTLSAuthCredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) error {
+	cpID := cred.GetControlPlaneID()
+	id := cred.GetKonnectStatus().GetKonnectID()
+	_, err := sdk.DeleteMtlsAuthWithConsumer(ctx,
+		sdkkonnectops.DeleteMtlsAuthWithConsumerRequest{
+			ControlPlaneID:              cpID,
+			ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+			MTLSAuthID:                  id,
+		})
+	if errWrap := wrapErrIfKonnectOpFailed(err, DeleteOp, cred); errWrap != nil {
+		return handleDeleteError(ctx, err, cred)
+	}
+
+	return nil
+}
+
+func adoptKongCredentialMTLS(
+	ctx context.Context,
+	sdk sdkkonnectgo.MTLSAuthCredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return KonnectEntityAdoptionMissingControlPlaneIDError{}
+	}
+	if cred.Status.Konnect == nil || cred.Status.Konnect.GetConsumerID() == "" {
+		return fmt.Errorf("can't adopt %T %s without a Konnect Consumer ID", cred, client.ObjectKeyFromObject(cred))
+	}
+	if cred.Spec.Adopt == nil || cred.Spec.Adopt.Konnect == nil {
+		return fmt.Errorf("missing Konnect adoption options for %T %s", cred, client.ObjectKeyFromObject(cred))
+	}
+
+	adoptOptions := cred.Spec.Adopt
+	konnectID := adoptOptions.Konnect.ID
+
+	resp, err := sdk.GetMtlsAuthWithConsumer(ctx, sdkkonnectops.GetMtlsAuthWithConsumerRequest{
+		ControlPlaneID:              cpID,
+		ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+		MTLSAuthID:                  konnectID,
+	})
+	if err != nil {
+		return KonnectEntityAdoptionFetchError{
+			KonnectID: konnectID,
+			Err:       err,
+		}
+	}
+	if resp == nil || resp.MTLSAuth == nil {
+		return fmt.Errorf("failed to adopt %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	uidTag, hasUIDTag := findUIDTag(resp.MTLSAuth.Tags)
+	if hasUIDTag && extractUIDFromTag(uidTag) != string(cred.UID) {
+		return KonnectEntityAdoptionUIDTagConflictError{
+			KonnectID:    konnectID,
+			ActualUIDTag: extractUIDFromTag(uidTag),
+		}
+	}
+
+	adoptMode := adoptOptions.Mode
+	if adoptMode == "" {
+		adoptMode = commonv1alpha1.AdoptModeOverride
+	}
+
+	switch adoptMode {
+	case commonv1alpha1.AdoptModeOverride:
+		credCopy := cred.DeepCopy()
+		credCopy.SetKonnectID(konnectID)
+		if err = updateKongCredentialMTLS(ctx, sdk, credCopy); err != nil {
+			return err
+		}
+	case commonv1alpha1.AdoptModeMatch:
+		if !credentialMTLSMatch(resp.MTLSAuth, cred) {
+			return KonnectEntityAdoptionNotMatchError{
+				KonnectID: konnectID,
+			}
+		}
+	default:
+		return fmt.Errorf("failed to adopt: adopt mode %q not supported", adoptMode)
+	}
+
+	cred.SetKonnectID(konnectID)
+	return nil
+}
+
+func kongCredentialMTLSToMTLSAuthWithoutParents(
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) *sdkkonnectcomp.MTLSAuthWithoutParents {
+	return &sdkkonnectcomp.MTLSAuthWithoutParents{
+		SubjectName: cred.Spec.SubjectName,
+		Tags:        GenerateTagsForObject(cred, cred.Spec.Tags...),
+	}
+}
+
+// getKongCredentialMTLSForUID lists mTLS credentials in Konnect with given k8s uid as its tag.
+func getKongCredentialMTLSForUID(
+	ctx context.Context,
+	sdk sdkkonnectgo.MTLSAuthCredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) (string, error) {
+	cpID := cred.GetControlPlaneID()
+
+	req := sdkkonnectops.ListMtlsAuthRequest{
+		// NOTE: only filter on object's UID.
+		// Other fields like subject name might have changed in the meantime but that's OK.
+		// Those will be enforced via subsequent updates.
+		ControlPlaneID: cpID,
+		Tags:           new(UIDLabelForObject(cred)),
+	}
+
+	resp, err := sdk.ListMtlsAuth(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed listing %s: %w", cred.GetTypeName(), err)
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	return getMatchingEntryFromListResponseData(sliceToEntityWithIDPtrSlice(resp.Object.Data), cred)
+}
+
+func credentialMTLSMatch(
+	konnectMTLS *sdkkonnectcomp.MTLSAuth,
+	cred *configurationv1alpha1.KongCredentialMTLS,
+) bool {
+	if konnectMTLS == nil {
+		return false
+	}
+
+	return konnectMTLS.SubjectName == cred.Spec.SubjectName
+}
@@ -0,0 +1,303 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+)
+
+func createKongCredentialOAuth2(
+	ctx context.Context,
+	sdk sdkkonnectgo.OAuth2CredentialsSDK,
+	cl client.Client,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: cred, Op: CreateOp}
+	}
+
+	body, err := kongCredentialOAuth2ToOAuth2CredentialWithoutParents(ctx, cl, cred)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sdk.CreateOauth2CredentialWithConsumer(ctx,
+		sdkkonnectops.CreateOauth2CredentialWithConsumerRequest{
+			ControlPlaneID:                 cpID,
+			ConsumerIDForNestedEntities:    cred.Status.Konnect.GetConsumerID(),
+			OAuth2CredentialWithoutParents: body,
+		},
+	)
+	if errWrap := wrapErrIfKonnectOpFailed(err, CreateOp, cred); errWrap != nil {
+		return errWrap
+	}
+
+	if resp == nil || resp.OAuth2Credential == nil || resp.OAuth2Credential.ID == nil {
+		return fmt.Errorf("failed creating %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	cred.SetKonnectID(*resp.OAuth2Credential.ID)
+
+	return nil
+}
+
+// updateKongCredentialOAuth2 updates the Konnect OAuth2 credential entity.
+// It is assumed that the provided OAuth2 credential has Konnect ID set in status.
+// It returns an error if the OAuth2 credential does not have a ControlPlaneRef or
+// if the operation fails.
+func updateKongCredentialOAuth2(
+	ctx context.Context,
+	sdk sdkkonnectgo.OAuth2CredentialsSDK,
+	cl client.Client,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: cred, Op: UpdateOp}
+	}
+
+	body, err := kongCredentialOAuth2ToOAuth2CredentialWithoutParents(ctx, cl, cred)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.UpsertOauth2CredentialWithConsumer(ctx,
+		sdkkonnectops.UpsertOauth2CredentialWithConsumerRequest{
+			ControlPlaneID:                 cpID,
+			ConsumerIDForNestedEntities:    cred.Status.Konnect.GetConsumerID(),
+			OAuth2CredentialID:             cred.GetKonnectStatus().GetKonnectID(),
+			OAuth2CredentialWithoutParents: body,
+		})
+	if errWrap := wrapErrIfKonnectOpFailed(err, UpdateOp, cred); errWrap != nil {
+		return errWrap
+	}
+
+	return nil
+}
+
+// deleteKongCredentialOAuth2 deletes an OAuth2 credential in Konnect.
+// It is assumed that the provided OAuth2 credential has Konnect ID set in status.
+// It returns an error if the operation fails.
+func deleteKongCredentialOAuth2(
+	ctx context.Context,
+	sdk sdkkonnectgo.OAuth2CredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) error {
+	cpID := cred.GetControlPlaneID()
+	id := cred.GetKonnectStatus().GetKonnectID()
+	_, err := sdk.DeleteOauth2CredentialWithConsumer(ctx,
+		sdkkonnectops.DeleteOauth2CredentialWithConsumerRequest{
+			ControlPlaneID:              cpID,
+			ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+			OAuth2CredentialID:          id,
+		})
+	if errWrap := wrapErrIfKonnectOpFailed(err, DeleteOp, cred); errWrap != nil {
+		return handleDeleteError(ctx, err, cred)
+	}
+
+	return nil
+}
+
+func adoptKongCredentialOAuth2(
+	ctx context.Context,
+	sdk sdkkonnectgo.OAuth2CredentialsSDK,
+	cl client.Client,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) error {
+	cpID := cred.GetControlPlaneID()
+	if cpID == "" {
+		return KonnectEntityAdoptionMissingControlPlaneIDError{}
+	}
+	if cred.Status.Konnect == nil || cred.Status.Konnect.GetConsumerID() == "" {
+		return fmt.Errorf("can't adopt %T %s without a Konnect Consumer ID", cred, client.ObjectKeyFromObject(cred))
+	}
+	if cred.Spec.Adopt == nil || cred.Spec.Adopt.Konnect == nil {
+		return fmt.Errorf("missing Konnect adoption options for %T %s", cred, client.ObjectKeyFromObject(cred))
+	}
+
+	adoptOptions := cred.Spec.Adopt
+	konnectID := adoptOptions.Konnect.ID
+
+	resp, err := sdk.GetOauth2CredentialWithConsumer(ctx, sdkkonnectops.GetOauth2CredentialWithConsumerRequest{
+		ControlPlaneID:              cpID,
+		ConsumerIDForNestedEntities: cred.Status.Konnect.GetConsumerID(),
+		OAuth2CredentialID:          konnectID,
+	})
+	if err != nil {
+		return KonnectEntityAdoptionFetchError{
+			KonnectID: konnectID,
+			Err:       err,
+		}
+	}
+	if resp == nil || resp.OAuth2Credential == nil {
+		return fmt.Errorf("failed to adopt %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	uidTag, hasUIDTag := findUIDTag(resp.OAuth2Credential.Tags)
+	if hasUIDTag && extractUIDFromTag(uidTag) != string(cred.UID) {
+		return KonnectEntityAdoptionUIDTagConflictError{
+			KonnectID:    konnectID,
+			ActualUIDTag: extractUIDFromTag(uidTag),
+		}
+	}
+
+	adoptMode := adoptOptions.Mode
+	if adoptMode == "" {
+		adoptMode = commonv1alpha1.AdoptModeOverride
+	}
+
+	switch adoptMode {
+	case commonv1alpha1.AdoptModeOverride:
+		credCopy := cred.DeepCopy()
+		credCopy.SetKonnectID(konnectID)
+		if err = updateKongCredentialOAuth2(ctx, sdk, cl, credCopy); err != nil {
+			return err
+		}
+	case commonv1alpha1.AdoptModeMatch:
+		if !credentialOAuth2Match(resp.OAuth2Credential, cred) {
+			return KonnectEntityAdoptionNotMatchError{
+				KonnectID: konnectID,
+			}
+		}
+	default:
+		return fmt.Errorf("failed to adopt: adopt mode %q not supported", adoptMode)
+	}
+
+	cred.SetKonnectID(konnectID)
+	return nil
+}
+
+// kongCredentialOAuth2ToOAuth2CredentialWithoutParents converts the OAuth2 credential
+// to the SDK request body, resolving the client secret from the referenced Secret
+// when it is not provided inline.
+func kongCredentialOAuth2ToOAuth2CredentialWithoutParents(
+	ctx context.Context,
+	cl client.Client,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) (*sdkkonnectcomp.OAuth2CredentialWithoutParents, error) {
+	clientSecret, err := resolveKongCredentialOAuth2ClientSecret(ctx, cl, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &sdkkonnectcomp.OAuth2CredentialWithoutParents{
+		Name:         cred.Spec.Name,
+		ClientID:     new(cred.Spec.ClientID),
+		ClientSecret: clientSecret,
+		HashSecret:   cred.Spec.HashSecret,
+		RedirectUris: cred.Spec.RedirectURIs,
+		Tags:         GenerateTagsForObject(cred, cred.Spec.Tags...),
+	}
+	if cred.Spec.ClientType != "" {
+		ret.ClientType = new(sdkkonnectcomp.OAuth2CredentialWithoutParentsClientType(cred.Spec.ClientType))
+	}
+	return ret, nil
+}
+
+// resolveKongCredentialOAuth2ClientSecret returns the client secret of the OAuth2
+// credential, reading it from the referenced Secret when needed.
+// The client secret is always sent so that Kong doesn't generate a new one on updates.
+func resolveKongCredentialOAuth2ClientSecret(
+	ctx context.Context,
+	cl client.Client,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) (*string, error) {
+	src := cred.Spec.ClientSecret
+	if src == nil {
+		return nil, fmt.Errorf("spec.client_secret is required")
+	}
+	if src.Type != configurationv1alpha1.SensitiveDataSourceTypeSecretRef {
+		return src.Value, nil
+	}
+	if src.SecretRef == nil {
+		return nil, fmt.Errorf("secretRef is nil for spec.client_secret")
+	}
+
+	namespace := cred.GetNamespace()
+	if src.SecretRef.Namespace != nil && *src.SecretRef.Namespace != "" {
+		namespace = *src.SecretRef.Namespace
+	}
+	var secret corev1.Secret
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: src.SecretRef.Name}, &secret); err != nil {
+		return nil, fmt.Errorf("failed to fetch Secret %s/%s: %w", namespace, src.SecretRef.Name, err)
+	}
+	secretBytes, ok := secret.Data[src.SecretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s is missing key %q", namespace, src.SecretRef.Name, src.SecretRef.Key)
+	}
+	return new(string(secretBytes)), nil
+}
+
+// getKongCredentialOAuth2ForUID lists OAuth2 credentials in Konnect with given k8s uid as its tag.
+func getKongCredentialOAuth2ForUID(
+	ctx context.Context,
+	sdk sdkkonnectgo.OAuth2CredentialsSDK,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) (string, error) {
+	cpID := cred.GetControlPlaneID()
+
+	req := sdkkonnectops.ListOauth2CredentialRequest{
+		// NOTE: only filter on object's UID.
+		// Other fields like name might have changed in the meantime but that's OK.
+		// Those will be enforced via subsequent updates.
+		ControlPlaneID: cpID,
+		Tags:           new(UIDLabelForObject(cred)),
+	}
+
+	resp, err := sdk.ListOauth2Credential(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed listing %s: %w", cred.GetTypeName(), err)
+	}
+	if resp == nil || resp.Object == nil {
+		return "", fmt.Errorf("failed listing %s: %w", cred.GetTypeName(), ErrNilResponse)
+	}
+
+	return getMatchingEntryFromListResponseData(sliceToEntityWithIDPtrSlice(resp.Object.Data), cred)
+}
+
+// credentialOAuth2Match reports whether the OAuth2 credential in Konnect matches
+// the spec. The client secret is not compared as Konnect may store it hashed.
+func credentialOAuth2Match(
+	konnectOAuth2 *sdkkonnectcomp.OAuth2Credential,
+	cred *configurationv1alpha1.KongCredentialOAuth2,
+) bool {
+	if konnectOAuth2 == nil {
+		return false
+	}
+
+	if konnectOAuth2.Name != cred.Spec.Name {
+		return false
+	}
+
+	if konnectOAuth2.ClientID == nil || *konnectOAuth2.ClientID != cred.Spec.ClientID {
+		return false
+	}
+
+	if cred.Spec.ClientType != "" {
+		if konnectOAuth2.ClientType == nil || string(*konnectOAuth2.ClientType) != string(cred.Spec.ClientType) {
+			return false
+		}
+	}
+
+	if len(cred.Spec.RedirectURIs) > 0 {
+		if len(konnectOAuth2.RedirectUris) != len(cred.Spec.RedirectURIs) {
+			return false
+		}
+		for i, uri := range cred.Spec.RedirectURIs {
+			if konnectOAuth2.RedirectUris[i] != uri {
+				return false
+			}
+		}
+	}
+
+	return true
+}
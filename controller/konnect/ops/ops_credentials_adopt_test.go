@@ -307,3 +307,164 @@ func TestAdoptKongCredentialJWT(t *testing.T) {
 		sdk.AssertNotCalled(t, "UpsertJwtWithConsumer", mock.Anything, mock.Anything)
 	})
 }
+
+func TestAdoptKongCredentialOAuth2(t *testing.T) {
+	ctx := t.Context()
+
+	clientID := "client-id"
+
+	newCredential := func(mode commonv1alpha1.AdoptMode) *configurationv1alpha1.KongCredentialOAuth2 {
+		return &configurationv1alpha1.KongCredentialOAuth2{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "oauth2",
+				Namespace: "default",
+				UID:       types.UID("uid-6"),
+			},
+			Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+				ConsumerRef: corev1.LocalObjectReference{Name: "consumer"},
+				Adopt: &commonv1alpha1.AdoptOptions{
+					From:    commonv1alpha1.AdoptSourceKonnect,
+					Mode:    mode,
+					Konnect: &commonv1alpha1.AdoptKonnectOptions{ID: "oauth2-1"},
+				},
+				KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+					Name:     "app",
+					ClientID: clientID,
+					ClientSecret: &configurationv1alpha1.SensitiveDataSource{
+						Type:  configurationv1alpha1.SensitiveDataSourceTypeInline,
+						Value: new("client-secret"),
+					},
+					ClientType:   configurationv1alpha1.KongCredentialOAuth2ClientTypeConfidential,
+					RedirectURIs: []string{"https://example.com/callback"},
+				},
+			},
+			Status: configurationv1alpha1.KongCredentialOAuth2Status{
+				Konnect: newCredentialStatus(),
+			},
+		}
+	}
+
+	t.Run("match success", func(t *testing.T) {
+		sdk := mocks.NewMockOAuth2CredentialsSDK(t)
+		clientType := sdkkonnectcomp.OAuth2CredentialClientTypeConfidential
+		sdk.EXPECT().GetOauth2CredentialWithConsumer(mock.Anything, mock.Anything).Return(&sdkkonnectops.GetOauth2CredentialWithConsumerResponse{
+			OAuth2Credential: &sdkkonnectcomp.OAuth2Credential{
+				ID:           new("oauth2-1"),
+				Name:         "app",
+				ClientID:     new(clientID),
+				ClientSecret: new("hashed-secret"),
+				ClientType:   clientType.ToPointer(),
+				RedirectUris: []string{"https://example.com/callback"},
+				Tags:         []string{"k8s-uid:uid-6"},
+			},
+		}, nil)
+
+		cred := newCredential(commonv1alpha1.AdoptModeMatch)
+		err := adoptKongCredentialOAuth2(ctx, sdk, nil, cred)
+		require.NoError(t, err)
+		assert.Equal(t, "oauth2-1", cred.GetKonnectID())
+		sdk.AssertNotCalled(t, "UpsertOauth2CredentialWithConsumer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("override sends the client ID and secret", func(t *testing.T) {
+		sdk := mocks.NewMockOAuth2CredentialsSDK(t)
+		sdk.EXPECT().GetOauth2CredentialWithConsumer(mock.Anything, mock.Anything).Return(&sdkkonnectops.GetOauth2CredentialWithConsumerResponse{
+			OAuth2Credential: &sdkkonnectcomp.OAuth2Credential{
+				ID:           new("oauth2-1"),
+				Name:         "app",
+				ClientID:     new("generated-client-id"),
+				ClientSecret: new("generated-client-secret"),
+			},
+		}, nil)
+		sdk.EXPECT().UpsertOauth2CredentialWithConsumer(mock.Anything, mock.MatchedBy(
+			func(req sdkkonnectops.UpsertOauth2CredentialWithConsumerRequest) bool {
+				body := req.OAuth2CredentialWithoutParents
+				return req.OAuth2CredentialID == "oauth2-1" && body != nil &&
+					body.ClientID != nil && *body.ClientID == clientID &&
+					body.ClientSecret != nil && *body.ClientSecret == "client-secret"
+			},
+		)).Return(&sdkkonnectops.UpsertOauth2CredentialWithConsumerResponse{}, nil)
+
+		cred := newCredential(commonv1alpha1.AdoptModeOverride)
+		err := adoptKongCredentialOAuth2(ctx, sdk, nil, cred)
+		require.NoError(t, err)
+		assert.Equal(t, "oauth2-1", cred.GetKonnectID())
+	})
+
+	t.Run("match failure on redirect URIs", func(t *testing.T) {
+		sdk := mocks.NewMockOAuth2CredentialsSDK(t)
+		sdk.EXPECT().GetOauth2CredentialWithConsumer(mock.Anything, mock.Anything).Return(&sdkkonnectops.GetOauth2CredentialWithConsumerResponse{
+			OAuth2Credential: &sdkkonnectcomp.OAuth2Credential{
+				ID:           new("oauth2-1"),
+				Name:         "app",
+				ClientID:     new(clientID),
+				RedirectUris: []string{"https://other.example.com/callback"},
+			},
+		}, nil)
+
+		cred := newCredential(commonv1alpha1.AdoptModeMatch)
+		err := adoptKongCredentialOAuth2(ctx, sdk, nil, cred)
+		var notMatchErr KonnectEntityAdoptionNotMatchError
+		require.ErrorAs(t, err, &notMatchErr)
+	})
+}
+
+func TestAdoptKongCredentialMTLS(t *testing.T) {
+	ctx := t.Context()
+
+	newCredential := func(mode commonv1alpha1.AdoptMode) *configurationv1alpha1.KongCredentialMTLS {
+		return &configurationv1alpha1.KongCredentialMTLS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mtls",
+				Namespace: "default",
+				UID:       types.UID("uid-7"),
+			},
+			Spec: configurationv1alpha1.KongCredentialMTLSSpec{
+				ConsumerRef: corev1.LocalObjectReference{Name: "consumer"},
+				Adopt: &commonv1alpha1.AdoptOptions{
+					From:    commonv1alpha1.AdoptSourceKonnect,
+					Mode:    mode,
+					Konnect: &commonv1alpha1.AdoptKonnectOptions{ID: "mtls-1"},
+				},
+				KongCredentialMTLSAPISpec: configurationv1alpha1.KongCredentialMTLSAPISpec{
+					SubjectName: "client.example.com",
+				},
+			},
+			Status: configurationv1alpha1.KongCredentialMTLSStatus{
+				Konnect: newCredentialStatus(),
+			},
+		}
+	}
+
+	t.Run("match success", func(t *testing.T) {
+		sdk := mocks.NewMockMTLSAuthCredentialsSDK(t)
+		sdk.EXPECT().GetMtlsAuthWithConsumer(mock.Anything, mock.Anything).Return(&sdkkonnectops.GetMtlsAuthWithConsumerResponse{
+			MTLSAuth: &sdkkonnectcomp.MTLSAuth{
+				ID:          new("mtls-1"),
+				SubjectName: "client.example.com",
+				Tags:        []string{"k8s-uid:uid-7"},
+			},
+		}, nil)
+
+		cred := newCredential(commonv1alpha1.AdoptModeMatch)
+		err := adoptKongCredentialMTLS(ctx, sdk, cred)
+		require.NoError(t, err)
+		assert.Equal(t, "mtls-1", cred.GetKonnectID())
+	})
+
+	t.Run("uid tag conflict", func(t *testing.T) {
+		sdk := mocks.NewMockMTLSAuthCredentialsSDK(t)
+		sdk.EXPECT().GetMtlsAuthWithConsumer(mock.Anything, mock.Anything).Return(&sdkkonnectops.GetMtlsAuthWithConsumerResponse{
+			MTLSAuth: &sdkkonnectcomp.MTLSAuth{
+				ID:          new("mtls-1"),
+				SubjectName: "client.example.com",
+				Tags:        []string{"k8s-uid:other"},
+			},
+		}, nil)
+
+		cred := newCredential(commonv1alpha1.AdoptModeOverride)
+		err := adoptKongCredentialMTLS(ctx, sdk, cred)
+		var uidConflict KonnectEntityAdoptionUIDTagConflictError
+		require.ErrorAs(t, err, &uidConflict)
+	})
+}
@@ -0,0 +1,152 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// createCustomEntity creates a KongCustomEntity in Konnect.
+// It sets the KonnectID in the KongCustomEntity status.
+func createCustomEntity(
+	ctx context.Context,
+	cl client.Client,
+	sdk sdkops.CustomEntitiesSDK,
+	entity *configurationv1alpha1.KongCustomEntity,
+) error {
+	cpID := entity.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: entity, Op: CreateOp}
+	}
+
+	input, err := kongCustomEntityToCustomEntityInput(ctx, cl, entity)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sdk.CreateCustomEntity(ctx, cpID, entity.Spec.EntityType, input)
+	if errWrap := wrapErrIfKonnectOpFailed(err, CreateOp, entity); errWrap != nil {
+		return errWrap
+	}
+
+	if resp.GetID() == "" {
+		return fmt.Errorf("failed creating %s: %w", entity.GetTypeName(), ErrNilResponse)
+	}
+
+	entity.SetKonnectID(resp.GetID())
+
+	return nil
+}
+
+// updateCustomEntity updates a KongCustomEntity in Konnect.
+// The KongCustomEntity must have a KonnectID set in its status.
+// It returns an error if the KongCustomEntity does not have a KonnectID.
+func updateCustomEntity(
+	ctx context.Context,
+	cl client.Client,
+	sdk sdkops.CustomEntitiesSDK,
+	entity *configurationv1alpha1.KongCustomEntity,
+) error {
+	cpID := entity.GetControlPlaneID()
+	if cpID == "" {
+		return CantPerformOperationWithoutControlPlaneIDError{Entity: entity, Op: UpdateOp}
+	}
+
+	input, err := kongCustomEntityToCustomEntityInput(ctx, cl, entity)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.UpsertCustomEntity(ctx, cpID, entity.Spec.EntityType, entity.GetKonnectStatus().GetKonnectID(), input)
+	if errWrap := wrapErrIfKonnectOpFailed(err, UpdateOp, entity); errWrap != nil {
+		return errWrap
+	}
+
+	return nil
+}
+
+// deleteCustomEntity deletes a KongCustomEntity in Konnect.
+// The KongCustomEntity must have a KonnectID set in its status.
+// It returns an error if the operation fails.
+func deleteCustomEntity(
+	ctx context.Context,
+	sdk sdkops.CustomEntitiesSDK,
+	entity *configurationv1alpha1.KongCustomEntity,
+) error {
+	id := entity.GetKonnectStatus().GetKonnectID()
+	err := sdk.DeleteCustomEntity(ctx, entity.GetControlPlaneID(), entity.Spec.EntityType, id)
+	if errWrap := wrapErrIfKonnectOpFailed(err, DeleteOp, entity); errWrap != nil {
+		return handleDeleteError(ctx, err, entity)
+	}
+
+	return nil
+}
+
+func getKongCustomEntityForUID(
+	ctx context.Context,
+	sdk sdkops.CustomEntitiesSDK,
+	entity *configurationv1alpha1.KongCustomEntity,
+) (string, error) {
+	resp, err := sdk.ListCustomEntities(ctx, entity.GetControlPlaneID(), entity.Spec.EntityType, UIDLabelForObject(entity))
+	if err != nil {
+		return "", fmt.Errorf("failed to list KongCustomEntities: %w", err)
+	}
+
+	return getMatchingEntryFromListResponseData(resp, entity)
+}
+
+// kongCustomEntityToCustomEntityInput converts the KongCustomEntity to the payload sent to Konnect.
+// The foreign fields referencing the parent KongService and KongRoute are set to their Konnect IDs.
+func kongCustomEntityToCustomEntityInput(
+	ctx context.Context,
+	cl client.Client,
+	entity *configurationv1alpha1.KongCustomEntity,
+) (sdkops.CustomEntity, error) {
+	input := sdkops.CustomEntity{}
+	if len(entity.Spec.Fields.Raw) > 0 {
+		if err := json.Unmarshal(entity.Spec.Fields.Raw, &input); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal fields of %s: %w", entity.GetTypeName(), err)
+		}
+	}
+
+	if ref := entity.Spec.ServiceRef; ref != nil {
+		kongService := configurationv1alpha1.KongService{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: entity.GetNamespace(), Name: ref.Name}, &kongService); err != nil {
+			return nil, fmt.Errorf("failed to get KongService %s referenced by %s: %w", ref.Name, entity.GetTypeName(), err)
+		}
+		id := kongService.GetKonnectID()
+		if id == "" {
+			return nil, fmt.Errorf("KongService %s is not configured in Konnect yet", ref.Name)
+		}
+		input["service"] = map[string]string{"id": id}
+	}
+
+	if ref := entity.Spec.RouteRef; ref != nil {
+		kongRoute := configurationv1alpha1.KongRoute{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: entity.GetNamespace(), Name: ref.Name}, &kongRoute); err != nil {
+			return nil, fmt.Errorf("failed to get KongRoute %s referenced by %s: %w", ref.Name, entity.GetTypeName(), err)
+		}
+		id := kongRoute.GetKonnectID()
+		if id == "" {
+			return nil, fmt.Errorf("KongRoute %s is not configured in Konnect yet", ref.Name)
+		}
+		input["route"] = map[string]string{"id": id}
+	}
+
+	var tags []string
+	if userTags, ok := input["tags"].([]any); ok {
+		for _, t := range userTags {
+			if s, ok := t.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+	input["tags"] = GenerateTagsForObject(entity, tags...)
+
+	return input, nil
+}
@@ -0,0 +1,120 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/mocks/sdkmocks"
+)
+
+func TestKongCustomEntityOps(t *testing.T) {
+	const cpID = "cp-id"
+
+	newEntity := func(serviceRef, routeRef *configurationv1alpha1.TargetRef) *configurationv1alpha1.KongCustomEntity {
+		return &configurationv1alpha1.KongCustomEntity{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "KongCustomEntity",
+				APIVersion: "configuration.konghq.com/v1alpha1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "degraphql-route",
+				Namespace:  "default",
+				Generation: 1,
+				UID:        k8stypes.UID("entity-uid"),
+			},
+			Spec: configurationv1alpha1.KongCustomEntitySpec{
+				EntityType: "degraphql_routes",
+				Fields: apiextensionsv1.JSON{
+					Raw: []byte(`{"uri":"/contacts","query":"query{ contacts { name } }","tags":["user-tag"]}`),
+				},
+				ControlPlaneRef: &commonv1alpha1.ControlPlaneRef{
+					Type: commonv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+					KonnectNamespacedRef: &commonv1alpha1.KonnectNamespacedRef{
+						Name: "cp",
+					},
+				},
+				ServiceRef: serviceRef,
+				RouteRef:   routeRef,
+			},
+			Status: configurationv1alpha1.KongCustomEntityStatus{
+				Konnect: &konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef{
+					ControlPlaneID: cpID,
+				},
+			},
+		}
+	}
+	kongService := &configurationv1alpha1.KongService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc",
+			Namespace: "default",
+		},
+		Status: configurationv1alpha1.KongServiceStatus{
+			Konnect: &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndCertificateAndCACertificatesRefs{
+				KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "svc-id"},
+			},
+		},
+	}
+	kongRoute := &configurationv1alpha1.KongRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+
+	t.Run("create, update and delete with a KongService parent", func(t *testing.T) {
+		cl := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme.Get()).WithObjects(kongService).Build()
+		sdk := sdkmocks.NewFakeCustomEntitiesSDK()
+		entity := newEntity(&configurationv1alpha1.TargetRef{Name: "svc"}, nil)
+
+		require.NoError(t, createCustomEntity(t.Context(), cl, sdk, entity))
+		id := entity.GetKonnectID()
+		require.NotEmpty(t, id)
+
+		stored := sdk.Entities[cpID]["degraphql_routes"][id]
+		assert.Equal(t, "/contacts", stored["uri"])
+		assert.Equal(t, map[string]string{"id": "svc-id"}, stored["service"])
+		assert.NotContains(t, stored, "route")
+		assert.Contains(t, stored["tags"], "user-tag")
+		assert.Contains(t, stored["tags"], "k8s-uid:entity-uid")
+
+		foundID, err := getKongCustomEntityForUID(t.Context(), sdk, entity)
+		require.NoError(t, err)
+		assert.Equal(t, id, foundID)
+
+		entity.Spec.Fields.Raw = []byte(`{"uri":"/people","query":"query{ people { name } }"}`)
+		require.NoError(t, updateCustomEntity(t.Context(), cl, sdk, entity))
+		assert.Equal(t, "/people", sdk.Entities[cpID]["degraphql_routes"][id]["uri"])
+
+		require.NoError(t, deleteCustomEntity(t.Context(), sdk, entity))
+		assert.Empty(t, sdk.Entities[cpID]["degraphql_routes"])
+	})
+
+	t.Run("create fails when the KongRoute parent is not configured in Konnect yet", func(t *testing.T) {
+		cl := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme.Get()).WithObjects(kongRoute).Build()
+		sdk := sdkmocks.NewFakeCustomEntitiesSDK()
+		entity := newEntity(nil, &configurationv1alpha1.TargetRef{Name: "route"})
+
+		err := createCustomEntity(t.Context(), cl, sdk, entity)
+		require.ErrorContains(t, err, "KongRoute route is not configured in Konnect yet")
+		assert.Empty(t, entity.GetKonnectID())
+	})
+
+	t.Run("create fails without a ControlPlane ID", func(t *testing.T) {
+		cl := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme.Get()).Build()
+		entity := newEntity(nil, nil)
+		entity.Status.Konnect = nil
+
+		err := createCustomEntity(t.Context(), cl, sdkmocks.NewFakeCustomEntitiesSDK(), entity)
+		require.ErrorAs(t, err, &CantPerformOperationWithoutControlPlaneIDError{})
+	})
+}
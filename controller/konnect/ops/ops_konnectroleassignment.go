@@ -0,0 +1,140 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/konnect/server"
+)
+
+// AssignControlPlaneRole assigns the provided control plane role to the team or
+// system account with the provided Konnect ID and returns the ID of the assigned role.
+func AssignControlPlaneRole(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	subjectKind konnectv1alpha1.KonnectRoleAssignmentSubjectKind,
+	subjectID string,
+	cpID string,
+	role konnectv1alpha1.KonnectControlPlaneRole,
+) (string, error) {
+	req := &sdkkonnectcomp.AssignRole{
+		RoleName:       new(sdkkonnectcomp.RoleName(role)),
+		EntityID:       new(cpID),
+		EntityTypeName: new(sdkkonnectcomp.EntityTypeNameControlPlanes),
+		EntityRegion:   new(entityRegionForServer(sdk.GetServer())),
+	}
+
+	var assigned *sdkkonnectcomp.AssignedRole
+	switch subjectKind {
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam:
+		resp, err := sdk.GetTeamRolesSDK().TeamsAssignRole(ctx, subjectID, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to assign role %q to team %s: %w", role, subjectID, err)
+		}
+		if resp != nil {
+			assigned = resp.AssignedRole
+		}
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindSystemAccount:
+		resp, err := sdk.GetSystemAccountsRolesSDK().PostSystemAccountsAccountIDAssignedRoles(ctx, subjectID, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to assign role %q to system account %s: %w", role, subjectID, err)
+		}
+		if resp != nil {
+			assigned = resp.AssignedRole
+		}
+	default:
+		return "", fmt.Errorf("unsupported role assignment subject kind %q", subjectKind)
+	}
+
+	if assigned == nil || assigned.GetID() == nil || *assigned.GetID() == "" {
+		return "", fmt.Errorf("failed to assign role %q: %w", role, ErrNilResponse)
+	}
+	return *assigned.GetID(), nil
+}
+
+// ControlPlaneRoleAssigned returns true if the team or system account with the
+// provided Konnect ID still has the assigned role with the provided ID.
+// Subjects which don't exist anymore have no roles.
+func ControlPlaneRoleAssigned(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	subjectKind konnectv1alpha1.KonnectRoleAssignmentSubjectKind,
+	subjectID string,
+	roleID string,
+) (bool, error) {
+	var roles *sdkkonnectcomp.AssignedRoleCollection
+	switch subjectKind {
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam:
+		resp, err := sdk.GetTeamRolesSDK().ListTeamRoles(ctx, subjectID, nil)
+		if err != nil {
+			if ErrIsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to list roles of team %s: %w", subjectID, err)
+		}
+		if resp != nil {
+			roles = resp.AssignedRoleCollection
+		}
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindSystemAccount:
+		resp, err := sdk.GetSystemAccountsRolesSDK().GetSystemAccountsAccountIDAssignedRoles(ctx, subjectID, nil)
+		if err != nil {
+			if ErrIsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to list roles of system account %s: %w", subjectID, err)
+		}
+		if resp != nil {
+			roles = resp.AssignedRoleCollection
+		}
+	default:
+		return false, fmt.Errorf("unsupported role assignment subject kind %q", subjectKind)
+	}
+
+	if roles == nil {
+		return false, nil
+	}
+	for _, role := range roles.Data {
+		if id := role.GetID(); id != nil && *id == roleID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RemoveControlPlaneRole removes the assigned role with the provided ID from
+// the team or system account with the provided Konnect ID.
+// Roles which are already removed are ignored.
+func RemoveControlPlaneRole(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	subjectKind konnectv1alpha1.KonnectRoleAssignmentSubjectKind,
+	subjectID string,
+	roleID string,
+) error {
+	var err error
+	switch subjectKind {
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam:
+		_, err = sdk.GetTeamRolesSDK().TeamsRemoveRole(ctx, subjectID, roleID)
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindSystemAccount:
+		_, err = sdk.GetSystemAccountsRolesSDK().DeleteSystemAccountsAccountIDAssignedRolesRoleID(ctx, subjectID, roleID)
+	default:
+		return fmt.Errorf("unsupported role assignment subject kind %q", subjectKind)
+	}
+	if err != nil && !ErrIsNotFound(err) {
+		return fmt.Errorf("failed to remove role %s from %s %s: %w", roleID, subjectKind, subjectID, err)
+	}
+	return nil
+}
+
+// entityRegionForServer returns the region of the entities managed through the
+// provided server, as expected by the Konnect role assignment API.
+func entityRegionForServer(s server.Server) sdkkonnectcomp.EntityRegion {
+	if s.Region() == server.RegionGlobal {
+		return sdkkonnectcomp.EntityRegionWildcard
+	}
+	return sdkkonnectcomp.EntityRegion(s.Region().String())
+}
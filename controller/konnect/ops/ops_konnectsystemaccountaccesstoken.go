@@ -0,0 +1,73 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// SystemAccountAccessToken is an access token minted for a Konnect system account.
+type SystemAccountAccessToken struct {
+	// ID is the Konnect ID of the token.
+	ID string
+	// Token is the secret token value. It is only returned by Konnect when the token is created.
+	Token string
+	// ExpiresAt is the time the token expires at.
+	ExpiresAt time.Time
+}
+
+// CreateSystemAccountAccessToken mints a new access token for the system account
+// with the provided Konnect ID.
+func CreateSystemAccountAccessToken(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	accountID string,
+	name string,
+	expiresAt time.Time,
+) (SystemAccountAccessToken, error) {
+	resp, err := sdk.GetSystemAccountsAccessTokensSDK().PostSystemAccountsIDAccessTokens(ctx, accountID,
+		&sdkkonnectcomp.CreateSystemAccountAccessToken{
+			Name:      name,
+			ExpiresAt: expiresAt.UTC(),
+		},
+	)
+	if err != nil {
+		return SystemAccountAccessToken{}, fmt.Errorf("failed to create access token for system account %s: %w", accountID, err)
+	}
+	if resp == nil || resp.SystemAccountAccessTokenCreated == nil {
+		return SystemAccountAccessToken{}, fmt.Errorf("failed to create access token for system account %s: %w", accountID, ErrNilResponse)
+	}
+
+	created := resp.SystemAccountAccessTokenCreated
+	if created.GetID() == nil || created.GetToken() == nil {
+		return SystemAccountAccessToken{}, fmt.Errorf("failed to create access token for system account %s: %w", accountID, ErrNilResponse)
+	}
+	token := SystemAccountAccessToken{
+		ID:        *created.GetID(),
+		Token:     *created.GetToken(),
+		ExpiresAt: expiresAt,
+	}
+	if created.GetExpiresAt() != nil {
+		token.ExpiresAt = *created.GetExpiresAt()
+	}
+	return token, nil
+}
+
+// DeleteSystemAccountAccessToken revokes the access token with the provided ID.
+// Tokens which are already revoked or expired are ignored.
+func DeleteSystemAccountAccessToken(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	accountID string,
+	tokenID string,
+) error {
+	_, err := sdk.GetSystemAccountsAccessTokensSDK().DeleteSystemAccountsIDAccessTokensID(ctx, accountID, tokenID)
+	if err != nil && !ErrIsNotFound(err) {
+		return fmt.Errorf("failed to delete access token %s of system account %s: %w", tokenID, accountID, err)
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/samber/lo"
+
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// ManagedEntity is an entity in a Konnect control plane, described by its ID and tags.
+type ManagedEntity struct {
+	ID   string
+	Tags []string
+}
+
+// ManagedEntityType describes how to list the entities of a given type that
+// are managed by the operator in a Konnect control plane and how to delete them.
+type ManagedEntityType struct {
+	// Name is the Konnect entity type name, e.g. "Service".
+	Name string
+	// List returns all the entities of this type tagged as managed by the operator.
+	List func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error)
+	// Delete deletes the entity of this type with the provided ID.
+	Delete func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error
+}
+
+// managedEntitiesListPageSize is the page size used when listing managed entities.
+const managedEntitiesListPageSize int64 = 1000
+
+// managedByOperatorTag is the tag set on all the entities created by the operator.
+var managedByOperatorTag = ManagedByLabelKey + ":" + ManagedByKongOperatorLabelValue
+
+// ControlPlaneManagedEntityTypes lists the types of the entities created by the
+// operator in a Konnect control plane which can be listed and deleted by ID.
+//
+// Entities which depend on other entities (e.g. plugins or routes) are listed
+// before the entities they depend on, so that deleting entities in this order
+// does not fail because of references still pointing to them.
+// Entities nested under a parent in the Konnect API (credentials, targets, SNIs)
+// are not listed: they are deleted together with their parents.
+var ControlPlaneManagedEntityTypes = []ManagedEntityType{
+	{
+		Name: "Plugin",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetPluginSDK().ListPlugin(ctx, sdkkonnectops.ListPluginRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetPluginSDK().DeletePlugin(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Route",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetRoutesSDK().ListRoute(ctx, sdkkonnectops.ListRouteRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				entities := make([]ManagedEntity, 0, len(resp.Object.Data))
+				for _, r := range resp.Object.Data {
+					switch {
+					case r.RouteJSON != nil:
+						entities = append(entities, ManagedEntity{ID: lo.FromPtr(r.RouteJSON.ID), Tags: r.RouteJSON.Tags})
+					case r.RouteExpressions != nil:
+						entities = append(entities, ManagedEntity{ID: lo.FromPtr(r.RouteExpressions.ID), Tags: r.RouteExpressions.Tags})
+					}
+				}
+				return entities, resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetRoutesSDK().DeleteRoute(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Service",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetServicesSDK().ListService(ctx, sdkkonnectops.ListServiceRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetServicesSDK().DeleteService(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Consumer",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetConsumersSDK().ListConsumer(ctx, sdkkonnectops.ListConsumerRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetConsumersSDK().DeleteConsumer(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "ConsumerGroup",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetConsumerGroupsSDK().ListConsumerGroup(ctx, sdkkonnectops.ListConsumerGroupRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetConsumerGroupsSDK().DeleteConsumerGroup(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Upstream",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetUpstreamsSDK().ListUpstream(ctx, sdkkonnectops.ListUpstreamRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetUpstreamsSDK().DeleteUpstream(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Certificate",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetCertificatesSDK().ListCertificate(ctx, sdkkonnectops.ListCertificateRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetCertificatesSDK().DeleteCertificate(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "CACertificate",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetCACertificatesSDK().ListCaCertificate(ctx, sdkkonnectops.ListCaCertificateRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetCACertificatesSDK().DeleteCaCertificate(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Key",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetKeysSDK().ListKey(ctx, sdkkonnectops.ListKeyRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetKeysSDK().DeleteKey(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "KeySet",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetKeySetsSDK().ListKeySet(ctx, sdkkonnectops.ListKeySetRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetKeySetsSDK().DeleteKeySet(ctx, cpID, id)
+			return err
+		},
+	},
+	{
+		Name: "Vault",
+		List: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string) ([]ManagedEntity, error) {
+			return listAllManagedEntities(func(offset *string) ([]ManagedEntity, *string, error) {
+				resp, err := sdk.GetVaultSDK().ListVault(ctx, sdkkonnectops.ListVaultRequest{
+					ControlPlaneID: cpID,
+					Tags:           new(managedByOperatorTag),
+					Size:           new(managedEntitiesListPageSize),
+					Offset:         offset,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				if resp == nil || resp.Object == nil {
+					return nil, nil, ErrNilResponse
+				}
+				return toManagedEntities(resp.Object.Data), resp.Object.Offset, nil
+			})
+		},
+		Delete: func(ctx context.Context, sdk sdkops.SDKWrapper, cpID string, id string) error {
+			_, err := sdk.GetVaultSDK().DeleteVault(ctx, cpID, id)
+			return err
+		},
+	},
+}
+
+// toManagedEntities converts a slice of Konnect entities to a slice of ManagedEntity.
+func toManagedEntities[
+	T any,
+	TPtr interface {
+		*T
+		GetID() *string
+		GetTags() []string
+	},
+](data []T) []ManagedEntity {
+	ret := make([]ManagedEntity, 0, len(data))
+	for i := range data {
+		e := TPtr(&data[i])
+		ret = append(ret, ManagedEntity{
+			ID:   lo.FromPtr(e.GetID()),
+			Tags: e.GetTags(),
+		})
+	}
+	return ret
+}
+
+// listAllManagedEntities calls fetch with the offset returned by the previous
+// call until Konnect reports there are no more pages.
+func listAllManagedEntities(
+	fetch func(offset *string) ([]ManagedEntity, *string, error),
+) ([]ManagedEntity, error) {
+	var (
+		ret    []ManagedEntity
+		offset *string
+	)
+	for {
+		entities, next, err := fetch(offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing managed entities: %w", err)
+		}
+		ret = append(ret, entities...)
+		if next == nil || *next == "" {
+			return ret, nil
+		}
+		offset = next
+	}
+}
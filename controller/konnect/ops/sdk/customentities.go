@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+)
+
+// CustomEntity is a Kong entity of a type that is not known to the Konnect SDK,
+// e.g. an entity defined by a custom plugin DAO.
+type CustomEntity map[string]any
+
+// GetID returns the ID of the custom entity or an empty string when it is not set.
+func (e CustomEntity) GetID() string {
+	id, _ := e["id"].(string)
+	return id
+}
+
+// CustomEntitiesSDK is the SDK to operate Kong entities of types that are
+// not supported by the Konnect SDK, e.g. the ones defined by custom plugins.
+type CustomEntitiesSDK interface {
+	CreateCustomEntity(ctx context.Context, controlPlaneID string, entityType string, entity CustomEntity) (CustomEntity, error)
+	UpsertCustomEntity(ctx context.Context, controlPlaneID string, entityType string, id string, entity CustomEntity) (CustomEntity, error)
+	DeleteCustomEntity(ctx context.Context, controlPlaneID string, entityType string, id string) error
+	ListCustomEntities(ctx context.Context, controlPlaneID string, entityType string, tags string) ([]CustomEntity, error)
+}
+
+// customEntitiesSDK implements CustomEntitiesSDK using the Konnect control plane
+// configuration API which exposes entities under their type's endpoint,
+// the same way the Kong Admin API does.
+type customEntitiesSDK struct {
+	serverURL  string
+	token      SDKToken
+	httpClient *http.Client
+}
+
+var _ CustomEntitiesSDK = customEntitiesSDK{}
+
+// CreateCustomEntity creates a custom entity in the given control plane.
+func (s customEntitiesSDK) CreateCustomEntity(
+	ctx context.Context, controlPlaneID string, entityType string, entity CustomEntity,
+) (CustomEntity, error) {
+	var created CustomEntity
+	if err := s.do(ctx, http.MethodPost, s.entityURL(controlPlaneID, entityType, ""), entity, &created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpsertCustomEntity creates or replaces the custom entity with the given ID in the given control plane.
+func (s customEntitiesSDK) UpsertCustomEntity(
+	ctx context.Context, controlPlaneID string, entityType string, id string, entity CustomEntity,
+) (CustomEntity, error) {
+	var upserted CustomEntity
+	if err := s.do(ctx, http.MethodPut, s.entityURL(controlPlaneID, entityType, id), entity, &upserted); err != nil {
+		return nil, err
+	}
+	return upserted, nil
+}
+
+// DeleteCustomEntity deletes the custom entity with the given ID from the given control plane.
+func (s customEntitiesSDK) DeleteCustomEntity(
+	ctx context.Context, controlPlaneID string, entityType string, id string,
+) error {
+	return s.do(ctx, http.MethodDelete, s.entityURL(controlPlaneID, entityType, id), nil, nil)
+}
+
+// ListCustomEntities lists the custom entities of the given type in the given control plane
+// that are tagged with the provided tags.
+func (s customEntitiesSDK) ListCustomEntities(
+	ctx context.Context, controlPlaneID string, entityType string, tags string,
+) ([]CustomEntity, error) {
+	u := s.entityURL(controlPlaneID, entityType, "")
+	if tags != "" {
+		u += "?" + url.Values{"tags": []string{tags}}.Encode()
+	}
+	var resp struct {
+		Data []CustomEntity `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, u, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (s customEntitiesSDK) entityURL(controlPlaneID, entityType, id string) string {
+	u := fmt.Sprintf("%s/v2/control-planes/%s/core-entities/%s",
+		s.serverURL, url.PathEscape(controlPlaneID), url.PathEscape(entityType),
+	)
+	if id != "" {
+		u += "/" + url.PathEscape(id)
+	}
+	return u
+}
+
+// do sends the request and decodes the response body into out (when provided).
+// Non 2xx responses are returned as *sdkkonnecterrs.SDKError so that they are
+// handled the same way as errors returned by the Konnect SDK.
+func (s customEntitiesSDK) do(ctx context.Context, method, u string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+string(s.token))
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := s.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return sdkkonnecterrs.NewSDKError("API error occurred", resp.StatusCode, string(respBody), resp)
+	}
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	return nil
+}
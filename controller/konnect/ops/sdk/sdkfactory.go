@@ -27,6 +27,8 @@ type SDKWrapper interface {
 	GetACLCredentialsSDK() sdkkonnectgo.ACLsSDK
 	GetJWTCredentialsSDK() sdkkonnectgo.JWTsSDK
 	GetHMACCredentialsSDK() sdkkonnectgo.HMACAuthCredentialsSDK
+	GetOAuth2CredentialsSDK() sdkkonnectgo.OAuth2CredentialsSDK
+	GetMTLSAuthCredentialsSDK() sdkkonnectgo.MTLSAuthCredentialsSDK
 	GetCACertificatesSDK() sdkkonnectgo.CACertificatesSDK
 	GetCertificatesSDK() sdkkonnectgo.CertificatesSDK
 	GetKeysSDK() sdkkonnectgo.KeysSDK
@@ -35,6 +37,12 @@ type SDKWrapper interface {
 	GetDataPlaneCertificatesSDK() sdkkonnectgo.DPCertificatesSDK
 	GetCloudGatewaysSDK() sdkkonnectgo.CloudGatewaysSDK
 	GetMCPServersSDK() *sdkkonnectgo.MCPServers
+	GetTeamRolesSDK() sdkkonnectgo.TeamRolesSDK
+	GetSystemAccountsRolesSDK() sdkkonnectgo.SystemAccountsRolesSDK
+	GetSystemAccountsAccessTokensSDK() sdkkonnectgo.SystemAccountsAccessTokensSDK
+	GetAPIImplementationSDK() sdkkonnectgo.APIImplementationSDK
+	GetAPIPublicationSDK() sdkkonnectgo.APIPublicationSDK
+	GetCustomEntitiesSDK() CustomEntitiesSDK
 
 	GeneratedSDK
 
@@ -44,8 +52,10 @@ type SDKWrapper interface {
 }
 
 type sdkWrapper struct {
-	server server.Server
-	sdk    *sdkkonnectgo.SDK
+	server     server.Server
+	sdk        *sdkkonnectgo.SDK
+	token      SDKToken
+	httpClient *http.Client
 }
 
 var _ SDKWrapper = sdkWrapper{}
@@ -155,6 +165,16 @@ func (w sdkWrapper) GetHMACCredentialsSDK() sdkkonnectgo.HMACAuthCredentialsSDK
 	return w.sdk.HMACAuthCredentials
 }
 
+// GetOAuth2CredentialsSDK returns the SDK to operate OAuth2 credentials.
+func (w sdkWrapper) GetOAuth2CredentialsSDK() sdkkonnectgo.OAuth2CredentialsSDK {
+	return w.sdk.OAuth2Credentials
+}
+
+// GetMTLSAuthCredentialsSDK returns the SDK to operate mTLS auth credentials.
+func (w sdkWrapper) GetMTLSAuthCredentialsSDK() sdkkonnectgo.MTLSAuthCredentialsSDK {
+	return w.sdk.MTLSAuthCredentials
+}
+
 // GetKeysSDK returns the SDK to operate keys.
 func (w sdkWrapper) GetKeysSDK() sdkkonnectgo.KeysSDK {
 	return w.sdk.Keys
@@ -180,6 +200,40 @@ func (w sdkWrapper) GetMCPServersSDK() *sdkkonnectgo.MCPServers {
 	return w.sdk.MCPServers
 }
 
+// GetTeamRolesSDK returns the SDK to operate roles assigned to teams.
+func (w sdkWrapper) GetTeamRolesSDK() sdkkonnectgo.TeamRolesSDK {
+	return w.sdk.TeamRoles
+}
+
+// GetSystemAccountsRolesSDK returns the SDK to operate roles assigned to system accounts.
+func (w sdkWrapper) GetSystemAccountsRolesSDK() sdkkonnectgo.SystemAccountsRolesSDK {
+	return w.sdk.SystemAccountsRoles
+}
+
+// GetSystemAccountsAccessTokensSDK returns the SDK to operate system accounts access tokens.
+func (w sdkWrapper) GetSystemAccountsAccessTokensSDK() sdkkonnectgo.SystemAccountsAccessTokensSDK {
+	return w.sdk.SystemAccountsAccessTokens
+}
+
+// GetAPIImplementationSDK returns the SDK to operate API implementations.
+func (w sdkWrapper) GetAPIImplementationSDK() sdkkonnectgo.APIImplementationSDK {
+	return w.sdk.APIImplementation
+}
+
+// GetAPIPublicationSDK returns the SDK to operate API publications.
+func (w sdkWrapper) GetAPIPublicationSDK() sdkkonnectgo.APIPublicationSDK {
+	return w.sdk.APIPublication
+}
+
+// GetCustomEntitiesSDK returns the SDK to operate entities of types unknown to the Konnect SDK.
+func (w sdkWrapper) GetCustomEntitiesSDK() CustomEntitiesSDK {
+	return customEntitiesSDK{
+		serverURL:  w.server.URL(),
+		token:      w.token,
+		httpClient: w.httpClient,
+	}
+}
+
 // SDKToken is a token used to authenticate with the Konnect SDK.
 type SDKToken string
 
@@ -228,7 +282,9 @@ func (f sdkFactory) NewKonnectSDK(server server.Server, token SDKToken) SDKWrapp
 	}
 
 	return sdkWrapper{
-		server: server,
-		sdk:    sdkkonnectgo.New(opts...),
+		server:     server,
+		sdk:        sdkkonnectgo.New(opts...),
+		token:      token,
+		httpClient: f.httpClient,
 	}
 }
@@ -17,6 +17,7 @@ type GeneratedSDK interface {
 	GetAIGatewayModelsSDK() sdkkonnectgo.AIGatewayModelsSDK
 	GetAIGatewayModelProvidersSDK() sdkkonnectgo.AIGatewayModelProvidersSDK
 	GetAIGatewayPoliciesSDK() sdkkonnectgo.AIGatewayPoliciesSDK
+	GetAPISpecificationSDK() sdkkonnectgo.APISpecificationSDK
 	GetEventGatewayBackendClustersSDK() sdkkonnectgo.EventGatewayBackendClustersSDK
 	GetEventGatewayDataPlaneCertificatesSDK() sdkkonnectgo.EventGatewayDataPlaneCertificatesSDK
 	GetEventGatewayListenersSDK() sdkkonnectgo.EventGatewayListenersSDK
@@ -27,8 +28,11 @@ type GeneratedSDK interface {
 	GetEventGatewayVirtualClusterPoliciesSDK() sdkkonnectgo.EventGatewayVirtualClusterPoliciesSDK
 	GetEventGatewayVirtualClusterProducePoliciesSDK() sdkkonnectgo.EventGatewayVirtualClusterProducePoliciesSDK
 	GetAIGatewaysSDK() sdkkonnectgo.AIGatewaysSDK
+	GetAPISDK() sdkkonnectgo.APISDK
 	GetConfigStoresSDK() sdkkonnectgo.ConfigStoresSDK
 	GetEventGatewaysSDK() sdkkonnectgo.EventGatewaysSDK
+	GetSystemAccountsSDK() sdkkonnectgo.SystemAccountsSDK
+	GetTeamsSDK() sdkkonnectgo.TeamsSDK
 	GetPortalsSDK() sdkkonnectgo.PortalsSDK
 	GetPortalCustomDomainsSDK() sdkkonnectgo.PortalCustomDomainsSDK
 	GetPortalCustomizationSDK() sdkkonnectgo.PortalCustomizationSDK
@@ -84,6 +88,11 @@ func (w sdkWrapper) GetAIGatewayPoliciesSDK() sdkkonnectgo.AIGatewayPoliciesSDK
 	return w.sdk.AIGatewayPolicies
 }
 
+// GetAPISpecificationSDK returns the SDK to operate APISpecification.
+func (w sdkWrapper) GetAPISpecificationSDK() sdkkonnectgo.APISpecificationSDK {
+	return w.sdk.APISpecification
+}
+
 // GetEventGatewayBackendClustersSDK returns the SDK to operate EventGatewayBackendCluster.
 func (w sdkWrapper) GetEventGatewayBackendClustersSDK() sdkkonnectgo.EventGatewayBackendClustersSDK {
 	return w.sdk.EventGatewayBackendClusters
@@ -134,6 +143,11 @@ func (w sdkWrapper) GetAIGatewaysSDK() sdkkonnectgo.AIGatewaysSDK {
 	return w.sdk.AIGateways
 }
 
+// GetAPISDK returns the SDK to operate KonnectAPI.
+func (w sdkWrapper) GetAPISDK() sdkkonnectgo.APISDK {
+	return w.sdk.API
+}
+
 // GetConfigStoresSDK returns the SDK to operate KonnectConfigStore.
 func (w sdkWrapper) GetConfigStoresSDK() sdkkonnectgo.ConfigStoresSDK {
 	return w.sdk.ConfigStores
@@ -144,6 +158,16 @@ func (w sdkWrapper) GetEventGatewaysSDK() sdkkonnectgo.EventGatewaysSDK {
 	return w.sdk.EventGateways
 }
 
+// GetSystemAccountsSDK returns the SDK to operate KonnectSystemAccount.
+func (w sdkWrapper) GetSystemAccountsSDK() sdkkonnectgo.SystemAccountsSDK {
+	return w.sdk.SystemAccounts
+}
+
+// GetTeamsSDK returns the SDK to operate KonnectTeam.
+func (w sdkWrapper) GetTeamsSDK() sdkkonnectgo.TeamsSDK {
+	return w.sdk.Teams
+}
+
 // GetPortalsSDK returns the SDK to operate Portal.
 func (w sdkWrapper) GetPortalsSDK() sdkkonnectgo.PortalsSDK {
 	return w.sdk.Portals
@@ -47,6 +47,8 @@ func CreateGeneratedOps[
 		return createAIGatewayModelProvider(ctx, cl, sdk.GetAIGatewayModelProvidersSDK(), ent)
 	case *konnectv1alpha1.AIGatewayPolicy:
 		return createAIGatewayPolicy(ctx, cl, sdk.GetAIGatewayPoliciesSDK(), ent)
+	case *konnectv1alpha1.APISpecification:
+		return createAPISpecification(ctx, cl, sdk.GetAPISpecificationSDK(), ent)
 	case *configurationv1alpha1.EventGatewayBackendCluster:
 		return createEventGatewayBackendCluster(ctx, cl, sdk.GetEventGatewayBackendClustersSDK(), ent)
 	case *configurationv1alpha1.EventGatewayDataPlaneCertificate:
@@ -67,10 +69,16 @@ func CreateGeneratedOps[
 		return createEventGatewayVirtualClusterProducePolicy(ctx, sdk.GetEventGatewayVirtualClusterProducePoliciesSDK(), ent)
 	case *konnectv1alpha1.KonnectAIGateway:
 		return createKonnectAIGateway(ctx, sdk.GetAIGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectAPI:
+		return createKonnectAPI(ctx, sdk.GetAPISDK(), ent)
 	case *konnectv1alpha1.KonnectConfigStore:
 		return createKonnectConfigStore(ctx, sdk.GetConfigStoresSDK(), ent)
 	case *konnectv1alpha1.KonnectEventGateway:
 		return createKonnectEventGateway(ctx, sdk.GetEventGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return createKonnectSystemAccount(ctx, sdk.GetSystemAccountsSDK(), ent)
+	case *konnectv1alpha1.KonnectTeam:
+		return createKonnectTeam(ctx, sdk.GetTeamsSDK(), ent)
 	case *konnectv1alpha1.Portal:
 		return createPortal(ctx, sdk.GetPortalsSDK(), ent)
 	case *konnectv1alpha1.PortalCustomDomain:
@@ -45,6 +45,8 @@ func DeleteGeneratedOps[
 		return deleteAIGatewayModelProvider(ctx, sdk.GetAIGatewayModelProvidersSDK(), ent)
 	case *konnectv1alpha1.AIGatewayPolicy:
 		return deleteAIGatewayPolicy(ctx, sdk.GetAIGatewayPoliciesSDK(), ent)
+	case *konnectv1alpha1.APISpecification:
+		return deleteAPISpecification(ctx, sdk.GetAPISpecificationSDK(), ent)
 	case *configurationv1alpha1.EventGatewayBackendCluster:
 		return deleteEventGatewayBackendCluster(ctx, sdk.GetEventGatewayBackendClustersSDK(), ent)
 	case *configurationv1alpha1.EventGatewayDataPlaneCertificate:
@@ -65,10 +67,16 @@ func DeleteGeneratedOps[
 		return deleteEventGatewayVirtualClusterProducePolicy(ctx, sdk.GetEventGatewayVirtualClusterProducePoliciesSDK(), ent)
 	case *konnectv1alpha1.KonnectAIGateway:
 		return deleteKonnectAIGateway(ctx, sdk.GetAIGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectAPI:
+		return deleteKonnectAPI(ctx, sdk.GetAPISDK(), ent)
 	case *konnectv1alpha1.KonnectConfigStore:
 		return deleteKonnectConfigStore(ctx, sdk.GetConfigStoresSDK(), ent)
 	case *konnectv1alpha1.KonnectEventGateway:
 		return deleteKonnectEventGateway(ctx, sdk.GetEventGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return deleteKonnectSystemAccount(ctx, sdk.GetSystemAccountsSDK(), ent)
+	case *konnectv1alpha1.KonnectTeam:
+		return deleteKonnectTeam(ctx, sdk.GetTeamsSDK(), ent)
 	case *konnectv1alpha1.Portal:
 		return deletePortal(ctx, sdk.GetPortalsSDK(), ent)
 	case *konnectv1alpha1.PortalCustomDomain:
@@ -61,6 +61,8 @@ func getForUID[
 		return getAIGatewayModelProviderForUID(ctx, sdk.GetAIGatewayModelProvidersSDK(), ent)
 	case *konnectv1alpha1.AIGatewayPolicy:
 		return getAIGatewayPolicyForUID(ctx, sdk.GetAIGatewayPoliciesSDK(), ent)
+	case *konnectv1alpha1.APISpecification:
+		return getAPISpecificationForUID(ctx, sdk.GetAPISpecificationSDK(), ent)
 	case *configurationv1alpha1.EventGatewayBackendCluster:
 		return getEventGatewayBackendClusterForUID(ctx, sdk.GetEventGatewayBackendClustersSDK(), ent)
 	case *configurationv1alpha1.EventGatewayDataPlaneCertificate:
@@ -81,10 +83,16 @@ func getForUID[
 		return getEventGatewayVirtualClusterProducePolicyForUID(ctx, sdk.GetEventGatewayVirtualClusterProducePoliciesSDK(), ent)
 	case *konnectv1alpha1.KonnectAIGateway:
 		return getKonnectAIGatewayForUID(ctx, sdk.GetAIGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectAPI:
+		return getKonnectAPIForUID(ctx, sdk.GetAPISDK(), ent)
 	case *konnectv1alpha1.KonnectConfigStore:
 		return getKonnectConfigStoreForUID(ctx, sdk.GetConfigStoresSDK(), ent)
 	case *konnectv1alpha1.KonnectEventGateway:
 		return getKonnectEventGatewayForUID(ctx, sdk.GetEventGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return getKonnectSystemAccountForUID(ctx, sdk.GetSystemAccountsSDK(), ent)
+	case *konnectv1alpha1.KonnectTeam:
+		return getKonnectTeamForUID(ctx, sdk.GetTeamsSDK(), ent)
 	case *konnectv1alpha1.Portal:
 		return getPortalForUID(ctx, sdk.GetPortalsSDK(), ent)
 	case *konnectv1alpha1.PortalCustomDomain:
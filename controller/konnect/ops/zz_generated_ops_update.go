@@ -47,6 +47,8 @@ func UpdateGeneratedOps[
 		return updateAIGatewayModelProvider(ctx, cl, sdk.GetAIGatewayModelProvidersSDK(), ent)
 	case *konnectv1alpha1.AIGatewayPolicy:
 		return updateAIGatewayPolicy(ctx, cl, sdk.GetAIGatewayPoliciesSDK(), ent)
+	case *konnectv1alpha1.APISpecification:
+		return updateAPISpecification(ctx, cl, sdk.GetAPISpecificationSDK(), ent)
 	case *configurationv1alpha1.EventGatewayBackendCluster:
 		return updateEventGatewayBackendCluster(ctx, cl, sdk.GetEventGatewayBackendClustersSDK(), ent)
 	case *configurationv1alpha1.EventGatewayDataPlaneCertificate:
@@ -67,10 +69,16 @@ func UpdateGeneratedOps[
 		return updateEventGatewayVirtualClusterProducePolicy(ctx, sdk.GetEventGatewayVirtualClusterProducePoliciesSDK(), ent)
 	case *konnectv1alpha1.KonnectAIGateway:
 		return updateKonnectAIGateway(ctx, sdk.GetAIGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectAPI:
+		return updateKonnectAPI(ctx, sdk.GetAPISDK(), ent)
 	case *konnectv1alpha1.KonnectConfigStore:
 		return updateKonnectConfigStore(ctx, sdk.GetConfigStoresSDK(), ent)
 	case *konnectv1alpha1.KonnectEventGateway:
 		return updateKonnectEventGateway(ctx, sdk.GetEventGatewaysSDK(), ent)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return updateKonnectSystemAccount(ctx, sdk.GetSystemAccountsSDK(), ent)
+	case *konnectv1alpha1.KonnectTeam:
+		return updateKonnectTeam(ctx, sdk.GetTeamsSDK(), ent)
 	case *konnectv1alpha1.Portal:
 		return updatePortal(ctx, sdk.GetPortalsSDK(), ent)
 	case *konnectv1alpha1.PortalCustomDomain:
@@ -52,6 +52,11 @@ type portalRefAccessor interface {
 	GetPortalRef() commonv1alpha1.ObjectRef
 }
 
+type konnectAPIRefAccessor interface {
+	objectWithParentRef
+	GetKonnectAPIRef() commonv1alpha1.ObjectRef
+}
+
 type konnectAIGatewayRefAccessor interface {
 	objectWithParentRef
 	GetKonnectAIGatewayRef() commonv1alpha1.ObjectRef
@@ -106,6 +111,9 @@ func getAPIAuthRef[
 	if obj, ok := any(ent).(portalRefAccessor); ok {
 		return getAPIAuthConfigurationRefFromParent[konnectv1alpha1.Portal](ctx, cl, obj, obj.GetParentRef())
 	}
+	if obj, ok := any(ent).(konnectAPIRefAccessor); ok {
+		return getAPIAuthConfigurationRefFromParent[konnectv1alpha1.KonnectAPI](ctx, cl, obj, obj.GetParentRef())
+	}
 	if obj, ok := any(ent).(konnectGatewayControlPlaneRefAccessor); ok {
 		return getAPIAuthConfigurationRefFromParent[konnectv1alpha2.KonnectGatewayControlPlane](ctx, cl, obj, obj.GetParentRef())
 	}
@@ -0,0 +1,306 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/controller/konnect/ops"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// APIImplementationReconciler reconciles APIImplementations.
+//
+// It links the referenced KonnectAPI to the referenced KongService once both
+// are Programmed, and removes the link when the APIImplementation is deleted.
+// Implementations are checked every sync period and created again when they
+// have been removed in Konnect or the API or Service have been created again.
+type APIImplementationReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	sdkFactory        sdkops.SDKFactory
+	syncPeriod        time.Duration
+}
+
+// NewAPIImplementationReconciler creates a new APIImplementationReconciler.
+func NewAPIImplementationReconciler(
+	ctrlOptions controller.Options,
+	sdkFactory sdkops.SDKFactory,
+	loggingMode logging.Mode,
+	cl client.Client,
+	syncPeriod time.Duration,
+) *APIImplementationReconciler {
+	return &APIImplementationReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		sdkFactory:        sdkFactory,
+		syncPeriod:        syncPeriod,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *APIImplementationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("APIImplementation").
+		WithOptions(r.controllerOptions).
+		For(&konnectv1alpha1.APIImplementation{}).
+		Watches(&konnectv1alpha1.KonnectAPI{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForRef(func(impl *konnectv1alpha1.APIImplementation) string {
+				return impl.Spec.APIRef.Name
+			})),
+		).
+		Watches(&configurationv1alpha1.KongService{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForRef(func(impl *konnectv1alpha1.APIImplementation) string {
+				return impl.Spec.ServiceRef.Name
+			})),
+		).
+		Complete(r)
+}
+
+// enqueueForRef returns a map func enqueueing the APIImplementations in the
+// namespace of the object which reference it by the name returned by refName.
+func (r *APIImplementationReconciler) enqueueForRef(
+	refName func(*konnectv1alpha1.APIImplementation) string,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var l konnectv1alpha1.APIImplementationList
+		if err := r.client.List(ctx, &l, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, impl := range l.Items {
+			if refName(&impl) == obj.GetName() {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&impl)})
+			}
+		}
+		return reqs
+	}
+}
+
+// Reconcile reconciles an APIImplementation.
+func (r *APIImplementationReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var impl konnectv1alpha1.APIImplementation
+	if err := r.client.Get(ctx, req.NamespacedName, &impl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "APIImplementation", r.loggingMode)
+	log.Debug(logger, "reconciling")
+
+	if !impl.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.deleteImplementation(ctx, &impl)
+	}
+
+	old := impl.DeepCopy()
+
+	api, err := getKonnectAPI(ctx, r.client, impl.Namespace, impl.Spec.APIRef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if api == nil || api.GetKonnectID() == "" {
+		setAPIImplementationNotProgrammed(&impl,
+			konnectv1alpha1.APIImplementationReasonAPINotProgrammed,
+			fmt.Sprintf("KonnectAPI %s is not Programmed yet", impl.Spec.APIRef.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &impl)
+	}
+
+	svc, err := r.getService(ctx, &impl)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if svc == nil || svc.GetKonnectID() == "" || svc.GetControlPlaneID() == "" {
+		setAPIImplementationNotProgrammed(&impl,
+			konnectv1alpha1.APIImplementationReasonServiceNotProgrammed,
+			fmt.Sprintf("KongService %s is not Programmed in Konnect yet", impl.Spec.ServiceRef.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &impl)
+	}
+
+	if controllerutil.AddFinalizer(&impl, KonnectCleanupFinalizer) {
+		if err := r.client.Patch(ctx, &impl, client.MergeFrom(old)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed adding finalizer to APIImplementation %s: %w", req.NamespacedName, err)
+		}
+		old = impl.DeepCopy()
+	}
+
+	sdk, serverURL, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, api)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if impl.Status.ID != "" {
+		switch {
+		case impl.Status.APIID != api.GetKonnectID():
+			// The API has been created again in Konnect, without the implementations of the previous one.
+			log.Info(logger, "API changed in Konnect, creating implementation again",
+				"previousAPIID", impl.Status.APIID, "apiID", api.GetKonnectID())
+		case impl.Status.ControlPlaneID != svc.GetControlPlaneID() || impl.Status.ServiceID != svc.GetKonnectID():
+			// The implementation links the API to the previous Service.
+			log.Info(logger, "service changed in Konnect, creating implementation again",
+				"previousServiceID", impl.Status.ServiceID, "serviceID", svc.GetKonnectID())
+			if err := ops.DeleteAPIImplementation(ctx, sdk, impl.Status.APIID, impl.Status.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+		default:
+			exists, err := ops.APIImplementationExists(ctx, sdk, impl.Status.APIID, impl.Status.ID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if exists {
+				return ctrl.Result{RequeueAfter: r.syncPeriod}, nil
+			}
+			log.Info(logger, "implementation not found in Konnect, creating it again", "id", impl.Status.ID)
+		}
+	}
+
+	id, err := ops.CreateAPIImplementation(ctx, sdk, api.GetKonnectID(), svc.GetControlPlaneID(), svc.GetKonnectID())
+	if err != nil {
+		setAPIImplementationNotProgrammed(&impl, konnectv1alpha1.KonnectEntityProgrammedReasonKonnectAPIOpFailed, err.Error())
+		if errStatus := r.patchStatus(ctx, old, &impl); errStatus != nil {
+			return ctrl.Result{}, errStatus
+		}
+		return ctrl.Result{}, err
+	}
+	log.Info(logger, "created implementation in Konnect", "id", id)
+
+	impl.Status.KonnectEntityStatus = konnectv1alpha2.KonnectEntityStatus{
+		ID:        id,
+		ServerURL: serverURL,
+		OrgID:     api.Status.OrgID,
+	}
+	impl.Status.APIID = api.GetKonnectID()
+	impl.Status.ControlPlaneID = svc.GetControlPlaneID()
+	impl.Status.ServiceID = svc.GetKonnectID()
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionTrue,
+			konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+			"",
+			impl.GetGeneration(),
+		),
+		&impl,
+	)
+	return ctrl.Result{RequeueAfter: r.syncPeriod}, r.patchStatus(ctx, old, &impl)
+}
+
+// getKonnectAPI returns the KonnectAPI with the provided name and namespace
+// or nil when it doesn't exist.
+func getKonnectAPI(
+	ctx context.Context, cl client.Client, namespace, name string,
+) (*konnectv1alpha1.KonnectAPI, error) {
+	var api konnectv1alpha1.KonnectAPI
+	nn := types.NamespacedName{Namespace: namespace, Name: name}
+	if err := cl.Get(ctx, nn, &api); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting KonnectAPI %s: %w", nn, err)
+	}
+	return &api, nil
+}
+
+// getService returns the KongService referenced by the APIImplementation
+// or nil when it doesn't exist.
+func (r *APIImplementationReconciler) getService(
+	ctx context.Context,
+	impl *konnectv1alpha1.APIImplementation,
+) (*configurationv1alpha1.KongService, error) {
+	var svc configurationv1alpha1.KongService
+	nn := types.NamespacedName{Namespace: impl.Namespace, Name: impl.Spec.ServiceRef.Name}
+	if err := r.client.Get(ctx, nn, &svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting KongService %s: %w", nn, err)
+	}
+	return &svc, nil
+}
+
+// deleteImplementation deletes the implementation from Konnect and drops the finalizer.
+func (r *APIImplementationReconciler) deleteImplementation(
+	ctx context.Context,
+	impl *konnectv1alpha1.APIImplementation,
+) error {
+	if !controllerutil.ContainsFinalizer(impl, KonnectCleanupFinalizer) {
+		return nil
+	}
+
+	if impl.Status.ID != "" && impl.Status.APIID != "" {
+		api, err := getKonnectAPI(ctx, r.client, impl.Namespace, impl.Spec.APIRef.Name)
+		if err != nil {
+			return err
+		}
+		// When the API is gone, so are its implementations in Konnect.
+		if api != nil && api.GetKonnectID() == impl.Status.APIID {
+			sdk, _, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, api)
+			if err != nil {
+				return err
+			}
+			if err := ops.DeleteAPIImplementation(ctx, sdk, impl.Status.APIID, impl.Status.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	old := impl.DeepCopy()
+	controllerutil.RemoveFinalizer(impl, KonnectCleanupFinalizer)
+	if err := r.client.Patch(ctx, impl, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed removing finalizer from APIImplementation %s: %w", client.ObjectKeyFromObject(impl), err)
+	}
+	return nil
+}
+
+func (r *APIImplementationReconciler) patchStatus(
+	ctx context.Context,
+	old, impl *konnectv1alpha1.APIImplementation,
+) error {
+	if err := r.client.Status().Patch(ctx, impl, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed updating APIImplementation %s status: %w",
+			client.ObjectKeyFromObject(impl), err,
+		)
+	}
+	return nil
+}
+
+func setAPIImplementationNotProgrammed(
+	impl *konnectv1alpha1.APIImplementation,
+	reason apiconsts.ConditionReason,
+	msg string,
+) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionFalse,
+			reason,
+			msg,
+			impl.GetGeneration(),
+		),
+		impl,
+	)
+}
@@ -0,0 +1,5 @@
+package konnect
+
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apiimplementations,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apiimplementations/status,verbs=update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apiimplementations/finalizers,verbs=update;patch
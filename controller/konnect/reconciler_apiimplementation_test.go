@@ -0,0 +1,202 @@
+package konnect
+
+import (
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/mocks/sdkmocks"
+)
+
+func TestAPIImplementationReconciler(t *testing.T) {
+	const (
+		syncPeriod = time.Minute
+		ns         = "default"
+	)
+	nn := types.NamespacedName{Namespace: ns, Name: "implementation"}
+
+	objects := func(serviceID string, status konnectv1alpha1.APIImplementationStatus) []client.Object {
+		return []client.Object{
+			&konnectv1alpha1.KonnectAPIAuthConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+					Type:      konnectv1alpha1.KonnectAPIAuthTypeToken,
+					Token:     "kpat_token",
+					ServerURL: "us.api.konghq.com",
+				},
+			},
+			&konnectv1alpha1.KonnectAPI{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectAPISpec{
+					KonnectConfiguration: konnectv1alpha2.KonnectConfiguration{
+						APIAuthConfigurationRef: konnectv1alpha2.KonnectAPIAuthConfigurationRef{Name: "auth"},
+					},
+				},
+				Status: konnectv1alpha1.KonnectAPIStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "api-id", OrgID: "org-id"},
+				},
+			},
+			&configurationv1alpha1.KongService{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: ns},
+				Status: configurationv1alpha1.KongServiceStatus{
+					Konnect: &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndCertificateAndCACertificatesRefs{
+						KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: serviceID},
+						ControlPlaneID:      "cp-id",
+					},
+				},
+			},
+			&konnectv1alpha1.APIImplementation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       nn.Name,
+					Namespace:  nn.Namespace,
+					Finalizers: []string{KonnectCleanupFinalizer},
+				},
+				Spec: konnectv1alpha1.APIImplementationSpec{
+					APIRef:     commonv1alpha1.NameRef{Name: "api"},
+					ServiceRef: commonv1alpha1.NameRef{Name: "svc"},
+				},
+				Status: status,
+			},
+		}
+	}
+	created := &sdkkonnectops.CreateAPIImplementationResponse{
+		APIImplementationResponse: &sdkkonnectcomp.APIImplementationResponse{ID: "new-impl-id"},
+	}
+	expectCreate := func(sdk *sdkmocks.MockSDKWrapper, serviceID string) {
+		sdk.APIImplementationSDK.EXPECT().
+			CreateAPIImplementation(mock.Anything, "api-id", mock.MatchedBy(func(req sdkkonnectcomp.APIImplementation) bool {
+				return req.Service != nil && req.Service.ControlPlaneID == "cp-id" && req.Service.ID == serviceID
+			})).
+			Return(created, nil)
+	}
+	programmed := konnectv1alpha1.APIImplementationStatus{
+		KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "impl-id"},
+		APIID:               "api-id",
+		ControlPlaneID:      "cp-id",
+		ServiceID:           "svc-id",
+	}
+
+	testCases := []struct {
+		name           string
+		serviceID      string
+		status         konnectv1alpha1.APIImplementationStatus
+		expectSDKCalls func(*sdkmocks.MockSDKWrapper)
+		wantID         string
+		wantServiceID  string
+	}{
+		{
+			name:      "implementation is created",
+			serviceID: "svc-id",
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				expectCreate(sdk, "svc-id")
+			},
+			wantID:        "new-impl-id",
+			wantServiceID: "svc-id",
+		},
+		{
+			name:      "implementation still exists in Konnect",
+			serviceID: "svc-id",
+			status:    programmed,
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.APIImplementationSDK.EXPECT().
+					FetchAPIImplementation(mock.Anything, "api-id", "impl-id").
+					Return(&sdkkonnectops.FetchAPIImplementationResponse{}, nil)
+			},
+			wantID:        "impl-id",
+			wantServiceID: "svc-id",
+		},
+		{
+			name:      "implementation removed in Konnect is created again",
+			serviceID: "svc-id",
+			status:    programmed,
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.APIImplementationSDK.EXPECT().
+					FetchAPIImplementation(mock.Anything, "api-id", "impl-id").
+					Return(nil, &sdkkonnecterrs.NotFoundError{})
+				expectCreate(sdk, "svc-id")
+			},
+			wantID:        "new-impl-id",
+			wantServiceID: "svc-id",
+		},
+		{
+			name:      "implementation linking the previous service is replaced",
+			serviceID: "new-svc-id",
+			status:    programmed,
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.APIImplementationSDK.EXPECT().
+					DeleteAPIImplementation(mock.Anything, "api-id", "impl-id").
+					Return(&sdkkonnectops.DeleteAPIImplementationResponse{}, nil)
+				expectCreate(sdk, "new-svc-id")
+			},
+			wantID:        "new-impl-id",
+			wantServiceID: "new-svc-id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(objects(tc.serviceID, tc.status)...).
+				WithStatusSubresource(
+					&konnectv1alpha1.APIImplementation{},
+					&konnectv1alpha1.KonnectAPI{},
+					&configurationv1alpha1.KongService{},
+				).
+				Build()
+
+			factory := sdkmocks.NewMockSDKFactory(t)
+			tc.expectSDKCalls(factory.SDK)
+
+			r := NewAPIImplementationReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, syncPeriod)
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+			require.NoError(t, err)
+			assert.Equal(t, syncPeriod, res.RequeueAfter)
+
+			var impl konnectv1alpha1.APIImplementation
+			require.NoError(t, cl.Get(t.Context(), nn, &impl))
+			assert.Equal(t, tc.wantID, impl.Status.ID)
+			assert.Equal(t, "api-id", impl.Status.APIID)
+			assert.Equal(t, "cp-id", impl.Status.ControlPlaneID)
+			assert.Equal(t, tc.wantServiceID, impl.Status.ServiceID)
+		})
+	}
+
+	t.Run("service not Programmed in Konnect", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects("", konnectv1alpha1.APIImplementationStatus{})...).
+			WithStatusSubresource(&konnectv1alpha1.APIImplementation{}).
+			Build()
+
+		factory := sdkmocks.NewMockSDKFactory(t)
+		r := NewAPIImplementationReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, syncPeriod)
+		res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Equal(t, konnectRefNotProgrammedRequeueAfter, res.RequeueAfter)
+
+		var impl konnectv1alpha1.APIImplementation
+		require.NoError(t, cl.Get(t.Context(), nn, &impl))
+		require.Len(t, impl.Status.Conditions, 1)
+		assert.Equal(t, metav1.ConditionFalse, impl.Status.Conditions[0].Status)
+		assert.Equal(t, konnectv1alpha1.APIImplementationReasonServiceNotProgrammed, impl.Status.Conditions[0].Reason)
+	})
+}
@@ -0,0 +1,272 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/controller/konnect/ops"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// APIPublicationReconciler reconciles APIPublications.
+//
+// It publishes the referenced KonnectAPI to the referenced Portal once both
+// are Programmed, and unpublishes it when the APIPublication is deleted.
+// Konnect creates or replaces publications, hence the publication is applied
+// again every sync period, which also restores publications removed or
+// changed in Konnect.
+type APIPublicationReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	sdkFactory        sdkops.SDKFactory
+	syncPeriod        time.Duration
+}
+
+// NewAPIPublicationReconciler creates a new APIPublicationReconciler.
+func NewAPIPublicationReconciler(
+	ctrlOptions controller.Options,
+	sdkFactory sdkops.SDKFactory,
+	loggingMode logging.Mode,
+	cl client.Client,
+	syncPeriod time.Duration,
+) *APIPublicationReconciler {
+	return &APIPublicationReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		sdkFactory:        sdkFactory,
+		syncPeriod:        syncPeriod,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *APIPublicationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("APIPublication").
+		WithOptions(r.controllerOptions).
+		For(&konnectv1alpha1.APIPublication{}).
+		Watches(&konnectv1alpha1.KonnectAPI{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForRef(func(pub *konnectv1alpha1.APIPublication) string {
+				return pub.Spec.APIRef.Name
+			})),
+		).
+		Watches(&konnectv1alpha1.Portal{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForRef(func(pub *konnectv1alpha1.APIPublication) string {
+				return pub.Spec.PortalRef.Name
+			})),
+		).
+		Complete(r)
+}
+
+// enqueueForRef returns a map func enqueueing the APIPublications in the
+// namespace of the object which reference it by the name returned by refName.
+func (r *APIPublicationReconciler) enqueueForRef(
+	refName func(*konnectv1alpha1.APIPublication) string,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var l konnectv1alpha1.APIPublicationList
+		if err := r.client.List(ctx, &l, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, pub := range l.Items {
+			if refName(&pub) == obj.GetName() {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pub)})
+			}
+		}
+		return reqs
+	}
+}
+
+// Reconcile reconciles an APIPublication.
+func (r *APIPublicationReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var pub konnectv1alpha1.APIPublication
+	if err := r.client.Get(ctx, req.NamespacedName, &pub); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "APIPublication", r.loggingMode)
+	log.Debug(logger, "reconciling")
+
+	if !pub.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.unpublish(ctx, &pub)
+	}
+
+	old := pub.DeepCopy()
+
+	api, err := getKonnectAPI(ctx, r.client, pub.Namespace, pub.Spec.APIRef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if api == nil || api.GetKonnectID() == "" {
+		setAPIPublicationNotProgrammed(&pub,
+			konnectv1alpha1.APIPublicationReasonAPINotProgrammed,
+			fmt.Sprintf("KonnectAPI %s is not Programmed yet", pub.Spec.APIRef.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &pub)
+	}
+
+	portal, err := r.getPortal(ctx, &pub)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if portal == nil || portal.GetKonnectID() == "" {
+		setAPIPublicationNotProgrammed(&pub,
+			konnectv1alpha1.APIPublicationReasonPortalNotProgrammed,
+			fmt.Sprintf("Portal %s is not Programmed yet", pub.Spec.PortalRef.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &pub)
+	}
+
+	if controllerutil.AddFinalizer(&pub, KonnectCleanupFinalizer) {
+		if err := r.client.Patch(ctx, &pub, client.MergeFrom(old)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed adding finalizer to APIPublication %s: %w", req.NamespacedName, err)
+		}
+		old = pub.DeepCopy()
+	}
+
+	sdk, serverURL, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, api)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// When the Portal has been created again in Konnect, the API is still
+	// published to the previous one if it exists.
+	if pub.Status.APIID == api.GetKonnectID() && pub.Status.PortalID != "" && pub.Status.PortalID != portal.GetKonnectID() {
+		log.Info(logger, "portal changed in Konnect, unpublishing API from the previous one",
+			"previousPortalID", pub.Status.PortalID, "portalID", portal.GetKonnectID())
+		if err := ops.UnpublishAPI(ctx, sdk, pub.Status.APIID, pub.Status.PortalID); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := ops.PublishAPI(ctx, sdk, api.GetKonnectID(), portal.GetKonnectID(), &pub); err != nil {
+		setAPIPublicationNotProgrammed(&pub, konnectv1alpha1.KonnectEntityProgrammedReasonKonnectAPIOpFailed, err.Error())
+		if errStatus := r.patchStatus(ctx, old, &pub); errStatus != nil {
+			return ctrl.Result{}, errStatus
+		}
+		return ctrl.Result{}, err
+	}
+	log.Debug(logger, "published API in Konnect", "apiID", api.GetKonnectID(), "portalID", portal.GetKonnectID())
+
+	pub.Status.KonnectEntityStatus = konnectv1alpha2.KonnectEntityStatus{
+		ServerURL: serverURL,
+		OrgID:     api.Status.OrgID,
+	}
+	pub.Status.APIID = api.GetKonnectID()
+	pub.Status.PortalID = portal.GetKonnectID()
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionTrue,
+			konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+			"",
+			pub.GetGeneration(),
+		),
+		&pub,
+	)
+	return ctrl.Result{RequeueAfter: r.syncPeriod}, r.patchStatus(ctx, old, &pub)
+}
+
+// getPortal returns the Portal referenced by the APIPublication
+// or nil when it doesn't exist.
+func (r *APIPublicationReconciler) getPortal(
+	ctx context.Context,
+	pub *konnectv1alpha1.APIPublication,
+) (*konnectv1alpha1.Portal, error) {
+	var portal konnectv1alpha1.Portal
+	nn := types.NamespacedName{Namespace: pub.Namespace, Name: pub.Spec.PortalRef.Name}
+	if err := r.client.Get(ctx, nn, &portal); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting Portal %s: %w", nn, err)
+	}
+	return &portal, nil
+}
+
+// unpublish removes the publication from Konnect and drops the finalizer.
+func (r *APIPublicationReconciler) unpublish(
+	ctx context.Context,
+	pub *konnectv1alpha1.APIPublication,
+) error {
+	if !controllerutil.ContainsFinalizer(pub, KonnectCleanupFinalizer) {
+		return nil
+	}
+
+	if pub.Status.APIID != "" && pub.Status.PortalID != "" {
+		api, err := getKonnectAPI(ctx, r.client, pub.Namespace, pub.Spec.APIRef.Name)
+		if err != nil {
+			return err
+		}
+		// When the API is gone, so are its publications in Konnect.
+		if api != nil && api.GetKonnectID() == pub.Status.APIID {
+			sdk, _, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, api)
+			if err != nil {
+				return err
+			}
+			if err := ops.UnpublishAPI(ctx, sdk, pub.Status.APIID, pub.Status.PortalID); err != nil {
+				return err
+			}
+		}
+	}
+
+	old := pub.DeepCopy()
+	controllerutil.RemoveFinalizer(pub, KonnectCleanupFinalizer)
+	if err := r.client.Patch(ctx, pub, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed removing finalizer from APIPublication %s: %w", client.ObjectKeyFromObject(pub), err)
+	}
+	return nil
+}
+
+func (r *APIPublicationReconciler) patchStatus(
+	ctx context.Context,
+	old, pub *konnectv1alpha1.APIPublication,
+) error {
+	if err := r.client.Status().Patch(ctx, pub, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed updating APIPublication %s status: %w",
+			client.ObjectKeyFromObject(pub), err,
+		)
+	}
+	return nil
+}
+
+func setAPIPublicationNotProgrammed(
+	pub *konnectv1alpha1.APIPublication,
+	reason apiconsts.ConditionReason,
+	msg string,
+) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionFalse,
+			reason,
+			msg,
+			pub.GetGeneration(),
+		),
+		pub,
+	)
+}
@@ -0,0 +1,5 @@
+package konnect
+
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apipublications,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apipublications/status,verbs=update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=apipublications/finalizers,verbs=update;patch
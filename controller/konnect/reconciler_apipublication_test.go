@@ -0,0 +1,175 @@
+package konnect
+
+import (
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/mocks/sdkmocks"
+)
+
+func TestAPIPublicationReconciler(t *testing.T) {
+	const (
+		syncPeriod = time.Minute
+		ns         = "default"
+	)
+	nn := types.NamespacedName{Namespace: ns, Name: "publication"}
+
+	objects := func(portalID string, status konnectv1alpha1.APIPublicationStatus) []client.Object {
+		return []client.Object{
+			&konnectv1alpha1.KonnectAPIAuthConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+					Type:      konnectv1alpha1.KonnectAPIAuthTypeToken,
+					Token:     "kpat_token",
+					ServerURL: "us.api.konghq.com",
+				},
+			},
+			&konnectv1alpha1.KonnectAPI{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectAPISpec{
+					KonnectConfiguration: konnectv1alpha2.KonnectConfiguration{
+						APIAuthConfigurationRef: konnectv1alpha2.KonnectAPIAuthConfigurationRef{Name: "auth"},
+					},
+				},
+				Status: konnectv1alpha1.KonnectAPIStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "api-id", OrgID: "org-id"},
+				},
+			},
+			&konnectv1alpha1.Portal{
+				ObjectMeta: metav1.ObjectMeta{Name: "portal", Namespace: ns},
+				Status: konnectv1alpha1.PortalStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: portalID},
+				},
+			},
+			&konnectv1alpha1.APIPublication{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       nn.Name,
+					Namespace:  nn.Namespace,
+					Finalizers: []string{KonnectCleanupFinalizer},
+				},
+				Spec: konnectv1alpha1.APIPublicationSpec{
+					APIRef:                   commonv1alpha1.NameRef{Name: "api"},
+					PortalRef:                commonv1alpha1.NameRef{Name: "portal"},
+					Visibility:               konnectv1alpha1.APIPublicationVisibilityPublic,
+					AutoApproveRegistrations: new(true),
+				},
+				Status: status,
+			},
+		}
+	}
+	expectPublish := func(sdk *sdkmocks.MockSDKWrapper, portalID string) {
+		sdk.APIPublicationSDK.EXPECT().
+			PublishAPIToPortal(mock.Anything, mock.MatchedBy(func(req sdkkonnectops.PublishAPIToPortalRequest) bool {
+				return req.APIID == "api-id" && req.PortalID == portalID &&
+					req.APIPublication.Visibility != nil &&
+					*req.APIPublication.Visibility == sdkkonnectcomp.APIPublicationVisibilityPublic &&
+					req.APIPublication.AutoApproveRegistrations != nil && *req.APIPublication.AutoApproveRegistrations
+			})).
+			Return(&sdkkonnectops.PublishAPIToPortalResponse{}, nil)
+	}
+
+	testCases := []struct {
+		name           string
+		portalID       string
+		status         konnectv1alpha1.APIPublicationStatus
+		expectSDKCalls func(*sdkmocks.MockSDKWrapper)
+	}{
+		{
+			name:     "API is published",
+			portalID: "portal-id",
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				expectPublish(sdk, "portal-id")
+			},
+		},
+		{
+			name:     "published API is published again",
+			portalID: "portal-id",
+			status: konnectv1alpha1.APIPublicationStatus{
+				APIID:    "api-id",
+				PortalID: "portal-id",
+			},
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				expectPublish(sdk, "portal-id")
+			},
+		},
+		{
+			name:     "API published to the previous portal is unpublished from it",
+			portalID: "new-portal-id",
+			status: konnectv1alpha1.APIPublicationStatus{
+				APIID:    "api-id",
+				PortalID: "portal-id",
+			},
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.APIPublicationSDK.EXPECT().
+					DeletePublication(mock.Anything, "api-id", "portal-id").
+					Return(&sdkkonnectops.DeletePublicationResponse{}, nil)
+				expectPublish(sdk, "new-portal-id")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(objects(tc.portalID, tc.status)...).
+				WithStatusSubresource(
+					&konnectv1alpha1.APIPublication{},
+					&konnectv1alpha1.KonnectAPI{},
+					&konnectv1alpha1.Portal{},
+				).
+				Build()
+
+			factory := sdkmocks.NewMockSDKFactory(t)
+			tc.expectSDKCalls(factory.SDK)
+
+			r := NewAPIPublicationReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, syncPeriod)
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+			require.NoError(t, err)
+			assert.Equal(t, syncPeriod, res.RequeueAfter)
+
+			var pub konnectv1alpha1.APIPublication
+			require.NoError(t, cl.Get(t.Context(), nn, &pub))
+			assert.Equal(t, "api-id", pub.Status.APIID)
+			assert.Equal(t, tc.portalID, pub.Status.PortalID)
+			assert.Equal(t, "org-id", pub.Status.OrgID)
+		})
+	}
+
+	t.Run("portal not Programmed", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects("", konnectv1alpha1.APIPublicationStatus{})...).
+			WithStatusSubresource(&konnectv1alpha1.APIPublication{}).
+			Build()
+
+		factory := sdkmocks.NewMockSDKFactory(t)
+		r := NewAPIPublicationReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, syncPeriod)
+		res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Equal(t, konnectRefNotProgrammedRequeueAfter, res.RequeueAfter)
+
+		var pub konnectv1alpha1.APIPublication
+		require.NoError(t, cl.Get(t.Context(), nn, &pub))
+		require.Len(t, pub.Status.Conditions, 1)
+		assert.Equal(t, metav1.ConditionFalse, pub.Status.Conditions[0].Status)
+		assert.Equal(t, konnectv1alpha1.APIPublicationReasonPortalNotProgrammed, pub.Status.Conditions[0].Reason)
+	})
+}
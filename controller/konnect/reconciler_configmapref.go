@@ -0,0 +1,88 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/api/common/consts"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/konnect/constraints"
+	"github.com/kong/kong-operator/v2/controller/pkg/patch"
+	"github.com/kong/kong-operator/v2/internal/utils/crossnamespace"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// handleConfigMapRef handles the ConfigMap references of the given entity.
+// Cross-namespace references must be permitted by a KongReferenceGrant in the
+// ConfigMap's namespace, the ConfigMap is not read otherwise.
+func handleConfigMapRef[T constraints.SupportedKonnectEntityType, TEnt constraints.EntityType[T]](
+	ctx context.Context,
+	cl client.Client,
+	ent TEnt,
+) (ctrl.Result, bool, error) {
+	if !ent.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, false, nil
+	}
+	refs, ok := configMapRefsForSensitiveData(ent)
+	if !ok {
+		return ctrl.Result{}, false, nil
+	}
+
+	var entityHasCrossNamespaceRefs bool
+	for _, ref := range refs {
+		if ref.Namespace == nil || *ref.Namespace == "" || *ref.Namespace == ent.GetNamespace() {
+			continue
+		}
+		entityHasCrossNamespaceRefs = true
+
+		err := crossnamespace.CheckKongReferenceGrantForResource(
+			ctx,
+			cl,
+			ent.GetNamespace(),
+			*ref.Namespace,
+			ref.Name,
+			metav1.GroupVersionKind(ent.GetObjectKind().GroupVersionKind()),
+			metav1.GroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+		)
+		if err != nil {
+			if crossnamespace.IsReferenceNotGranted(err) {
+				if res, errStatus := patch.StatusWithCondition(
+					ctx, cl, ent,
+					consts.ConditionType(configurationv1alpha1.KongReferenceGrantConditionTypeResolvedRefs),
+					metav1.ConditionFalse,
+					configurationv1alpha1.KongReferenceGrantReasonRefNotPermitted,
+					fmt.Sprintf("KongReferenceGrants do not allow access to ConfigMap %s/%s", *ref.Namespace, ref.Name),
+				); errStatus != nil || !res.IsZero() {
+					return res, true, errStatus
+				}
+				return ctrl.Result{}, true, nil
+			}
+			return ctrl.Result{}, true, err
+		}
+	}
+
+	if !entityHasCrossNamespaceRefs {
+		return ctrl.Result{}, false, nil
+	}
+	// Cross-namespace Secret references of the entity may have set the condition already.
+	if cond, ok := k8sutils.GetCondition(
+		consts.ConditionType(configurationv1alpha1.KongReferenceGrantConditionTypeResolvedRefs), ent,
+	); ok && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == ent.GetGeneration() {
+		return ctrl.Result{}, false, nil
+	}
+	if res, errStatus := patch.StatusWithCondition(
+		ctx, cl, ent,
+		consts.ConditionType(configurationv1alpha1.KongReferenceGrantConditionTypeResolvedRefs),
+		metav1.ConditionTrue,
+		configurationv1alpha1.KongReferenceGrantReasonResolvedRefs,
+		"KongReferenceGrants allow access to ConfigMaps",
+	); errStatus != nil || !res.IsZero() {
+		return res, true, errStatus
+	}
+	return ctrl.Result{}, false, nil
+}
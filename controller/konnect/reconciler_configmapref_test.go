@@ -0,0 +1,135 @@
+package konnect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kong-operator/v2/api/common/consts"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+func TestHandleConfigMapRef(t *testing.T) {
+	apiSpecification := func(configMapNamespace string) *konnectv1alpha1.APISpecification {
+		return &konnectv1alpha1.APISpecification{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: konnectv1alpha1.GroupVersion.String(),
+				Kind:       "APISpecification",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "spec",
+				Namespace: "api-ns",
+			},
+			Spec: konnectv1alpha1.APISpecificationSpec{
+				APISpec: konnectv1alpha1.APISpecificationAPISpec{
+					Content: konnectv1alpha1.ConfigMapDataSource{
+						Type: konnectv1alpha1.ConfigMapDataSourceTypeConfigMapRef,
+						ConfigMapRef: &konnectv1alpha1.ConfigMapKeyRef{
+							Name:      "openapi",
+							Key:       "openapi.yaml",
+							Namespace: configMapNamespace,
+						},
+					},
+				},
+			},
+		}
+	}
+	grant := func(configMapName string) *configurationv1alpha1.KongReferenceGrant {
+		return &configurationv1alpha1.KongReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "allow-spec-to-configmap",
+				Namespace: "configmap-ns",
+			},
+			Spec: configurationv1alpha1.KongReferenceGrantSpec{
+				From: []configurationv1alpha1.ReferenceGrantFrom{
+					{
+						Group:     "konnect.konghq.com",
+						Kind:      "APISpecification",
+						Namespace: "api-ns",
+					},
+				},
+				To: []configurationv1alpha1.ReferenceGrantTo{
+					{
+						Group: "core",
+						Kind:  "ConfigMap",
+						Name:  new(configurationv1alpha1.ObjectName(configMapName)),
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		spec            *konnectv1alpha1.APISpecification
+		grants          []client.Object
+		expectStop      bool
+		expectCondition *metav1.ConditionStatus
+		expectReason    string
+	}{
+		{
+			name: "ConfigMap in the same namespace",
+			spec: apiSpecification(""),
+		},
+		{
+			name: "ConfigMap in the same namespace set explicitly",
+			spec: apiSpecification("api-ns"),
+		},
+		{
+			name:            "cross-namespace ConfigMap without a KongReferenceGrant",
+			spec:            apiSpecification("configmap-ns"),
+			expectStop:      true,
+			expectCondition: new(metav1.ConditionFalse),
+			expectReason:    configurationv1alpha1.KongReferenceGrantReasonRefNotPermitted,
+		},
+		{
+			name:            "cross-namespace ConfigMap with a KongReferenceGrant for another ConfigMap",
+			spec:            apiSpecification("configmap-ns"),
+			grants:          []client.Object{grant("other")},
+			expectStop:      true,
+			expectCondition: new(metav1.ConditionFalse),
+			expectReason:    configurationv1alpha1.KongReferenceGrantReasonRefNotPermitted,
+		},
+		{
+			name:            "cross-namespace ConfigMap with a KongReferenceGrant",
+			spec:            apiSpecification("configmap-ns"),
+			grants:          []client.Object{grant("openapi")},
+			expectCondition: new(metav1.ConditionTrue),
+			expectReason:    configurationv1alpha1.KongReferenceGrantReasonResolvedRefs,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(tc.spec).
+				WithObjects(tc.grants...).
+				WithStatusSubresource(tc.spec).
+				Build()
+
+			res, stop, err := handleConfigMapRef(t.Context(), cl, tc.spec)
+			require.NoError(t, err)
+			assert.True(t, res.IsZero())
+			assert.Equal(t, tc.expectStop, stop)
+
+			cond, ok := k8sutils.GetCondition(
+				consts.ConditionType(configurationv1alpha1.KongReferenceGrantConditionTypeResolvedRefs), tc.spec,
+			)
+			if tc.expectCondition == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, *tc.expectCondition, cond.Status)
+			assert.Equal(t, tc.expectReason, cond.Reason)
+		})
+	}
+}
@@ -36,6 +36,10 @@ func getConsumerRef[T constraints.SupportedKonnectEntityType, TEnt constraints.E
 		return mo.Some(e.Spec.ConsumerRef)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		return mo.Some(e.Spec.ConsumerRef)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		return mo.Some(e.Spec.ConsumerRef)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		return mo.Some(e.Spec.ConsumerRef)
 	default:
 		return mo.None[corev1.LocalObjectReference]()
 	}
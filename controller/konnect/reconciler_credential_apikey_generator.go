@@ -0,0 +1,514 @@
+package konnect
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	apiconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+const (
+	// KongCredentialAPIKeyGeneratorLabel is the label set on the Secrets and
+	// KongCredentialAPIKeys managed by a KongCredentialAPIKeyGenerator.
+	// Its value is the name of the generator.
+	KongCredentialAPIKeyGeneratorLabel = "konghq.com/credential-generator" //nolint:gosec
+
+	// KongCredentialAPIKeyGeneratorRotationTimeAnnotation is the annotation set on the Secrets managed
+	// by a KongCredentialAPIKeyGenerator. Its value is the time (RFC3339) the key stored in the Secret
+	// was generated at.
+	KongCredentialAPIKeyGeneratorRotationTimeAnnotation = "konghq.com/credential-rotation-time"
+	// KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation is the annotation set on the Secrets managed
+	// by a KongCredentialAPIKeyGenerator. Its value is the name of the KongCredentialAPIKey holding the key
+	// replaced by the last rotation.
+	KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation = "konghq.com/credential-previous"
+	// KongCredentialAPIKeyGeneratorPreviousCredentialExpirationAnnotation is the annotation set on the Secrets
+	// managed by a KongCredentialAPIKeyGenerator. Its value is the time (RFC3339) the KongCredentialAPIKey
+	// holding the key replaced by the last rotation is deleted at.
+	KongCredentialAPIKeyGeneratorPreviousCredentialExpirationAnnotation = "konghq.com/credential-previous-expiration"
+)
+
+// KongCredentialAPIKeyGeneratorReconciler reconciles KongCredentialAPIKeyGenerators.
+//
+// It generates a random key, stores it in a Secret owned by the generator and
+// makes sure a KongCredentialAPIKey holding that key exists for the referenced
+// KongConsumer. The KongCredentialAPIKey is then synced to Konnect by its own
+// controller. When rotation is configured a new key (and credential) is generated
+// every interval and the previous credential is deleted once the overlap window
+// has passed. The rotation state is recorded on the Secret along with the key,
+// so that it's never lost when updating the generator's status fails.
+type KongCredentialAPIKeyGeneratorReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	scheme            *runtime.Scheme
+	now               func() time.Time
+	generateKey       func(length int32) (string, error)
+}
+
+// NewKongCredentialAPIKeyGeneratorReconciler creates a new KongCredentialAPIKeyGeneratorReconciler.
+func NewKongCredentialAPIKeyGeneratorReconciler(
+	ctrlOptions controller.Options,
+	loggingMode logging.Mode,
+	cl client.Client,
+	scheme *runtime.Scheme,
+) *KongCredentialAPIKeyGeneratorReconciler {
+	return &KongCredentialAPIKeyGeneratorReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		scheme:            scheme,
+		now:               time.Now,
+		generateKey:       generateAPIKey,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KongCredentialAPIKeyGeneratorReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KongCredentialAPIKeyGenerator").
+		WithOptions(r.controllerOptions).
+		For(&configurationv1alpha1.KongCredentialAPIKeyGenerator{}).
+		Owns(&corev1.Secret{}).
+		Owns(&configurationv1alpha1.KongCredentialAPIKey{}).
+		Complete(r)
+}
+
+// Reconcile reconciles a KongCredentialAPIKeyGenerator.
+func (r *KongCredentialAPIKeyGeneratorReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+	if err := r.client.Get(ctx, req.NamespacedName, &gen); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "KongCredentialAPIKeyGenerator", r.loggingMode)
+	log.Debug(logger, "reconciling")
+
+	// Owned Secret and credentials are garbage collected through owner references.
+	if !gen.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := gen.DeepCopy()
+	now := r.now()
+
+	creds, err := r.listCredentials(ctx, &gen)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	secret, err := r.ensureSecret(ctx, &gen, creds, now)
+	if err != nil {
+		if conflictErr := (secretConflictError{}); errors.As(err, &conflictErr) {
+			k8sutils.SetCondition(
+				k8sutils.NewConditionWithGeneration(
+					konnectv1alpha1.KonnectEntityProgrammedConditionType,
+					metav1.ConditionFalse,
+					configurationv1alpha1.KongCredentialAPIKeyGeneratorReasonSecretConflict,
+					err.Error(),
+					gen.GetGeneration(),
+				),
+				&gen,
+			)
+			return ctrl.Result{}, r.patchStatus(ctx, old, &gen)
+		}
+		return ctrl.Result{}, err
+	}
+	key := string(secret.Data[CredentialSecretKeyNameAPIKeyKey])
+
+	current, err := r.ensureCurrentCredential(ctx, &gen, creds, key)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	gen.Status.CurrentCredential = current.Name
+
+	if err := r.deleteExpiredCredentials(ctx, &gen, creds, current, now); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	setAPIKeyGeneratorNextRotationTime(&gen)
+	setKongCredentialAPIKeyGeneratorProgrammed(&gen, current)
+
+	if err := r.patchStatus(ctx, old, &gen); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfterForAPIKeyGenerator(&gen, now)}, nil
+}
+
+// secretConflictError is returned when the Secret the generated key should be
+// stored in exists but is not owned by the generator.
+type secretConflictError struct {
+	secret types.NamespacedName
+}
+
+func (e secretConflictError) Error() string {
+	return fmt.Sprintf("Secret %s already exists and is not owned by the KongCredentialAPIKeyGenerator", e.secret)
+}
+
+// ensureSecret makes sure the Secret holding the current key exists and
+// rotates the key when rotation is due. The rotation time and the credential
+// holding the replaced key are recorded on the Secret in the same write as the
+// key and the generator's status is restored from them, so that a failed status
+// update neither rotates the key again nor loses the previous credential.
+func (r *KongCredentialAPIKeyGeneratorReconciler) ensureSecret(
+	ctx context.Context,
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	creds []configurationv1alpha1.KongCredentialAPIKey,
+	now time.Time,
+) (*corev1.Secret, error) {
+	nn := types.NamespacedName{Namespace: gen.Namespace, Name: gen.GetSecretName()}
+	gen.Status.SecretName = nn.Name
+
+	var secret corev1.Secret
+	err := r.client.Get(ctx, nn, &secret)
+	switch {
+	case apierrors.IsNotFound(err):
+		key, err := r.generateKey(gen.Spec.KeyLength)
+		if err != nil {
+			return nil, err
+		}
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nn.Name,
+				Namespace: nn.Namespace,
+				Labels: map[string]string{
+					KongCredentialAPIKeyGeneratorLabel: gen.Name,
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				CredentialSecretKeyNameAPIKeyKey: []byte(key),
+			},
+		}
+		setAPIKeyGeneratorRotationAnnotations(&secret, now, nil)
+		if err := controllerutil.SetControllerReference(gen, &secret, r.scheme); err != nil {
+			return nil, err
+		}
+		if err := r.client.Create(ctx, &secret); err != nil {
+			return nil, fmt.Errorf("failed creating Secret %s: %w", nn, err)
+		}
+		restoreAPIKeyGeneratorRotationState(gen, &secret)
+		return &secret, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("failed getting Secret %s: %w", nn, err)
+	}
+
+	if !metav1.IsControlledBy(&secret, gen) {
+		return nil, secretConflictError{secret: nn}
+	}
+
+	restoreAPIKeyGeneratorRotationState(gen, &secret)
+
+	rotate := len(secret.Data[CredentialSecretKeyNameAPIKeyKey]) == 0
+	if rotation := gen.Spec.Rotation; rotation != nil && gen.Status.LastRotationTime != nil &&
+		!now.Before(gen.Status.LastRotationTime.Add(rotation.Interval.Duration)) {
+		rotate = true
+	}
+	if !rotate && gen.Status.LastRotationTime != nil {
+		return &secret, nil
+	}
+
+	oldSecret := secret.DeepCopy()
+	if rotate {
+		key, err := r.generateKey(gen.Spec.KeyLength)
+		if err != nil {
+			return nil, err
+		}
+		var prev *configurationv1alpha1.KongCredentialAPIKeyGeneratorPreviousCredential
+		if name := credentialHoldingKey(creds, string(secret.Data[CredentialSecretKeyNameAPIKeyKey])); name != "" && gen.Spec.Rotation != nil {
+			prev = &configurationv1alpha1.KongCredentialAPIKeyGeneratorPreviousCredential{
+				Name:           name,
+				ExpirationTime: metav1.NewTime(now.Add(gen.Spec.Rotation.GetOverlap())),
+			}
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[CredentialSecretKeyNameAPIKeyKey] = []byte(key)
+		setAPIKeyGeneratorRotationAnnotations(&secret, now, prev)
+	} else {
+		// The Secret was created without recording the rotation time: the key is considered generated now.
+		setAPIKeyGeneratorRotationAnnotations(&secret, now, gen.Status.PreviousCredential)
+	}
+	if err := r.client.Patch(ctx, &secret, client.MergeFrom(oldSecret)); err != nil {
+		return nil, fmt.Errorf("failed rotating key in Secret %s: %w", nn, err)
+	}
+	restoreAPIKeyGeneratorRotationState(gen, &secret)
+	return &secret, nil
+}
+
+// credentialHoldingKey returns the name of the credential holding the given key,
+// or an empty string when there's none.
+func credentialHoldingKey(creds []configurationv1alpha1.KongCredentialAPIKey, key string) string {
+	if key == "" {
+		return ""
+	}
+	for _, cred := range creds {
+		if cred.Spec.Key == key && cred.DeletionTimestamp.IsZero() {
+			return cred.Name
+		}
+	}
+	return ""
+}
+
+// setAPIKeyGeneratorRotationAnnotations records the rotation time and the credential
+// holding the replaced key (if any) in the Secret's annotations.
+func setAPIKeyGeneratorRotationAnnotations(
+	secret *corev1.Secret,
+	now time.Time,
+	prev *configurationv1alpha1.KongCredentialAPIKeyGeneratorPreviousCredential,
+) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[KongCredentialAPIKeyGeneratorRotationTimeAnnotation] = now.UTC().Format(time.RFC3339)
+	if prev == nil {
+		delete(secret.Annotations, KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation)
+		delete(secret.Annotations, KongCredentialAPIKeyGeneratorPreviousCredentialExpirationAnnotation)
+		return
+	}
+	secret.Annotations[KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation] = prev.Name
+	secret.Annotations[KongCredentialAPIKeyGeneratorPreviousCredentialExpirationAnnotation] = prev.ExpirationTime.UTC().Format(time.RFC3339)
+}
+
+// restoreAPIKeyGeneratorRotationState sets the rotation times and the previous credential
+// in the generator's status from the Secret's annotations. The status is left untouched
+// when the Secret doesn't record the rotation time.
+func restoreAPIKeyGeneratorRotationState(
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	secret *corev1.Secret,
+) {
+	rotationTime, err := time.Parse(time.RFC3339, secret.Annotations[KongCredentialAPIKeyGeneratorRotationTimeAnnotation])
+	if err != nil {
+		return
+	}
+	setAPIKeyGeneratorRotationTimes(gen, rotationTime)
+
+	gen.Status.PreviousCredential = nil
+	name := secret.Annotations[KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation]
+	expiration, err := time.Parse(time.RFC3339, secret.Annotations[KongCredentialAPIKeyGeneratorPreviousCredentialExpirationAnnotation])
+	if name != "" && err == nil {
+		gen.Status.PreviousCredential = &configurationv1alpha1.KongCredentialAPIKeyGeneratorPreviousCredential{
+			Name:           name,
+			ExpirationTime: metav1.NewTime(expiration),
+		}
+	}
+}
+
+func (r *KongCredentialAPIKeyGeneratorReconciler) listCredentials(
+	ctx context.Context,
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+) ([]configurationv1alpha1.KongCredentialAPIKey, error) {
+	var l configurationv1alpha1.KongCredentialAPIKeyList
+	if err := r.client.List(ctx, &l,
+		client.InNamespace(gen.Namespace),
+		client.MatchingLabels{KongCredentialAPIKeyGeneratorLabel: gen.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed listing KongCredentialAPIKeys: %w", err)
+	}
+
+	creds := make([]configurationv1alpha1.KongCredentialAPIKey, 0, len(l.Items))
+	for _, cred := range l.Items {
+		if metav1.IsControlledBy(&cred, gen) {
+			creds = append(creds, cred)
+		}
+	}
+	return creds, nil
+}
+
+// ensureCurrentCredential returns the KongCredentialAPIKey holding the current
+// key, creating it when it doesn't exist yet.
+func (r *KongCredentialAPIKeyGeneratorReconciler) ensureCurrentCredential(
+	ctx context.Context,
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	creds []configurationv1alpha1.KongCredentialAPIKey,
+	key string,
+) (*configurationv1alpha1.KongCredentialAPIKey, error) {
+	for i := range creds {
+		cred := &creds[i]
+		if cred.Spec.Key != key || !cred.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// The generator's consumerRef is immutable, so only the tags can get out of sync.
+		if slices.Equal(cred.Spec.Tags, gen.Spec.Tags) {
+			return cred, nil
+		}
+		oldCred := cred.DeepCopy()
+		cred.Spec.Tags = gen.Spec.Tags
+		if err := r.client.Patch(ctx, cred, client.MergeFrom(oldCred)); err != nil {
+			return nil, fmt.Errorf("failed updating KongCredentialAPIKey %s: %w", client.ObjectKeyFromObject(cred), err)
+		}
+		return cred, nil
+	}
+
+	cred := &configurationv1alpha1.KongCredentialAPIKey{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: gen.Name + "-",
+			Namespace:    gen.Namespace,
+			Labels: map[string]string{
+				KongCredentialAPIKeyGeneratorLabel: gen.Name,
+			},
+		},
+		Spec: configurationv1alpha1.KongCredentialAPIKeySpec{
+			ConsumerRef: gen.Spec.ConsumerRef,
+			KongCredentialAPIKeyAPISpec: configurationv1alpha1.KongCredentialAPIKeyAPISpec{
+				Key:  key,
+				Tags: gen.Spec.Tags,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(gen, cred, r.scheme); err != nil {
+		return nil, err
+	}
+	if err := r.client.Create(ctx, cred); err != nil {
+		return nil, fmt.Errorf("failed creating KongCredentialAPIKey for KongCredentialAPIKeyGenerator %s: %w",
+			client.ObjectKeyFromObject(gen), err,
+		)
+	}
+	return cred, nil
+}
+
+// deleteExpiredCredentials deletes all the credentials managed by the generator
+// which neither hold the current key nor the previous key within its overlap window.
+func (r *KongCredentialAPIKeyGeneratorReconciler) deleteExpiredCredentials(
+	ctx context.Context,
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	creds []configurationv1alpha1.KongCredentialAPIKey,
+	current *configurationv1alpha1.KongCredentialAPIKey,
+	now time.Time,
+) error {
+	prev := gen.Status.PreviousCredential
+	if prev != nil && (!now.Before(prev.ExpirationTime.Time) || prev.Name == current.Name) {
+		gen.Status.PreviousCredential = nil
+		prev = nil
+	}
+
+	for i := range creds {
+		cred := &creds[i]
+		if cred.Name == current.Name || (prev != nil && cred.Name == prev.Name) {
+			continue
+		}
+		if !cred.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.client.Delete(ctx, cred); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting expired KongCredentialAPIKey %s: %w", client.ObjectKeyFromObject(cred), err)
+		}
+	}
+	return nil
+}
+
+func (r *KongCredentialAPIKeyGeneratorReconciler) patchStatus(
+	ctx context.Context,
+	old, gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+) error {
+	if err := r.client.Status().Patch(ctx, gen, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed updating KongCredentialAPIKeyGenerator %s status: %w",
+			client.ObjectKeyFromObject(gen), err,
+		)
+	}
+	return nil
+}
+
+// setKongCredentialAPIKeyGeneratorProgrammed mirrors the Programmed condition of
+// the current credential on the generator.
+func setKongCredentialAPIKeyGeneratorProgrammed(
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	current *configurationv1alpha1.KongCredentialAPIKey,
+) {
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, current)
+	if !ok || cond.Status == metav1.ConditionUnknown {
+		k8sutils.SetCondition(
+			k8sutils.NewConditionWithGeneration(
+				konnectv1alpha1.KonnectEntityProgrammedConditionType,
+				metav1.ConditionFalse,
+				configurationv1alpha1.KongCredentialAPIKeyGeneratorReasonPending,
+				fmt.Sprintf("KongCredentialAPIKey %s is not Programmed yet", current.Name),
+				gen.GetGeneration(),
+			),
+			gen,
+		)
+		return
+	}
+
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			cond.Status,
+			apiconsts.ConditionReason(cond.Reason),
+			cond.Message,
+			gen.GetGeneration(),
+		),
+		gen,
+	)
+}
+
+func setAPIKeyGeneratorRotationTimes(gen *configurationv1alpha1.KongCredentialAPIKeyGenerator, now time.Time) {
+	gen.Status.LastRotationTime = new(metav1.NewTime(now))
+	setAPIKeyGeneratorNextRotationTime(gen)
+}
+
+func setAPIKeyGeneratorNextRotationTime(gen *configurationv1alpha1.KongCredentialAPIKeyGenerator) {
+	gen.Status.NextRotationTime = nil
+	if rotation := gen.Spec.Rotation; rotation != nil && gen.Status.LastRotationTime != nil {
+		gen.Status.NextRotationTime = new(metav1.NewTime(gen.Status.LastRotationTime.Add(rotation.Interval.Duration)))
+	}
+}
+
+// requeueAfterForAPIKeyGenerator returns the time after which the generator
+// should be reconciled again: either for the next rotation or for deleting
+// the previous credential when its overlap window ends.
+func requeueAfterForAPIKeyGenerator(
+	gen *configurationv1alpha1.KongCredentialAPIKeyGenerator,
+	now time.Time,
+) time.Duration {
+	var next time.Time
+	if gen.Status.NextRotationTime != nil {
+		next = gen.Status.NextRotationTime.Time
+	}
+	if prev := gen.Status.PreviousCredential; prev != nil && (next.IsZero() || prev.ExpirationTime.Time.Before(next)) {
+		next = prev.ExpirationTime.Time
+	}
+	if next.IsZero() {
+		return 0
+	}
+	return max(next.Sub(now), time.Second)
+}
+
+// generateAPIKey returns a cryptographically random key built from length bytes.
+func generateAPIKey(length int32) (string, error) {
+	if length <= 0 {
+		length = 32
+	}
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed generating API key: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
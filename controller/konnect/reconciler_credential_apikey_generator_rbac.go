@@ -0,0 +1,9 @@
+package konnect
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialapikeygenerators,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialapikeygenerators/status,verbs=update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialapikeygenerators/finalizers,verbs=update;patch
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialapikeys,verbs=get;list;watch;create;update;patch;delete
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
@@ -0,0 +1,252 @@
+package konnect
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+func TestKongCredentialAPIKeyGeneratorReconciler(t *testing.T) {
+	var (
+		start = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		nn    = types.NamespacedName{Namespace: "default", Name: "gen"}
+	)
+
+	newGenerator := func(rotation *configurationv1alpha1.KongCredentialAPIKeyRotation) *configurationv1alpha1.KongCredentialAPIKeyGenerator {
+		return &configurationv1alpha1.KongCredentialAPIKeyGenerator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nn.Name,
+				Namespace: nn.Namespace,
+				UID:       types.UID("gen-uid"),
+			},
+			Spec: configurationv1alpha1.KongCredentialAPIKeyGeneratorSpec{
+				ConsumerRef: corev1.LocalObjectReference{Name: "consumer"},
+				KeyLength:   32,
+				Rotation:    rotation,
+				Tags:        []string{"team-a"},
+			},
+		}
+	}
+
+	newReconciler := func(t *testing.T, now *time.Time, objs ...client.Object) (*KongCredentialAPIKeyGeneratorReconciler, client.Client) {
+		t.Helper()
+
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objs...).
+			WithStatusSubresource(&configurationv1alpha1.KongCredentialAPIKeyGenerator{}).
+			Build()
+
+		var keys int
+		r := NewKongCredentialAPIKeyGeneratorReconciler(controller.Options{}, logging.DevelopmentMode, cl, scheme.Get())
+		r.now = func() time.Time { return *now }
+		r.generateKey = func(int32) (string, error) {
+			keys++
+			return fmt.Sprintf("key-%d", keys), nil
+		}
+		return r, cl
+	}
+
+	listCredentials := func(t *testing.T, cl client.Client) []configurationv1alpha1.KongCredentialAPIKey {
+		t.Helper()
+		var l configurationv1alpha1.KongCredentialAPIKeyList
+		require.NoError(t, cl.List(t.Context(), &l, client.InNamespace(nn.Namespace)))
+		return l.Items
+	}
+
+	t.Run("generates a key, stores it in a Secret and creates a credential", func(t *testing.T) {
+		now := start
+		r, cl := newReconciler(t, &now, newGenerator(nil))
+
+		res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Zero(t, res.RequeueAfter)
+
+		var secret corev1.Secret
+		require.NoError(t, cl.Get(t.Context(), nn, &secret))
+		assert.Equal(t, "key-1", string(secret.Data[CredentialSecretKeyNameAPIKeyKey]))
+		assert.Equal(t, nn.Name, secret.Labels[KongCredentialAPIKeyGeneratorLabel])
+
+		creds := listCredentials(t, cl)
+		require.Len(t, creds, 1)
+		assert.Equal(t, "key-1", creds[0].Spec.Key)
+		assert.Equal(t, "consumer", creds[0].Spec.ConsumerRef.Name)
+		assert.Equal(t, []string{"team-a"}, []string(creds[0].Spec.Tags))
+
+		var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		assert.Equal(t, nn.Name, gen.Status.SecretName)
+		assert.Equal(t, creds[0].Name, gen.Status.CurrentCredential)
+		assert.Nil(t, gen.Status.NextRotationTime)
+		cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &gen)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, configurationv1alpha1.KongCredentialAPIKeyGeneratorReasonPending, cond.Reason)
+
+		// Reconciling again without rotation is a no-op.
+		now = now.Add(24 * time.Hour)
+		_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		require.Len(t, listCredentials(t, cl), 1)
+	})
+
+	t.Run("rotates the key and keeps the previous credential for the overlap window", func(t *testing.T) {
+		now := start
+		r, cl := newReconciler(t, &now, newGenerator(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+			Interval: metav1.Duration{Duration: 24 * time.Hour},
+			Overlap:  &metav1.Duration{Duration: time.Hour},
+		}))
+
+		res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, res.RequeueAfter)
+		first := listCredentials(t, cl)
+		require.Len(t, first, 1)
+
+		now = start.Add(24 * time.Hour)
+		res, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, res.RequeueAfter, "should requeue when the overlap window ends")
+
+		var secret corev1.Secret
+		require.NoError(t, cl.Get(t.Context(), nn, &secret))
+		assert.Equal(t, "key-2", string(secret.Data[CredentialSecretKeyNameAPIKeyKey]))
+
+		creds := listCredentials(t, cl)
+		require.Len(t, creds, 2)
+
+		var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		require.NotNil(t, gen.Status.PreviousCredential)
+		assert.Equal(t, first[0].Name, gen.Status.PreviousCredential.Name)
+		assert.True(t, gen.Status.PreviousCredential.ExpirationTime.Time.Equal(now.Add(time.Hour)))
+		assert.NotEqual(t, first[0].Name, gen.Status.CurrentCredential)
+		require.NotNil(t, gen.Status.NextRotationTime)
+		assert.True(t, gen.Status.NextRotationTime.Time.Equal(now.Add(24*time.Hour)))
+
+		now = now.Add(time.Hour)
+		_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		creds = listCredentials(t, cl)
+		require.Len(t, creds, 1)
+		assert.Equal(t, "key-2", creds[0].Spec.Key)
+
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		assert.Nil(t, gen.Status.PreviousCredential)
+		assert.Equal(t, creds[0].Name, gen.Status.CurrentCredential)
+	})
+
+	t.Run("keeps the rotation state when updating the status fails after rotating the key", func(t *testing.T) {
+		now := start
+		r, cl := newReconciler(t, &now, newGenerator(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+			Interval: metav1.Duration{Duration: 24 * time.Hour},
+			Overlap:  &metav1.Duration{Duration: time.Hour},
+		}))
+
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		first := listCredentials(t, cl)
+		require.Len(t, first, 1)
+		var beforeRotation configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &beforeRotation))
+
+		now = start.Add(24 * time.Hour)
+		_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		// Simulate the status update of the rotation being lost.
+		var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		gen.Status = beforeRotation.Status
+		require.NoError(t, cl.Status().Update(t.Context(), &gen))
+
+		now = now.Add(time.Minute)
+		_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		var secret corev1.Secret
+		require.NoError(t, cl.Get(t.Context(), nn, &secret))
+		assert.Equal(t, "key-2", string(secret.Data[CredentialSecretKeyNameAPIKeyKey]), "the key should not be rotated again")
+		assert.Equal(t, first[0].Name, secret.Annotations[KongCredentialAPIKeyGeneratorPreviousCredentialAnnotation])
+
+		creds := listCredentials(t, cl)
+		require.Len(t, creds, 2, "the credential holding the previous key should be kept for the overlap window")
+
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		require.NotNil(t, gen.Status.PreviousCredential)
+		assert.Equal(t, first[0].Name, gen.Status.PreviousCredential.Name)
+		assert.True(t, gen.Status.PreviousCredential.ExpirationTime.Time.Equal(start.Add(25*time.Hour)))
+		require.NotNil(t, gen.Status.LastRotationTime)
+		assert.True(t, gen.Status.LastRotationTime.Time.Equal(start.Add(24*time.Hour)))
+	})
+
+	t.Run("mirrors the Programmed condition of the current credential", func(t *testing.T) {
+		now := start
+		r, cl := newReconciler(t, &now, newGenerator(nil))
+
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		creds := listCredentials(t, cl)
+		require.Len(t, creds, 1)
+		cred := &creds[0]
+		cred.Status.Conditions = []metav1.Condition{
+			{
+				Type:               konnectv1alpha1.KonnectEntityProgrammedConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+				LastTransitionTime: metav1.Now(),
+			},
+		}
+		require.NoError(t, cl.Update(t.Context(), cred))
+
+		_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		assert.True(t, k8sutils.HasConditionWithStatus(konnectv1alpha1.KonnectEntityProgrammedConditionType, &gen, metav1.ConditionTrue))
+	})
+
+	t.Run("does not take over an existing Secret", func(t *testing.T) {
+		now := start
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nn.Name,
+				Namespace: nn.Namespace,
+			},
+			Data: map[string][]byte{
+				CredentialSecretKeyNameAPIKeyKey: []byte("user-provided"),
+			},
+		}
+		r, cl := newReconciler(t, &now, newGenerator(nil), secret)
+
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Empty(t, listCredentials(t, cl))
+
+		var gen configurationv1alpha1.KongCredentialAPIKeyGenerator
+		require.NoError(t, cl.Get(t.Context(), nn, &gen))
+		cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, &gen)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, configurationv1alpha1.KongCredentialAPIKeyGeneratorReasonSecretConflict, cond.Reason)
+	})
+}
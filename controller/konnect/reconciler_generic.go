@@ -30,6 +30,7 @@ import (
 	"github.com/kong/kong-operator/v2/controller/pkg/op"
 	"github.com/kong/kong-operator/v2/controller/pkg/patch"
 	"github.com/kong/kong-operator/v2/internal/metrics"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/internal/utils/crossnamespace"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
 	"github.com/kong/kong-operator/v2/pkg/consts"
@@ -134,7 +135,7 @@ func (r *KonnectEntityReconciler[T, TEnt]) SetupWithManager(ctx context.Context,
 	for _, dep := range ReconciliationWatchOptionsForEntity(r.Client, ent) {
 		b = dep(b)
 	}
-	return b.Complete(reconcile.AsReconciler(r.Client, r))
+	return b.Complete(reconcile.AsReconciler(r.Client, opentelemetry.ObjectReconciler[TEnt](entityTypeName, r)))
 }
 
 // Reconcile reconciles the given Konnect entity.
@@ -484,6 +485,15 @@ func (r *KonnectEntityReconciler[T, TEnt]) Reconcile(ctx context.Context, ent TE
 		return patchWithProgrammedStatusConditionBasedOnOtherConditions(ctx, r.Client, ent)
 	}
 
+	// If a type has ConfigMap refs, check the cross-namespace ones are permitted.
+	res, stop, err = handleConfigMapRef(ctx, r.Client, ent)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+	if stop {
+		return patchWithProgrammedStatusConditionBasedOnOtherConditions(ctx, r.Client, ent)
+	}
+
 	// If a type has a KonnectConfigStore ref (KongVault), handle it.
 	var resolvedConfigStoreID string
 	res, stop, resolvedConfigStoreID, err = handleConfigStoreRef(ctx, r.Client, ent)
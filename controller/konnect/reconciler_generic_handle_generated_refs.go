@@ -80,6 +80,10 @@ func init() {
 			gvk:     konnectv1alpha1.GroupVersion.WithKind("Portal"),
 			handler: parentRefHandler[konnectv1alpha1.Portal, *konnectv1alpha1.Portal]{},
 		},
+		{
+			gvk:     konnectv1alpha1.GroupVersion.WithKind("KonnectAPI"),
+			handler: parentRefHandler[konnectv1alpha1.KonnectAPI, *konnectv1alpha1.KonnectAPI]{},
+		},
 		{
 			gvk:     konnectv1alpha1.GroupVersion.WithKind("KonnectAIGateway"),
 			handler: parentRefHandler[konnectv1alpha1.KonnectAIGateway, *konnectv1alpha1.KonnectAIGateway]{},
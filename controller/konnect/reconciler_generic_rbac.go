@@ -64,10 +64,22 @@ package konnect
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialjwts/status,verbs=update;patch
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialjwts/finalizers,verbs=update;patch
 
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialmtlses,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialmtlses/status,verbs=update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialmtlses/finalizers,verbs=update;patch
+
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialoauth2s,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialoauth2s/status,verbs=update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcredentialoauth2s/finalizers,verbs=update;patch
+
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongdataplaneclientcertificates,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongdataplaneclientcertificates/status,verbs=update;patch
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongdataplaneclientcertificates/finalizers,verbs=update;patch
 
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcustomentities,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcustomentities/status,verbs=update;patch
+//+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongcustomentities/finalizers,verbs=update;patch
+
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongkeys,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongkeys/status,verbs=update;patch
 //+kubebuilder:rbac:groups=configuration.konghq.com,resources=kongkeys/finalizers,verbs=update;patch
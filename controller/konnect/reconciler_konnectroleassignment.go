@@ -0,0 +1,350 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/controller/konnect/constraints"
+	"github.com/kong/kong-operator/v2/controller/konnect/ops"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/konnect/server"
+	"github.com/kong/kong-operator/v2/controller/pkg/controlplane"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// konnectRefNotProgrammedRequeueAfter is the time after which an object waiting
+// for a referenced Konnect entity to be Programmed is reconciled again.
+const konnectRefNotProgrammedRequeueAfter = 10 * time.Second
+
+// KonnectRoleAssignmentReconciler reconciles KonnectRoleAssignments.
+//
+// It assigns a control plane scoped role to the referenced KonnectTeam or
+// KonnectSystemAccount once both the subject and the KonnectGatewayControlPlane
+// are Programmed, and removes the role when the KonnectRoleAssignment is deleted.
+// Assigned roles are checked every sync period and assigned again when they
+// have been removed in Konnect or the subject or control plane have changed.
+type KonnectRoleAssignmentReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	sdkFactory        sdkops.SDKFactory
+	syncPeriod        time.Duration
+}
+
+// NewKonnectRoleAssignmentReconciler creates a new KonnectRoleAssignmentReconciler.
+func NewKonnectRoleAssignmentReconciler(
+	ctrlOptions controller.Options,
+	sdkFactory sdkops.SDKFactory,
+	loggingMode logging.Mode,
+	cl client.Client,
+	syncPeriod time.Duration,
+) *KonnectRoleAssignmentReconciler {
+	return &KonnectRoleAssignmentReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		sdkFactory:        sdkFactory,
+		syncPeriod:        syncPeriod,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KonnectRoleAssignmentReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KonnectRoleAssignment").
+		WithOptions(r.controllerOptions).
+		For(&konnectv1alpha1.KonnectRoleAssignment{}).
+		Watches(&konnectv1alpha1.KonnectTeam{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForSubject(konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam)),
+		).
+		Watches(&konnectv1alpha1.KonnectSystemAccount{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForSubject(konnectv1alpha1.KonnectRoleAssignmentSubjectKindSystemAccount)),
+		).
+		Complete(r)
+}
+
+// enqueueForSubject returns a map func enqueueing the KonnectRoleAssignments
+// in the namespace of the subject which reference it.
+func (r *KonnectRoleAssignmentReconciler) enqueueForSubject(
+	kind konnectv1alpha1.KonnectRoleAssignmentSubjectKind,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var l konnectv1alpha1.KonnectRoleAssignmentList
+		if err := r.client.List(ctx, &l, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, ra := range l.Items {
+			if ra.GetSubjectKind() == kind && ra.Spec.Subject.Name == obj.GetName() {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&ra)})
+			}
+		}
+		return reqs
+	}
+}
+
+// Reconcile reconciles a KonnectRoleAssignment.
+func (r *KonnectRoleAssignmentReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var ra konnectv1alpha1.KonnectRoleAssignment
+	if err := r.client.Get(ctx, req.NamespacedName, &ra); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "KonnectRoleAssignment", r.loggingMode)
+	log.Debug(logger, "reconciling")
+
+	if !ra.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.removeRole(ctx, &ra)
+	}
+
+	old := ra.DeepCopy()
+
+	subject, subjectID, err := r.getSubject(ctx, &ra)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if subjectID == "" {
+		setKonnectRoleAssignmentNotProgrammed(&ra,
+			konnectv1alpha1.KonnectRoleAssignmentReasonSubjectNotProgrammed,
+			fmt.Sprintf("%s %s is not Programmed yet", ra.GetSubjectKind(), ra.Spec.Subject.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &ra)
+	}
+
+	cp, err := controlplane.GetCPForRef(ctx, r.client, ra.Spec.ControlPlaneRef, ra.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if cp == nil || cp.GetKonnectID() == "" {
+		setKonnectRoleAssignmentNotProgrammed(&ra,
+			konnectv1alpha1.KonnectRoleAssignmentReasonControlPlaneNotProgrammed,
+			fmt.Sprintf("KonnectGatewayControlPlane %s is not Programmed yet", ra.Spec.ControlPlaneRef.String()),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &ra)
+	}
+
+	if controllerutil.AddFinalizer(&ra, KonnectCleanupFinalizer) {
+		if err := r.client.Patch(ctx, &ra, client.MergeFrom(old)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed adding finalizer to KonnectRoleAssignment %s: %w", req.NamespacedName, err)
+		}
+		old = ra.DeepCopy()
+	}
+
+	sdk, serverURL, err := r.sdkForSubject(ctx, subject)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if ra.Status.ID != "" {
+		switch {
+		case ra.Status.SubjectID != subjectID:
+			// The subject has been created again in Konnect, without the roles of the previous one.
+			log.Info(logger, "subject changed in Konnect, assigning role again",
+				"previousSubjectID", ra.Status.SubjectID, "subjectID", subjectID)
+		case ra.Status.ControlPlaneID != cp.GetKonnectID():
+			// The assigned role is scoped to the previous control plane.
+			log.Info(logger, "control plane changed in Konnect, assigning role again",
+				"previousControlPlaneID", ra.Status.ControlPlaneID, "controlPlaneID", cp.GetKonnectID())
+			if err := ops.RemoveControlPlaneRole(ctx, sdk, ra.GetSubjectKind(), subjectID, ra.Status.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+		default:
+			assigned, err := ops.ControlPlaneRoleAssigned(ctx, sdk, ra.GetSubjectKind(), subjectID, ra.Status.ID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if assigned {
+				return ctrl.Result{RequeueAfter: r.syncPeriod}, nil
+			}
+			log.Info(logger, "assigned role not found in Konnect, assigning it again", "id", ra.Status.ID)
+		}
+	}
+
+	id, err := ops.AssignControlPlaneRole(ctx, sdk, ra.GetSubjectKind(), subjectID, cp.GetKonnectID(), ra.Spec.Role)
+	if err != nil {
+		setKonnectRoleAssignmentNotProgrammed(&ra, konnectv1alpha1.KonnectEntityProgrammedReasonKonnectAPIOpFailed, err.Error())
+		if errStatus := r.patchStatus(ctx, old, &ra); errStatus != nil {
+			return ctrl.Result{}, errStatus
+		}
+		return ctrl.Result{}, err
+	}
+	log.Info(logger, "assigned role in Konnect", "role", ra.Spec.Role, "id", id)
+
+	ra.Status.KonnectEntityStatus = konnectv1alpha2.KonnectEntityStatus{
+		ID:        id,
+		ServerURL: serverURL,
+		OrgID:     cp.Status.OrgID,
+	}
+	ra.Status.SubjectID = subjectID
+	ra.Status.ControlPlaneID = cp.GetKonnectID()
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionTrue,
+			konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+			"",
+			ra.GetGeneration(),
+		),
+		&ra,
+	)
+	return ctrl.Result{RequeueAfter: r.syncPeriod}, r.patchStatus(ctx, old, &ra)
+}
+
+// getSubject returns the subject referenced by the KonnectRoleAssignment and its Konnect ID.
+// The returned ID is empty when the subject doesn't exist or is not Programmed yet.
+func (r *KonnectRoleAssignmentReconciler) getSubject(
+	ctx context.Context,
+	ra *konnectv1alpha1.KonnectRoleAssignment,
+) (client.Object, string, error) {
+	nn := types.NamespacedName{Namespace: ra.Namespace, Name: ra.Spec.Subject.Name}
+
+	var obj interface {
+		client.Object
+		GetKonnectID() string
+	}
+	switch kind := ra.GetSubjectKind(); kind {
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam:
+		obj = &konnectv1alpha1.KonnectTeam{}
+	case konnectv1alpha1.KonnectRoleAssignmentSubjectKindSystemAccount:
+		obj = &konnectv1alpha1.KonnectSystemAccount{}
+	default:
+		return nil, "", fmt.Errorf("unsupported role assignment subject kind %q", kind)
+	}
+
+	if err := r.client.Get(ctx, nn, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("failed getting %s %s: %w", ra.GetSubjectKind(), nn, err)
+	}
+	return obj, obj.GetKonnectID(), nil
+}
+
+// sdkForSubject returns an SDK authenticated with the KonnectAPIAuthConfiguration
+// referenced by the provided subject together with the server URL it targets.
+func (r *KonnectRoleAssignmentReconciler) sdkForSubject(
+	ctx context.Context, subject client.Object,
+) (sdkops.SDKWrapper, string, error) {
+	switch s := subject.(type) {
+	case *konnectv1alpha1.KonnectTeam:
+		return newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, s)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, s)
+	default:
+		return nil, "", fmt.Errorf("unsupported role assignment subject %T", subject)
+	}
+}
+
+// newSDKForKonnectEntity returns an SDK authenticated with the KonnectAPIAuthConfiguration
+// referenced by the provided entity together with the server URL it targets.
+func newSDKForKonnectEntity[T constraints.SupportedKonnectEntityType, TEnt constraints.EntityType[T]](
+	ctx context.Context,
+	cl client.Client,
+	sdkFactory sdkops.SDKFactory,
+	ent TEnt,
+) (sdkops.SDKWrapper, string, error) {
+	apiAuthRef, err := GetAPIAuthRefNN(ctx, cl, ent)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get APIAuth ref for %s: %w", client.ObjectKeyFromObject(ent), err)
+	}
+
+	var apiAuth konnectv1alpha1.KonnectAPIAuthConfiguration
+	if err := cl.Get(ctx, apiAuthRef, &apiAuth); err != nil {
+		return nil, "", fmt.Errorf("failed to get KonnectAPIAuthConfiguration %s: %w", apiAuthRef, err)
+	}
+
+	token, err := GetTokenFromKonnectAPIAuthConfiguration(ctx, cl, &apiAuth)
+	if err != nil {
+		return nil, "", err
+	}
+
+	server, err := server.NewServer[T](apiAuth.Spec.ServerURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse server URL: %w", err)
+	}
+	return sdkFactory.NewKonnectSDK(server, sdkops.SDKToken(token)), server.URL(), nil
+}
+
+// removeRole removes the assigned role from Konnect and drops the finalizer.
+func (r *KonnectRoleAssignmentReconciler) removeRole(
+	ctx context.Context,
+	ra *konnectv1alpha1.KonnectRoleAssignment,
+) error {
+	if !controllerutil.ContainsFinalizer(ra, KonnectCleanupFinalizer) {
+		return nil
+	}
+
+	if ra.Status.ID != "" && ra.Status.SubjectID != "" {
+		subject, _, err := r.getSubject(ctx, ra)
+		if err != nil {
+			return err
+		}
+		// When the subject is gone, so are its roles in Konnect.
+		if subject != nil {
+			sdk, _, err := r.sdkForSubject(ctx, subject)
+			if err != nil {
+				return err
+			}
+			if err := ops.RemoveControlPlaneRole(ctx, sdk, ra.GetSubjectKind(), ra.Status.SubjectID, ra.Status.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	old := ra.DeepCopy()
+	controllerutil.RemoveFinalizer(ra, KonnectCleanupFinalizer)
+	if err := r.client.Patch(ctx, ra, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed removing finalizer from KonnectRoleAssignment %s: %w", client.ObjectKeyFromObject(ra), err)
+	}
+	return nil
+}
+
+func (r *KonnectRoleAssignmentReconciler) patchStatus(
+	ctx context.Context,
+	old, ra *konnectv1alpha1.KonnectRoleAssignment,
+) error {
+	if err := r.client.Status().Patch(ctx, ra, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed updating KonnectRoleAssignment %s status: %w",
+			client.ObjectKeyFromObject(ra), err,
+		)
+	}
+	return nil
+}
+
+func setKonnectRoleAssignmentNotProgrammed(
+	ra *konnectv1alpha1.KonnectRoleAssignment,
+	reason apiconsts.ConditionReason,
+	msg string,
+) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionFalse,
+			reason,
+			msg,
+			ra.GetGeneration(),
+		),
+		ra,
+	)
+}
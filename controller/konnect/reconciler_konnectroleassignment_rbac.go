@@ -0,0 +1,5 @@
+package konnect
+
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectroleassignments,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectroleassignments/status,verbs=update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectroleassignments/finalizers,verbs=update;patch
@@ -0,0 +1,178 @@
+package konnect
+
+import (
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/mocks/sdkmocks"
+)
+
+func TestKonnectRoleAssignmentReconciler_AssignedRole(t *testing.T) {
+	const (
+		syncPeriod = time.Minute
+		ns         = "default"
+	)
+	nn := types.NamespacedName{Namespace: ns, Name: "role-assignment"}
+
+	objects := func(controlPlaneID string) []client.Object {
+		return []client.Object{
+			&konnectv1alpha1.KonnectAPIAuthConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+					Type:      konnectv1alpha1.KonnectAPIAuthTypeToken,
+					Token:     "kpat_token",
+					ServerURL: "us.api.konghq.com",
+				},
+			},
+			&konnectv1alpha1.KonnectTeam{
+				ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: ns},
+				Spec: konnectv1alpha1.KonnectTeamSpec{
+					KonnectConfiguration: konnectv1alpha2.KonnectConfiguration{
+						APIAuthConfigurationRef: konnectv1alpha2.KonnectAPIAuthConfigurationRef{Name: "auth"},
+					},
+				},
+				Status: konnectv1alpha1.KonnectTeamStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "team-id"},
+				},
+			},
+			&konnectv1alpha2.KonnectGatewayControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp", Namespace: ns},
+				Status: konnectv1alpha2.KonnectGatewayControlPlaneStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: controlPlaneID},
+				},
+			},
+			&konnectv1alpha1.KonnectRoleAssignment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       nn.Name,
+					Namespace:  nn.Namespace,
+					Finalizers: []string{KonnectCleanupFinalizer},
+				},
+				Spec: konnectv1alpha1.KonnectRoleAssignmentSpec{
+					Subject: konnectv1alpha1.KonnectRoleAssignmentSubject{
+						Kind: konnectv1alpha1.KonnectRoleAssignmentSubjectKindTeam,
+						Name: "team",
+					},
+					ControlPlaneRef: commonv1alpha1.ControlPlaneRef{
+						Type:                 commonv1alpha1.ControlPlaneRefKonnectNamespacedRef,
+						KonnectNamespacedRef: &commonv1alpha1.KonnectNamespacedRef{Name: "cp"},
+					},
+					Role: konnectv1alpha1.KonnectControlPlaneRoleViewer,
+				},
+				Status: konnectv1alpha1.KonnectRoleAssignmentStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "role-id"},
+					SubjectID:           "team-id",
+					ControlPlaneID:      "cp-id",
+				},
+			},
+		}
+	}
+	assignedRoles := func(ids ...string) *sdkkonnectops.ListTeamRolesResponse {
+		roles := make([]sdkkonnectcomp.AssignedRole, 0, len(ids))
+		for _, id := range ids {
+			roles = append(roles, sdkkonnectcomp.AssignedRole{ID: new(id)})
+		}
+		return &sdkkonnectops.ListTeamRolesResponse{
+			AssignedRoleCollection: &sdkkonnectcomp.AssignedRoleCollection{Data: roles},
+		}
+	}
+	newRoleAssigned := &sdkkonnectops.TeamsAssignRoleResponse{
+		AssignedRole: &sdkkonnectcomp.AssignedRole{ID: new("new-role-id")},
+	}
+
+	testCases := []struct {
+		name               string
+		controlPlaneID     string
+		expectSDKCalls     func(*sdkmocks.MockSDKWrapper)
+		wantID             string
+		wantControlPlaneID string
+	}{
+		{
+			name:           "role still assigned in Konnect",
+			controlPlaneID: "cp-id",
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.TeamRolesSDK.EXPECT().
+					ListTeamRoles(mock.Anything, "team-id", mock.Anything).
+					Return(assignedRoles("other-role-id", "role-id"), nil)
+			},
+			wantID:             "role-id",
+			wantControlPlaneID: "cp-id",
+		},
+		{
+			name:           "role removed in Konnect is assigned again",
+			controlPlaneID: "cp-id",
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.TeamRolesSDK.EXPECT().
+					ListTeamRoles(mock.Anything, "team-id", mock.Anything).
+					Return(assignedRoles("other-role-id"), nil)
+				sdk.TeamRolesSDK.EXPECT().
+					TeamsAssignRole(mock.Anything, "team-id", mock.MatchedBy(func(req *sdkkonnectcomp.AssignRole) bool {
+						return req.EntityID != nil && *req.EntityID == "cp-id"
+					})).
+					Return(newRoleAssigned, nil)
+			},
+			wantID:             "new-role-id",
+			wantControlPlaneID: "cp-id",
+		},
+		{
+			name:           "role scoped to the previous control plane is replaced",
+			controlPlaneID: "new-cp-id",
+			expectSDKCalls: func(sdk *sdkmocks.MockSDKWrapper) {
+				sdk.TeamRolesSDK.EXPECT().
+					TeamsRemoveRole(mock.Anything, "team-id", "role-id").
+					Return(&sdkkonnectops.TeamsRemoveRoleResponse{}, nil)
+				sdk.TeamRolesSDK.EXPECT().
+					TeamsAssignRole(mock.Anything, "team-id", mock.MatchedBy(func(req *sdkkonnectcomp.AssignRole) bool {
+						return req.EntityID != nil && *req.EntityID == "new-cp-id"
+					})).
+					Return(newRoleAssigned, nil)
+			},
+			wantID:             "new-role-id",
+			wantControlPlaneID: "new-cp-id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme.Get()).
+				WithObjects(objects(tc.controlPlaneID)...).
+				WithStatusSubresource(
+					&konnectv1alpha1.KonnectRoleAssignment{},
+					&konnectv1alpha1.KonnectTeam{},
+					&konnectv1alpha2.KonnectGatewayControlPlane{},
+				).
+				Build()
+
+			factory := sdkmocks.NewMockSDKFactory(t)
+			tc.expectSDKCalls(factory.SDK)
+
+			r := NewKonnectRoleAssignmentReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, syncPeriod)
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+			require.NoError(t, err)
+			assert.Equal(t, syncPeriod, res.RequeueAfter)
+
+			var ra konnectv1alpha1.KonnectRoleAssignment
+			require.NoError(t, cl.Get(t.Context(), nn, &ra))
+			assert.Equal(t, tc.wantID, ra.Status.ID)
+			assert.Equal(t, "team-id", ra.Status.SubjectID)
+			assert.Equal(t, tc.wantControlPlaneID, ra.Status.ControlPlaneID)
+		})
+	}
+}
@@ -0,0 +1,485 @@
+package konnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	"github.com/kong/kong-operator/v2/controller/konnect/ops"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+const (
+	// KonnectSystemAccountAccessTokenSecretKey is the key under which the minted
+	// token is stored in the Secret managed by a KonnectSystemAccountAccessToken.
+	KonnectSystemAccountAccessTokenSecretKey = "token" //nolint:gosec
+
+	// KonnectSystemAccountAccessTokenLabel is the label set on the Secrets managed
+	// by a KonnectSystemAccountAccessToken. Its value is the name of the KonnectSystemAccountAccessToken.
+	KonnectSystemAccountAccessTokenLabel = "konghq.com/konnect-system-account-access-token" //nolint:gosec
+
+	// KonnectSystemAccountAccessTokenIDAnnotation is the annotation set on the Secrets managed
+	// by a KonnectSystemAccountAccessToken. Its value is the Konnect ID of the token stored in the Secret.
+	KonnectSystemAccountAccessTokenIDAnnotation = "konghq.com/konnect-system-account-access-token-id" //nolint:gosec
+
+	// KonnectSystemAccountAccessTokenExpirationAnnotation is the annotation set on the Secrets managed
+	// by a KonnectSystemAccountAccessToken. Its value is the expiration time of the token stored
+	// in the Secret, in RFC 3339 format.
+	KonnectSystemAccountAccessTokenExpirationAnnotation = "konghq.com/konnect-system-account-access-token-expiration" //nolint:gosec
+
+	// maxPreviousAccessTokens is the maximum number of replaced tokens which are
+	// kept until they expire. It matches the limit of the previousTokens status field.
+	maxPreviousAccessTokens = 8
+)
+
+// KonnectSystemAccountAccessTokenReconciler reconciles KonnectSystemAccountAccessTokens.
+//
+// It mints an access token for the referenced KonnectSystemAccount, stores it in
+// a Secret owned by the KonnectSystemAccountAccessToken and mints a new one
+// renewBefore the current token expires. Replaced tokens are left to expire on
+// their own, all tokens which did not expire yet are revoked on deletion.
+//
+// The Konnect ID of the token is stored in an annotation on the Secret in the
+// same write as the token itself, so that a token is never lost track of when
+// updating the status fails afterwards.
+type KonnectSystemAccountAccessTokenReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	scheme            *runtime.Scheme
+	sdkFactory        sdkops.SDKFactory
+	now               func() time.Time
+}
+
+// NewKonnectSystemAccountAccessTokenReconciler creates a new KonnectSystemAccountAccessTokenReconciler.
+func NewKonnectSystemAccountAccessTokenReconciler(
+	ctrlOptions controller.Options,
+	sdkFactory sdkops.SDKFactory,
+	loggingMode logging.Mode,
+	cl client.Client,
+	scheme *runtime.Scheme,
+) *KonnectSystemAccountAccessTokenReconciler {
+	return &KonnectSystemAccountAccessTokenReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		scheme:            scheme,
+		sdkFactory:        sdkFactory,
+		now:               time.Now,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KonnectSystemAccountAccessTokenReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KonnectSystemAccountAccessToken").
+		WithOptions(r.controllerOptions).
+		For(&konnectv1alpha1.KonnectSystemAccountAccessToken{}).
+		Owns(&corev1.Secret{}).
+		Watches(&konnectv1alpha1.KonnectSystemAccount{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueForSystemAccount),
+		).
+		Complete(r)
+}
+
+// enqueueForSystemAccount enqueues the KonnectSystemAccountAccessTokens referencing
+// the provided KonnectSystemAccount.
+func (r *KonnectSystemAccountAccessTokenReconciler) enqueueForSystemAccount(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	var l konnectv1alpha1.KonnectSystemAccountAccessTokenList
+	if err := r.client.List(ctx, &l, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, t := range l.Items {
+		if t.Spec.SystemAccountRef.Name == obj.GetName() {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&t)})
+		}
+	}
+	return reqs
+}
+
+// Reconcile reconciles a KonnectSystemAccountAccessToken.
+func (r *KonnectSystemAccountAccessTokenReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var t konnectv1alpha1.KonnectSystemAccountAccessToken
+	if err := r.client.Get(ctx, req.NamespacedName, &t); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "KonnectSystemAccountAccessToken", r.loggingMode)
+	log.Debug(logger, "reconciling")
+
+	if !t.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.revokeTokens(ctx, &t)
+	}
+
+	old := t.DeepCopy()
+	now := r.now()
+
+	var sa konnectv1alpha1.KonnectSystemAccount
+	saNN := types.NamespacedName{Namespace: t.Namespace, Name: t.Spec.SystemAccountRef.Name}
+	if err := r.client.Get(ctx, saNN, &sa); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("failed getting KonnectSystemAccount %s: %w", saNN, err)
+	}
+	if sa.GetKonnectID() == "" {
+		setKonnectSystemAccountAccessTokenNotProgrammed(&t,
+			konnectv1alpha1.KonnectSystemAccountAccessTokenReasonSystemAccountNotProgrammed,
+			fmt.Sprintf("KonnectSystemAccount %s is not Programmed yet", saNN.Name),
+		)
+		return ctrl.Result{RequeueAfter: konnectRefNotProgrammedRequeueAfter}, r.patchStatus(ctx, old, &t)
+	}
+
+	if controllerutil.AddFinalizer(&t, KonnectCleanupFinalizer) {
+		if err := r.client.Patch(ctx, &t, client.MergeFrom(old)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed adding finalizer to KonnectSystemAccountAccessToken %s: %w", req.NamespacedName, err)
+		}
+		old = t.DeepCopy()
+	}
+
+	t.Status.SystemAccountID = sa.GetKonnectID()
+	t.Status.SecretName = t.GetSecretName()
+
+	secret, err := r.getSecret(ctx, &t)
+	if err != nil {
+		if conflictErr := (secretConflictError{}); errors.As(err, &conflictErr) {
+			setKonnectSystemAccountAccessTokenNotProgrammed(&t,
+				konnectv1alpha1.KonnectSystemAccountAccessTokenReasonSecretConflict,
+				fmt.Sprintf("Secret %s already exists and is not owned by the KonnectSystemAccountAccessToken", conflictErr.secret),
+			)
+			return ctrl.Result{}, r.patchStatus(ctx, old, &t)
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Recover the token stored in the Secret when recording it in the status
+	// failed after it was minted.
+	if stored := storedAccessToken(secret); stored != nil &&
+		(t.Status.CurrentToken == nil || t.Status.CurrentToken.ID != stored.ID) {
+		log.Info(logger, "recording Konnect system account access token stored in the Secret", "id", stored.ID)
+		retireAccessToken(&t, t.Status.CurrentToken)
+		t.Status.CurrentToken = stored
+	}
+	t.Status.PreviousTokens = slices.DeleteFunc(t.Status.PreviousTokens,
+		func(prev konnectv1alpha1.KonnectSystemAccountAccessTokenInfo) bool {
+			return !now.Before(prev.ExpirationTime.Time)
+		},
+	)
+
+	if accessTokenRotationDue(&t, secret, now) {
+		sdk, _, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, &sa)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.rotate(ctx, sdk, &t, secret, now); err != nil {
+			setKonnectSystemAccountAccessTokenNotProgrammed(&t, konnectv1alpha1.KonnectEntityProgrammedReasonKonnectAPIOpFailed, err.Error())
+			if errStatus := r.patchStatus(ctx, old, &t); errStatus != nil {
+				return ctrl.Result{}, errStatus
+			}
+			return ctrl.Result{}, err
+		}
+		log.Info(logger, "minted new Konnect system account access token", "id", t.Status.CurrentToken.ID)
+	}
+
+	t.Status.NextRotationTime = new(metav1.NewTime(t.Status.CurrentToken.ExpirationTime.Add(-t.GetRenewBefore())))
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionTrue,
+			konnectv1alpha1.KonnectEntityProgrammedReasonProgrammed,
+			"",
+			t.GetGeneration(),
+		),
+		&t,
+	)
+	if err := r.patchStatus(ctx, old, &t); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfterForAccessToken(&t, now)}, nil
+}
+
+// getSecret returns the Secret holding the current token or nil when it doesn't exist yet.
+func (r *KonnectSystemAccountAccessTokenReconciler) getSecret(
+	ctx context.Context,
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+) (*corev1.Secret, error) {
+	nn := types.NamespacedName{Namespace: t.Namespace, Name: t.GetSecretName()}
+	var secret corev1.Secret
+	if err := r.client.Get(ctx, nn, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting Secret %s: %w", nn, err)
+	}
+	if !metav1.IsControlledBy(&secret, t) {
+		return nil, secretConflictError{secret: nn}
+	}
+	return &secret, nil
+}
+
+// rotate mints a new token and stores it in the Secret.
+//
+// The replaced token becomes a previous token and is left to expire on its own,
+// also when the Secret lost it: clients may still use the token they read
+// before. Only when more than maxPreviousAccessTokens tokens are kept, the ones
+// expiring first are revoked.
+func (r *KonnectSystemAccountAccessTokenReconciler) rotate(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	secret *corev1.Secret,
+	now time.Time,
+) error {
+	accountID := t.Status.SystemAccountID
+	name := fmt.Sprintf("%s-%s-%d", t.Namespace, t.Name, now.Unix())
+	token, err := ops.CreateSystemAccountAccessToken(ctx, sdk, accountID, name, now.Add(t.GetTTL()))
+	if err != nil {
+		return err
+	}
+	info := &konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{
+		ID:             token.ID,
+		ExpirationTime: metav1.NewTime(token.ExpiresAt),
+	}
+
+	if err := r.storeToken(ctx, t, secret, token.Token, info); err != nil {
+		// Don't leave a token nobody can use behind.
+		if errRevoke := ops.DeleteSystemAccountAccessToken(ctx, sdk, accountID, token.ID); errRevoke != nil {
+			return errors.Join(err, errRevoke)
+		}
+		return err
+	}
+
+	retireAccessToken(t, t.Status.CurrentToken)
+	t.Status.CurrentToken = info
+
+	for len(t.Status.PreviousTokens) > maxPreviousAccessTokens {
+		prev := t.Status.PreviousTokens[0]
+		if err := ops.DeleteSystemAccountAccessToken(ctx, sdk, accountID, prev.ID); err != nil {
+			return err
+		}
+		t.Status.PreviousTokens = t.Status.PreviousTokens[1:]
+	}
+	return nil
+}
+
+// retireAccessToken adds the token to the previous tokens of the KonnectSystemAccountAccessToken,
+// keeping them ordered by their expiration time.
+func retireAccessToken(
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	token *konnectv1alpha1.KonnectSystemAccountAccessTokenInfo,
+) {
+	if token == nil || slices.ContainsFunc(t.Status.PreviousTokens, func(prev konnectv1alpha1.KonnectSystemAccountAccessTokenInfo) bool {
+		return prev.ID == token.ID
+	}) {
+		return
+	}
+	t.Status.PreviousTokens = append(t.Status.PreviousTokens, *token)
+	slices.SortStableFunc(t.Status.PreviousTokens, func(a, b konnectv1alpha1.KonnectSystemAccountAccessTokenInfo) int {
+		return a.ExpirationTime.Compare(b.ExpirationTime.Time)
+	})
+}
+
+// storedAccessToken returns the token recorded in the annotations of the Secret
+// or nil when the Secret doesn't record one.
+func storedAccessToken(secret *corev1.Secret) *konnectv1alpha1.KonnectSystemAccountAccessTokenInfo {
+	if secret == nil || len(secret.Data[KonnectSystemAccountAccessTokenSecretKey]) == 0 {
+		return nil
+	}
+	id := secret.Annotations[KonnectSystemAccountAccessTokenIDAnnotation]
+	expiration, err := time.Parse(time.RFC3339, secret.Annotations[KonnectSystemAccountAccessTokenExpirationAnnotation])
+	if id == "" || err != nil {
+		return nil
+	}
+	return &konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{
+		ID:             id,
+		ExpirationTime: metav1.NewTime(expiration),
+	}
+}
+
+// storeToken creates the Secret holding the token or updates it in place,
+// recording the token ID and expiration time in its annotations.
+func (r *KonnectSystemAccountAccessTokenReconciler) storeToken(
+	ctx context.Context,
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	secret *corev1.Secret,
+	token string,
+	info *konnectv1alpha1.KonnectSystemAccountAccessTokenInfo,
+) error {
+	annotations := map[string]string{
+		KonnectSystemAccountAccessTokenIDAnnotation:         info.ID,
+		KonnectSystemAccountAccessTokenExpirationAnnotation: info.ExpirationTime.UTC().Format(time.RFC3339),
+	}
+	if secret == nil {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      t.GetSecretName(),
+				Namespace: t.Namespace,
+				Labels: map[string]string{
+					KonnectSystemAccountAccessTokenLabel: t.Name,
+				},
+				Annotations: annotations,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				KonnectSystemAccountAccessTokenSecretKey: []byte(token),
+			},
+		}
+		if err := controllerutil.SetControllerReference(t, secret, r.scheme); err != nil {
+			return err
+		}
+		if err := r.client.Create(ctx, secret); err != nil {
+			return fmt.Errorf("failed creating Secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+		return nil
+	}
+
+	oldSecret := secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[KonnectSystemAccountAccessTokenSecretKey] = []byte(token)
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	maps.Copy(secret.Annotations, annotations)
+	if err := r.client.Patch(ctx, secret, client.MergeFrom(oldSecret)); err != nil {
+		return fmt.Errorf("failed storing token in Secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+	return nil
+}
+
+// revokeTokens revokes the tokens which did not expire yet and drops the finalizer.
+func (r *KonnectSystemAccountAccessTokenReconciler) revokeTokens(
+	ctx context.Context,
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+) error {
+	if !controllerutil.ContainsFinalizer(t, KonnectCleanupFinalizer) {
+		return nil
+	}
+
+	var sa konnectv1alpha1.KonnectSystemAccount
+	saNN := types.NamespacedName{Namespace: t.Namespace, Name: t.Spec.SystemAccountRef.Name}
+	err := r.client.Get(ctx, saNN, &sa)
+	switch {
+	// When the system account is gone, so are its tokens in Konnect.
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed getting KonnectSystemAccount %s: %w", saNN, err)
+	case t.Status.SystemAccountID != "":
+		sdk, _, err := newSDKForKonnectEntity(ctx, r.client, r.sdkFactory, &sa)
+		if err != nil {
+			return err
+		}
+		tokens := t.Status.PreviousTokens
+		if t.Status.CurrentToken != nil {
+			tokens = append(slices.Clone(tokens), *t.Status.CurrentToken)
+		}
+		for _, token := range tokens {
+			if err := ops.DeleteSystemAccountAccessToken(ctx, sdk, t.Status.SystemAccountID, token.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	old := t.DeepCopy()
+	controllerutil.RemoveFinalizer(t, KonnectCleanupFinalizer)
+	if err := r.client.Patch(ctx, t, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed removing finalizer from KonnectSystemAccountAccessToken %s: %w", client.ObjectKeyFromObject(t), err)
+	}
+	return nil
+}
+
+func (r *KonnectSystemAccountAccessTokenReconciler) patchStatus(
+	ctx context.Context,
+	old, t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+) error {
+	if err := r.client.Status().Patch(ctx, t, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed updating KonnectSystemAccountAccessToken %s status: %w",
+			client.ObjectKeyFromObject(t), err,
+		)
+	}
+	return nil
+}
+
+func setKonnectSystemAccountAccessTokenNotProgrammed(
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	reason apiconsts.ConditionReason,
+	msg string,
+) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			konnectv1alpha1.KonnectEntityProgrammedConditionType,
+			metav1.ConditionFalse,
+			reason,
+			msg,
+			t.GetGeneration(),
+		),
+		t,
+	)
+}
+
+// accessTokenRotationDue returns whether a new token has to be minted: when no
+// token was minted yet, the Secret does not hold the token anymore or the token
+// is within its renewal window.
+func accessTokenRotationDue(
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	secret *corev1.Secret,
+	now time.Time,
+) bool {
+	current := t.Status.CurrentToken
+	if current == nil {
+		return true
+	}
+	if secret == nil || len(secret.Data[KonnectSystemAccountAccessTokenSecretKey]) == 0 {
+		return true
+	}
+	return !now.Before(current.ExpirationTime.Add(-t.GetRenewBefore()))
+}
+
+// requeueAfterForAccessToken returns the time after which the KonnectSystemAccountAccessToken
+// should be reconciled again: either for the next rotation or for forgetting the
+// first previous token to expire.
+func requeueAfterForAccessToken(
+	t *konnectv1alpha1.KonnectSystemAccountAccessToken,
+	now time.Time,
+) time.Duration {
+	var next time.Time
+	if t.Status.NextRotationTime != nil {
+		next = t.Status.NextRotationTime.Time
+	}
+	for _, prev := range t.Status.PreviousTokens {
+		if next.IsZero() || prev.ExpirationTime.Time.Before(next) {
+			next = prev.ExpirationTime.Time
+		}
+	}
+	if next.IsZero() {
+		return 0
+	}
+	return max(next.Sub(now), time.Second)
+}
@@ -0,0 +1,7 @@
+package konnect
+
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectsystemaccountaccesstokens,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectsystemaccountaccesstokens/status,verbs=update;patch
+//+kubebuilder:rbac:groups=konnect.konghq.com,resources=konnectsystemaccountaccesstokens/finalizers,verbs=update;patch
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
@@ -0,0 +1,298 @@
+package konnect
+
+import (
+	"testing"
+	"time"
+
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+	"github.com/kong/kong-operator/v2/test/mocks/sdkmocks"
+)
+
+func TestAccessTokenRotationDue(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	secretWithToken := &corev1.Secret{
+		Data: map[string][]byte{KonnectSystemAccountAccessTokenSecretKey: []byte("token")},
+	}
+	newToken := func(current *konnectv1alpha1.KonnectSystemAccountAccessTokenInfo) *konnectv1alpha1.KonnectSystemAccountAccessToken {
+		return &konnectv1alpha1.KonnectSystemAccountAccessToken{
+			Spec: konnectv1alpha1.KonnectSystemAccountAccessTokenSpec{
+				TTL:         &metav1.Duration{Duration: 24 * time.Hour},
+				RenewBefore: &metav1.Duration{Duration: time.Hour},
+			},
+			Status: konnectv1alpha1.KonnectSystemAccountAccessTokenStatus{
+				CurrentToken: current,
+			},
+		}
+	}
+	expiringAt := func(t time.Time) *konnectv1alpha1.KonnectSystemAccountAccessTokenInfo {
+		return &konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{ID: "id", ExpirationTime: metav1.NewTime(t)}
+	}
+
+	testCases := []struct {
+		name    string
+		token   *konnectv1alpha1.KonnectSystemAccountAccessToken
+		secret  *corev1.Secret
+		wantDue bool
+	}{
+		{
+			name:    "no token minted yet",
+			token:   newToken(nil),
+			wantDue: true,
+		},
+		{
+			name:    "Secret is gone",
+			token:   newToken(expiringAt(now.Add(10 * time.Hour))),
+			wantDue: true,
+		},
+		{
+			name:    "Secret lost the token",
+			token:   newToken(expiringAt(now.Add(10 * time.Hour))),
+			secret:  &corev1.Secret{},
+			wantDue: true,
+		},
+		{
+			name:   "token outside of the renewal window",
+			token:  newToken(expiringAt(now.Add(10 * time.Hour))),
+			secret: secretWithToken,
+		},
+		{
+			name:    "token within the renewal window",
+			token:   newToken(expiringAt(now.Add(30 * time.Minute))),
+			secret:  secretWithToken,
+			wantDue: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantDue, accessTokenRotationDue(tc.token, tc.secret, now))
+		})
+	}
+}
+
+func TestRequeueAfterForAccessToken(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	token := &konnectv1alpha1.KonnectSystemAccountAccessToken{
+		Status: konnectv1alpha1.KonnectSystemAccountAccessTokenStatus{
+			NextRotationTime: new(metav1.NewTime(now.Add(time.Hour))),
+		},
+	}
+	assert.Equal(t, time.Hour, requeueAfterForAccessToken(token, now))
+
+	token.Status.PreviousTokens = []konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{
+		{ID: "older", ExpirationTime: metav1.NewTime(now.Add(2 * time.Minute))},
+		{ID: "previous", ExpirationTime: metav1.NewTime(now.Add(time.Minute))},
+	}
+	assert.Equal(t, time.Minute, requeueAfterForAccessToken(token, now))
+
+	token.Status.NextRotationTime = new(metav1.NewTime(now.Add(-time.Minute)))
+	assert.Equal(t, time.Second, requeueAfterForAccessToken(token, now))
+}
+
+func TestKonnectSystemAccountAccessTokenReconciler_SystemAccountNotProgrammed(t *testing.T) {
+	nn := types.NamespacedName{Namespace: "default", Name: "token"}
+	token := &konnectv1alpha1.KonnectSystemAccountAccessToken{
+		ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
+		Spec: konnectv1alpha1.KonnectSystemAccountAccessTokenSpec{
+			SystemAccountRef: corev1.LocalObjectReference{Name: "sa"},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme.Get()).
+		WithObjects(token).
+		WithStatusSubresource(&konnectv1alpha1.KonnectSystemAccountAccessToken{}).
+		Build()
+
+	r := NewKonnectSystemAccountAccessTokenReconciler(
+		controller.Options{}, sdkmocks.NewMockSDKFactory(t), logging.DevelopmentMode, cl, scheme.Get(),
+	)
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+	require.NoError(t, err)
+	assert.Equal(t, konnectRefNotProgrammedRequeueAfter, res.RequeueAfter)
+
+	require.NoError(t, cl.Get(t.Context(), nn, token))
+	cond, ok := k8sutils.GetCondition(konnectv1alpha1.KonnectEntityProgrammedConditionType, token)
+	require.True(t, ok)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, konnectv1alpha1.KonnectSystemAccountAccessTokenReasonSystemAccountNotProgrammed, cond.Reason)
+	assert.Empty(t, token.Finalizers)
+}
+
+func TestKonnectSystemAccountAccessTokenReconciler_Rotation(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	nn := types.NamespacedName{Namespace: "default", Name: "token"}
+
+	objects := func(
+		status konnectv1alpha1.KonnectSystemAccountAccessTokenStatus,
+		secretAnnotations map[string]string,
+	) []client.Object {
+		return []client.Object{
+			&konnectv1alpha1.KonnectAPIAuthConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: nn.Namespace},
+				Spec: konnectv1alpha1.KonnectAPIAuthConfigurationSpec{
+					Type:      konnectv1alpha1.KonnectAPIAuthTypeToken,
+					Token:     "kpat_token",
+					ServerURL: "us.api.konghq.com",
+				},
+			},
+			&konnectv1alpha1.KonnectSystemAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: nn.Namespace},
+				Spec: konnectv1alpha1.KonnectSystemAccountSpec{
+					KonnectConfiguration: konnectv1alpha2.KonnectConfiguration{
+						APIAuthConfigurationRef: konnectv1alpha2.KonnectAPIAuthConfigurationRef{Name: "auth"},
+					},
+				},
+				Status: konnectv1alpha1.KonnectSystemAccountStatus{
+					KonnectEntityStatus: konnectv1alpha2.KonnectEntityStatus{ID: "sa-id"},
+				},
+			},
+			&konnectv1alpha1.KonnectSystemAccountAccessToken{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       nn.Name,
+					Namespace:  nn.Namespace,
+					UID:        "token-uid",
+					Finalizers: []string{KonnectCleanupFinalizer},
+				},
+				Spec: konnectv1alpha1.KonnectSystemAccountAccessTokenSpec{
+					SystemAccountRef: corev1.LocalObjectReference{Name: "sa"},
+					TTL:              &metav1.Duration{Duration: 24 * time.Hour},
+					RenewBefore:      &metav1.Duration{Duration: 12 * time.Hour},
+				},
+				Status: status,
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        nn.Name,
+					Namespace:   nn.Namespace,
+					Annotations: secretAnnotations,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: konnectv1alpha1.GroupVersion.String(),
+							Kind:       "KonnectSystemAccountAccessToken",
+							Name:       nn.Name,
+							UID:        "token-uid",
+							Controller: new(true),
+						},
+					},
+				},
+				Data: map[string][]byte{KonnectSystemAccountAccessTokenSecretKey: []byte("kpat_current")},
+			},
+		}
+	}
+	tokenInfo := func(id string, expiration time.Time) konnectv1alpha1.KonnectSystemAccountAccessTokenInfo {
+		return konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{ID: id, ExpirationTime: metav1.NewTime(expiration)}
+	}
+	ids := func(tokens []konnectv1alpha1.KonnectSystemAccountAccessTokenInfo) []string {
+		var ids []string
+		for _, token := range tokens {
+			ids = append(ids, token.ID)
+		}
+		return ids
+	}
+	newReconciler := func(t *testing.T, factory *sdkmocks.MockSDKFactory, cl client.Client) *KonnectSystemAccountAccessTokenReconciler {
+		r := NewKonnectSystemAccountAccessTokenReconciler(controller.Options{}, factory, logging.DevelopmentMode, cl, scheme.Get())
+		r.now = func() time.Time { return now }
+		return r
+	}
+
+	t.Run("rotation keeps the replaced tokens until they expire", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects(konnectv1alpha1.KonnectSystemAccountAccessTokenStatus{
+				CurrentToken:   new(tokenInfo("current", now.Add(time.Hour))),
+				PreviousTokens: []konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{tokenInfo("previous", now.Add(30*time.Minute))},
+			}, nil)...).
+			WithStatusSubresource(&konnectv1alpha1.KonnectSystemAccountAccessToken{}).
+			Build()
+
+		factory := sdkmocks.NewMockSDKFactory(t)
+		factory.SDK.SystemAccountsAccessTokensSDK.EXPECT().
+			PostSystemAccountsIDAccessTokens(mock.Anything, "sa-id", mock.Anything).
+			Return(&sdkkonnectops.PostSystemAccountsIDAccessTokensResponse{
+				SystemAccountAccessTokenCreated: &sdkkonnectcomp.SystemAccountAccessTokenCreated{
+					ID:    new("new"),
+					Token: new("kpat_new"),
+				},
+			}, nil)
+
+		_, err := newReconciler(t, factory, cl).Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		var token konnectv1alpha1.KonnectSystemAccountAccessToken
+		require.NoError(t, cl.Get(t.Context(), nn, &token))
+		require.NotNil(t, token.Status.CurrentToken)
+		assert.Equal(t, "new", token.Status.CurrentToken.ID)
+		assert.Equal(t, []string{"previous", "current"}, ids(token.Status.PreviousTokens))
+
+		var secret corev1.Secret
+		require.NoError(t, cl.Get(t.Context(), nn, &secret))
+		assert.Equal(t, "kpat_new", string(secret.Data[KonnectSystemAccountAccessTokenSecretKey]))
+		assert.Equal(t, "new", secret.Annotations[KonnectSystemAccountAccessTokenIDAnnotation])
+	})
+
+	t.Run("token stored in the Secret but missing in status is recorded", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects(konnectv1alpha1.KonnectSystemAccountAccessTokenStatus{
+				CurrentToken: new(tokenInfo("current", now.Add(time.Hour))),
+			}, map[string]string{
+				KonnectSystemAccountAccessTokenIDAnnotation:         "stored",
+				KonnectSystemAccountAccessTokenExpirationAnnotation: now.Add(24 * time.Hour).Format(time.RFC3339),
+			})...).
+			WithStatusSubresource(&konnectv1alpha1.KonnectSystemAccountAccessToken{}).
+			Build()
+
+		// No token is minted: the stored one is not within its renewal window.
+		_, err := newReconciler(t, sdkmocks.NewMockSDKFactory(t), cl).Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+
+		var token konnectv1alpha1.KonnectSystemAccountAccessToken
+		require.NoError(t, cl.Get(t.Context(), nn, &token))
+		require.NotNil(t, token.Status.CurrentToken)
+		assert.Equal(t, "stored", token.Status.CurrentToken.ID)
+		assert.Equal(t, []string{"current"}, ids(token.Status.PreviousTokens))
+	})
+
+	t.Run("expired previous tokens are forgotten", func(t *testing.T) {
+		cl := fake.NewClientBuilder().
+			WithScheme(scheme.Get()).
+			WithObjects(objects(konnectv1alpha1.KonnectSystemAccountAccessTokenStatus{
+				CurrentToken: new(tokenInfo("current", now.Add(24*time.Hour))),
+				PreviousTokens: []konnectv1alpha1.KonnectSystemAccountAccessTokenInfo{
+					tokenInfo("expired", now.Add(-time.Minute)),
+					tokenInfo("previous", now.Add(time.Hour)),
+				},
+			}, map[string]string{
+				KonnectSystemAccountAccessTokenIDAnnotation:         "current",
+				KonnectSystemAccountAccessTokenExpirationAnnotation: now.Add(24 * time.Hour).Format(time.RFC3339),
+			})...).
+			WithStatusSubresource(&konnectv1alpha1.KonnectSystemAccountAccessToken{}).
+			Build()
+
+		res, err := newReconciler(t, sdkmocks.NewMockSDKFactory(t), cl).Reconcile(t.Context(), ctrl.Request{NamespacedName: nn})
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, res.RequeueAfter)
+
+		var token konnectv1alpha1.KonnectSystemAccountAccessToken
+		require.NoError(t, cl.Get(t.Context(), nn, &token))
+		assert.Equal(t, []string{"previous"}, ids(token.Status.PreviousTokens))
+	})
+}
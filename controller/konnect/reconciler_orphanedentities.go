@@ -0,0 +1,457 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/controller/konnect/ops"
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+	"github.com/kong/kong-operator/v2/controller/konnect/server"
+	"github.com/kong/kong-operator/v2/controller/pkg/log"
+	"github.com/kong/kong-operator/v2/internal/metrics"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+)
+
+// maxReportedOrphanedEntities is the maximum number of orphaned entities listed
+// in the KonnectGatewayControlPlane status.
+const maxReportedOrphanedEntities = 100
+
+// KonnectOrphanedEntitiesReconciler periodically sweeps the KonnectGatewayControlPlanes
+// which opted in via spec.orphanedEntities for entities that carry the operator's
+// Kubernetes UID tag but are not backed by an existing Kubernetes object anymore.
+//
+// This complements the in-memory pendingKonnectIDStore, which only covers the
+// window between creating an entity and persisting its ID within one process
+// lifetime: entities leaked before a crash or whose objects were force-deleted
+// with their finalizers removed are only found by this sweeper.
+type KonnectOrphanedEntitiesReconciler struct {
+	controllerOptions controller.Options
+	loggingMode       logging.Mode
+	client            client.Client
+	apiReader         client.Reader
+	sdkFactory        sdkops.SDKFactory
+	metricRecorder    metrics.OrphanedEntitiesRecorder
+	now               func() time.Time
+
+	// firstDetected tracks the first detection time of all the orphaned entities
+	// found by the last sweep of each KonnectGatewayControlPlane (by UID), including
+	// the ones beyond maxReportedOrphanedEntities which are not listed in the status.
+	firstDetected     map[types.UID]map[string]metav1.Time
+	firstDetectedLock sync.Mutex
+}
+
+// NewKonnectOrphanedEntitiesReconciler creates a new KonnectOrphanedEntitiesReconciler.
+//
+// apiReader is used to look up the Kubernetes objects referenced by the
+// entities' tags. It should not be backed by the cache so that objects created
+// after the cache was synced are not mistakenly considered missing.
+func NewKonnectOrphanedEntitiesReconciler(
+	ctrlOptions controller.Options,
+	sdkFactory sdkops.SDKFactory,
+	loggingMode logging.Mode,
+	cl client.Client,
+	apiReader client.Reader,
+	metricRecorder metrics.OrphanedEntitiesRecorder,
+) *KonnectOrphanedEntitiesReconciler {
+	return &KonnectOrphanedEntitiesReconciler{
+		controllerOptions: ctrlOptions,
+		loggingMode:       loggingMode,
+		client:            cl,
+		apiReader:         apiReader,
+		sdkFactory:        sdkFactory,
+		metricRecorder:    metricRecorder,
+		now:               time.Now,
+		firstDetected:     make(map[types.UID]map[string]metav1.Time),
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KonnectOrphanedEntitiesReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("KonnectGatewayControlPlaneOrphanedEntities").
+		WithOptions(r.controllerOptions).
+		For(&konnectv1alpha2.KonnectGatewayControlPlane{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// Reconcile sweeps a KonnectGatewayControlPlane for orphaned entities.
+func (r *KonnectOrphanedEntitiesReconciler) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var cp konnectv1alpha2.KonnectGatewayControlPlane
+	if err := r.client.Get(ctx, req.NamespacedName, &cp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	logger := log.GetLogger(ctx, "KonnectGatewayControlPlaneOrphanedEntities", r.loggingMode)
+	cfg := cp.Spec.OrphanedEntities
+	cpID := cp.GetKonnectID()
+
+	if !cp.DeletionTimestamp.IsZero() || cfg.GetPolicy() == konnectv1alpha2.OrphanedEntitiesPolicyDisabled {
+		if cpID != "" {
+			r.metricRecorder.ForgetKonnectOrphanedEntities(cpID)
+		}
+		r.setFirstDetected(cp.UID, nil)
+		if cp.Status.OrphanedEntities == nil || !cp.DeletionTimestamp.IsZero() {
+			return ctrl.Result{}, nil
+		}
+		old := cp.DeepCopy()
+		cp.Status.OrphanedEntities = nil
+		return ctrl.Result{}, r.client.Status().Patch(ctx, &cp, client.MergeFrom(old))
+	}
+
+	interval := cfg.GetInterval().Duration
+	if cpID == "" {
+		log.Debug(logger, "KonnectGatewayControlPlane has no Konnect ID yet, postponing orphaned entities sweep")
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	now := r.now()
+	if st := cp.Status.OrphanedEntities; st != nil && st.LastSweepTime != nil {
+		if next := st.LastSweepTime.Add(interval); now.Before(next) {
+			return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+		}
+	}
+
+	sdk, err := r.sdkForControlPlane(ctx, &cp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	found, err := findOrphanedEntities(ctx, r.apiReader, sdk, cpID)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sweep KonnectGatewayControlPlane %s for orphaned entities: %w",
+			client.ObjectKeyFromObject(&cp), err,
+		)
+	}
+
+	var previous []konnectv1alpha2.OrphanedEntity
+	if cp.Status.OrphanedEntities != nil {
+		previous = cp.Status.OrphanedEntities.Entities
+	}
+	orphans := mergeOrphanedEntities(previous, r.getFirstDetected(cp.UID), found, now)
+
+	if cfg.GetPolicy() == konnectv1alpha2.OrphanedEntitiesPolicyDelete {
+		orphans = r.deleteExpiredOrphanedEntities(ctx, sdk, cpID, orphans, now.Add(-cfg.GetGracePeriod().Duration))
+	}
+	r.setFirstDetected(cp.UID, orphans)
+
+	counts := make(map[string]int, len(ops.ControlPlaneManagedEntityTypes))
+	for _, t := range ops.ControlPlaneManagedEntityTypes {
+		counts[t.Name] = 0
+	}
+	for _, o := range orphans {
+		counts[o.Type]++
+	}
+	r.metricRecorder.RecordKonnectOrphanedEntities(sdk.GetServerURL(), cpID, counts)
+
+	if len(orphans) > 0 {
+		log.Info(logger, "found orphaned entities in Konnect control plane",
+			"count", len(orphans), "policy", cfg.GetPolicy(),
+		)
+	}
+
+	old := cp.DeepCopy()
+	cp.Status.OrphanedEntities = &konnectv1alpha2.OrphanedEntitiesStatus{
+		LastSweepTime: &metav1.Time{Time: now},
+		Count:         int32(len(orphans)), //nolint:gosec
+		Entities:      orphans[:min(len(orphans), maxReportedOrphanedEntities)],
+	}
+	if err := r.client.Status().Patch(ctx, &cp, client.MergeFrom(old)); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to update orphaned entities status of KonnectGatewayControlPlane %s: %w",
+			client.ObjectKeyFromObject(&cp), err,
+		)
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// sdkForControlPlane returns an SDK authenticated with the KonnectAPIAuthConfiguration
+// referenced by the provided KonnectGatewayControlPlane.
+func (r *KonnectOrphanedEntitiesReconciler) sdkForControlPlane(
+	ctx context.Context, cp *konnectv1alpha2.KonnectGatewayControlPlane,
+) (sdkops.SDKWrapper, error) {
+	apiAuthRef, err := GetAPIAuthRefNN(ctx, r.client, cp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get APIAuth ref for %s: %w", client.ObjectKeyFromObject(cp), err)
+	}
+
+	var apiAuth konnectv1alpha1.KonnectAPIAuthConfiguration
+	if err := r.client.Get(ctx, apiAuthRef, &apiAuth); err != nil {
+		return nil, fmt.Errorf("failed to get KonnectAPIAuthConfiguration %s: %w", apiAuthRef, err)
+	}
+
+	token, err := GetTokenFromKonnectAPIAuthConfiguration(ctx, r.client, &apiAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := server.NewServer[konnectv1alpha2.KonnectGatewayControlPlane](apiAuth.Spec.ServerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse server URL: %w", err)
+	}
+	return r.sdkFactory.NewKonnectSDK(server, sdkops.SDKToken(token)), nil
+}
+
+// deleteExpiredOrphanedEntities deletes the orphaned entities that were first
+// detected before the provided deadline and returns the ones left in place.
+func (r *KonnectOrphanedEntitiesReconciler) deleteExpiredOrphanedEntities(
+	ctx context.Context,
+	sdk sdkops.SDKWrapper,
+	cpID string,
+	orphans []konnectv1alpha2.OrphanedEntity,
+	deadline time.Time,
+) []konnectv1alpha2.OrphanedEntity {
+	logger := log.GetLogger(ctx, "KonnectGatewayControlPlaneOrphanedEntities", r.loggingMode)
+
+	left := make([]konnectv1alpha2.OrphanedEntity, 0, len(orphans))
+	// Entities are deleted in the order of ops.ControlPlaneManagedEntityTypes so that
+	// children (e.g. routes) are removed before their parents (e.g. services).
+	for _, t := range ops.ControlPlaneManagedEntityTypes {
+		for _, o := range orphans {
+			if o.Type != t.Name {
+				continue
+			}
+			if o.FirstDetectedTime.After(deadline) {
+				left = append(left, o)
+				continue
+			}
+			if err := t.Delete(ctx, sdk, cpID, o.ID); err != nil && !ops.ErrIsNotFound(err) {
+				r.metricRecorder.RecordKonnectOrphanedEntityDeletion(sdk.GetServerURL(), cpID, o.Type, false)
+				log.Error(logger, err, "failed to delete orphaned entity", "type", o.Type, "id", o.ID)
+				left = append(left, o)
+				continue
+			}
+			r.metricRecorder.RecordKonnectOrphanedEntityDeletion(sdk.GetServerURL(), cpID, o.Type, true)
+			log.Info(logger, "deleted orphaned entity",
+				"type", o.Type, "id", o.ID, "kind", o.Kind, "namespace", o.Namespace, "name", o.Name, "uid", o.UID,
+			)
+		}
+	}
+	return left
+}
+
+// getFirstDetected returns the first detection times of the orphaned entities
+// found by the last sweep of the KonnectGatewayControlPlane with the given UID.
+func (r *KonnectOrphanedEntitiesReconciler) getFirstDetected(uid types.UID) map[string]metav1.Time {
+	r.firstDetectedLock.Lock()
+	defer r.firstDetectedLock.Unlock()
+	return r.firstDetected[uid]
+}
+
+// setFirstDetected stores the first detection times of the provided orphaned
+// entities for the KonnectGatewayControlPlane with the given UID, replacing the
+// previously stored ones. Passing no entities forgets the control plane.
+func (r *KonnectOrphanedEntitiesReconciler) setFirstDetected(uid types.UID, orphans []konnectv1alpha2.OrphanedEntity) {
+	r.firstDetectedLock.Lock()
+	defer r.firstDetectedLock.Unlock()
+	if len(orphans) == 0 {
+		delete(r.firstDetected, uid)
+		return
+	}
+	firstDetected := make(map[string]metav1.Time, len(orphans))
+	for _, o := range orphans {
+		firstDetected[orphanedEntityKey(o)] = o.FirstDetectedTime
+	}
+	r.firstDetected[uid] = firstDetected
+}
+
+// orphanedEntityKey returns the key identifying an orphaned entity in a control plane.
+func orphanedEntityKey(o konnectv1alpha2.OrphanedEntity) string {
+	return o.Type + "/" + o.ID
+}
+
+// mergeOrphanedEntities returns the currently found orphaned entities, carrying
+// over the first detection time of the entities that were already found in
+// previous sweeps, either reported in the status (previous) or tracked by the
+// reconciler (tracked), which also covers the entities beyond the status cap.
+// The earliest of both times is used, as the tracked times are lost on restarts.
+// Entities that are not found anymore are dropped so that the grace period
+// restarts if they ever show up again.
+func mergeOrphanedEntities(
+	previous []konnectv1alpha2.OrphanedEntity,
+	tracked map[string]metav1.Time,
+	found []konnectv1alpha2.OrphanedEntity,
+	now time.Time,
+) []konnectv1alpha2.OrphanedEntity {
+	firstDetected := make(map[string]metav1.Time, len(previous)+len(tracked))
+	for k, t := range tracked {
+		firstDetected[k] = t
+	}
+	for _, p := range previous {
+		k := orphanedEntityKey(p)
+		if t, ok := firstDetected[k]; !ok || p.FirstDetectedTime.Before(&t) {
+			firstDetected[k] = p.FirstDetectedTime
+		}
+	}
+
+	ret := make([]konnectv1alpha2.OrphanedEntity, 0, len(found))
+	for _, f := range found {
+		if t, ok := firstDetected[orphanedEntityKey(f)]; ok {
+			f.FirstDetectedTime = t
+		} else {
+			f.FirstDetectedTime = metav1.Time{Time: now}
+		}
+		ret = append(ret, f)
+	}
+	slices.SortStableFunc(ret, func(a, b konnectv1alpha2.OrphanedEntity) int {
+		if c := a.FirstDetectedTime.Compare(b.FirstDetectedTime.Time); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return ret
+}
+
+// findOrphanedEntities lists the operator managed entities of all supported types
+// in the given control plane and returns the ones which are orphaned.
+func findOrphanedEntities(
+	ctx context.Context,
+	cl client.Reader,
+	sdk sdkops.SDKWrapper,
+	cpID string,
+) ([]konnectv1alpha2.OrphanedEntity, error) {
+	var ret []konnectv1alpha2.OrphanedEntity
+	for _, t := range ops.ControlPlaneManagedEntityTypes {
+		entities, err := t.List(ctx, sdk, cpID)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing %s entities: %w", t.Name, err)
+		}
+		for _, e := range entities {
+			owner, ok := ownerFromTags(e.Tags)
+			if !ok {
+				continue
+			}
+			orphaned, err := isOrphaned(ctx, cl, owner, e.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !orphaned {
+				continue
+			}
+			ret = append(ret, konnectv1alpha2.OrphanedEntity{
+				Type:      t.Name,
+				ID:        e.ID,
+				Kind:      owner.gvk.Kind,
+				Namespace: owner.nn.Namespace,
+				Name:      owner.nn.Name,
+				UID:       string(owner.uid),
+			})
+		}
+	}
+	return ret, nil
+}
+
+// entityOwner describes the Kubernetes object that a Konnect entity was created for,
+// as recorded in the entity's tags.
+type entityOwner struct {
+	gvk schema.GroupVersionKind
+	nn  types.NamespacedName
+	uid types.UID
+}
+
+// ownerFromTags extracts the Kubernetes object that a Konnect entity was created
+// for from the "k8s-*" tags generated by ops.GenerateTagsForObject.
+// It returns false when the tags do not fully describe an object, e.g. because
+// the entity is not managed by the operator or because a tag value got truncated.
+func ownerFromTags(tags []string) (entityOwner, bool) {
+	// Tags are truncated to this many runes by ops.GenerateTagsForObject.
+	const maxTagLength = 128
+
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		k, v, ok := strings.Cut(tag, ":")
+		if !ok {
+			continue
+		}
+		if len([]rune(tag)) >= maxTagLength {
+			// The value might have been truncated so we cannot trust it.
+			continue
+		}
+		values[k] = v
+	}
+
+	if values[ops.ManagedByLabelKey] != ops.ManagedByKongOperatorLabelValue {
+		return entityOwner{}, false
+	}
+	uid, kind, version, name := values[ops.KubernetesUIDLabelKey], values[ops.KubernetesKindLabelKey],
+		values[ops.KubernetesVersionLabelKey], values[ops.KubernetesNameLabelKey]
+	if uid == "" || kind == "" || version == "" || name == "" {
+		return entityOwner{}, false
+	}
+
+	return entityOwner{
+		gvk: schema.GroupVersionKind{
+			Group:   values[ops.KubernetesGroupLabelKey],
+			Version: version,
+			Kind:    kind,
+		},
+		nn: types.NamespacedName{
+			Namespace: values[ops.KubernetesNamespaceLabelKey],
+			Name:      name,
+		},
+		uid: types.UID(uid),
+	}, true
+}
+
+// isOrphaned returns true when the owner of the Konnect entity with the provided
+// ID does not exist anymore, has been recreated with a different UID or tracks a
+// different Konnect ID in its status.
+// Owners whose kind is not served by the API server are never considered missing
+// as it cannot be told whether they exist.
+func isOrphaned(ctx context.Context, cl client.Reader, owner entityOwner, konnectID string) (bool, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(owner.gvk)
+	if err := cl.Get(ctx, owner.nn, obj); err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+			return true, nil
+		case meta.IsNoMatchError(err):
+			return false, nil
+		default:
+			return false, fmt.Errorf("failed to get %s %s: %w", owner.gvk.Kind, owner.nn, err)
+		}
+	}
+
+	if obj.GetUID() != owner.uid {
+		return true, nil
+	}
+
+	// The object's status may not contain the Konnect ID yet, e.g. when the
+	// entity has just been created. Only an ID pointing to a different entity
+	// proves this one is a leftover duplicate.
+	statusID, _, _ := unstructured.NestedString(obj.Object, "status", "konnect", "id")
+	if statusID == "" {
+		statusID, _, _ = unstructured.NestedString(obj.Object, "status", "id")
+	}
+	return statusID != "" && statusID != konnectID, nil
+}
@@ -0,0 +1,233 @@
+package konnect
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+)
+
+func TestOwnerFromTags(t *testing.T) {
+	validTags := []string{
+		"k8s-generation:1",
+		"k8s-group:configuration.konghq.com",
+		"k8s-kind:KongService",
+		"k8s-name:svc",
+		"k8s-namespace:default",
+		"k8s-uid:0b8e5e1a-3b0c-4a4c-9d1e-5e6f7a8b9c0d",
+		"k8s-version:v1alpha1",
+		"managed-by:kong-operator",
+		"user-tag",
+	}
+
+	tests := []struct {
+		name          string
+		tags          []string
+		expectedOwner entityOwner
+		expectedOK    bool
+	}{
+		{
+			name:       "complete set of tags",
+			tags:       validTags,
+			expectedOK: true,
+			expectedOwner: entityOwner{
+				gvk: schema.GroupVersionKind{
+					Group:   "configuration.konghq.com",
+					Version: "v1alpha1",
+					Kind:    "KongService",
+				},
+				nn:  types.NamespacedName{Namespace: "default", Name: "svc"},
+				uid: "0b8e5e1a-3b0c-4a4c-9d1e-5e6f7a8b9c0d",
+			},
+		},
+		{
+			name: "not managed by the operator",
+			tags: []string{
+				"k8s-kind:KongService",
+				"k8s-name:svc",
+				"k8s-uid:0b8e5e1a-3b0c-4a4c-9d1e-5e6f7a8b9c0d",
+				"k8s-version:v1alpha1",
+			},
+		},
+		{
+			name: "missing uid",
+			tags: []string{
+				"k8s-kind:KongService",
+				"k8s-name:svc",
+				"k8s-version:v1alpha1",
+				"managed-by:kong-operator",
+			},
+		},
+		{
+			name: "possibly truncated name",
+			tags: []string{
+				"k8s-kind:KongService",
+				"k8s-name:" + strings.Repeat("a", 119),
+				"k8s-uid:0b8e5e1a-3b0c-4a4c-9d1e-5e6f7a8b9c0d",
+				"k8s-version:v1alpha1",
+				"managed-by:kong-operator",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			owner, ok := ownerFromTags(tc.tags)
+			require.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedOwner, owner)
+		})
+	}
+}
+
+func TestMergeOrphanedEntities(t *testing.T) {
+	var (
+		now     = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		earlier = metav1.Time{Time: now.Add(-time.Hour)}
+	)
+
+	previous := []konnectv1alpha2.OrphanedEntity{
+		{Type: "Service", ID: "svc-1", FirstDetectedTime: earlier},
+		{Type: "Route", ID: "route-gone", FirstDetectedTime: earlier},
+	}
+	found := []konnectv1alpha2.OrphanedEntity{
+		{Type: "Route", ID: "route-1"},
+		{Type: "Service", ID: "svc-1"},
+	}
+
+	merged := mergeOrphanedEntities(previous, nil, found, now)
+	assert.Equal(t, []konnectv1alpha2.OrphanedEntity{
+		{Type: "Service", ID: "svc-1", FirstDetectedTime: earlier},
+		{Type: "Route", ID: "route-1", FirstDetectedTime: metav1.Time{Time: now}},
+	}, merged)
+}
+
+func TestMergeOrphanedEntitiesKeepsFirstDetectedTimeBeyondReportedCap(t *testing.T) {
+	var (
+		now      = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		earliest = metav1.Time{Time: now.Add(-2 * time.Hour)}
+		earlier  = metav1.Time{Time: now.Add(-time.Hour)}
+	)
+
+	r := NewKonnectOrphanedEntitiesReconciler(controller.Options{}, nil, logging.ProductionMode, nil, nil, nil)
+	const uid = types.UID("cp-uid")
+
+	// The previous sweep found more orphaned entities than reported in the status.
+	var swept []konnectv1alpha2.OrphanedEntity
+	for i := range maxReportedOrphanedEntities + 1 {
+		swept = append(swept, konnectv1alpha2.OrphanedEntity{
+			Type:              "Route",
+			ID:                fmt.Sprintf("route-%03d", i),
+			FirstDetectedTime: earlier,
+		})
+	}
+	r.setFirstDetected(uid, swept)
+	reported := swept[:maxReportedOrphanedEntities]
+
+	found := []konnectv1alpha2.OrphanedEntity{
+		{Type: "Route", ID: fmt.Sprintf("route-%03d", maxReportedOrphanedEntities)},
+		{Type: "Route", ID: "route-000"},
+		{Type: "Route", ID: "route-new"},
+	}
+	merged := mergeOrphanedEntities(reported, r.getFirstDetected(uid), found, now)
+	assert.Equal(t, []konnectv1alpha2.OrphanedEntity{
+		{Type: "Route", ID: "route-000", FirstDetectedTime: earlier},
+		{Type: "Route", ID: fmt.Sprintf("route-%03d", maxReportedOrphanedEntities), FirstDetectedTime: earlier},
+		{Type: "Route", ID: "route-new", FirstDetectedTime: metav1.Time{Time: now}},
+	}, merged)
+
+	t.Run("earliest time is kept when status and tracked times differ", func(t *testing.T) {
+		previous := []konnectv1alpha2.OrphanedEntity{
+			{Type: "Route", ID: "route-000", FirstDetectedTime: earliest},
+		}
+		merged := mergeOrphanedEntities(previous, r.getFirstDetected(uid), found[1:2], now)
+		assert.Equal(t, []konnectv1alpha2.OrphanedEntity{
+			{Type: "Route", ID: "route-000", FirstDetectedTime: earliest},
+		}, merged)
+	})
+
+	t.Run("tracked times are forgotten when no entities are left", func(t *testing.T) {
+		r.setFirstDetected(uid, nil)
+		assert.Empty(t, r.getFirstDetected(uid))
+	})
+}
+
+func TestIsOrphaned(t *testing.T) {
+	const uid = types.UID("0b8e5e1a-3b0c-4a4c-9d1e-5e6f7a8b9c0d")
+
+	owner := entityOwner{
+		gvk: configurationv1alpha1.GroupVersion.WithKind("KongService"),
+		nn:  types.NamespacedName{Namespace: "default", Name: "svc"},
+		uid: uid,
+	}
+	svc := func(uid types.UID, konnectID string) *configurationv1alpha1.KongService {
+		s := &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc",
+				Namespace: "default",
+				UID:       uid,
+			},
+		}
+		if konnectID != "" {
+			s.SetKonnectID(konnectID)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		objects  []*configurationv1alpha1.KongService
+		expected bool
+	}{
+		{
+			name:     "owner does not exist",
+			expected: true,
+		},
+		{
+			name:     "owner was recreated with a different UID",
+			objects:  []*configurationv1alpha1.KongService{svc("other-uid", "")},
+			expected: true,
+		},
+		{
+			name:     "owner tracks a different Konnect ID",
+			objects:  []*configurationv1alpha1.KongService{svc(uid, "other-id")},
+			expected: true,
+		},
+		{
+			name:     "owner tracks the entity",
+			objects:  []*configurationv1alpha1.KongService{svc(uid, "entity-id")},
+			expected: false,
+		},
+		{
+			name:     "owner has no Konnect ID yet",
+			objects:  []*configurationv1alpha1.KongService{svc(uid, "")},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Get())
+			for _, o := range tc.objects {
+				builder = builder.WithObjects(o).WithStatusSubresource(o)
+			}
+			cl := builder.Build()
+
+			orphaned, err := isOrphaned(t.Context(), cl, owner, "entity-id")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, orphaned)
+		})
+	}
+}
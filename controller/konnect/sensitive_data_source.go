@@ -1,6 +1,9 @@
 package konnect
 
-import konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+import (
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+)
 
 // sensitiveDataSecretRefsGetter is implemented by CRD types that have one or
 // more sensitive fields backed by Kubernetes Secrets. The reconciler calls
@@ -9,3 +12,27 @@ import konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
 type sensitiveDataSecretRefsGetter interface {
 	GetSensitiveDataSecretRefs() []konnectv1alpha1.SensitiveDataSecretRef
 }
+
+// configurationSensitiveDataSecretRefsGetter is the counterpart of
+// sensitiveDataSecretRefsGetter for types from the configuration API group.
+type configurationSensitiveDataSecretRefsGetter interface {
+	GetSensitiveDataSecretRefs() []configurationv1alpha1.SensitiveDataSecretRef
+}
+
+// sensitiveDataSecretRefs returns the Secret references used by the sensitive
+// fields of obj. It returns false when obj has no Secret backed sensitive fields.
+func sensitiveDataSecretRefs(obj any) ([]konnectv1alpha1.SensitiveDataSecretRef, bool) {
+	switch g := obj.(type) {
+	case sensitiveDataSecretRefsGetter:
+		return g.GetSensitiveDataSecretRefs(), true
+	case configurationSensitiveDataSecretRefsGetter:
+		refs := g.GetSensitiveDataSecretRefs()
+		out := make([]konnectv1alpha1.SensitiveDataSecretRef, len(refs))
+		for i, r := range refs {
+			out[i] = konnectv1alpha1.SensitiveDataSecretRef(r)
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
@@ -63,6 +63,10 @@ func ReconciliationWatchOptionsForEntity[
 		return kongCredentialJWTReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.KongCredentialHMAC:
 		return kongCredentialHMACReconciliationWatchOptions(cl)
+	case *configurationv1alpha1.KongCredentialOAuth2:
+		return kongCredentialOAuth2ReconciliationWatchOptions(cl)
+	case *configurationv1alpha1.KongCredentialMTLS:
+		return kongCredentialMTLSReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.KongCACertificate:
 		return KongCACertificateReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.KongCertificate:
@@ -79,6 +83,8 @@ func ReconciliationWatchOptionsForEntity[
 		return KongSNIReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.KongDataPlaneClientCertificate:
 		return KongDataPlaneClientCertificateReconciliationWatchOptions(cl)
+	case *configurationv1alpha1.KongCustomEntity:
+		return KongCustomEntityReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.MCPServer:
 		return MCPServerReconciliationWatchOptions(cl)
 	default:
@@ -310,6 +316,10 @@ type WatchableEntityType interface {
 		konnectv1alpha1.PortalIPAllowList |
 		konnectv1alpha1.PortalTeam |
 		konnectv1alpha1.PortalIdentityProviderRequest |
+		konnectv1alpha1.KonnectAPI |
+		konnectv1alpha1.APISpecification |
+		konnectv1alpha1.KonnectTeam |
+		konnectv1alpha1.KonnectSystemAccount |
 		konnectv1alpha1.KonnectAIGateway |
 		konnectv1alpha1.KonnectConfigStore |
 		konnectv1alpha1.AIGatewayModel |
@@ -342,6 +352,8 @@ type WatchableEntityType interface {
 		configurationv1alpha1.KongCredentialACL |
 		configurationv1alpha1.KongCredentialJWT |
 		configurationv1alpha1.KongCredentialHMAC |
+		configurationv1alpha1.KongCredentialOAuth2 |
+		configurationv1alpha1.KongCredentialMTLS |
 		configurationv1alpha1.KongUpstream |
 		configurationv1alpha1.KongCACertificate |
 		configurationv1alpha1.KongCertificate |
@@ -351,6 +363,7 @@ type WatchableEntityType interface {
 		configurationv1alpha1.KongKeySet |
 		configurationv1alpha1.KongSNI |
 		configurationv1alpha1.KongDataPlaneClientCertificate |
+		configurationv1alpha1.KongCustomEntity |
 		konnectv1alpha1.KonnectAPIAuthConfiguration
 
 	GetTypeName() string
@@ -539,3 +552,103 @@ func enqueueObjectsForSecretRef[
 		return ret
 	}
 }
+
+// configMapRefsForSensitiveData extracts the active ConfigMap references from
+// obj's generated GetSensitiveDataConfigMapRefs method, converting to the
+// shared commonv1alpha1.NamespacedRef shape, and reports whether obj has one
+// at all. See secretRefsForSensitiveData for why this dispatches by type switch.
+func configMapRefsForSensitiveData(obj any) ([]commonv1alpha1.NamespacedRef, bool) {
+	switch g := obj.(type) {
+	case interface {
+		GetSensitiveDataConfigMapRefs() []konnectv1alpha1.ConfigMapKeyRef
+	}:
+		refs := g.GetSensitiveDataConfigMapRefs()
+		out := make([]commonv1alpha1.NamespacedRef, len(refs))
+		for i, r := range refs {
+			out[i] = commonv1alpha1.NamespacedRef{Name: r.Name}
+			if r.Namespace != "" {
+				out[i].Namespace = &r.Namespace
+			}
+		}
+		return out, true
+	case interface {
+		GetSensitiveDataConfigMapRefs() []configurationv1alpha1.ConfigMapKeyRef
+	}:
+		refs := g.GetSensitiveDataConfigMapRefs()
+		out := make([]commonv1alpha1.NamespacedRef, len(refs))
+		for i, r := range refs {
+			out[i] = commonv1alpha1.NamespacedRef{Name: r.Name}
+			if r.Namespace != "" {
+				out[i].Namespace = &r.Namespace
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// enqueueObjectsForConfigMapRef returns a function that enqueues reconcile.Requests
+// for all objects of type T whose generated GetSensitiveDataConfigMapRefs()
+// references the changed ConfigMap, so that editing the ConfigMap's data
+// (e.g. an API specification document) promptly retriggers reconciliation of
+// every entity depending on it.
+func enqueueObjectsForConfigMapRef[
+	TList interface {
+		GetItems() []T
+	},
+	TListPtr interface {
+		*TList
+		client.ObjectList
+		GetItems() []T
+	},
+	T any,
+	TT interface {
+		*T
+		client.Object
+	},
+](
+	cl client.Client,
+) func(ctx context.Context, obj client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		configMap, ok := obj.(*corev1.ConfigMap)
+		if !ok {
+			return nil
+		}
+
+		var (
+			l    TList
+			lPtr TListPtr = &l
+		)
+		if err := cl.List(ctx, lPtr); err != nil {
+			return nil
+		}
+
+		var ret []reconcile.Request
+		items := lPtr.GetItems()
+		for i := range items {
+			itemPtr := TT(&items[i])
+			refs, ok := configMapRefsForSensitiveData(any(itemPtr))
+			if !ok {
+				continue
+			}
+			for _, ref := range refs {
+				ns := itemPtr.GetNamespace()
+				if ref.Namespace != nil && *ref.Namespace != "" {
+					ns = *ref.Namespace
+				}
+				if ref.Name == configMap.Name && ns == configMap.Namespace {
+					ret = append(ret, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: itemPtr.GetNamespace(),
+							Name:      itemPtr.GetName(),
+						},
+					})
+					break
+				}
+			}
+		}
+
+		return ret
+	}
+}
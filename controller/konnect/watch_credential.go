@@ -23,7 +23,9 @@ func kongCredentialRefersToKonnectGatewayControlPlane[
 			*configurationv1alpha1.KongCredentialAPIKey |
 			*configurationv1alpha1.KongCredentialBasicAuth |
 			*configurationv1alpha1.KongCredentialJWT |
-			*configurationv1alpha1.KongCredentialHMAC
+			*configurationv1alpha1.KongCredentialHMAC |
+			*configurationv1alpha1.KongCredentialOAuth2 |
+			*configurationv1alpha1.KongCredentialMTLS
 
 		GetConsumerRefName() string
 		GetTypeName() string
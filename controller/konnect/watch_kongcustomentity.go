@@ -0,0 +1,96 @@
+package konnect
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/internal/utils/index"
+)
+
+// KongCustomEntityReconciliationWatchOptions returns the watch options for the KongCustomEntity.
+func KongCustomEntityReconciliationWatchOptions(cl client.Client) []func(*ctrl.Builder) *ctrl.Builder {
+	return []func(*ctrl.Builder) *ctrl.Builder{
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.For(&configurationv1alpha1.KongCustomEntity{},
+				builder.WithPredicates(
+					predicate.NewPredicateFuncs(objRefersToKonnectGatewayControlPlane[configurationv1alpha1.KongCustomEntity]),
+				),
+			)
+		},
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&configurationv1alpha1.KongService{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueKongCustomEntityForParent(cl, index.IndexFieldKongCustomEntityOnKongServiceReference),
+				),
+			)
+		},
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&configurationv1alpha1.KongRoute{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueKongCustomEntityForParent(cl, index.IndexFieldKongCustomEntityOnKongRouteReference),
+				),
+			)
+		},
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&konnectv1alpha1.KonnectAPIAuthConfiguration{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueObjectForAPIAuthThroughControlPlaneRef[configurationv1alpha1.KongCustomEntityList](
+						cl, index.IndexFieldKongCustomEntityOnKonnectGatewayControlPlane,
+					),
+				),
+			)
+		},
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&konnectv1alpha2.KonnectGatewayControlPlane{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueObjectForKonnectGatewayControlPlane[configurationv1alpha1.KongCustomEntityList](
+						cl, index.IndexFieldKongCustomEntityOnKonnectGatewayControlPlane,
+					),
+				),
+			)
+		},
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&configurationv1alpha1.KongReferenceGrant{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueObjectsForKongReferenceGrant[configurationv1alpha1.KongCustomEntityList](cl),
+				),
+			)
+		},
+	}
+}
+
+// enqueueKongCustomEntityForParent returns a function that enqueues the KongCustomEntities
+// referencing the KongService or KongRoute (depending on the provided index field) so that
+// they get created in Konnect once their parent gets its Konnect ID.
+func enqueueKongCustomEntityForParent(
+	cl client.Client,
+	indexField string,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var l configurationv1alpha1.KongCustomEntityList
+		if err := cl.List(ctx, &l,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{
+				indexField: obj.GetName(),
+			},
+		); err != nil {
+			return nil
+		}
+
+		return objectListToReconcileRequests(l.Items)
+	}
+}
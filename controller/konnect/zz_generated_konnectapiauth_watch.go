@@ -12,12 +12,18 @@ import (
 
 var generatedKonnectAPIAuthReferencingTypes = []constraints.EntityWithKonnectAPIAuthConfigurationRef{
 	&konnectv1alpha1.KonnectAIGateway{},
+	&konnectv1alpha1.KonnectAPI{},
 	&konnectv1alpha1.KonnectEventGateway{},
+	&konnectv1alpha1.KonnectSystemAccount{},
+	&konnectv1alpha1.KonnectTeam{},
 	&konnectv1alpha1.Portal{},
 }
 
 var generatedKonnectAPIAuthReferencingTypeListsWithIndexes = map[client.ObjectList]string{
-	&konnectv1alpha1.KonnectAIGatewayList{}:    index.IndexFieldKonnectAIGatewayOnAPIAuthConfiguration,
-	&konnectv1alpha1.KonnectEventGatewayList{}: index.IndexFieldKonnectEventGatewayOnAPIAuthConfiguration,
-	&konnectv1alpha1.PortalList{}:              index.IndexFieldPortalOnAPIAuthConfiguration,
+	&konnectv1alpha1.KonnectAIGatewayList{}:     index.IndexFieldKonnectAIGatewayOnAPIAuthConfiguration,
+	&konnectv1alpha1.KonnectAPIList{}:           index.IndexFieldKonnectAPIOnAPIAuthConfiguration,
+	&konnectv1alpha1.KonnectEventGatewayList{}:  index.IndexFieldKonnectEventGatewayOnAPIAuthConfiguration,
+	&konnectv1alpha1.KonnectSystemAccountList{}: index.IndexFieldKonnectSystemAccountOnAPIAuthConfiguration,
+	&konnectv1alpha1.KonnectTeamList{}:          index.IndexFieldKonnectTeamOnAPIAuthConfiguration,
+	&konnectv1alpha1.PortalList{}:               index.IndexFieldPortalOnAPIAuthConfiguration,
 }
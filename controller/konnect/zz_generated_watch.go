@@ -41,6 +41,8 @@ func reconciliationWatchOptionsForEntity[
 		return AIGatewayModelProviderReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.AIGatewayPolicy:
 		return AIGatewayPolicyReconciliationWatchOptions(cl)
+	case *konnectv1alpha1.APISpecification:
+		return APISpecificationReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.EventGatewayBackendCluster:
 		return EventGatewayBackendClusterReconciliationWatchOptions(cl)
 	case *configurationv1alpha1.EventGatewayDataPlaneCertificate:
@@ -61,10 +63,16 @@ func reconciliationWatchOptionsForEntity[
 		return EventGatewayVirtualClusterProducePolicyReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.KonnectAIGateway:
 		return KonnectAIGatewayReconciliationWatchOptions(cl)
+	case *konnectv1alpha1.KonnectAPI:
+		return KonnectAPIReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.KonnectConfigStore:
 		return KonnectConfigStoreReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.KonnectEventGateway:
 		return KonnectEventGatewayReconciliationWatchOptions(cl)
+	case *konnectv1alpha1.KonnectSystemAccount:
+		return KonnectSystemAccountReconciliationWatchOptions(cl)
+	case *konnectv1alpha1.KonnectTeam:
+		return KonnectTeamReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.Portal:
 		return PortalReconciliationWatchOptions(cl)
 	case *konnectv1alpha1.PortalCustomDomain:
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	konnectv1alpha1 "github.com/kong/kong-operator/v2/api/konnect/v1alpha1"
 	mcpv1alpha1 "github.com/kong/kong-operator/v2/api/mcp/v1alpha1"
 	konnectcontroller "github.com/kong/kong-operator/v2/controller/konnect"
@@ -365,6 +366,19 @@ func generateDeployment(
 		},
 	}
 
+	hardened := commonv1alpha1.HardeningStateDisabled
+	if deploy := mcpDataPlane.Spec.Deployment; deploy != nil && deploy.Hardened != "" {
+		hardened = deploy.Hardened
+	}
+	podSpec := &deployment.Spec.Template.Spec
+	// The init container shares the MCP server's volumes, so the ones returned
+	// for it are the same and only need to be added once.
+	podSpec.InitContainers[0], _ = k8sresources.HardenContainer(podSpec.InitContainers[0], k8sresources.DataPlaneTypeMCPServer, hardened)
+	var volumes []corev1.Volume
+	podSpec.Containers[0], volumes = k8sresources.HardenContainer(podSpec.Containers[0], k8sresources.DataPlaneTypeMCPServer, hardened)
+	podSpec.Volumes = append(podSpec.Volumes, volumes...)
+	k8sresources.HardenPodSpec(podSpec, hardened)
+
 	k8sresources.LabelObjectAsMCPServerManaged(deployment)
 	k8sutils.SetOwnerForObject(deployment, mcpDataPlane)
 
@@ -103,3 +103,51 @@ func deduceAddressSourceTypeFromService(s *corev1.Service) operatorv1beta1.Addre
 	// By default, assume that a load balancer is public.
 	return operatorv1beta1.PublicLoadBalancerAddressSourceType
 }
+
+// AddressesFromNodes retrieves addresses from the provided nodes.
+// It's used for DataPlanes which bind their listeners on the nodes they run on,
+// in which case those nodes are the entrypoint for the DataPlane's traffic.
+//
+// The return value is created such that:
+//   - nodes' ExternalIP addresses are added first, InternalIP addresses next.
+//   - within each address type, addresses follow the order of the provided nodes.
+//   - addresses shared by several nodes are only added once.
+func AddressesFromNodes(nodes []corev1.Node) ([]operatorv1beta1.Address, error) {
+	var (
+		addresses = make([]operatorv1beta1.Address, 0, len(nodes))
+		seen      = make(map[string]struct{}, len(nodes))
+	)
+
+	for _, addressType := range []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP} {
+		for _, node := range nodes {
+			for _, nodeAddress := range node.Status.Addresses {
+				if nodeAddress.Type != addressType {
+					continue
+				}
+				if _, ok := seen[nodeAddress.Address]; ok {
+					continue
+				}
+
+				ip, err := netip.ParseAddr(nodeAddress.Address)
+				if err != nil {
+					return nil, fmt.Errorf("failed parsing IP (%v) for node %s: %w", nodeAddress.Address, node.Name, err)
+				}
+
+				sourceType := operatorv1beta1.PublicIPAddressSourceType
+				if addressType == corev1.NodeInternalIP || ip.IsPrivate() {
+					sourceType = operatorv1beta1.PrivateIPAddressSourceType
+				}
+
+				addresses = append(addresses,
+					operatorv1beta1.Address{
+						Type:       new(operatorv1beta1.IPAddressType),
+						Value:      nodeAddress.Address,
+						SourceType: sourceType,
+					},
+				)
+				seen[nodeAddress.Address] = struct{}{}
+			}
+		}
+	}
+	return addresses, nil
+}
@@ -291,3 +291,97 @@ func Test_AddressesFromService(t *testing.T) {
 		})
 	}
 }
+
+func Test_AddressesFromNodes(t *testing.T) {
+	node := func(name string, addresses ...corev1.NodeAddress) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Addresses: addresses,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		nodes   []corev1.Node
+		want    []operatorv1beta1.Address
+		wantErr bool
+	}{
+		{
+			name:  "no nodes",
+			nodes: nil,
+			want:  []operatorv1beta1.Address{},
+		},
+		{
+			name: "external addresses first, then internal ones",
+			nodes: []corev1.Node{
+				node("node-1",
+					corev1.NodeAddress{Type: corev1.NodeHostName, Address: "node-1"},
+					corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+					corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "1.1.1.1"},
+				),
+				node("node-2",
+					corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+					corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "192.168.1.2"},
+				),
+			},
+			want: []operatorv1beta1.Address{
+				{
+					Type:       new(operatorv1beta1.IPAddressType),
+					Value:      "1.1.1.1",
+					SourceType: operatorv1beta1.PublicIPAddressSourceType,
+				},
+				{
+					Type:       new(operatorv1beta1.IPAddressType),
+					Value:      "192.168.1.2",
+					SourceType: operatorv1beta1.PrivateIPAddressSourceType,
+				},
+				{
+					Type:       new(operatorv1beta1.IPAddressType),
+					Value:      "10.0.0.1",
+					SourceType: operatorv1beta1.PrivateIPAddressSourceType,
+				},
+				{
+					Type:       new(operatorv1beta1.IPAddressType),
+					Value:      "10.0.0.2",
+					SourceType: operatorv1beta1.PrivateIPAddressSourceType,
+				},
+			},
+		},
+		{
+			name: "shared addresses are added once",
+			nodes: []corev1.Node{
+				node("node-1", corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"}),
+				node("node-2", corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"}),
+			},
+			want: []operatorv1beta1.Address{
+				{
+					Type:       new(operatorv1beta1.IPAddressType),
+					Value:      "10.0.0.1",
+					SourceType: operatorv1beta1.PrivateIPAddressSourceType,
+				},
+			},
+		},
+		{
+			name: "invalid address",
+			nodes: []corev1.Node{
+				node("node-1", corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "not-an-ip"}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := AddressesFromNodes(tt.nodes)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, actual)
+		})
+	}
+}
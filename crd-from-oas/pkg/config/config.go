@@ -187,11 +187,20 @@ type SecretReferenceConfig struct {
 	// emits a list of secret sources ([]SensitiveDataSource, one per element)
 	// instead of a single one — no separate array notation is needed in Path.
 	Path string `yaml:"path"`
-	// Type is the Kubernetes resource type that holds the sensitive data.
-	// Currently only "Secret" is supported.
+	// Type is the Kubernetes resource type that holds the data: "Secret" or
+	// "ConfigMap". ConfigMap is meant for non-sensitive but large values (e.g.
+	// an OpenAPI document) and is only supported for string-valued leaves that
+	// are not inside an array.
 	Type string `yaml:"type"`
 }
 
+const (
+	// SecretReferenceTypeSecret sources the field from a Kubernetes Secret.
+	SecretReferenceTypeSecret = "Secret"
+	// SecretReferenceTypeConfigMap sources the field from a Kubernetes ConfigMap.
+	SecretReferenceTypeConfigMap = "ConfigMap"
+)
+
 // TypeConfig holds configuration for a single CRD type (identified by its OpenAPI path).
 type TypeConfig struct {
 	// Path is the OpenAPI path that identifies the resource (e.g. "/services").
@@ -964,8 +973,8 @@ func (tc *TypeConfig) validate() error {
 		if !strings.HasPrefix(sr.Path, "spec.apiSpec.") {
 			return fmt.Errorf("secretReferences[%d].path must start with \"spec.apiSpec.\", got %q", i, sr.Path)
 		}
-		if sr.Type != "Secret" {
-			return fmt.Errorf("secretReferences[%d].type %q is not supported; only \"Secret\" is currently allowed", i, sr.Type)
+		if sr.Type != SecretReferenceTypeSecret && sr.Type != SecretReferenceTypeConfigMap {
+			return fmt.Errorf("secretReferences[%d].type %q is not supported; only \"Secret\" and \"ConfigMap\" are currently allowed", i, sr.Type)
 		}
 		if seenSecretPaths[sr.Path] {
 			return fmt.Errorf("secretReferences[%d]: duplicate path %q", i, sr.Path)
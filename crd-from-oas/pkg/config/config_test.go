@@ -735,6 +735,28 @@ func TestTypeConfig_ValidateAssociations(t *testing.T) {
 	})
 }
 
+func TestTypeConfig_ValidateSecretReferences(t *testing.T) {
+	t.Run("Secret and ConfigMap types are allowed", func(t *testing.T) {
+		tc := &TypeConfig{SecretReferences: []SecretReferenceConfig{
+			{Path: "spec.apiSpec.certificate", Type: SecretReferenceTypeSecret},
+			{Path: "spec.apiSpec.content", Type: SecretReferenceTypeConfigMap},
+		}}
+		require.NoError(t, tc.validate())
+	})
+	t.Run("unsupported type errors", func(t *testing.T) {
+		tc := &TypeConfig{SecretReferences: []SecretReferenceConfig{
+			{Path: "spec.apiSpec.content", Type: "Volume"},
+		}}
+		require.ErrorContains(t, tc.validate(), `type "Volume" is not supported`)
+	})
+	t.Run("path outside of apiSpec errors", func(t *testing.T) {
+		tc := &TypeConfig{SecretReferences: []SecretReferenceConfig{
+			{Path: "spec.content", Type: SecretReferenceTypeConfigMap},
+		}}
+		require.ErrorContains(t, tc.validate(), `must start with "spec.apiSpec."`)
+	})
+}
+
 func TestAPIGroupVersionConfig_AssociationsConfig(t *testing.T) {
 	agv := &APIGroupVersionConfig{
 		Types: []*TypeConfig{
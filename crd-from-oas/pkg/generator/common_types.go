@@ -6,6 +6,11 @@ package generator
 // but for now it's a constant since it applies uniformly to all string fields in the SensitiveDataSource struct.
 const sensitiveDataSourceValueMaxLength = 4096
 
+// configMapDataSourceValueMaxLength defines the maximum length for inline
+// values in the ConfigMapDataSource struct. It matches the maximum size of a
+// ConfigMap so that a value can be moved between the two sources.
+const configMapDataSourceValueMaxLength = 1048576
+
 const objectRefTypeEnum = `// ObjectRefType is the enum type for the ObjectRef.
 //
 // +kubebuilder:validation:Enum=namespacedRef
@@ -103,7 +108,9 @@ type ConfigMapKeyRef struct {
 	// +kubebuilder:validation:MinLength=1
 	Key string ` + "`json:\"key,omitzero\"`" + `
 
-	// Namespace is the namespace of the ConfigMap
+	// Namespace is the namespace of the ConfigMap. It defaults to the namespace
+	// of the referrer. Other namespaces must be permitted by a KongReferenceGrant
+	// in that namespace.
 	//
 	// +optional
 	// +kubebuilder:validation:MaxLength=63
@@ -150,6 +157,41 @@ type SensitiveDataSource struct {
 	SecretRef *SensitiveDataSecretRef ` + "`" + `json:"secretRef,omitempty"` + "`" + `
 }`
 
+const configMapDataSourceType = `// ConfigMapDataSourceType is the type of source for data that can be sourced from a ConfigMap.
+type ConfigMapDataSourceType string
+
+const (
+	// ConfigMapDataSourceTypeInline indicates that the data is provided inline in the APISpec.
+	ConfigMapDataSourceTypeInline ConfigMapDataSourceType = "inline"
+	// ConfigMapDataSourceTypeConfigMapRef indicates that the data is sourced from a Kubernetes ConfigMap.
+	ConfigMapDataSourceTypeConfigMapRef ConfigMapDataSourceType = "configMapRef"
+)`
+
+const configMapDataSourceStructType = `// ConfigMapDataSource holds a string value that can be provided
+// either inline or sourced from a Kubernetes ConfigMap.
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'inline' ? has(self.value) : has(self.configMapRef)",message="value required when type=inline; configMapRef required when type=configMapRef"
+type ConfigMapDataSource struct {
+	// Type indicates the source of the data: 'inline' or 'configMapRef'.
+	//
+	// +kubebuilder:validation:Enum=inline;configMapRef
+	// +kubebuilder:default=inline
+	Type ConfigMapDataSourceType ` + "`" + `json:"type"` + "`" + `
+
+	// Value contains the data provided inline.
+	// Required when type is 'inline'.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength={{ .ConfigMapDataSourceValueMaxLength }}
+	Value *string ` + "`" + `json:"value,omitempty"` + "`" + `
+
+	// ConfigMapRef is a reference to a key in a Kubernetes ConfigMap containing the data.
+	// Required when type is 'configMapRef'.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapKeyRef ` + "`" + `json:"configMapRef,omitempty"` + "`" + `
+}`
+
 // dedicatedSensitiveDataSourceStructType is a per-field variant of
 // sensitiveDataSourceStructType, emitted into an entity's own generated types
 // file (not common_types.go) for secret reference leaves whose OAS type isn't
@@ -197,14 +239,15 @@ type KonnectEntityRef struct {
 
 // flattenSensitiveDataHelper is a runtime helper emitted into common_types.go.
 // It replaces every JSON object matching the SensitiveDataSource (or dedicated
-// per-field DataSource) wire shape {"type": "inline"|"secretRef", "value": X, ...}
+// per-field DataSource, or ConfigMapDataSource) wire shape
+// {"type": "inline"|"secretRef"|"configMapRef", "value": X, ...}
 // with the bare value X, so the Konnect SDK receives a plain value instead of
 // the structured CRD representation. X may be a string, number, boolean,
 // object, or array — the shape check only inspects "type", not "value"'s kind.
 const flattenSensitiveDataHelper = `// flattenSensitiveData recursively replaces any SensitiveDataSource (or
-// dedicated per-field DataSource) JSON object shape
-// {"type": "inline|secretRef", "value": X, ...} with the bare value X,
-// translating the CRD wire format to the Konnect SDK wire format which
+// dedicated per-field DataSource, or ConfigMapDataSource) JSON object shape
+// {"type": "inline|secretRef|configMapRef", "value": X, ...} with the bare
+// value X, translating the CRD wire format to the Konnect SDK wire format which
 // expects plain values (of whatever type X is) for sensitive fields.
 func flattenSensitiveData(v any) any {
 	switch x := v.(type) {
@@ -213,7 +256,7 @@ func flattenSensitiveData(v any) any {
 			x[k] = flattenSensitiveData(val)
 		}
 		typ, _ := x["type"].(string)
-		if typ != "inline" && typ != "secretRef" {
+		if typ != "inline" && typ != "secretRef" && typ != "configMapRef" {
 			return x
 		}
 		if rawVal, hasVal := x["value"]; hasVal {
@@ -131,15 +131,21 @@ type Generator struct {
 	schemaTypeRefFieldsCache map[string]map[string]string
 }
 
-const sensitiveDataSourceTypeName = "SensitiveDataSource"
+const (
+	sensitiveDataSourceTypeName = "SensitiveDataSource"
+	configMapDataSourceTypeName = "ConfigMapDataSource"
+)
 
 // sensitiveLeafType records the resolved value type for a single secret
 // reference leaf. ValueGoType "string" means the leaf keeps using the shared
 // SensitiveDataSource type; any other value means DedicatedTypeName is the
-// generated per-field union type name to use instead.
+// generated per-field union type name to use instead. FromConfigMap marks a
+// string leaf sourced from a ConfigMap, which uses the shared
+// ConfigMapDataSource type.
 type sensitiveLeafType struct {
 	ValueGoType       string
 	DedicatedTypeName string
+	FromConfigMap     bool
 }
 
 // NewGenerator creates a new generator.
@@ -222,6 +228,28 @@ func (g *Generator) hasAnySecretRefs() bool {
 	return len(g.config.SecretReferences) > 0
 }
 
+// hasSecretRefsOfType returns true if the entity has at least one configured
+// SecretReference of the given type ("Secret" or "ConfigMap").
+func (g *Generator) hasSecretRefsOfType(entityName, typ string) bool {
+	for _, ref := range g.config.SecretReferences[entityName] {
+		if ref.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyConfigMapRefs returns true if any entity in the config has a
+// SecretReference sourced from a ConfigMap.
+func (g *Generator) hasAnyConfigMapRefs() bool {
+	for entityName := range g.config.SecretReferences {
+		if g.hasSecretRefsOfType(entityName, config.SecretReferenceTypeConfigMap) {
+			return true
+		}
+	}
+	return false
+}
+
 // entitySupportsMirror reports whether the entity opted into Origin+Mirror via
 // source.supportsMirror in the config.
 func (g *Generator) entitySupportsMirror(entityName string) bool {
@@ -292,6 +320,43 @@ func (g *Generator) buildSensitiveLeaves(parsed *parser.ParsedSpec) error {
 			}
 		}
 	}
+	return g.markConfigMapLeaves()
+}
+
+// markConfigMapLeaves flags every recorded leaf configured with type
+// ConfigMap so that it is emitted as ConfigMapDataSource instead of
+// SensitiveDataSource. ConfigMap sourcing is only supported for string
+// leaves outside of arrays: it exists for large, non-sensitive documents
+// rather than for per-element values.
+func (g *Generator) markConfigMapLeaves() error {
+	for entityName, refs := range g.config.SecretReferences {
+		for _, ref := range refs {
+			if ref.Type != config.SecretReferenceTypeConfigMap {
+				continue
+			}
+			tmpls := g.sensitiveLeafSelectors[entityName][ref.Path]
+			for i := range tmpls {
+				if tmpls[i].IsSlice || tmpls[i].ValueGoType != "string" {
+					return fmt.Errorf("entity %q path %q: ConfigMap references are only supported for string fields outside of arrays", entityName, ref.Path)
+				}
+				tmpls[i].FromConfigMap = true
+			}
+		}
+	}
+	markLeaves := func(leaves map[string]map[string]config.SecretReferenceConfig, types map[string]map[string]sensitiveLeafType) {
+		for typeName, fields := range leaves {
+			for jsonFieldName, ref := range fields {
+				lt, ok := types[typeName][jsonFieldName]
+				if !ok || ref.Type != config.SecretReferenceTypeConfigMap {
+					continue
+				}
+				lt.FromConfigMap = true
+				types[typeName][jsonFieldName] = lt
+			}
+		}
+	}
+	markLeaves(g.sensitiveSchemaLeaves, g.schemaLeafValueTypes)
+	markLeaves(g.entityDirectSensitiveLeaves, g.entityDirectLeafValueTypes)
 	return nil
 }
 
@@ -647,9 +712,13 @@ func (g *Generator) entityAPISpecFieldSensitiveType(entityName, jsonFieldName st
 }
 
 // sensitiveGoTypeName returns the Go type name to emit for a sensitive leaf:
-// the shared SensitiveDataSource for string-valued leaves, or the dedicated
+// the shared SensitiveDataSource for string-valued leaves, the shared
+// ConfigMapDataSource for leaves sourced from a ConfigMap, or the dedicated
 // per-field type name otherwise.
 func (lt sensitiveLeafType) sensitiveGoTypeName() string {
+	if lt.FromConfigMap {
+		return configMapDataSourceTypeName
+	}
 	if lt.DedicatedTypeName != "" {
 		return lt.DedicatedTypeName
 	}
@@ -843,6 +912,9 @@ type SecretReferenceForTemplate struct {
 	// this leaf instead of the shared SensitiveDataSource, e.g.
 	// "AIGatewayPolicyConfigDataSource". Empty when ValueGoType is "string".
 	DedicatedTypeName string
+	// FromConfigMap is true when the leaf is configured with type ConfigMap and
+	// uses the shared ConfigMapDataSource type, resolved from a ConfigMap key.
+	FromConfigMap bool
 }
 
 // selectorPart records one step in a Go field selector during sensitive-leaf path walking.
@@ -1001,6 +1073,17 @@ func secretReferencesNeedCoreV1Import(refs []SecretReferenceForTemplate) bool {
 	return false
 }
 
+// secretReferencesUseConfigMap reports whether any of the given secret
+// references is sourced from a ConfigMap.
+func secretReferencesUseConfigMap(refs []SecretReferenceForTemplate) bool {
+	for _, ref := range refs {
+		if ref.FromConfigMap {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *Generator) entityAPISpecSensitiveLeaf(entityName, jsonFieldName string) bool {
 	leaves, ok := g.entityDirectSensitiveLeaves[entityName]
 	if !ok {
@@ -4221,6 +4304,8 @@ func (g *Generator) generateCommonTypes(typeCursors map[string]*config.FieldConf
 		ObjectRefImport                         *config.ImportConfig
 		Namespaced                              bool
 		HasSecretRefEntities                    bool
+		HasConfigMapRefEntities                 bool
+		ConfigMapDataSourceValueMaxLength       int
 		SensitiveDataSourceValueMaxLength       int
 		SensitiveDataSourceTypeValidations      []string
 		SensitiveDataSourceSecretRefValidations []string
@@ -4232,6 +4317,8 @@ func (g *Generator) generateCommonTypes(typeCursors map[string]*config.FieldConf
 		ObjectRefImport:                         objectRefImport,
 		Namespaced:                              g.objectRefNamespaced(),
 		HasSecretRefEntities:                    hasSecretRefs,
+		HasConfigMapRefEntities:                 g.hasAnyConfigMapRefs(),
+		ConfigMapDataSourceValueMaxLength:       configMapDataSourceValueMaxLength,
 		SensitiveDataSourceValueMaxLength:       sensitiveDataSourceValueMaxLength,
 		SensitiveDataSourceTypeValidations:      fieldValidations(sensitiveCursor, "type"),
 		SensitiveDataSourceSecretRefValidations: fieldValidations(sensitiveCursor, "secretRef"),
@@ -5747,6 +5834,7 @@ func (g *Generator) generateSDKOps(entityName string, schema *parser.Schema, ops
 		NeedsClient              bool
 		SecretReferences         []SecretReferenceForTemplate
 		NeedsSecretFetchImport   bool
+		HasConfigMapRefs         bool
 		HasReferences            bool
 		References               []TemplateReferenceConfig
 		NeedsCrossNamespaceCheck bool
@@ -5765,6 +5853,7 @@ func (g *Generator) generateSDKOps(entityName string, schema *parser.Schema, ops
 		NeedsClient:              opsConfig.RequireClient || g.entityHasReferences(entityName),
 		SecretReferences:         secretReferences,
 		NeedsSecretFetchImport:   secretReferencesNeedCoreV1Import(secretReferences),
+		HasConfigMapRefs:         secretReferencesUseConfigMap(secretReferences),
 		HasReferences:            g.entityHasReferences(entityName),
 		References:               references,
 		NeedsCrossNamespaceCheck: referencesNeedCrossNamespaceCheck(references),
@@ -6055,6 +6144,7 @@ func (g *Generator) generateRootUnionSDKOps(
 		NeedsClient              bool
 		SecretReferences         []SecretReferenceForTemplate
 		NeedsSecretFetchImport   bool
+		HasConfigMapRefs         bool
 		References               []TemplateReferenceConfig
 		NeedsCrossNamespaceCheck bool
 		RefInjections            []TemplateRefInjection
@@ -6072,6 +6162,7 @@ func (g *Generator) generateRootUnionSDKOps(
 		NeedsClient:              opsConfig.RequireClient || g.entityHasReferences(entityName),
 		SecretReferences:         secretReferences,
 		NeedsSecretFetchImport:   secretReferencesNeedCoreV1Import(secretReferences),
+		HasConfigMapRefs:         secretReferencesUseConfigMap(secretReferences),
 		References:               references,
 		NeedsCrossNamespaceCheck: referencesNeedCrossNamespaceCheck(references),
 		RefInjections:            injections,
@@ -6146,9 +6237,11 @@ func (g *Generator) buildSDKOpsTestFields(entityName string, props []*parser.Pro
 		if leafType, ok := g.entityAPISpecFieldSensitiveType(entityName, jsonName(prop.Name)); ok {
 			// Sensitive field: emit an inline value; after flattenSensitiveData
 			// the JSON payload contains just the plain (unwrapped) value.
-			typeName := "SensitiveDataSource"
+			typeName, typeInline := "SensitiveDataSource", "SensitiveDataSourceTypeInline"
 			innerValue, expectedValue := `"test-value"`, fmt.Sprintf("%q", "test-value")
-			if leafType.DedicatedTypeName != "" {
+			if leafType.FromConfigMap {
+				typeName, typeInline = configMapDataSourceTypeName, "ConfigMapDataSourceTypeInline"
+			} else if leafType.DedicatedTypeName != "" {
 				typeName = leafType.DedicatedTypeName
 				innerValue, expectedValue = testValuesForProperty(prop, leafType.ValueGoType)
 				if innerValue == "" || expectedValue == "" {
@@ -6163,7 +6256,7 @@ func (g *Generator) buildSDKOpsTestFields(entityName string, props []*parser.Pro
 				// JSONName is the SDK payload key used in the generated
 				// payload["..."] check; prop.Name is already the OAS snake_case name.
 				JSONName:      prop.Name,
-				TestValue:     fmt.Sprintf("%s{Type: SensitiveDataSourceTypeInline, Value: new(%s)}", typeName, innerValue),
+				TestValue:     fmt.Sprintf("%s{Type: %s, Value: new(%s)}", typeName, typeInline, innerValue),
 				ExpectedValue: expectedValue,
 			})
 			continue
@@ -161,9 +161,11 @@ func (g *Generator) buildOpsControllerTestFields(entityName string, props []*par
 			continue
 		}
 		if leafType, ok := g.entityAPISpecFieldSensitiveType(entityName, jsonName(prop.Name)); ok {
-			typeName := "SensitiveDataSource"
+			typeName, typeInline := "SensitiveDataSource", "SensitiveDataSourceTypeInline"
 			innerValue := `"test-value"`
-			if leafType.DedicatedTypeName != "" {
+			if leafType.FromConfigMap {
+				typeName, typeInline = configMapDataSourceTypeName, "ConfigMapDataSourceTypeInline"
+			} else if leafType.DedicatedTypeName != "" {
 				typeName = leafType.DedicatedTypeName
 				innerValue = controllerOpsTestValueForProperty(prop, leafType.ValueGoType, g.config.APIGroupPackageAlias)
 				if innerValue == "" {
@@ -176,10 +178,11 @@ func (g *Generator) buildOpsControllerTestFields(entityName string, props []*par
 			testFields = append(testFields, opsControllerTestField{
 				FieldName: goFieldName(prop.Name),
 				TestValue: fmt.Sprintf(
-					`%s.%s{Type: %s.SensitiveDataSourceTypeInline, Value: new(%s)}`,
+					`%s.%s{Type: %s.%s, Value: new(%s)}`,
 					g.config.APIGroupPackageAlias,
 					typeName,
 					g.config.APIGroupPackageAlias,
+					typeInline,
 					innerValue,
 				),
 			})
@@ -23,7 +23,7 @@ import (
 	"context"
 
 	ctrl "sigs.k8s.io/controller-runtime"
-{{- if .HasSecretRefs}}
+{{- if or .HasSecretRefs .HasConfigMapRefs}}
 	corev1 "k8s.io/api/core/v1"
 {{- end}}
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,6 +84,16 @@ func {{.EntityName}}ReconciliationWatchOptions(
 				),
 			)
 		},
+{{- end}}
+{{- if .HasConfigMapRefs}}
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&corev1.ConfigMap{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueObjectsForConfigMapRef[{{.APIGroupPackageAlias}}.{{.EntityName}}List](cl),
+				),
+			)
+		},
 {{- end}}
 	}
 }
@@ -268,7 +278,7 @@ import (
 	"context"
 
 	ctrl "sigs.k8s.io/controller-runtime"
-{{- if .HasSecretRefs}}
+{{- if or .HasSecretRefs .HasConfigMapRefs}}
 	corev1 "k8s.io/api/core/v1"
 {{- end}}
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -329,6 +339,16 @@ func {{.EntityName}}ReconciliationWatchOptions(
 				),
 			)
 		},
+{{- end}}
+{{- if .HasConfigMapRefs}}
+		func(b *ctrl.Builder) *ctrl.Builder {
+			return b.Watches(
+				&corev1.ConfigMap{},
+				handler.EnqueueRequestsFromMapFunc(
+					enqueueObjectsForConfigMapRef[{{.APIGroupPackageAlias}}.{{.EntityName}}List](cl),
+				),
+			)
+		},
 {{- end}}
 	}
 }
@@ -508,9 +528,13 @@ type reconcilerEntityMetadata struct {
 	ParentAPIGroupPackagePath  string
 	ParentAPIGroupPackageAlias string
 	// HasSecretRefs is true when the entity has at least one configured
-	// secretReferences entry (string-valued or dedicated-type), so the
-	// generated watch file should also watch corev1.Secret.
+	// secretReferences entry of type Secret (string-valued or dedicated-type),
+	// so the generated watch file should also watch corev1.Secret.
 	HasSecretRefs bool
+	// HasConfigMapRefs is true when the entity has at least one configured
+	// secretReferences entry of type ConfigMap, so the generated watch file
+	// should also watch corev1.ConfigMap.
+	HasConfigMapRefs bool
 }
 
 type reconcilerConditionGroup struct {
@@ -739,6 +763,7 @@ func (g *Generator) generateWatch(metadata reconcilerEntityMetadata, rc *config.
 		ParentAPIGroupPackageAlias string
 		CrossRefs                  []crossRefWatchData
 		HasSecretRefs              bool
+		HasConfigMapRefs           bool
 	}{
 		EntityName:                 metadata.EntityName,
 		EntityNameLowerCamel:       metadata.EntityNameLowerCamel,
@@ -754,6 +779,7 @@ func (g *Generator) generateWatch(metadata reconcilerEntityMetadata, rc *config.
 		ParentAPIGroupPackageAlias: metadata.ParentAPIGroupPackageAlias,
 		CrossRefs:                  crossRefs,
 		HasSecretRefs:              metadata.HasSecretRefs,
+		HasConfigMapRefs:           metadata.HasConfigMapRefs,
 	}
 
 	if err := tmpl.Execute(&buf, data); err != nil {
@@ -851,7 +877,8 @@ func (g *Generator) reconcilerEntityMetadata(
 		APIGroupPackageAlias:       g.config.APIGroupPackageAlias,
 		ParentAPIGroupPackagePath:  g.config.APIGroupPackagePath,
 		ParentAPIGroupPackageAlias: g.config.APIGroupPackageAlias,
-		HasSecretRefs:              g.hasSecretRefs(entityName),
+		HasSecretRefs:              g.hasSecretRefsOfType(entityName, config.SecretReferenceTypeSecret),
+		HasConfigMapRefs:           g.hasSecretRefsOfType(entityName, config.SecretReferenceTypeConfigMap),
 	}
 
 	if rc.GetIsRoot() {
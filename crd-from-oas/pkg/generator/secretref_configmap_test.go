@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kong-operator/v2/crd-from-oas/pkg/config"
+	"github.com/kong/kong-operator/v2/crd-from-oas/pkg/parser"
+)
+
+func configMapSecretRefGenerator(t *testing.T, entitySchema *parser.Schema, refs ...config.SecretReferenceConfig) *Generator {
+	t.Helper()
+
+	parsed := &parser.ParsedSpec{
+		RequestBodies: map[string]*parser.Schema{"FakeSpecification": entitySchema},
+		Schemas:       map[string]*parser.Schema{},
+	}
+	g := NewGenerator(Config{
+		APIVersion: "v1alpha1",
+		SecretReferences: map[string][]config.SecretReferenceConfig{
+			"FakeSpecification": refs,
+		},
+	})
+	require.NoError(t, g.buildSensitiveLeaves(parsed))
+	return g
+}
+
+// TestBuildSensitiveLeaves_ConfigMapDirectField covers a direct apiSpec-level
+// string field configured with type ConfigMap: it must use the shared
+// ConfigMapDataSource type instead of SensitiveDataSource.
+func TestBuildSensitiveLeaves_ConfigMapDirectField(t *testing.T) {
+	entitySchema := &parser.Schema{
+		Properties: []*parser.Property{
+			{Name: "content", Type: "string"},
+			{Name: "type", Type: "string"},
+		},
+	}
+	g := configMapSecretRefGenerator(t, entitySchema,
+		config.SecretReferenceConfig{Path: "spec.apiSpec.content", Type: config.SecretReferenceTypeConfigMap},
+	)
+
+	tmpls := g.templateSecretReferences("FakeSpecification")
+	require.Len(t, tmpls, 1)
+	assert.True(t, tmpls[0].FromConfigMap)
+	assert.Equal(t, "string", tmpls[0].ValueGoType)
+	assert.Empty(t, tmpls[0].DedicatedTypeName)
+
+	content, err := g.generateCRDType("FakeSpecification", entitySchema)
+	require.NoError(t, err)
+	assert.Contains(t, content, "Content ConfigMapDataSource")
+	assert.NotContains(t, content, "SensitiveDataSource")
+}
+
+func TestBuildSensitiveLeaves_ConfigMapArrayLeaf_Errors(t *testing.T) {
+	entitySchema := &parser.Schema{
+		Properties: []*parser.Property{
+			{Name: "documents", Type: "array", Items: &parser.Property{Type: "string"}},
+		},
+	}
+	parsed := &parser.ParsedSpec{
+		RequestBodies: map[string]*parser.Schema{"FakeSpecification": entitySchema},
+		Schemas:       map[string]*parser.Schema{},
+	}
+	g := NewGenerator(Config{
+		APIVersion: "v1alpha1",
+		SecretReferences: map[string][]config.SecretReferenceConfig{
+			"FakeSpecification": {
+				{Path: "spec.apiSpec.documents", Type: config.SecretReferenceTypeConfigMap},
+			},
+		},
+	})
+	err := g.buildSensitiveLeaves(parsed)
+	require.ErrorContains(t, err, "ConfigMap references are only supported for string fields outside of arrays")
+}
+
+func TestGenerateSDKOps_ConfigMapReference_ResolvesConfigMap(t *testing.T) {
+	entitySchema := &parser.Schema{
+		Properties: []*parser.Property{
+			{Name: "content", Type: "string"},
+			{Name: "token", Type: "string"},
+		},
+	}
+	g := configMapSecretRefGenerator(t, entitySchema,
+		config.SecretReferenceConfig{Path: "spec.apiSpec.content", Type: config.SecretReferenceTypeConfigMap},
+		config.SecretReferenceConfig{Path: "spec.apiSpec.token", Type: config.SecretReferenceTypeSecret},
+	)
+
+	opsConfig := &config.EntityOpsConfig{
+		RequireClient: true,
+		Ops: map[string]*config.OpConfig{
+			"create": {Path: "github.com/Kong/sdk-konnect-go/models/components.CreateFakeSpecificationRequest"},
+		},
+	}
+	content, err := g.generateSDKOps("FakeSpecification", entitySchema, opsConfig)
+	require.NoError(t, err)
+
+	assert.Contains(t, content, "if src.Type == ConfigMapDataSourceTypeConfigMapRef {")
+	assert.Contains(t, content, "var configMap corev1.ConfigMap")
+	assert.Contains(t, content, "resolved, ok := configMap.Data[src.ConfigMapRef.Key]")
+	assert.Contains(t, content, "apiSpec.Content.Value = &resolved")
+	assert.Contains(t, content, "func (obj *FakeSpecification) GetSensitiveDataConfigMapRefs() []ConfigMapKeyRef {")
+	assert.Contains(t, content, "refs = append(refs, *obj.Spec.APISpec.Content.ConfigMapRef)")
+	// The Secret sourced field is still resolved and reported as a Secret reference.
+	assert.Contains(t, content, "refs = append(refs, *obj.Spec.APISpec.Token.SecretRef)")
+	assert.NotContains(t, content, "obj.Spec.APISpec.Content.SecretRef")
+}
+
+func TestGenerateCommonTypes_ConfigMapDataSource(t *testing.T) {
+	entitySchema := &parser.Schema{
+		Properties: []*parser.Property{
+			{Name: "content", Type: "string"},
+		},
+	}
+
+	t.Run("emitted when a ConfigMap reference is configured", func(t *testing.T) {
+		g := configMapSecretRefGenerator(t, entitySchema,
+			config.SecretReferenceConfig{Path: "spec.apiSpec.content", Type: config.SecretReferenceTypeConfigMap},
+		)
+		content, err := g.generateCommonTypes(nil)
+		require.NoError(t, err)
+		assert.Contains(t, content, "type ConfigMapDataSource struct {")
+		assert.Contains(t, content, `ConfigMapDataSourceTypeConfigMapRef ConfigMapDataSourceType = "configMapRef"`)
+		assert.Contains(t, content, `typ != "configMapRef"`)
+	})
+
+	t.Run("omitted for Secret references only", func(t *testing.T) {
+		g := configMapSecretRefGenerator(t, entitySchema,
+			config.SecretReferenceConfig{Path: "spec.apiSpec.content", Type: config.SecretReferenceTypeSecret},
+		)
+		content, err := g.generateCommonTypes(nil)
+		require.NoError(t, err)
+		assert.NotContains(t, content, "type ConfigMapDataSource struct {")
+	})
+}
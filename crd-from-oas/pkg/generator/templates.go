@@ -798,6 +798,28 @@ func (obj *{{$.EntityName}}) sdkOpsAPISpec(ctx context.Context, cl client.Client
 {{- end}}
 	{
 		src := apiSpec.{{.GoFieldSelector}}
+{{- if $ref.FromConfigMap}}
+		if src.Type == ConfigMapDataSourceTypeConfigMapRef {
+			if src.ConfigMapRef == nil {
+				return nil, fmt.Errorf("configMapRef is nil for {{.Path}}")
+			}
+			namespace := obj.GetNamespace()
+			// Cross-namespace references are permitted by KongReferenceGrants, which
+			// the reconciler checks before converting the entity.
+			if src.ConfigMapRef.Namespace != "" {
+				namespace = src.ConfigMapRef.Namespace
+			}
+			var configMap corev1.ConfigMap
+			if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: src.ConfigMapRef.Name}, &configMap); err != nil {
+				return nil, fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", namespace, src.ConfigMapRef.Name, err)
+			}
+			resolved, ok := configMap.Data[src.ConfigMapRef.Key]
+			if !ok {
+				return nil, fmt.Errorf("configmap %s/%s is missing key %q", namespace, src.ConfigMapRef.Name, src.ConfigMapRef.Key)
+			}
+			apiSpec.{{.GoFieldSelector}}.Value = &resolved
+		}
+{{- else}}
 		if src.Type == SensitiveDataSourceTypeSecretRef {
 			if src.SecretRef == nil {
 				return nil, fmt.Errorf("secretRef is nil for {{.Path}}")
@@ -826,6 +848,7 @@ func (obj *{{$.EntityName}}) sdkOpsAPISpec(ctx context.Context, cl client.Client
 {{- end}}
 			apiSpec.{{.GoFieldSelector}}.Value = &resolved
 		}
+{{- end}}
 	}
 {{- range $ref.PointerGuards}}
 	}
@@ -843,7 +866,8 @@ func (obj *{{$.EntityName}}) GetSensitiveDataSecretRefs() []SensitiveDataSecretR
 	var refs []SensitiveDataSecretRef
 {{- range .SecretReferences}}
 {{- $ref := .}}
-{{- if .IsSlice}}
+{{- if .FromConfigMap}}
+{{- else if .IsSlice}}
 {{- range .PointerGuards}}
 	if obj.Spec.APISpec.{{.}} != nil {
 {{- end}}
@@ -869,6 +893,30 @@ func (obj *{{$.EntityName}}) GetSensitiveDataSecretRefs() []SensitiveDataSecretR
 {{- end}}
 	return refs
 }
+{{- if $.HasConfigMapRefs}}
+
+// GetSensitiveDataConfigMapRefs returns all ConfigMap references used to populate SDK payload fields.
+func (obj *{{$.EntityName}}) GetSensitiveDataConfigMapRefs() []ConfigMapKeyRef {
+	if obj == nil {
+		return nil
+	}
+	var refs []ConfigMapKeyRef
+{{- range .SecretReferences}}
+{{- if .FromConfigMap}}
+{{- range .PointerGuards}}
+	if obj.Spec.APISpec.{{.}} != nil {
+{{- end}}
+	if obj.Spec.APISpec.{{.GoFieldSelector}}.Type == ConfigMapDataSourceTypeConfigMapRef && obj.Spec.APISpec.{{.GoFieldSelector}}.ConfigMapRef != nil {
+		refs = append(refs, *obj.Spec.APISpec.{{.GoFieldSelector}}.ConfigMapRef)
+	}
+{{- range .PointerGuards}}
+	}
+{{- end}}
+{{- end}}
+{{- end}}
+	return refs
+}
+{{- end}}
 {{- end}}
 {{- template "sdkOpsReferenceResolvers" $}}
 {{range .Methods}}
@@ -1699,6 +1747,28 @@ func (obj *{{$.EntityName}}) sdkOpsAPISpec(ctx context.Context, cl client.Client
 {{- end}}
 	{
 		src := apiSpec.{{.GoFieldSelector}}
+{{- if $ref.FromConfigMap}}
+		if src.Type == ConfigMapDataSourceTypeConfigMapRef {
+			if src.ConfigMapRef == nil {
+				return nil, fmt.Errorf("configMapRef is nil for {{.Path}}")
+			}
+			namespace := obj.GetNamespace()
+			// Cross-namespace references are permitted by KongReferenceGrants, which
+			// the reconciler checks before converting the entity.
+			if src.ConfigMapRef.Namespace != "" {
+				namespace = src.ConfigMapRef.Namespace
+			}
+			var configMap corev1.ConfigMap
+			if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: src.ConfigMapRef.Name}, &configMap); err != nil {
+				return nil, fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", namespace, src.ConfigMapRef.Name, err)
+			}
+			resolved, ok := configMap.Data[src.ConfigMapRef.Key]
+			if !ok {
+				return nil, fmt.Errorf("configmap %s/%s is missing key %q", namespace, src.ConfigMapRef.Name, src.ConfigMapRef.Key)
+			}
+			apiSpec.{{.GoFieldSelector}}.Value = &resolved
+		}
+{{- else}}
 		if src.Type == SensitiveDataSourceTypeSecretRef {
 			if src.SecretRef == nil {
 				return nil, fmt.Errorf("secretRef is nil for {{.Path}}")
@@ -1727,6 +1797,7 @@ func (obj *{{$.EntityName}}) sdkOpsAPISpec(ctx context.Context, cl client.Client
 {{- end}}
 			apiSpec.{{.GoFieldSelector}}.Value = &resolved
 		}
+{{- end}}
 	}
 {{- range $ref.PointerGuards}}
 	}
@@ -1744,7 +1815,8 @@ func (obj *{{$.EntityName}}) GetSensitiveDataSecretRefs() []SensitiveDataSecretR
 	var refs []SensitiveDataSecretRef
 {{- range .SecretReferences}}
 {{- $ref := .}}
-{{- if .IsSlice}}
+{{- if .FromConfigMap}}
+{{- else if .IsSlice}}
 {{- range .PointerGuards}}
 	if obj.Spec.APISpec.{{.}} != nil {
 {{- end}}
@@ -1770,6 +1842,30 @@ func (obj *{{$.EntityName}}) GetSensitiveDataSecretRefs() []SensitiveDataSecretR
 {{- end}}
 	return refs
 }
+{{- if $.HasConfigMapRefs}}
+
+// GetSensitiveDataConfigMapRefs returns all ConfigMap references used to populate SDK payload fields.
+func (obj *{{$.EntityName}}) GetSensitiveDataConfigMapRefs() []ConfigMapKeyRef {
+	if obj == nil {
+		return nil
+	}
+	var refs []ConfigMapKeyRef
+{{- range .SecretReferences}}
+{{- if .FromConfigMap}}
+{{- range .PointerGuards}}
+	if obj.Spec.APISpec.{{.}} != nil {
+{{- end}}
+	if obj.Spec.APISpec.{{.GoFieldSelector}}.Type == ConfigMapDataSourceTypeConfigMapRef && obj.Spec.APISpec.{{.GoFieldSelector}}.ConfigMapRef != nil {
+		refs = append(refs, *obj.Spec.APISpec.{{.GoFieldSelector}}.ConfigMapRef)
+	}
+{{- range .PointerGuards}}
+	}
+{{- end}}
+{{- end}}
+{{- end}}
+	return refs
+}
+{{- end}}
 {{- end}}
 {{- template "sdkOpsReferenceResolvers" $}}
 {{range .Methods}}
@@ -2397,6 +2493,12 @@ import (
 
 ` + sensitiveDataSourceStructType + `
 {{- end}}
+{{- if .HasConfigMapRefEntities}}
+
+` + configMapDataSourceType + `
+
+` + configMapDataSourceStructType + `
+{{- end}}
 
 ` + flattenSDKUnionsHelper + `
 
@@ -74,6 +74,10 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "BackendTLSPolicy",
 		Package: "gatewayapi",
 	},
+	{
+		Type:    "ListenerSet",
+		Package: "gatewayapi",
+	},
 	// Kong types
 	{
 		Type:       "KongPlugin",
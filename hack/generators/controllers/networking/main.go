@@ -237,6 +237,7 @@ var inputControllersNeeded = &typesNeeded{
 		},
 		AcceptsIngressClassNameAnnotation: true,
 		RBACVerbs:                         []string{"get", "list", "watch"},
+		HasControlPlaneReference:          true,
 	},
 }
 
@@ -37,6 +37,8 @@ type Client struct {
 	podRef *k8stypes.NamespacedName
 	// tlsServerName stores the SNI used to verify the Admin API certificate.
 	tlsServerName string
+	// partition is the name of the configuration partition the client is to be configured with.
+	partition string
 }
 
 // NewClient creates an Admin API client that is to be used with a regular Admin API exposed by Kong Gateways.
@@ -205,6 +207,11 @@ func (c *Client) AttachTLSServerName(tlsServerName string) {
 	c.tlsServerName = tlsServerName
 }
 
+// AttachPartition sets the name of the configuration partition the client is to be configured with.
+func (c *Client) AttachPartition(partition string) {
+	c.partition = partition
+}
+
 // PodReference returns an optional reference to the Pod the client communicates with.
 func (c *Client) PodReference() (k8stypes.NamespacedName, bool) {
 	if c.podRef != nil {
@@ -218,6 +225,12 @@ func (c *Client) TLSServerName() string {
 	return c.tlsServerName
 }
 
+// Partition returns the name of the configuration partition the client is to be configured with. It's empty when
+// the client is to be configured with the routes not belonging to any partition.
+func (c *Client) Partition() string {
+	return c.partition
+}
+
 type ClientFactory struct {
 	logger     logr.Logger
 	workspace  string
@@ -254,5 +267,6 @@ func (cf ClientFactory) CreateAdminAPIClient(ctx context.Context, discoveredAdmi
 
 	cl.AttachPodReference(discoveredAdminAPI.PodRef)
 	cl.AttachTLSServerName(discoveredAdminAPI.TLSServerName)
+	cl.AttachPartition(discoveredAdminAPI.Partition)
 	return cl, nil
 }
@@ -22,6 +22,9 @@ type DiscoveredAdminAPI struct {
 	TLSServerName string
 	// PodRef is the reference to the Pod with the above IP address.
 	PodRef k8stypes.NamespacedName
+	// Partition is the name of the configuration partition the Admin API was discovered for. It's empty for the
+	// Admin APIs receiving the configuration not belonging to any partition.
+	Partition string
 }
 
 type Discoverer struct {
@@ -18,6 +18,7 @@ import (
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/versions"
 	ingresserrors "github.com/kong/kong-operator/v2/ingress-controller/pkg/errors"
 	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/modules/manager/metadata"
 )
 
@@ -170,10 +171,10 @@ func makeHTTPClient(opts managercfg.AdminAPIClientConfig, kongAdminToken string)
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	transport.TLSClientConfig = &tlsConfig
 	return &http.Client{
-		Transport: &HeaderRoundTripper{
+		Transport: opentelemetry.HTTPTransport(&HeaderRoundTripper{
 			headers: prepareHeaders(opts.Headers, kongAdminToken),
 			rt:      transport,
-		},
+		}),
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/admission/validation"
 	gatewaycontroller "github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/gateway"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
@@ -74,10 +76,68 @@ func ValidateHTTPRoute(
 	return ok, msg, nil
 }
 
+// ValidateHTTPRouteConflicts checks whether any expression route translated from the provided HTTPRoute
+// would be shadowed by, or ambiguous with, a route translated from one of the existing HTTPRoutes.
+// Existing HTTPRoutes with the same namespace and name as the provided one are ignored, as they are
+// previous versions of it.
+func ValidateHTTPRouteConflicts(
+	httproute *gatewayapi.HTTPRoute,
+	existingHTTPRoutes []*gatewayapi.HTTPRoute,
+	translatorFeatures translator.FeatureFlags,
+) (bool, string) {
+	if !translatorFeatures.ExpressionRoutes {
+		return true, ""
+	}
+
+	routes := make([]*gatewayapi.HTTPRoute, 0, len(existingHTTPRoutes)+1)
+	for _, existing := range existingHTTPRoutes {
+		if existing.Namespace == httproute.Namespace && existing.Name == httproute.Name {
+			continue
+		}
+		routes = append(routes, withHTTPRouteTypeMeta(existing))
+	}
+	routes = append(routes, withHTTPRouteTypeMeta(httproute))
+
+	translationResult := subtranslator.TranslateHTTPRoutesToKongstateServices(
+		logr.Discard(),
+		store.NewFakeStoreEmpty(),
+		routes,
+		subtranslator.TranslateHTTPRouteToKongstateServiceOptions{
+			CombinedServicesFromDifferentHTTPRoutes: translatorFeatures.CombinedServicesFromDifferentHTTPRoutes,
+			ExpressionRoutes:                        true,
+			SupportRedirectPlugin:                   translatorFeatures.SupportRedirectPlugin,
+		},
+	)
+	services := make([]kongstate.Service, 0, len(translationResult.ServiceNameToKongstateService))
+	for _, service := range translationResult.ServiceNameToKongstateService {
+		services = append(services, service)
+	}
+
+	var msgs []string
+	for _, conflict := range translator.DetectRouteConflicts(services) {
+		if conflict.Source.Namespace == httproute.Namespace && conflict.Source.Name == httproute.Name {
+			msgs = append(msgs, conflict.Message())
+		}
+	}
+	if len(msgs) > 0 {
+		slices.Sort(msgs)
+		return false, fmt.Sprintf("HTTPRoute conflicts with existing HTTPRoutes: %s", strings.Join(msgs, ", "))
+	}
+	return true, ""
+}
+
 // -----------------------------------------------------------------------------
 // Validation - HTTPRoute - Private Functions
 // -----------------------------------------------------------------------------
 
+// withHTTPRouteTypeMeta returns a copy of the HTTPRoute with its TypeMeta set, as the kind of the route is needed
+// to tell which object the translated routes come from.
+func withHTTPRouteTypeMeta(httproute *gatewayapi.HTTPRoute) *gatewayapi.HTTPRoute {
+	httproute = httproute.DeepCopy()
+	httproute.TypeMeta = gatewayapi.V1HTTPRouteTypeMeta
+	return httproute
+}
+
 // parentRefIsGateway returns true if the group/kind of ParentReference is empty or gateway.networking.k8s.io/Gateway.
 func parentRefIsGateway(parentRef gatewayapi.ParentReference) bool {
 	const KindGateway = gatewayapi.Kind("Gateway")
@@ -3,16 +3,20 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	netv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/admission/validation"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/failures"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
@@ -41,7 +45,19 @@ func ValidateIngress(
 		return false, fmt.Sprintf("Ingress has invalid Kong annotations: %s", err), nil
 	}
 
-	for _, kg := range ingressToKongRoutesForValidation(kongVersion, translatorFeatures, ingress, failuresCollector, logger, storer) {
+	// Canary Ingresses are translated along with their primary Ingress.
+	ingresses := []*netv1.Ingress{ingress}
+	if primaryName, ok := annotations.ExtractCanaryOf(ingress.Annotations); ok {
+		primary, found := lo.Find(storer.ListIngressesV1(), func(i *netv1.Ingress) bool {
+			return i.Namespace == ingress.Namespace && i.Name == primaryName
+		})
+		if !found {
+			return false, fmt.Sprintf("Ingress has invalid Kong annotations: primary Ingress %q of canary Ingress not found", primaryName), nil
+		}
+		ingresses = append(ingresses, primary)
+	}
+
+	for _, kg := range ingressToKongRoutesForValidation(kongVersion, translatorFeatures, ingress, ingresses, failuresCollector, logger, storer) {
 		// Validate by using feature of Kong Gateway.
 		ok, msg, err := routesValidator.Validate(ctx, &kg)
 		if err != nil {
@@ -52,8 +68,13 @@ func ValidateIngress(
 			errMsgs = append(errMsgs, msg)
 		}
 	}
-	// Collect failures from the translation.
+	// Collect failures from the translation, ignoring the ones of the primary Ingress of a canary Ingress.
 	for _, failure := range failuresCollector.PopResourceFailures() {
+		if !slices.ContainsFunc(failure.CausingObjects(), func(obj client.Object) bool {
+			return obj.GetNamespace() == ingress.Namespace && obj.GetName() == ingress.Name
+		}) {
+			continue
+		}
 		errMsgs = append(errMsgs, failure.Message())
 	}
 	if len(errMsgs) > 0 {
@@ -63,17 +84,19 @@ func ValidateIngress(
 }
 
 // ingressToKongRoutesForValidation converts Ingress to Kong Routes that can be validated by Kong Gateway,
-// discards everything else that is not needed for validation.
+// discards everything else that is not needed for validation. The ingresses translated along with the Ingress
+// (e.g. the primary Ingress of a canary Ingress) have to include it.
 func ingressToKongRoutesForValidation(
 	kongVersion semver.Version,
 	translatorFeatures translator.FeatureFlags,
 	ingress *netv1.Ingress,
+	ingresses []*netv1.Ingress,
 	failuresCollector subtranslator.FailuresCollector,
 	logger logr.Logger,
 	storer store.Storer,
 ) []kong.Route {
 	kongServices := subtranslator.TranslateIngresses(
-		[]*netv1.Ingress{ingress},
+		ingresses,
 		configurationv1alpha1.IngressClassParametersSpec{EnableLegacyRegexDetection: true},
 		subtranslator.TranslateIngressFeatureFlags{
 			ExpressionRoutes:  translatorFeatures.ExpressionRoutes,
@@ -87,6 +110,9 @@ func ingressToKongRoutesForValidation(
 	var kongRoutes []kong.Route
 	for _, svc := range kongServices {
 		for _, route := range svc.Routes {
+			if route.Ingress.Namespace != ingress.Namespace || route.Ingress.Name != ingress.Name {
+				continue
+			}
 			route.Override(logger, kongVersion)
 			kongRoutes = append(kongRoutes, route.Route)
 		}
@@ -106,6 +106,115 @@ func TestValidateIngress(t *testing.T) {
 	}
 }
 
+func TestValidateIngressCanary(t *testing.T) {
+	newIngress := func(name string, anns map[string]string, path string) *netv1.Ingress {
+		return &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   corev1.NamespaceDefault,
+				Name:        name,
+				Annotations: anns,
+			},
+			Spec: netv1.IngressSpec{
+				IngressClassName: new(annotations.DefaultIngressClass),
+				Rules: []netv1.IngressRule{
+					{
+						Host: "example.com",
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     path,
+										PathType: new(netv1.PathTypePrefix),
+										Backend: netv1.IngressBackend{
+											Service: &netv1.IngressServiceBackend{
+												Name: name,
+												Port: netv1.ServiceBackendPort{Number: int32(80)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	primary := newIngress("primary", nil, "/")
+
+	for _, tt := range []struct {
+		msg           string
+		ingress       *netv1.Ingress
+		valid         bool
+		validationMsg string
+	}{
+		{
+			msg: "canary by weight",
+			ingress: newIngress("canary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryOfKey:     "primary",
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}, "/"),
+			valid: true,
+		},
+		{
+			msg: "canary by header",
+			ingress: newIngress("canary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryOfKey:       "primary",
+				annotations.AnnotationPrefix + annotations.CanaryByHeaderKey: "x-canary",
+			}, "/other"),
+			valid: true,
+		},
+		{
+			msg: "primary not found",
+			ingress: newIngress("canary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryOfKey:     "missing",
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}, "/"),
+			valid:         false,
+			validationMsg: `Ingress has invalid Kong annotations: primary Ingress "missing" of canary Ingress not found`,
+		},
+		{
+			msg: "invalid weight",
+			ingress: newIngress("canary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryOfKey:     "primary",
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "120",
+			}, "/"),
+			valid: false,
+			validationMsg: "Ingress failed schema validation: invalid canary Ingress: " +
+				`invalid konghq.com/canary-weight value "120": must be an integer between 0 and 100`,
+		},
+		{
+			msg: "canary by weight with a path not matching the primary's",
+			ingress: newIngress("canary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryOfKey:     "primary",
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}, "/other"),
+			valid: false,
+			validationMsg: "Ingress failed schema validation: invalid canary Ingress: " +
+				`path "/other" of host "example.com" doesn't match any path of primary Ingress "primary"`,
+		},
+	} {
+		t.Run(tt.msg, func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				IngressesV1: []*netv1.Ingress{primary},
+			})
+			require.NoError(t, err)
+			valid, validMsg, err := ValidateIngress(
+				t.Context(),
+				mockRoutesValidator{},
+				versions.KongWildcardSNICutoff,
+				translator.FeatureFlags{},
+				tt.ingress,
+				zapr.NewLogger(zap.NewNop()),
+				fakestore,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.valid, valid)
+			assert.Equal(t, tt.validationMsg, validMsg)
+		})
+	}
+}
+
 type mockRoutesValidator struct{}
 
 func (mockRoutesValidator) Validate(_ context.Context, r *kong.Route) (bool, string, error) {
@@ -121,6 +121,9 @@ type KongHTTPValidator struct {
 	AdminAPIServicesProvider AdminAPIServicesProvider
 	KongVersion              semver.Version
 	TranslatorFeatures       translator.FeatureFlags
+	// RejectConflictingRoutes makes the validator reject HTTPRoutes whose routes would be shadowed by,
+	// or ambiguous with, routes of other HTTPRoutes. It takes effect only with the expressions router.
+	RejectConflictingRoutes bool
 	// ReferenceIndexers gets the resources (KongPlugin and KongClusterPlugin)
 	// referring the validated resource (Secret) to check the changes on
 	// referred Secret will produce invalid configuration of the plugins.
@@ -469,9 +472,19 @@ func (validator KongHTTPValidator) ValidateHTTPRoute(
 	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
 		routeValidator = routesSvc
 	}
-	return gatewayvalidation.ValidateHTTPRoute(
+	ok, msg, err := gatewayvalidation.ValidateHTTPRoute(
 		ctx, routeValidator, validator.KongVersion, validator.TranslatorFeatures, &httproute, validator.ManagerClient,
 	)
+	if err != nil || !ok || !validator.RejectConflictingRoutes {
+		return ok, msg, err
+	}
+
+	existingHTTPRoutes, err := validator.Storer.ListHTTPRoutes()
+	if err != nil {
+		return false, "", fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+	ok, msg = gatewayvalidation.ValidateHTTPRouteConflicts(&httproute, existingHTTPRoutes, validator.TranslatorFeatures)
+	return ok, msg, nil
 }
 
 func (validator KongHTTPValidator) ValidateIngress(
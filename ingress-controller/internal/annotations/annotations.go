@@ -18,6 +18,8 @@ package annotations
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -71,6 +73,15 @@ const (
 	TLSVerifyDepthKey           = "/tls-verify-depth"
 	CACertificatesSecretsKey    = "/ca-certificates-secrets"
 	CACertificatesConfigMapsKey = "/ca-certificates-configmaps"
+	CanaryOfKey                 = "/canary-of"
+	CanaryWeightKey             = "/canary-weight"
+	CanaryByHeaderKey           = "/canary-by-header"
+	CanaryByHeaderValueKey      = "/canary-by-header-value"
+	CanaryByCookieKey           = "/canary-by-cookie"
+
+	// CanaryAlwaysValue is the value of the canary header (when no canary-by-header-value is set) or cookie
+	// routing requests to the backends of a canary Ingress.
+	CanaryAlwaysValue = "always"
 
 	// GatewayClassUnmanagedKey is an annotation used on a Gateway resource to
 	// indicate that the GatewayClass should be reconciled according to unmanaged
@@ -286,6 +297,7 @@ func ExtractRetries(anns map[string]string) (string, bool) {
 }
 
 // ExtractHeaders extracts the parsed headers annotations values. It returns a map of header names to slices of values.
+// For canary Ingresses routing requests by header or cookie, the canary headers are included too.
 func ExtractHeaders(anns map[string]string) (map[string][]string, bool) {
 	headers := make(map[string][]string)
 	const prefix = AnnotationPrefix + HeadersKey + "."
@@ -303,6 +315,7 @@ func ExtractHeaders(anns map[string]string) (map[string][]string, bool) {
 			})
 		}
 	}
+	maps.Copy(headers, ExtractCanaryHeaders(anns))
 	if len(headers) == 0 {
 		return headers, false
 	}
@@ -396,6 +409,73 @@ func ExtractTLSVerifyDepth(anns map[string]string) (int, bool) {
 	return depth, true
 }
 
+// ExtractCanaryOf extracts the name of the primary Ingress of a canary Ingress from the canary-of annotation.
+func ExtractCanaryOf(anns map[string]string) (string, bool) {
+	s, ok := anns[AnnotationPrefix+CanaryOfKey]
+	if !ok || s == "" {
+		return "", false
+	}
+	return s, true
+}
+
+// ExtractCanaryWeight extracts the canary-weight annotation value: the percentage of the requests matching the primary
+// Ingress' rules routed to the canary Ingress' backends.
+func ExtractCanaryWeight(anns map[string]string) (int, bool) {
+	s, ok := anns[AnnotationPrefix+CanaryWeightKey]
+	if !ok {
+		return 0, false
+	}
+	weight, err := strconv.Atoi(s)
+	if err != nil || weight < 0 || weight > 100 {
+		// If the annotation is present but not a valid percentage, we consider it not set.
+		return 0, false
+	}
+	return weight, true
+}
+
+// ExtractCanaryHeaders returns the headers requests have to match to be routed to the backends of a canary Ingress
+// by its canary-by-header or canary-by-cookie annotations. The canary header has to be set to the
+// canary-by-header-value annotation value (or to "always" when not set), and the canary cookie to "always".
+// When both annotations are set, only the canary header is returned, as requests are routed to the canary when
+// they match either of them: the ones with the canary cookie are matched with the annotations returned by
+// CanaryCookieAnnotations. It returns nil for Ingresses that are not canaries.
+func ExtractCanaryHeaders(anns map[string]string) map[string][]string {
+	if _, ok := ExtractCanaryOf(anns); !ok {
+		return nil
+	}
+	if header := anns[AnnotationPrefix+CanaryByHeaderKey]; header != "" {
+		value := anns[AnnotationPrefix+CanaryByHeaderValueKey]
+		if value == "" {
+			value = CanaryAlwaysValue
+		}
+		return map[string][]string{header: {value}}
+	}
+	if cookie := anns[AnnotationPrefix+CanaryByCookieKey]; cookie != "" {
+		// Kong can't match cookies, so match the Cookie header with a regex instead (the ~* prefix marks regex
+		// header values).
+		return map[string][]string{
+			"cookie": {fmt.Sprintf(`~*(^|;\s*)%s=%s(;|$)`, regexp.QuoteMeta(cookie), CanaryAlwaysValue)},
+		}
+	}
+	return nil
+}
+
+// CanaryCookieAnnotations returns the annotations of a canary Ingress routing requests by both header and cookie
+// without its canary-by-header annotations, for translating the routes matching requests by the canary cookie.
+// It returns false for other Ingresses.
+func CanaryCookieAnnotations(anns map[string]string) (map[string]string, bool) {
+	if _, ok := ExtractCanaryOf(anns); !ok {
+		return nil, false
+	}
+	if anns[AnnotationPrefix+CanaryByHeaderKey] == "" || anns[AnnotationPrefix+CanaryByCookieKey] == "" {
+		return nil, false
+	}
+	cookieAnns := maps.Clone(anns)
+	delete(cookieAnns, AnnotationPrefix+CanaryByHeaderKey)
+	delete(cookieAnns, AnnotationPrefix+CanaryByHeaderValueKey)
+	return cookieAnns, true
+}
+
 // ExtractCACertificateSecretNames extracts the ca-certificates secret names from the `ca-certificates-secret` annotation.
 // It expects a comma-separated list of secret names containing CA certificates.
 func ExtractCACertificateSecretNames(anns map[string]string) []string {
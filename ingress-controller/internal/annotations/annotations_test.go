@@ -1105,6 +1105,72 @@ func TestExtractTLSVerifyDepth(t *testing.T) {
 	assert.Equal(t, 1, v)
 }
 
+func TestExtractCanaryWeight(t *testing.T) {
+	_, ok := ExtractCanaryWeight(nil)
+	assert.False(t, ok)
+
+	_, ok = ExtractCanaryWeight(map[string]string{AnnotationPrefix + CanaryWeightKey: "non-integer"})
+	assert.False(t, ok)
+
+	_, ok = ExtractCanaryWeight(map[string]string{AnnotationPrefix + CanaryWeightKey: "101"})
+	assert.False(t, ok, "expected a percentage")
+
+	v, ok := ExtractCanaryWeight(map[string]string{AnnotationPrefix + CanaryWeightKey: "20"})
+	assert.True(t, ok)
+	assert.Equal(t, 20, v)
+}
+
+func TestExtractCanaryHeaders(t *testing.T) {
+	v := ExtractCanaryHeaders(map[string]string{AnnotationPrefix + CanaryByHeaderKey: "x-canary"})
+	assert.Nil(t, v, "expected no headers for Ingresses which are not canaries")
+
+	v = ExtractCanaryHeaders(map[string]string{
+		AnnotationPrefix + CanaryOfKey:     "primary",
+		AnnotationPrefix + CanaryWeightKey: "20",
+	})
+	assert.Nil(t, v, "expected no headers for canaries routing requests only by weight")
+
+	v = ExtractCanaryHeaders(map[string]string{
+		AnnotationPrefix + CanaryOfKey:       "primary",
+		AnnotationPrefix + CanaryByHeaderKey: "x-canary",
+	})
+	assert.Equal(t, map[string][]string{"x-canary": {"always"}}, v)
+
+	v = ExtractCanaryHeaders(map[string]string{
+		AnnotationPrefix + CanaryOfKey:       "primary",
+		AnnotationPrefix + CanaryByCookieKey: "canary.v2",
+	})
+	assert.Equal(t, map[string][]string{"cookie": {`~*(^|;\s*)canary\.v2=always(;|$)`}}, v)
+
+	both := map[string]string{
+		AnnotationPrefix + CanaryOfKey:            "primary",
+		AnnotationPrefix + CanaryByHeaderKey:      "x-canary",
+		AnnotationPrefix + CanaryByHeaderValueKey: "yes",
+		AnnotationPrefix + CanaryByCookieKey:      "canary.v2",
+	}
+	v = ExtractCanaryHeaders(both)
+	assert.Equal(t, map[string][]string{"x-canary": {"yes"}}, v, "expected the cookie not to be required along with the header")
+
+	cookieAnns, ok := CanaryCookieAnnotations(both)
+	require.True(t, ok)
+	assert.Equal(t, map[string][]string{"cookie": {`~*(^|;\s*)canary\.v2=always(;|$)`}}, ExtractCanaryHeaders(cookieAnns))
+	assert.Contains(t, both, AnnotationPrefix+CanaryByHeaderKey, "expected the Ingress' annotations not to be modified")
+
+	_, ok = CanaryCookieAnnotations(map[string]string{
+		AnnotationPrefix + CanaryOfKey:       "primary",
+		AnnotationPrefix + CanaryByCookieKey: "canary.v2",
+	})
+	assert.False(t, ok, "expected no cookie annotations for canaries routing requests only by cookie")
+
+	headers, ok := ExtractHeaders(map[string]string{
+		AnnotationPrefix + HeadersKey + ".x-env": "prod",
+		AnnotationPrefix + CanaryOfKey:           "primary",
+		AnnotationPrefix + CanaryByHeaderKey:     "x-canary",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, map[string][]string{"x-env": {"prod"}, "x-canary": {"always"}}, headers, "expected canary headers to be matched too")
+}
+
 func TestExtractCACertificates(t *testing.T) {
 	v := ExtractCACertificateSecretNames(nil)
 	assert.Empty(t, v)
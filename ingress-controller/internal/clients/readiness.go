@@ -48,6 +48,7 @@ type AlreadyCreatedClient interface {
 	PodReference() (k8stypes.NamespacedName, bool)
 	BaseRootURL() string
 	TLSServerName() string
+	Partition() string
 }
 
 type DefaultReadinessChecker struct {
@@ -182,6 +183,7 @@ func (c DefaultReadinessChecker) checkAlreadyExistingClients(ctx context.Context
 					Address:       client.BaseRootURL(),
 					TLSServerName: client.TLSServerName(),
 					PodRef:        podRef,
+					Partition:     client.Partition(),
 				}:
 				}
 			}
@@ -61,6 +61,7 @@ type mockAlreadyCreatedClient struct {
 	isReady       bool
 	podRef        k8stypes.NamespacedName
 	tlsServerName string
+	partition     string
 }
 
 func (m mockAlreadyCreatedClient) IsReady(context.Context) error {
@@ -82,6 +83,10 @@ func (m mockAlreadyCreatedClient) TLSServerName() string {
 	return m.tlsServerName
 }
 
+func (m mockAlreadyCreatedClient) Partition() string {
+	return m.partition
+}
+
 func TestDefaultReadinessChecker(t *testing.T) {
 	const (
 		testURL1 = "http://localhost:8001"
@@ -9,7 +9,6 @@ import (
 	"github.com/samber/lo"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,9 +30,12 @@ type KongAdminAPIServiceReconciler struct {
 	client.Client
 
 	// ServiceNN is the service NamespacedName to watch EndpointSlices for.
-	ServiceNN        k8stypes.NamespacedName
-	Log              logr.Logger
-	CacheSyncTimeout time.Duration
+	ServiceNN k8stypes.NamespacedName
+	// PartitionServiceNNs are the NamespacedNames of the services of the configuration partitions, mapped to the
+	// names of the partitions, to watch EndpointSlices for.
+	PartitionServiceNNs map[k8stypes.NamespacedName]string
+	Log                 logr.Logger
+	CacheSyncTimeout    time.Duration
 	// EndpointsNotifier is used to notify about Admin API endpoints changes.
 	// We're going to call this only with endpoints when they change.
 	EndpointsNotifier EndpointsNotifier
@@ -94,17 +96,47 @@ func (r *KongAdminAPIServiceReconciler) shouldReconcileEndpointSlice(obj client.
 		return false
 	}
 
-	if endpoints.Namespace != r.ServiceNN.Namespace {
-		return false
-	}
+	_, ok = r.partitionOf(*endpoints)
+	return ok
+}
 
-	if !lo.ContainsBy(endpoints.OwnerReferences, func(ref metav1.OwnerReference) bool {
-		return ref.Kind == "Service" && ref.Name == r.ServiceNN.Name
-	}) {
-		return false
+// partitionOf returns the name of the configuration partition of the Service owning the EndpointSlice. It returns
+// false when the EndpointSlice isn't owned by any of the watched Services.
+func (r *KongAdminAPIServiceReconciler) partitionOf(endpoints discoveryv1.EndpointSlice) (string, bool) {
+	for _, ref := range endpoints.OwnerReferences {
+		if ref.Kind != "Service" {
+			continue
+		}
+		serviceNN := k8stypes.NamespacedName{Namespace: endpoints.Namespace, Name: ref.Name}
+		if serviceNN == r.ServiceNN {
+			return "", true
+		}
+		if partition, ok := r.PartitionServiceNNs[serviceNN]; ok {
+			return partition, true
+		}
 	}
+	return "", false
+}
 
-	return true
+// adminAPIsFromEndpointSlice discovers the Admin APIs of the EndpointSlice and assigns them the configuration
+// partition of the Service owning it.
+func (r *KongAdminAPIServiceReconciler) adminAPIsFromEndpointSlice(
+	endpoints discoveryv1.EndpointSlice,
+) (sets.Set[adminapi.DiscoveredAdminAPI], error) {
+	adminAPIs, err := r.AdminAPIsDiscoverer.AdminAPIsFromEndpointSlice(endpoints)
+	if err != nil {
+		return nil, err
+	}
+	partition, _ := r.partitionOf(endpoints)
+	if partition == "" {
+		return adminAPIs, nil
+	}
+	partitioned := sets.New[adminapi.DiscoveredAdminAPI]()
+	for adminAPI := range adminAPIs {
+		adminAPI.Partition = partition
+		partitioned.Insert(adminAPI)
+	}
+	return partitioned, nil
 }
 
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
@@ -144,7 +176,7 @@ func (r *KongAdminAPIServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 		// If we don't have an entry for this EndpointSlice then save it and notify
 		// about the change.
 		var err error
-		r.Cache[req.NamespacedName], err = r.AdminAPIsDiscoverer.AdminAPIsFromEndpointSlice(endpoints)
+		r.Cache[req.NamespacedName], err = r.adminAPIsFromEndpointSlice(endpoints)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf(
 				"failed getting Admin API from endpoints: %s/%s: %w", endpoints.Namespace, endpoints.Name, err,
@@ -157,7 +189,7 @@ func (r *KongAdminAPIServiceReconciler) Reconcile(ctx context.Context, req ctrl.
 	// We do have an entry for this EndpointSlice.
 	// If the address set is the same, do nothing.
 	// If the address set has changed, update the cache and send a notification.
-	addresses, err := r.AdminAPIsDiscoverer.AdminAPIsFromEndpointSlice(endpoints)
+	addresses, err := r.adminAPIsFromEndpointSlice(endpoints)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf(
 			"failed getting Admin API from endpoints: %s/%s: %w", endpoints.Namespace, endpoints.Name, err,
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -27,6 +28,7 @@ import (
 	ctrlutils "github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/utils"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/logging"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/upstreamhealth"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/util/kubernetes/object/status"
 )
 
@@ -53,6 +55,17 @@ type KongUpstreamPolicyReconciler struct {
 	// HTTPRouteEnabled determines whether the controller should populate the KongUpstreamPolicy's
 	// ancestor status for Services used in HTTPRoutes.
 	HTTPRouteEnabled bool
+	// UpstreamTargetsHealth provides the health of the upstream targets of Services. When set, the controller
+	// populates the TargetsHealthy condition of the KongUpstreamPolicy's Service ancestors.
+	UpstreamTargetsHealth UpstreamTargetsHealthProvider
+}
+
+// UpstreamTargetsHealthProvider provides the health of the upstream targets of Services as reported by Kong Gateways.
+type UpstreamTargetsHealthProvider interface {
+	// ServiceTargetsHealth returns the health of the targets of the Service's upstreams, if known.
+	ServiceTargetsHealth(nn k8stypes.NamespacedName) (upstreamhealth.ServiceTargetsHealth, bool)
+	// Subscribe returns a channel notified about the Services whose targets' health changed.
+	Subscribe() <-chan event.GenericEvent
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -120,6 +133,18 @@ func (r *KongUpstreamPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error
 			)
 	}
 
+	if r.UpstreamTargetsHealth != nil {
+		// Watch for notifications about Services whose upstream targets' health changed as it needs to be
+		// propagated to the KongUpstreamPolicy's ancestor TargetsHealthy status.
+		blder.WatchesRawSource(
+			source.Channel(
+				r.UpstreamTargetsHealth.Subscribe(),
+				handler.EnqueueRequestsFromMapFunc(r.getUpstreamPolicyForObject),
+				source.WithPredicates[client.Object, reconcile.Request](predicate.NewPredicateFuncs(doesObjectReferUpstreamPolicy)),
+			),
+		)
+	}
+
 	return blder.For(&configurationv1beta1.KongUpstreamPolicy{}).
 		Complete(r)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/samber/lo"
 	"github.com/samber/mo"
@@ -20,12 +21,17 @@ import (
 	gatewaycontroller "github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/gateway"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/utils"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/upstreamhealth"
 )
 
 // maxNAncestors is the maximum number of ancestors that can be stored in the KongUpstreamPolicy status.
 // This is a limitation of the Gateway API.
 const maxNAncestors = 16
 
+// maxNUnhealthyTargetsInMessage is the maximum number of unhealthy targets listed in the message of the
+// TargetsHealthy condition of an ancestor.
+const maxNUnhealthyTargetsInMessage = 10
+
 // upstreamPolicyAncestorKind represents kind of KongUpstreamPolicy ancestor (Service or KongServiceFacade).
 type upstreamPolicyAncestorKind string
 
@@ -41,7 +47,9 @@ type ancestorStatus struct {
 	ancestorKind        upstreamPolicyAncestorKind
 	acceptedCondition   metav1.Condition
 	programmedCondition metav1.Condition
-	creationTimestamp   metav1.Time
+	// targetsHealthyCondition is set only when the health of the ancestor's upstream targets is known.
+	targetsHealthyCondition *metav1.Condition
+	creationTimestamp       metav1.Time
 }
 
 // serviceKey is used as a key for indexing Services by "namespace/name".
@@ -300,10 +308,11 @@ func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 				Namespace: service.Namespace,
 				Name:      service.Name,
 			},
-			ancestorKind:        upstreamPolicyAncestorKindService,
-			acceptedCondition:   acceptedCondition,
-			programmedCondition: programmedCondition,
-			creationTimestamp:   service.CreationTimestamp,
+			ancestorKind:            upstreamPolicyAncestorKindService,
+			acceptedCondition:       acceptedCondition,
+			programmedCondition:     programmedCondition,
+			targetsHealthyCondition: r.targetsHealthyCondition(k8stypes.NamespacedName{Namespace: service.Namespace, Name: service.Name}),
+			creationTimestamp:       service.CreationTimestamp,
 		})
 	}
 	for _, serviceFacade := range serviceFacades {
@@ -329,6 +338,44 @@ func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 	return ancestorsStatus, nil
 }
 
+// targetsHealthyCondition builds the TargetsHealthy condition of a Service ancestor from the health of the Service's
+// upstream targets. It returns nil when the targets' health is not watched or not known yet.
+func (r *KongUpstreamPolicyReconciler) targetsHealthyCondition(serviceNN k8stypes.NamespacedName) *metav1.Condition {
+	if r.UpstreamTargetsHealth == nil {
+		return nil
+	}
+	health, ok := r.UpstreamTargetsHealth.ServiceTargetsHealth(serviceNN)
+	if !ok {
+		return nil
+	}
+
+	unhealthy := health.Unhealthy()
+	if len(unhealthy) == 0 {
+		return &metav1.Condition{
+			Type:               configurationv1beta1.KongUpstreamPolicyConditionTargetsHealthy,
+			Status:             metav1.ConditionTrue,
+			Reason:             configurationv1beta1.KongUpstreamPolicyReasonTargetsHealthy,
+			Message:            fmt.Sprintf("All %d targets are healthy", len(health)),
+			LastTransitionTime: metav1.Now(),
+		}
+	}
+
+	listed := lo.Map(lo.Slice(unhealthy, 0, maxNUnhealthyTargetsInMessage), func(t upstreamhealth.TargetHealth, _ int) string {
+		return t.String()
+	})
+	message := fmt.Sprintf("%d/%d targets are not healthy: %s", len(unhealthy), len(health), strings.Join(listed, "; "))
+	if more := len(unhealthy) - len(listed); more > 0 {
+		message += fmt.Sprintf(" (and %d more)", more)
+	}
+	return &metav1.Condition{
+		Type:               configurationv1beta1.KongUpstreamPolicyConditionTargetsHealthy,
+		Status:             metav1.ConditionFalse,
+		Reason:             configurationv1beta1.KongUpstreamPolicyReasonTargetsUnhealthy,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
 // getConflictedServices returns a set of services that have conflicts.
 func (r *KongUpstreamPolicyReconciler) getConflictedServices(ctx context.Context, services []corev1.Service) (servicesSet, error) {
 	// return directly when HTTPRoute is not enabled, as it only check conflicted services in HTTPRoute backends only.
@@ -449,14 +496,18 @@ func (r *KongUpstreamPolicyReconciler) buildPolicyStatus(
 		if err != nil {
 			return gatewayapi.PolicyStatus{}, fmt.Errorf("failed to build ancestor reference: %w", err)
 		}
+		conditions := []metav1.Condition{
+			ss.acceptedCondition,
+			ss.programmedCondition,
+		}
+		if ss.targetsHealthyCondition != nil {
+			conditions = append(conditions, *ss.targetsHealthyCondition)
+		}
 		policyStatus.Ancestors = append(policyStatus.Ancestors,
 			gatewayapi.PolicyAncestorStatus{
 				AncestorRef:    ancestorRef,
 				ControllerName: gatewaycontroller.GetControllerName(),
-				Conditions: []metav1.Condition{
-					ss.acceptedCondition,
-					ss.programmedCondition,
-				},
+				Conditions:     conditions,
 			},
 		)
 	}
@@ -1636,11 +1636,13 @@ func (r *KongV1Alpha1KongCustomEntityReconciler) SetupWithManager(mgr ctrl.Manag
 			),
 		)
 	}
+	cpRefPredicate := ctrlutils.GenerateCPReferenceMatchesPredicate[*kongv1alpha1.KongCustomEntity]()
 	if !r.DisableIngressClassLookups {
 		blder.Watches(&netv1.IngressClass{},
 			handler.EnqueueRequestsFromMapFunc(r.listClassless),
 			builder.WithPredicates(
 				predicate.NewPredicateFuncs(ctrlutils.IsDefaultIngressClass),
+				cpRefPredicate,
 			),
 		)
 	}
@@ -1649,6 +1651,7 @@ func (r *KongV1Alpha1KongCustomEntityReconciler) SetupWithManager(mgr ctrl.Manag
 		&handler.EnqueueRequestForObject{},
 		builder.WithPredicates(
 			preds,
+			cpRefPredicate,
 		),
 	).
 		Complete(r)
@@ -16,6 +16,9 @@ type DataPlane interface {
 	DataPlaneStatusClient
 
 	Listeners(ctx context.Context) ([]kong.ProxyListener, []kong.StreamListener, error)
+	// KubernetesObjectRouteConflict returns a message describing the conflicts of the routes translated
+	// from the object with routes of other objects, if there are any.
+	KubernetesObjectRouteConflict(obj client.Object) (string, bool)
 }
 
 type DataPlaneStatusClient interface {
@@ -128,6 +128,11 @@ func ensureParentsProgrammedCondition[
 					condition,
 				},
 			}
+			if g.listenerSet != nil {
+				sectionName := newParentStatus.ParentRef.SectionName
+				newParentStatus.ParentRef = g.listenerSetParentRef()
+				newParentStatus.ParentRef.SectionName = sectionName
+			}
 			setRouteParentInStatusForParent(route, newParentStatus, g)
 
 			routeParentStatuses = append(routeParentStatuses, newParentStatus)
@@ -146,3 +151,62 @@ func ensureParentsProgrammedCondition[
 	// no need to update if no status is changed.
 	return false, nil
 }
+
+// ensureParentsConflictedCondition ensures that provided route's parent statuses
+// have the Conflicted condition set with the provided message when the route
+// conflicts with other routes, and don't have it otherwise. Only parents already
+// present in the route's status are updated. It returns a boolean flag indicating
+// whether an update to the provided route has been performed.
+func ensureParentsConflictedCondition[
+	routeT gatewayapi.RouteT,
+](
+	ctx context.Context,
+	client client.SubResourceWriter,
+	route routeT,
+	routeParentStatuses []gatewayapi.RouteParentStatus,
+	gateways []supportedGatewayWithCondition,
+	conflicted bool,
+	message string,
+) (bool, error) {
+	parentStatuses := getParentStatuses(route, routeParentStatuses)
+
+	condition := newCondition(
+		ConditionTypeConflicted,
+		metav1.ConditionTrue,
+		string(ConditionReasonConflictingRoutes),
+		route.GetGeneration(),
+	)
+	condition.Message = message
+
+	statusChanged := false
+	for _, g := range gateways {
+		parentRefKey := routeParentStatusKey(route, g)
+		parentStatus, ok := parentStatuses[parentRefKey]
+		if !ok {
+			continue
+		}
+
+		var changed bool
+		if conflicted {
+			changed = setRouteParentStatusCondition(parentStatus, condition)
+		} else {
+			conditionsCount := len(parentStatus.Conditions)
+			parentStatus.Conditions = lo.Reject(parentStatus.Conditions, func(c metav1.Condition, _ int) bool {
+				return c.Type == ConditionTypeConflicted
+			})
+			changed = len(parentStatus.Conditions) != conditionsCount
+		}
+		if changed {
+			setRouteParentInStatusForParent(route, *parentStatus, g)
+		}
+		statusChanged = statusChanged || changed
+	}
+
+	if statusChanged {
+		if err := client.Update(ctx, route); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -85,6 +85,12 @@ func (r *GRPCRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listGRPCRoutesForGateway),
 		)
 
+	// if a ListenerSet updates then we need to enqueue the GRPCRoutes attached to it
+	// as its listeners (and their statuses) determine whether the routes are accepted.
+	watchListenerSetsForRoutes[*gatewayapi.GRPCRoute](blder, mgr, r.Log, func() client.ObjectList {
+		return &gatewayapi.GRPCRouteList{}
+	})
+
 	if r.StatusQueue != nil {
 		blder.WatchesRawSource(
 			source.Channel(
@@ -410,6 +416,18 @@ func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			debug(log, grpcroute, "Programmed condition updated")
 			return ctrl.Result{}, nil
 		}
+
+		// report Kong routes of the GRPCRoute that are shadowed by, or ambiguous with, routes of other objects.
+		conflictMessage, conflicted := r.DataplaneClient.KubernetesObjectRouteConflict(grpcroute)
+		statusUpdated, err = ensureParentsConflictedCondition(ctx, r.Status(), grpcroute, grpcroute.Status.Parents, gateways, conflicted, conflictMessage)
+		if err != nil {
+			debug(log, grpcroute, "Failed to update conflicted condition")
+			return ctrl.Result{}, err
+		}
+		if statusUpdated {
+			debug(log, grpcroute, "Conflicted condition updated")
+			return ctrl.Result{}, nil
+		}
 	}
 
 	// once the data-plane has accepted the GRPCRoute object, we're all set.
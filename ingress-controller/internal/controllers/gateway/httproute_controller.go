@@ -113,6 +113,12 @@ func (r *HTTPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listHTTPRoutesForGateway),
 		)
 
+	// if a ListenerSet updates then we need to enqueue the HTTPRoutes attached to it
+	// as its listeners (and their statuses) determine whether the routes are accepted.
+	watchListenerSetsForRoutes[*gatewayapi.HTTPRoute](blder, mgr, r.Log, func() client.ObjectList {
+		return &gatewayapi.HTTPRouteList{}
+	})
+
 	blder.Watches(&configurationv1.KongPlugin{},
 		handler.EnqueueRequestsFromMapFunc(r.listHTTPRoutesForKongPlugin),
 	)
@@ -542,6 +548,18 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			debug(log, httproute, "Programmed condition updated")
 			return ctrl.Result{}, nil
 		}
+
+		// report Kong routes of the HTTPRoute that are shadowed by, or ambiguous with, routes of other objects.
+		conflictMessage, conflicted := r.DataplaneClient.KubernetesObjectRouteConflict(httproute)
+		statusUpdated, err = ensureParentsConflictedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways, conflicted, conflictMessage)
+		if err != nil {
+			debug(log, httproute, "Failed to update conflicted condition")
+			return ctrl.Result{}, err
+		}
+		if statusUpdated {
+			debug(log, httproute, "Conflicted condition updated")
+			return ctrl.Result{}, nil
+		}
 	}
 
 	// once the data-plane has accepted the HTTPRoute object, we're all set.
@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/controllers"
+	ctrlutils "github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/utils"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+)
+
+// ListenerSetReconciler reconciles a ListenerSet object.
+//
+// ListenerSets' status is managed by the operator's Gateway controller which
+// also merges their listeners into the DataPlane configuration. This reconciler
+// only keeps ListenerSets in the proxy cache so that certificates of their
+// listeners are translated into Kong certificates and SNIs.
+type ListenerSetReconciler struct {
+	client.Client
+
+	Log             logr.Logger
+	Scheme          *runtime.Scheme
+	DataplaneClient controllers.DataPlane
+
+	CacheSyncTimeout time.Duration
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ListenerSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("listenerset-controller").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		}).
+		For(&gatewayapi.ListenerSet{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=listenersets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ListenerSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("GatewayV1ListenerSet", req.NamespacedName)
+
+	listenerSet := new(gatewayapi.ListenerSet)
+	if err := r.Get(ctx, req.NamespacedName, listenerSet); err != nil {
+		// if the queued object is no longer present in the proxy cache we need
+		// to ensure that if it was ever added to the cache, it gets removed.
+		if apierrors.IsNotFound(err) {
+			debug(log, listenerSet, "Object does not exist, ensuring it is not present in the proxy cache")
+			listenerSet.Namespace = req.Namespace
+			listenerSet.Name = req.Name
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(listenerSet)
+		}
+
+		// for any error other than 404, requeue
+		return ctrl.Result{}, err
+	}
+
+	debug(log, listenerSet, "Processing listenerset")
+
+	if listenerSet.DeletionTimestamp != nil {
+		debug(log, listenerSet, "Listenerset is being deleted, re-configuring data-plane")
+		if err := r.DataplaneClient.DeleteObject(listenerSet); err != nil {
+			debug(log, listenerSet, "Failed to delete object from data-plane, requeuing")
+			return ctrl.Result{}, err
+		}
+		debug(log, listenerSet, "Ensured object was removed from the data-plane (if ever present)")
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.DataplaneClient.UpdateObject(listenerSet); err != nil {
+		debug(log, listenerSet, "Failed to update object in data-plane, requeueing")
+		return ctrl.Result{}, err
+	}
+	info(log, listenerSet, "Listenerset has been configured on the data-plane")
+	return ctrl.Result{}, nil
+}
+
+// -----------------------------------------------------------------------------
+// ListenerSet - Route Event Handlers
+// -----------------------------------------------------------------------------
+
+// watchListenerSetsForRoutes makes the route controller built by blder enqueue the
+// routes attached to a ListenerSet whenever the ListenerSet changes, e.g. when the
+// Gateway controller updates the status of its listeners. Nothing is watched when
+// the ListenerSet CRD is not installed.
+func watchListenerSetsForRoutes[routeT gatewayapi.RouteT](
+	blder *builder.Builder, mgr ctrl.Manager, log logr.Logger, newRouteList func() client.ObjectList,
+) {
+	listenerSetGVR := schema.GroupVersion(gatewayv1.GroupVersion).WithResource("listenersets")
+	if !ctrlutils.CRDExists(mgr.GetRESTMapper(), listenerSetGVR) {
+		return
+	}
+	blder.Watches(&gatewayapi.ListenerSet{},
+		handler.EnqueueRequestsFromMapFunc(listRoutesForListenerSet[routeT](mgr.GetClient(), log, newRouteList)),
+	)
+}
+
+// listRoutesForListenerSet returns a controller-runtime handler.MapFunc which enqueues
+// the routes of the given list type referencing a ListenerSet through their parentRefs.
+func listRoutesForListenerSet[routeT gatewayapi.RouteT](
+	cl client.Reader, log logr.Logger, newRouteList func() client.ObjectList,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		listenerSet, ok := obj.(*gatewayapi.ListenerSet)
+		if !ok {
+			log.Error(errInvalidType, "Found invalid type in event handlers", "expected", "ListenerSet", "found", reflect.TypeOf(obj))
+			return nil
+		}
+
+		routeList := newRouteList()
+		if err := cl.List(ctx, routeList); err != nil {
+			log.Error(err, "Failed to list routes in watch", "listenerset", client.ObjectKeyFromObject(listenerSet))
+			return nil
+		}
+		items, err := meta.ExtractList(routeList)
+		if err != nil {
+			log.Error(err, "Failed to extract routes in watch", "listenerset", client.ObjectKeyFromObject(listenerSet))
+			return nil
+		}
+
+		var recs []reconcile.Request
+		for _, item := range items {
+			route, ok := item.(routeT)
+			if !ok {
+				continue
+			}
+			if lo.ContainsBy(getRouteParentRefs(route), func(parentRef gatewayapi.ParentReference) bool {
+				return gatewayapi.IsListenerSetParentRef(parentRef) &&
+					parentRefMatchesGatewayNN(parentRef, route.GetNamespace(), client.ObjectKeyFromObject(listenerSet))
+			}) {
+				recs = append(recs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(route)})
+			}
+		}
+		return recs
+	}
+}
@@ -32,6 +32,7 @@ func getParentStatuses[routeT gatewayapi.RouteT](
 type namespacedNamer interface {
 	GetNamespace() string
 	GetName() string
+	GetKind() string
 	GetSectionName() mo.Option[string]
 }
 
@@ -42,6 +43,11 @@ func routeParentStatusKey[routeT gatewayapi.RouteT](
 	if ns := parentRef.GetNamespace(); ns != "" {
 		namespace = ns
 	}
+	// Keep the keys of Gateway parents unchanged and tell ListenerSet parents
+	// apart from Gateways with the same name.
+	if parentRef.GetKind() == gatewayapi.ListenerSetKind {
+		namespace = gatewayapi.ListenerSetKind + "/" + namespace
+	}
 
 	switch any(route).(type) {
 	case *gatewayapi.HTTPRoute,
@@ -58,6 +64,7 @@ func routeParentStatusKey[routeT gatewayapi.RouteT](
 type parentRef struct {
 	Namespace   *string
 	Name        string
+	Kind        *string
 	SectionName *string
 }
 
@@ -72,6 +79,13 @@ func (p parentRef) GetNamespace() string {
 	return ""
 }
 
+func (p parentRef) GetKind() string {
+	if p.Kind != nil && *p.Kind != "" {
+		return *p.Kind
+	}
+	return "Gateway"
+}
+
 func (p parentRef) GetSectionName() mo.Option[string] {
 	if p.SectionName != nil {
 		return mo.Some(*p.SectionName)
@@ -85,6 +99,7 @@ func getParentRef(parentStatus gatewayapi.RouteParentStatus) parentRef {
 	var (
 		sectionName *string
 		namespace   *string
+		kind        *string
 		ref         = parentStatus.ParentRef
 	)
 	if ref.SectionName != nil {
@@ -93,9 +108,13 @@ func getParentRef(parentStatus gatewayapi.RouteParentStatus) parentRef {
 	if ref.Namespace != nil {
 		namespace = new(string(*ref.Namespace))
 	}
+	if ref.Kind != nil {
+		kind = new(string(*ref.Kind))
+	}
 	return parentRef{
 		Namespace:   namespace,
 		Name:        string(ref.Name),
+		Kind:        kind,
 		SectionName: sectionName,
 	}
 }
@@ -185,22 +204,27 @@ func gatewayParentStatusForRoute[routeT gatewayapi.RouteT](
 	parentGateway supportedGatewayWithCondition,
 	opts ...func(*gatewayapi.RouteParentStatus),
 ) *gatewayapi.RouteParentStatus {
-	parentGVK := parentGateway.gateway.GroupVersionKind()
-	if parentGVK.Kind == "" {
-		parentGVK.Kind = gatewayapi.V1GatewayTypeMeta.Kind
-	}
-	if parentGVK.Group == "" {
-		parentGVK.Group = gatewayapi.V1GatewayTypeMeta.GroupVersionKind().Group
-		parentGateway.gateway.SetGroupVersionKind(parentGVK)
-	}
-
-	var (
+	var parentRef gatewayapi.ParentReference
+	if parentGateway.listenerSet != nil {
+		parentRef = parentGateway.listenerSetParentRef()
+	} else {
+		parentGVK := parentGateway.gateway.GroupVersionKind()
+		if parentGVK.Kind == "" {
+			parentGVK.Kind = gatewayapi.V1GatewayTypeMeta.Kind
+		}
+		if parentGVK.Group == "" {
+			parentGVK.Group = gatewayapi.V1GatewayTypeMeta.GroupVersionKind().Group
+			parentGateway.gateway.SetGroupVersionKind(parentGVK)
+		}
 		parentRef = gatewayapi.ParentReference{
 			Group:     util.StringToTypedPtr[*gatewayapi.Group](parentGateway.gateway.GroupVersionKind().Group),
 			Kind:      util.StringToTypedPtr[*gatewayapi.Kind](parentGateway.gateway.Kind),
 			Namespace: (*gatewayapi.Namespace)(&parentGateway.gateway.Namespace),
 			Name:      gatewayapi.ObjectName(parentGateway.gateway.Name),
 		}
+	}
+
+	var (
 		routeParentStatus = &gatewayapi.RouteParentStatus{
 			ParentRef:      parentRef,
 			ControllerName: GetControllerName(),
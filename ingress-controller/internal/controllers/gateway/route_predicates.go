@@ -55,6 +55,19 @@ func IsRouteAttachedToReconciledGateway[routeT gatewayapi.RouteT](
 	// If the reconciler has a GatewayNN set, only routes attached to that Gateway are reconciled.
 	if gNN, ok := gatewayNN.Get(); ok {
 		for _, parentRef := range parentRefs {
+			// Routes attached to a ListenerSet are attached to the ListenerSet's Gateway.
+			if gatewayapi.IsListenerSetParentRef(parentRef) {
+				_, listenerSetGatewayNN, ok, err := getListenerSetForParentRef(context.Background(), cl, route.GetNamespace(), parentRef)
+				if err != nil {
+					log.Error(err, "Failed to get ListenerSet in route watch")
+					// Return true to trigger reconciliation on lookup failure; the reconciler will handle the error.
+					return true
+				}
+				if ok && listenerSetGatewayNN == gNN {
+					return true
+				}
+				continue
+			}
 			if parentRef.Namespace != nil && string(*parentRef.Namespace) != gNN.Namespace {
 				continue
 			}
@@ -89,10 +102,25 @@ func IsRouteAttachedToReconciledGateway[routeT gatewayapi.RouteT](
 		if parentRef.Group != nil && *parentRef.Group != "" {
 			group = string(*parentRef.Group)
 		}
+		name := string(parentRef.Name)
+		// Routes attached to a ListenerSet are attached to the ListenerSet's Gateway.
+		if kind == gatewayapi.ListenerSetKind && group == gatewayapi.GroupVersion.Group {
+			_, listenerSetGatewayNN, ok, err := getListenerSetForParentRef(context.Background(), cl, route.GetNamespace(), parentRef)
+			if err != nil {
+				log.Error(err, "Failed to get ListenerSet in route watch")
+				// Return true to trigger reconciliation on lookup failure; the reconciler will handle the error.
+				return true
+			}
+			if !ok {
+				continue
+			}
+			kind = "Gateway"
+			namespace, name = listenerSetGatewayNN.Namespace, listenerSetGatewayNN.Name
+		}
 		// Check the parent gateway if the parentRef points to a gateway that is possible to be controlled by KIC.
 		if kind == "Gateway" && group == gatewayapi.GroupVersion.Group {
 			var gateway gatewayapi.Gateway
-			err := cl.Get(context.Background(), k8stypes.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}, &gateway)
+			err := cl.Get(context.Background(), k8stypes.NamespacedName{Namespace: namespace, Name: name}, &gateway)
 			if err != nil {
 				log.Error(err, "Failed to get Gateway in HTTPRoute watch")
 				// Return true to trigger reconciliation on lookup failure; the reconciler will handle the error.
@@ -6,9 +6,11 @@ import (
 	"reflect"
 
 	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	"github.com/samber/mo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +31,11 @@ const (
 	ConditionReasonProgrammedUnknown   gatewayapi.RouteConditionReason = "Unknown"
 	ConditionReasonConfiguredInGateway gatewayapi.RouteConditionReason = "ConfiguredInGateway"
 	ConditionReasonTranslationError    gatewayapi.RouteConditionReason = "TranslationError"
+
+	// ConditionTypeConflicted is set on route parents when some of the Kong routes translated from the route
+	// are shadowed by, or ambiguous with, Kong routes translated from other objects.
+	ConditionTypeConflicted                                          = "Conflicted"
+	ConditionReasonConflictingRoutes gatewayapi.RouteConditionReason = "ConflictingRoutes"
 )
 
 var (
@@ -38,21 +45,37 @@ var (
 
 // supportedGatewayWithCondition is a struct that wraps a gateway and some further info
 // such as the condition Status condition Accepted of the gateway and the listenerName.
+// When the route is attached to the gateway through a ListenerSet, listenerSet is set
+// and the route's parent is the ListenerSet rather than the gateway.
 type supportedGatewayWithCondition struct {
 	gateway               *gatewayapi.Gateway
+	listenerSet           *gatewayapi.ListenerSet
 	condition             metav1.Condition
 	listenerName          string
 	attachedListenerNames []gatewayapi.SectionName
 }
 
 func (g supportedGatewayWithCondition) GetName() string {
+	if g.listenerSet != nil {
+		return g.listenerSet.GetName()
+	}
 	return g.gateway.GetName()
 }
 
 func (g supportedGatewayWithCondition) GetNamespace() string {
+	if g.listenerSet != nil {
+		return g.listenerSet.GetNamespace()
+	}
 	return g.gateway.GetNamespace()
 }
 
+func (g supportedGatewayWithCondition) GetKind() string {
+	if g.listenerSet != nil {
+		return gatewayapi.ListenerSetKind
+	}
+	return "Gateway"
+}
+
 func (g supportedGatewayWithCondition) GetSectionName() mo.Option[string] {
 	if g.listenerName != "" {
 		return mo.Some(g.listenerName)
@@ -60,6 +83,44 @@ func (g supportedGatewayWithCondition) GetSectionName() mo.Option[string] {
 	return mo.None[string]()
 }
 
+// listeners returns the listeners the route can attach to: the listener entries
+// of the ListenerSet if the route is attached through one, the gateway's listeners
+// otherwise.
+func (g supportedGatewayWithCondition) listeners() []gatewayapi.Listener {
+	if g.listenerSet != nil {
+		return gatewayapi.ListenerSetListeners(g.listenerSet)
+	}
+	return g.gateway.Spec.Listeners
+}
+
+// listenerStatuses returns the statuses of the listeners returned by listeners.
+func (g supportedGatewayWithCondition) listenerStatuses() []gatewayapi.ListenerStatus {
+	if g.listenerSet != nil {
+		return gatewayapi.ListenerSetListenerStatuses(g.listenerSet)
+	}
+	return g.gateway.Status.Listeners
+}
+
+// listenersGeneration returns the generation of the object defining the listeners
+// returned by listeners, which their statuses' conditions are observed against.
+func (g supportedGatewayWithCondition) listenersGeneration() int64 {
+	if g.listenerSet != nil {
+		return g.listenerSet.Generation
+	}
+	return g.gateway.Generation
+}
+
+// listenerSetParentRef returns the reference to the ListenerSet the route is
+// attached to, as reported in the route's status.
+func (g supportedGatewayWithCondition) listenerSetParentRef() gatewayapi.ParentReference {
+	return gatewayapi.ParentReference{
+		Group:     new(gatewayapi.Group(gatewayapi.GroupVersion.Group)),
+		Kind:      new(gatewayapi.Kind(gatewayapi.ListenerSetKind)),
+		Namespace: new(gatewayapi.Namespace(g.listenerSet.Namespace)),
+		Name:      gatewayapi.ObjectName(g.listenerSet.Name),
+	}
+}
+
 // parentRefsForRoute provides a list of the parentRefs given a Gateway APIs route object
 // (e.g. HTTPRoute, TCPRoute, e.t.c.) which refer to the Gateway resource(s) which manage it.
 func parentRefsForRoute[T gatewayapi.RouteT](route T) ([]gatewayapi.ParentReference, error) {
@@ -87,7 +148,7 @@ func parentRefsForRoute[T gatewayapi.RouteT](route T) ([]gatewayapi.ParentRefere
 		if ref.Kind != nil && *ref.Kind != "" {
 			kind = string(*ref.Kind)
 		}
-		if group != gatewayv1.GroupName || kind != "Gateway" {
+		if group != gatewayv1.GroupName || (kind != "Gateway" && kind != gatewayapi.ListenerSetKind) {
 			return nil, fmt.Errorf("unsupported parent kind %s/%s", group, kind)
 		}
 	}
@@ -95,6 +156,33 @@ func parentRefsForRoute[T gatewayapi.RouteT](route T) ([]gatewayapi.ParentRefere
 	return refs, nil
 }
 
+// getListenerSetForParentRef retrieves the ListenerSet referenced by the parentRef of
+// a route from routeNamespace, along with the namespaced name of the Gateway the
+// ListenerSet is attached to. It returns false if the ListenerSet does not exist,
+// including when the ListenerSet CRD is not installed, or if it is not attached to
+// a Gateway.
+func getListenerSetForParentRef(
+	ctx context.Context, mgrc client.Reader, routeNamespace string, parentRef gatewayapi.ParentReference,
+) (*gatewayapi.ListenerSet, k8stypes.NamespacedName, bool, error) {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+
+	listenerSet := &gatewayapi.ListenerSet{}
+	if err := mgrc.Get(ctx, client.ObjectKey{Namespace: namespace, Name: string(parentRef.Name)}, listenerSet); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, k8stypes.NamespacedName{}, false, nil
+		}
+		return nil, k8stypes.NamespacedName{}, false, fmt.Errorf("failed to retrieve listenerset for route: %w", err)
+	}
+	gatewayNN, ok := gatewayapi.ListenerSetParentGateway(listenerSet)
+	if !ok {
+		return nil, k8stypes.NamespacedName{}, false, nil
+	}
+	return listenerSet, gatewayNN, true, nil
+}
+
 // getSupportedGatewayForRoute will retrieve the Gateway and GatewayClass object for any
 // Gateway APIs route object (e.g. HTTPRoute, TCPRoute, e.t.c.) from the provided cached
 // client if they match this controller. If there are no gateways present for this route
@@ -123,6 +211,21 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 		}
 		name := string(parentRef.Name)
 
+		// Routes attached to a ListenerSet are served by the Gateway the ListenerSet
+		// is attached to, using the ListenerSet's listeners.
+		var listenerSet *gatewayapi.ListenerSet
+		if gatewayapi.IsListenerSetParentRef(parentRef) {
+			ls, gatewayNN, ok, err := getListenerSetForParentRef(ctx, mgrc, route.GetNamespace(), parentRef)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			listenerSet = ls
+			namespace, name = gatewayNN.Namespace, gatewayNN.Name
+		}
+
 		// If the flag `--gateway-to-reconcile` is set, KIC will only reconcile the specified gateway.
 		// https://github.com/Kong/kubernetes-ingress-controller/issues/5322
 		if gatewayToReconcile, ok := specifiedGW.Get(); ok {
@@ -145,6 +248,9 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 			return nil, fmt.Errorf("failed to retrieve gateway for route: %w", err)
 		}
 		gwLogger := logger.WithValues("parentRef.gateway", fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Name))
+		if listenerSet != nil {
+			gwLogger = gwLogger.WithValues("parentRef.listenerSet", fmt.Sprintf("%s/%s", listenerSet.Namespace, listenerSet.Name))
+		}
 
 		// pull the GatewayClass for the Gateway object from the cached client
 		gatewayClass := gatewayapi.GatewayClass{}
@@ -166,6 +272,8 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 		}
 
 		// Otherwise we're all set and this controller should reconcile this route.
+		parent := supportedGatewayWithCondition{gateway: &gateway, listenerSet: listenerSet}
+		listenerStatuses := parent.listenerStatuses()
 
 		var (
 			// Set to true if there exists a listener which wasn't filtered by:
@@ -186,10 +294,10 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 			attachedListenerNames []gatewayapi.SectionName
 		)
 
-		for _, listener := range gateway.Spec.Listeners {
+		for _, listener := range parent.listeners() {
 			listenerLogger := gwLogger.WithValues("listener", string(listener.Name))
 			// Check if the route matches listener's AllowedRoutes.
-			if ok, err := routeMatchesListenerAllowedRoutes(ctx, mgrc, route, listener, gateway.Namespace, parentRef.Namespace); err != nil {
+			if ok, err := routeMatchesListenerAllowedRoutes(ctx, mgrc, route, listener, parent.GetNamespace(), parentRef.Namespace); err != nil {
 				return nil, fmt.Errorf("failed matching listener %s to a route %s for gateway %s: %w",
 					listener.Name, route.GetName(), gateway.Name, err,
 				)
@@ -202,7 +310,7 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 			// Check the listeners statuses:
 			// - Check if a listener status exists with a matching type (via SupportedKinds).
 			// - Check if it matches the requested listener by name (if specified).
-			if err := existsMatchingListenerInStatus(route, listener, gateway.Status.Listeners); err != nil {
+			if err := existsMatchingListenerInStatus(route, listener, listenerStatuses); err != nil {
 				listenerLogger.V(logging.DebugLevel).Info("Listener does not support this route", "reason", err.Error())
 				continue
 			} else {
@@ -268,7 +376,7 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 			// above, or a listener that structurally matches but is momentarily not
 			// Programmed would be indistinguishable, via attachedListenerNames, from a
 			// listener that never matched at all.
-			if err := listenerProgrammedInStatus(listener.Name, gateway.Status.Listeners); err != nil {
+			if err := listenerProgrammedInStatus(listener.Name, listenerStatuses); err != nil {
 				listenerLogger.V(logging.DebugLevel).Info("Listener is not ready", "reason", err.Error())
 				continue
 			}
@@ -284,6 +392,7 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 
 			gateways = append(gateways, supportedGatewayWithCondition{
 				gateway:               &gateway,
+				listenerSet:           listenerSet,
 				listenerName:          listenerName,
 				attachedListenerNames: attachedListenerNames,
 				condition: metav1.Condition{
@@ -337,6 +446,7 @@ func getSupportedGatewayForRoute[T gatewayapi.RouteT](
 
 			gateways = append(gateways, supportedGatewayWithCondition{
 				gateway:               &gateway,
+				listenerSet:           listenerSet,
 				listenerName:          listenerName,
 				attachedListenerNames: attachedListenerNames,
 				condition: metav1.Condition{
@@ -537,10 +647,9 @@ func getUnionOfGatewayHostnames(gateways []supportedGatewayWithCondition) ([]gat
 	hostnames := make([]gatewayapi.Hostname, 0)
 	for _, gateway := range gateways {
 		if gateway.listenerName != "" {
-			if listener := extractListenerSpecFromGateway(
-				gateway.gateway,
-				gatewayapi.SectionName(gateway.listenerName),
-			); listener != nil {
+			if listener, ok := lo.Find(gateway.listeners(), func(l gatewayapi.Listener) bool {
+				return l.Name == gatewayapi.SectionName(gateway.listenerName)
+			}); ok {
 				// return true if the listener has not specified hostname to match any hostname.
 				if listener.Hostname == nil {
 					return nil, true
@@ -548,7 +657,7 @@ func getUnionOfGatewayHostnames(gateways []supportedGatewayWithCondition) ([]gat
 				hostnames = append(hostnames, *listener.Hostname)
 			}
 		} else {
-			for _, listener := range gateway.gateway.Spec.Listeners {
+			for _, listener := range gateway.listeners() {
 				// here we consider ALL listeners that are able to configure a hostname if no listener attached.
 				// may be changed if there is a conclusion on the upstream discussion about it:
 				// https://github.com/kubernetes-sigs/gateway-api/discussions/1563
@@ -573,7 +682,7 @@ func getUnionOfGatewayHostnames(gateways []supportedGatewayWithCondition) ([]gat
 // - if none of the above is true, return an empty string.
 func getMinimumHostnameIntersection(gateways []supportedGatewayWithCondition, hostname gatewayapi.Hostname) gatewayapi.Hostname {
 	for _, gateway := range gateways {
-		for _, listener := range gateway.gateway.Spec.Listeners {
+		for _, listener := range gateway.listeners() {
 			// if the listenerName is specified and matches the name of the gateway listener proceed
 			if (gatewayapi.SectionName)(gateway.listenerName) == "" ||
 				(gatewayapi.SectionName)(gateway.listenerName) == (listener.Name) {
@@ -619,18 +728,19 @@ func isGatewayProgrammedSettledForRoute(gateway supportedGatewayWithCondition) b
 		return true
 	}
 
-	generation := gateway.gateway.Generation
+	generation := gateway.listenersGeneration()
+	listenerStatuses := gateway.listenerStatuses()
 	transientNotProgrammed := false
 	for _, listenerName := range gateway.attachedListenerNames {
 		// Any one attached listener being Programmed is enough to proceed - mirrors `isRouteAccepted`.
-		if err := listenerProgrammedInStatus(listenerName, gateway.gateway.Status.Listeners); err == nil {
+		if err := listenerProgrammedInStatus(listenerName, listenerStatuses); err == nil {
 			return true
 		}
 		// A listener whose ResolvedRefs condition is explicitly False will never
 		// become Programmed until that reference is fixed. The same holds for other
 		// listener validation failures with dedicated reasons.
-		if gatewayapi.ListenerResolvedRefsFalse(listenerName, generation, gateway.gateway.Status.Listeners) ||
-			gatewayapi.ListenerProgrammedFalseForSettledReason(listenerName, generation, gateway.gateway.Status.Listeners) {
+		if gatewayapi.ListenerResolvedRefsFalse(listenerName, generation, listenerStatuses) ||
+			gatewayapi.ListenerProgrammedFalseForSettledReason(listenerName, generation, listenerStatuses) {
 			continue
 		}
 		// Transient not Programmed - keep looking for a Programmed sibling first.
@@ -824,7 +934,7 @@ func isParentRefEqualToParent[
 	if *parentRef.Group != gatewayv1.GroupName {
 		return false
 	}
-	if *parentRef.Kind != "Gateway" {
+	if string(*parentRef.Kind) != parent.GetKind() {
 		return false
 	}
 	if string(parentRef.Name) != parent.GetName() {
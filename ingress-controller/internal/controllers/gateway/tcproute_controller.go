@@ -102,6 +102,12 @@ func (r *TCPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listTCPRoutesForGateway),
 		)
 
+	// if a ListenerSet updates then we need to enqueue the TCPRoutes attached to it
+	// as its listeners (and their statuses) determine whether the routes are accepted.
+	watchListenerSetsForRoutes[*gatewayapi.TCPRoute](blder, mgr, r.Log, func() client.ObjectList {
+		return &gatewayapi.TCPRouteList{}
+	})
+
 	if r.enableReferenceGrant {
 		blder.Watches(gatewayapi.NewReferenceGrant(r.referenceGrantVersion),
 			handler.EnqueueRequestsFromMapFunc(r.listTCPRoutesForReferenceGrant),
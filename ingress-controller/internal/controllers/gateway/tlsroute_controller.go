@@ -98,6 +98,12 @@ func (r *TLSRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listTLSRoutesForGateway),
 		)
 
+	// if a ListenerSet updates then we need to enqueue the TLSRoutes attached to it
+	// as its listeners (and their statuses) determine whether the routes are accepted.
+	watchListenerSetsForRoutes[*gatewayapi.TLSRoute](blder, mgr, r.Log, func() client.ObjectList {
+		return &gatewayapi.TLSRouteList{}
+	})
+
 	if r.enableReferenceGrant {
 		blder.Watches(gatewayapi.NewReferenceGrant(r.referenceGrantVersion),
 			handler.EnqueueRequestsFromMapFunc(r.listTLSRoutesForReferenceGrant),
@@ -102,6 +102,12 @@ func (r *UDPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listUDPRoutesForGateway),
 		)
 
+	// if a ListenerSet updates then we need to enqueue the UDPRoutes attached to it
+	// as its listeners (and their statuses) determine whether the routes are accepted.
+	watchListenerSetsForRoutes[*gatewayapi.UDPRoute](blder, mgr, r.Log, func() client.ObjectList {
+		return &gatewayapi.UDPRouteList{}
+	})
+
 	if r.enableReferenceGrant {
 		blder.Watches(gatewayapi.NewReferenceGrant(r.referenceGrantVersion),
 			handler.EnqueueRequestsFromMapFunc(r.listUDPRoutesForReferenceGrant),
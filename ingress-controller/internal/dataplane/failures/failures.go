@@ -83,6 +83,12 @@ func (c *ResourceFailuresCollector) PushResourceFailure(reason string, causingOb
 	c.logResourceFailure(reason, causingObjects...)
 }
 
+// PushResourceFailures adds resource processing failures created earlier, e.g. collected in a previous run of the
+// process, to the collector. Unlike PushResourceFailure, it doesn't log them again.
+func (c *ResourceFailuresCollector) PushResourceFailures(failures ...ResourceFailure) {
+	c.failures = append(c.failures, failures...)
+}
+
 // logResourceFailure logs an error with a resource processing failure message for each causing object.
 func (c *ResourceFailuresCollector) logResourceFailure(reason string, causingObjects ...client.Object) {
 	for _, obj := range causingObjects {
@@ -88,6 +88,20 @@ func TestResourceFailuresCollector(t *testing.T) {
 		require.Empty(t, collector.PopResourceFailures(), "second call should not return any failure")
 	})
 
+	t.Run("pushes previously collected resource failures without logging them", func(t *testing.T) {
+		core, logs := observer.New(zap.DebugLevel)
+		logger := zapr.NewLogger(zap.New(core))
+
+		collector := NewResourceFailuresCollector(logger)
+		collector.PushResourceFailure(someValidResourceFailureReason, someResourceFailureCausingObjects()...)
+		collected := collector.PopResourceFailures()
+		logsCount := logs.Len()
+
+		collector.PushResourceFailures(collected...)
+		require.Equal(t, logsCount, logs.Len(), "expecting no new log entries")
+		require.Equal(t, collected, collector.PopResourceFailures())
+	})
+
 	t.Run("does not crash but logs error when no causing objects passed", func(t *testing.T) {
 		core, logs := observer.New(zap.DebugLevel)
 		logger := zapr.NewLogger(zap.New(core))
@@ -16,6 +16,7 @@ import (
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	"github.com/samber/mo"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,7 @@ import (
 	k8sobj "github.com/kong/kong-operator/v2/ingress-controller/internal/util/kubernetes/object"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/util/kubernetes/object/status"
 	"github.com/kong/kong-operator/v2/internal/iter"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 )
 
 const (
@@ -50,6 +52,12 @@ const (
 	KongConfigurationTranslationFailedEventReason = "KongConfigurationTranslationFailed"
 	// KongConfigurationApplyFailedEventReason defines an event reason used for creating all config apply resource failure events.
 	KongConfigurationApplyFailedEventReason = "KongConfigurationApplyFailed"
+	// KongRouteConflictEventReason defines an event reason used for creating events for objects whose routes are
+	// shadowed by, or ambiguous with, routes of other objects.
+	KongRouteConflictEventReason = "KongRouteConflict"
+	// KongConfigurationIgnoredFieldsEventReason defines an event reason used for creating events for objects
+	// setting fields which can't be translated to Kong configuration and don't take effect.
+	KongConfigurationIgnoredFieldsEventReason = "KongConfigurationIgnoredFields"
 
 	// FallbackKongConfigurationApplySucceededEventReason defines an event reason
 	// to tell the updating of fallback Kong configuration succeeded.
@@ -99,6 +107,11 @@ type KonnectKongStateUpdater interface {
 	UpdateKongState(kongState *kongstate.KongState, isFallback bool)
 }
 
+// UpstreamsUpdater is an interface for updating the upstreams applied to the Kong Gateways.
+type UpstreamsUpdater interface {
+	UpdateUpstreams(upstreams []kongstate.Upstream)
+}
+
 // KongClient is a threadsafe high level API client for the Kong data-plane(s)
 // which parses Kubernetes object caches into Kong Admin configurations and
 // sends them as updates to the data-plane(s) (Kong Admin API).
@@ -152,6 +165,10 @@ type KongClient struct {
 	// is actively configured (e.g. to know how to set the object status).
 	kubernetesObjectReportsFilter k8sobj.ConfigurationStatusSet
 
+	// kubernetesObjectRouteConflicts is a set of objects whose routes were found shadowed by,
+	// or ambiguous with, routes of other objects in the most recent Update().
+	kubernetesObjectRouteConflicts k8sobj.MessageSet
+
 	// eventRecorder is used to record warning events for resource failures.
 	eventRecorder record.EventRecorder
 
@@ -199,6 +216,9 @@ type KongClient struct {
 	// by the Konnect config synchronization loop.
 	konnectKongStateUpdater KonnectKongStateUpdater
 
+	// upstreamsUpdater is notified about the upstreams successfully applied to the Kong Gateways.
+	upstreamsUpdater UpstreamsUpdater
+
 	// hasSuccessfullyPushedConfig indicates whether this client instance has already
 	// successfully pushed a configuration to a gateway during its lifetime.
 	// It is used to prevent an initial empty config push from replacing a known
@@ -206,6 +226,9 @@ type KongClient struct {
 	// and syncing informer cache.
 	hasSuccessfullyPushedConfig     bool
 	hasSuccessfullyPushedConfigOnce sync.Once
+
+	// configPartitions splits the configuration across the gateways of the configuration partitions.
+	configPartitions ConfigPartitions
 }
 
 // KongClientOption is a functional option for configuring a KongClient.
@@ -218,6 +241,13 @@ func WithDiagnosticsClient(diagnostic diagnostics.Client) func(*KongClient) {
 	}
 }
 
+// WithConfigPartitions sets the configuration partitions the configuration is split across.
+func WithConfigPartitions(partitions ConfigPartitions) func(*KongClient) {
+	return func(c *KongClient) {
+		c.configPartitions = partitions
+	}
+}
+
 // NewKongClient provides a new KongClient object after connecting to the
 // data-plane API and verifying integrity.
 func NewKongClient(
@@ -453,6 +483,15 @@ func (c *KongClient) KubernetesObjectConfigurationStatus(obj client.Object) k8so
 	return c.kubernetesObjectReportsFilter.Get(obj)
 }
 
+// KubernetesObjectRouteConflict reports whether routes translated from the provided object are shadowed by,
+// or ambiguous with, routes of other objects in the configuration applied to the data-plane.
+// The returned message describes the conflicts.
+func (c *KongClient) KubernetesObjectRouteConflict(obj client.Object) (string, bool) {
+	c.kubernetesObjectReportLock.RLock()
+	defer c.kubernetesObjectReportLock.RUnlock()
+	return c.kubernetesObjectRouteConflicts.Get(obj)
+}
+
 // -----------------------------------------------------------------------------
 // Dataplane Client - Kong - Interface Implementation
 // -----------------------------------------------------------------------------
@@ -467,17 +506,24 @@ func (c *KongClient) DBMode() dpconf.DBMode {
 // Update parses the Cache present in the client and converts current
 // Kubernetes state into Kong objects and state, and then ships the
 // resulting configuration to the data-plane (Kong Admin API).
-func (c *KongClient) Update(ctx context.Context) error {
+func (c *KongClient) Update(ctx context.Context) (err error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	ctx, span := opentelemetry.StartSpan(ctx, "UpdateKongConfig")
+	defer func() { opentelemetry.EndSpan(span, err) }()
+
 	// If Kong is running in dbless mode, we can fetch and store the last good configuration.
 	if c.dbmode.IsDBLessMode() {
 		// Fetch the last valid configuration from the proxy only in case there is no valid
 		// configuration already stored in memory. This can happen when KIC restarts and there
 		// already is a Kong Proxy with a valid configuration loaded.
 		if _, found := c.kongConfigFetcher.LastValidConfig(); !found {
-			if err := c.kongConfigFetcher.TryFetchingValidConfigFromGateways(ctx, c.logger, c.clientsProvider.GatewayClients(), c.kongConfigBuilder.CustomEntityTypes()); err != nil {
+			// Gateways of the configuration partitions only have a part of the configuration loaded.
+			unpartitionedClients := lo.Filter(c.clientsProvider.GatewayClients(), func(cl *adminapi.Client, _ int) bool {
+				return cl.Partition() == ""
+			})
+			if err := c.kongConfigFetcher.TryFetchingValidConfigFromGateways(ctx, c.logger, unpartitionedClients, c.kongConfigBuilder.CustomEntityTypes()); err != nil {
 				// If the client fails to fetch the last good configuration, we log it
 				// and carry on, as this is a condition that can be recovered with the following steps.
 				c.logger.Error(err, "Failed to fetch last good configuration from gateways")
@@ -488,8 +534,9 @@ func (c *KongClient) Update(ctx context.Context) error {
 	// If FallbackConfiguration is enabled, we take a snapshot of the cache so that we operate on a consistent
 	// set of resources in case of failures being returned from Kong. As we're going to generate a fallback config
 	// based on the cache contents, we need to ensure it is not modified during the process.
+	// IncrementalTranslation needs the snapshots too, to find the objects changed since the previous translation.
 	var cacheSnapshot store.CacheStores
-	if c.kongConfig.FallbackConfiguration {
+	if c.kongConfig.FallbackConfiguration || c.kongConfig.IncrementalTranslation {
 		var newSnapshotHash store.SnapshotHash
 		var err error
 		// Empty snapshot hash means that the cache hasn't changed since the last snapshot was taken. That optimization can be used
@@ -520,7 +567,10 @@ func (c *KongClient) Update(ctx context.Context) error {
 
 	c.logger.V(logging.DebugLevel).Info("Parsing kubernetes objects into data-plane configuration")
 	translationStart := time.Now()
+	_, translationSpan := opentelemetry.StartSpan(ctx, "TranslateKongConfig")
 	parsingResult := c.kongConfigBuilder.BuildKongConfig()
+	translationSpan.SetAttributes(attribute.Int("translation.failures", len(parsingResult.TranslationFailures)))
+	opentelemetry.EndSpan(translationSpan, nil)
 	translationDuration := time.Since(translationStart)
 
 	kongState := parsingResult.KongState
@@ -540,6 +590,15 @@ func (c *KongClient) Update(ctx context.Context) error {
 			c.metricsRecorder.RecordTranslationBrokenResources(0)
 			c.logger.V(logging.DebugLevel).Info("Successfully built data-plane configuration", "duration", translationDuration.String())
 		}
+		c.metricsRecorder.RecordTranslationRouteConflicts(len(parsingResult.RouteConflicts))
+		if conflictsCount := len(parsingResult.RouteConflicts); conflictsCount > 0 {
+			c.recordResourceFailureEvents(parsingResult.RouteConflicts, KongRouteConflictEventReason)
+			c.logger.V(logging.DebugLevel).Info("Conflicting routes found in data-plane configuration", "count", conflictsCount)
+		}
+		if ignoredCount := len(parsingResult.IgnoredFields); ignoredCount > 0 {
+			c.recordResourceFailureEvents(parsingResult.IgnoredFields, KongConfigurationIgnoredFieldsEventReason)
+			c.logger.V(logging.DebugLevel).Info("Ignored fields found when building data-plane configuration", "count", ignoredCount)
+		}
 	}
 
 	const isFallback = false
@@ -568,6 +627,7 @@ func (c *KongClient) Update(ctx context.Context) error {
 
 	// Send configuration to Konnect only when successfully applied configuration to Kong Gateways run in cluster.
 	c.maybeUpdateKonnectKongState(kongState, isFallback)
+	c.maybeUpdateUpstreams(kongState)
 	// Gateways were successfully synced with the current configuration, so we can update the last valid cache snapshot.
 	c.maybePreserveTheLastValidConfigCache(cacheSnapshot)
 
@@ -578,7 +638,7 @@ func (c *KongClient) Update(ctx context.Context) error {
 		if !slices.Equal(shas, c.SHAs) {
 			c.logger.V(logging.DebugLevel).Info("Triggering report for configured Kubernetes objects", "count",
 				len(parsingResult.ConfiguredKubernetesObjects))
-			c.triggerKubernetesObjectReport(parsingResult.ConfiguredKubernetesObjects, parsingResult.TranslationFailures)
+			c.triggerKubernetesObjectReport(parsingResult.ConfiguredKubernetesObjects, parsingResult.TranslationFailures, parsingResult.RouteConflicts)
 		} else {
 			c.logger.V(logging.DebugLevel).Info("No configuration change; resource status update not necessary, skipping")
 		}
@@ -719,12 +779,15 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 		return fmt.Errorf("failed to sync fallback configuration with gateways: %w", gatewaysSyncErr)
 	}
 	c.maybeUpdateKonnectKongState(fallbackParsingResult.KongState, isFallback)
+	c.maybeUpdateUpstreams(fallbackParsingResult.KongState)
 
 	// Report on configured Kubernetes objects if enabled for fallback configuration
 	if c.AreKubernetesObjectReportsEnabled() {
 		c.logger.V(logging.DebugLevel).Info("Triggering report for configured Kubernetes objects in fallback configuration",
 			"count", len(fallbackParsingResult.ConfiguredKubernetesObjects))
-		c.triggerKubernetesObjectReport(fallbackParsingResult.ConfiguredKubernetesObjects, fallbackParsingResult.TranslationFailures)
+		c.triggerKubernetesObjectReport(
+			fallbackParsingResult.ConfiguredKubernetesObjects, fallbackParsingResult.TranslationFailures, fallbackParsingResult.RouteConflicts,
+		)
 	}
 
 	// Configuration was successfully recovered with the fallback configuration. Store the last valid configuration.
@@ -789,8 +852,13 @@ func (c *KongClient) sendOutToGatewayClients(
 	configureGatewayClientURLs := lo.Map(gatewayClientsToConfigure, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
 	c.logger.V(logging.DebugLevel).Info("Sending configuration to gateway clients", "urls", configureGatewayClientURLs)
 
+	partitionStates := c.configPartitions.States(s, routeParentRefsFromCache(c.cache))
 	shas, err := iter.MapErr(gatewayClientsToConfigure, func(client **adminapi.Client) (string, error) {
-		return c.sendToClient(ctx, *client, s, config, isFallback)
+		partitionState, ok := partitionStates[(*client).Partition()]
+		if !ok {
+			return "", fmt.Errorf("unknown configuration partition %q of %s", (*client).Partition(), (*client).BaseRootURL())
+		}
+		return c.sendToClient(ctx, *client, partitionState, config, isFallback)
 	})
 	if err != nil {
 		return nil, err
@@ -833,15 +901,30 @@ func (c *KongClient) maybeUpdateKonnectKongState(s *kongstate.KongState, isFallb
 	c.konnectKongStateUpdater.UpdateKongState(s, isFallback)
 }
 
+// maybeUpdateUpstreams notifies the upstreamsUpdater about the upstreams applied to the Kong Gateways if it is set.
+func (c *KongClient) maybeUpdateUpstreams(s *kongstate.KongState) {
+	if c.upstreamsUpdater == nil {
+		return
+	}
+	c.upstreamsUpdater.UpdateUpstreams(s.Upstreams)
+}
+
 func (c *KongClient) sendToClient(
 	ctx context.Context,
 	client sendconfig.AdminAPIClient,
 	s *kongstate.KongState,
 	config sendconfig.Config,
 	isFallback bool,
-) (string, error) {
+) (_ string, err error) {
 	logger := c.logger.WithValues("url", client.BaseRootURL())
 
+	ctx, span := opentelemetry.StartSpan(ctx, "SendKongConfig",
+		attribute.String("url", client.BaseRootURL()),
+		attribute.Bool("konnect", client.IsKonnect()),
+		attribute.Bool("fallback", isFallback),
+	)
+	defer func() { opentelemetry.EndSpan(span, err) }()
+
 	deckGenParams := deckgen.GenerateDeckContentParams{
 		SelectorTags:                    config.FilterTags,
 		ExpressionRoutes:                config.ExpressionRoutes,
@@ -936,6 +1019,13 @@ func (c *KongClient) SetKonnectKongStateUpdater(u KonnectKongStateUpdater) {
 	c.konnectKongStateUpdater = u
 }
 
+// SetUpstreamsUpdater sets the UpstreamsUpdater notified about the upstreams applied to the Kong Gateways.
+func (c *KongClient) SetUpstreamsUpdater(u UpstreamsUpdater) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.upstreamsUpdater = u
+}
+
 // -----------------------------------------------------------------------------
 // Dataplane Client - Kong - Private
 // -----------------------------------------------------------------------------
@@ -993,7 +1083,11 @@ func prepareSendDiagnosticFn(
 // enables filtering for which objects are currently applied to the data-plane,
 // as well as updating the c.kubernetesObjectStatusQueue to queue those objects
 // for reconciliation so their statuses can be properly updated.
-func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Object, translationFailures []failures.ResourceFailure) {
+func (c *KongClient) triggerKubernetesObjectReport(
+	reportedObjects []client.Object,
+	translationFailures []failures.ResourceFailure,
+	routeConflicts []failures.ResourceFailure,
+) {
 	// first a new set of the included objects for the most recent configuration
 	// needs to be generated.
 	set := k8sobj.ConfigurationStatusSet{}
@@ -1010,7 +1104,14 @@ func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Obje
 		}
 	}
 
-	c.updateKubernetesObjectReportFilter(set)
+	conflicts := k8sobj.MessageSet{}
+	for _, routeConflict := range routeConflicts {
+		for _, obj := range routeConflict.CausingObjects() {
+			conflicts.Insert(obj, routeConflict.Message())
+		}
+	}
+
+	c.updateKubernetesObjectReportFilter(set, conflicts)
 
 	// after the filter has been updated we signal the status queue so that the
 	// control-plane can update the Kubernetes object statuses for affected objs.
@@ -1032,12 +1133,13 @@ func UniqueObjects(reportedObjects []client.Object, resourceFailures []failures.
 	})
 }
 
-// updateKubernetesObjectReportFilter overrides the internal object set with
-// a new provided set.
-func (c *KongClient) updateKubernetesObjectReportFilter(set k8sobj.ConfigurationStatusSet) {
+// updateKubernetesObjectReportFilter overrides the internal object set and
+// route conflicts with new provided ones.
+func (c *KongClient) updateKubernetesObjectReportFilter(set k8sobj.ConfigurationStatusSet, routeConflicts k8sobj.MessageSet) {
 	c.kubernetesObjectReportLock.Lock()
 	defer c.kubernetesObjectReportLock.Unlock()
 	c.kubernetesObjectReportsFilter = set
+	c.kubernetesObjectRouteConflicts = routeConflicts
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
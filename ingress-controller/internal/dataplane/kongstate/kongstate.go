@@ -103,6 +103,91 @@ func (ks *KongState) SanitizedCopy(uuidGenerator util.UUIDGenerator) *KongState
 	}
 }
 
+// RoutesSubset returns a shallow copy of the state keeping only the routes for which keep returns true. Services left
+// without routes are dropped together with their upstreams and the plugins and custom entities attached to the dropped
+// services and routes. Other entities, e.g. consumers or certificates, are kept as they are.
+func (ks *KongState) RoutesSubset(keep func(Route) bool) *KongState {
+	subset := *ks
+	subset.Services = nil
+	droppedServices := sets.New[string]()
+	droppedRoutes := sets.New[string]()
+	hosts := sets.New[string]()
+	for _, svc := range ks.Services {
+		routes := lo.Filter(svc.Routes, func(r Route, _ int) bool {
+			if keep(r) {
+				return true
+			}
+			droppedRoutes.Insert(entityIdentifiers(r.ID, r.Name)...)
+			return false
+		})
+		if len(routes) == 0 {
+			droppedServices.Insert(entityIdentifiers(svc.ID, svc.Name)...)
+			continue
+		}
+		svc.Routes = routes
+		subset.Services = append(subset.Services, svc)
+		hosts.Insert(lo.FromPtr(svc.Host))
+	}
+	subset.Upstreams = lo.Filter(ks.Upstreams, func(u Upstream, _ int) bool {
+		return hosts.Has(lo.FromPtr(u.Name))
+	})
+	subset.Plugins = lo.Filter(ks.Plugins, func(p Plugin, _ int) bool {
+		if p.Service != nil && droppedServices.HasAny(entityIdentifiers(p.Service.ID, p.Service.Name)...) {
+			return false
+		}
+		if p.Route != nil && droppedRoutes.HasAny(entityIdentifiers(p.Route.ID, p.Route.Name)...) {
+			return false
+		}
+		return true
+	})
+	subset.CustomEntities = customEntitiesSubset(ks.CustomEntities, func(e CustomEntity) bool {
+		if id, ok := e.ForeignEntityIDs[kong.EntityTypeServices]; ok && droppedServices.Has(id) {
+			return false
+		}
+		if id, ok := e.ForeignEntityIDs[kong.EntityTypeRoutes]; ok && droppedRoutes.Has(id) {
+			return false
+		}
+		return true
+	})
+	return &subset
+}
+
+// customEntitiesSubset returns a copy of the custom entity collections keeping only the entities for which keep
+// returns true. Collections left without entities are dropped.
+func customEntitiesSubset(
+	collections map[string]*KongCustomEntityCollection, keep func(CustomEntity) bool,
+) map[string]*KongCustomEntityCollection {
+	if collections == nil {
+		return nil
+	}
+	subset := make(map[string]*KongCustomEntityCollection, len(collections))
+	for entityType, collection := range collections {
+		entities := lo.Filter(collection.Entities, func(e CustomEntity, _ int) bool {
+			return keep(e)
+		})
+		if len(entities) == 0 {
+			continue
+		}
+		subset[entityType] = &KongCustomEntityCollection{
+			Schema:   collection.Schema,
+			Entities: entities,
+		}
+	}
+	return subset
+}
+
+// entityIdentifiers returns the ID and the name of an entity, which plugins may use to refer to it.
+func entityIdentifiers(id, name *string) []string {
+	var identifiers []string
+	if id != nil {
+		identifiers = append(identifiers, *id)
+	}
+	if name != nil {
+		identifiers = append(identifiers, *name)
+	}
+	return identifiers
+}
+
 func (ks *KongState) FillConsumersAndCredentials(
 	_ logr.Logger,
 	s store.Storer,
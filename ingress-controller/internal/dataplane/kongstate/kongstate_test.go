@@ -15,6 +15,7 @@ import (
 	"github.com/go-logr/zapr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/kong/go-kong/kong"
+	"github.com/kong/go-kong/kong/custom"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -235,6 +236,85 @@ func ensureAllKongStateFieldsAreCoveredInTest(t *testing.T, testedFields []strin
 	}
 }
 
+func TestKongState_RoutesSubset(t *testing.T) {
+	route := func(name, namespace string) Route {
+		return Route{
+			Route:   kong.Route{ID: new(name + "-id"), Name: new(name)},
+			Ingress: util.K8sObjectInfo{Name: name, Namespace: namespace},
+		}
+	}
+	ks := &KongState{
+		Services: []Service{
+			{
+				Service: kong.Service{ID: new("mixed-id"), Name: new("mixed"), Host: new("mixed.default.80.svc")},
+				Routes:  []Route{route("internal", "internal"), route("external", "external")},
+			},
+			{
+				Service: kong.Service{ID: new("external-only-id"), Name: new("external-only"), Host: new("external-only.default.80.svc")},
+				Routes:  []Route{route("external-2", "external")},
+			},
+		},
+		Upstreams: []Upstream{
+			{Upstream: kong.Upstream{Name: new("mixed.default.80.svc")}},
+			{Upstream: kong.Upstream{Name: new("external-only.default.80.svc")}},
+		},
+		Plugins: []Plugin{
+			{Plugin: kong.Plugin{Name: new("global")}},
+			{Plugin: kong.Plugin{Name: new("on-mixed-service"), Service: &kong.Service{ID: new("mixed")}}},
+			{Plugin: kong.Plugin{Name: new("on-external-service"), Service: &kong.Service{ID: new("external-only")}}},
+			{Plugin: kong.Plugin{Name: new("on-internal-route"), Route: &kong.Route{ID: new("internal")}}},
+			{Plugin: kong.Plugin{Name: new("on-external-route"), Route: &kong.Route{ID: new("external-id")}}},
+		},
+		Consumers: []Consumer{{Consumer: kong.Consumer{Username: new("alice")}}},
+		CustomEntities: map[string]*KongCustomEntityCollection{
+			"on_services": {
+				Entities: []CustomEntity{
+					{Object: custom.Object{"name": "on-mixed-service"}, ForeignEntityIDs: map[kong.EntityType]string{kong.EntityTypeServices: "mixed"}},
+					{Object: custom.Object{"name": "on-external-service"}, ForeignEntityIDs: map[kong.EntityType]string{kong.EntityTypeServices: "external-only-id"}},
+				},
+			},
+			"on_routes": {
+				Entities: []CustomEntity{
+					{Object: custom.Object{"name": "on-external-route"}, ForeignEntityIDs: map[kong.EntityType]string{kong.EntityTypeRoutes: "external"}},
+				},
+			},
+			"unattached": {
+				Entities: []CustomEntity{
+					{Object: custom.Object{"name": "unattached"}},
+					{Object: custom.Object{"name": "on-consumer"}, ForeignEntityIDs: map[kong.EntityType]string{kong.EntityTypeConsumers: "alice"}},
+				},
+			},
+		},
+	}
+
+	subset := ks.RoutesSubset(func(r Route) bool { return r.Ingress.Namespace == "internal" })
+
+	require.Len(t, subset.Services, 1)
+	require.Equal(t, "mixed", *subset.Services[0].Name)
+	require.Equal(t, []Route{route("internal", "internal")}, subset.Services[0].Routes)
+	require.Equal(t, []Upstream{{Upstream: kong.Upstream{Name: new("mixed.default.80.svc")}}}, subset.Upstreams)
+	require.Equal(t,
+		[]string{"global", "on-mixed-service", "on-internal-route"},
+		lo.Map(subset.Plugins, func(p Plugin, _ int) string { return *p.Name }),
+	)
+	require.Equal(t, ks.Consumers, subset.Consumers)
+	customEntityNames := func(collections map[string]*KongCustomEntityCollection) map[string][]string {
+		return lo.MapValues(collections, func(c *KongCustomEntityCollection, _ string) []string {
+			return lo.Map(c.Entities, func(e CustomEntity, _ int) string { return e.Object["name"].(string) })
+		})
+	}
+	require.Equal(t, map[string][]string{
+		"on_services": {"on-mixed-service"},
+		"unattached":  {"unattached", "on-consumer"},
+	}, customEntityNames(subset.CustomEntities))
+
+	// The original state is left untouched.
+	require.Len(t, ks.Services, 2)
+	require.Len(t, ks.Services[0].Routes, 2)
+	require.Len(t, ks.CustomEntities, 3)
+	require.Len(t, ks.CustomEntities["on_services"].Entities, 2)
+}
+
 func TestGetPluginRelations(t *testing.T) {
 	type data struct {
 		inputState              KongState
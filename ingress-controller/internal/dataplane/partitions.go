@@ -0,0 +1,209 @@
+package dataplane
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
+	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+)
+
+// configPartition selects the routes sent to the gateways of a configuration partition by the objects they were
+// translated from.
+type configPartition struct {
+	name       string
+	namespaces sets.Set[string]
+	selector   labels.Selector
+	listeners  []managercfg.GatewayListener
+}
+
+// routeParentRefsFunc returns the parentRefs of the Gateway API route the object information refers to, and false
+// when it doesn't refer to a Gateway API route.
+type routeParentRefsFunc func(source util.K8sObjectInfo) ([]gatewayapi.ParentReference, bool)
+
+func (p configPartition) matches(source util.K8sObjectInfo, routeParentRefs routeParentRefsFunc) bool {
+	if p.namespaces.Len() > 0 && !p.namespaces.Has(source.Namespace) {
+		return false
+	}
+	if !p.selector.Matches(labels.Set(source.Labels)) {
+		return false
+	}
+	if len(p.listeners) == 0 {
+		return true
+	}
+	parentRefs, ok := routeParentRefs(source)
+	if !ok {
+		return false
+	}
+	return lo.SomeBy(parentRefs, func(parentRef gatewayapi.ParentReference) bool {
+		return lo.SomeBy(p.listeners, func(listener managercfg.GatewayListener) bool {
+			return parentRefAttachesToListener(source.Namespace, parentRef, listener)
+		})
+	})
+}
+
+// parentRefAttachesToListener returns true if the parentRef of a route in the given namespace attaches it to the
+// listener. A parentRef without a sectionName attaches the route to all the listeners of the Gateway.
+func parentRefAttachesToListener(
+	routeNamespace string, parentRef gatewayapi.ParentReference, listener managercfg.GatewayListener,
+) bool {
+	if parentRef.Group != nil && *parentRef.Group != gatewayapi.V1Group {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		return false
+	}
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	if (k8stypes.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}) != listener.Gateway {
+		return false
+	}
+	return listener.SectionName == "" || parentRef.SectionName == nil ||
+		string(*parentRef.SectionName) == listener.SectionName
+}
+
+// ConfigPartitions splits the Kong configuration across the gateways of the configuration partitions. The gateways
+// of a partition receive the routes translated from the objects matching the partition, while the gateways not
+// belonging to any partition receive the routes not matching any of them.
+type ConfigPartitions []configPartition
+
+// NewConfigPartitions creates ConfigPartitions from the partitions of the manager configuration.
+func NewConfigPartitions(partitions []managercfg.ConfigPartition) (ConfigPartitions, error) {
+	configPartitions := make(ConfigPartitions, 0, len(partitions))
+	for _, p := range partitions {
+		selector := labels.Everything()
+		if p.Selector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(p.Selector); err != nil {
+				return nil, fmt.Errorf("invalid selector of partition %s: %w", p.Name, err)
+			}
+		}
+		configPartitions = append(configPartitions, configPartition{
+			name:       p.Name,
+			namespaces: sets.New(p.Namespaces...),
+			selector:   selector,
+			listeners:  p.Listeners,
+		})
+	}
+	return configPartitions, nil
+}
+
+// States returns the state each partition's gateways are to be configured with, indexed by the partition name. The
+// state of the gateways not belonging to any partition is indexed by an empty name. routeParentRefs is used to find
+// the Gateway listeners the routes are attached to.
+func (p ConfigPartitions) States(
+	s *kongstate.KongState, routeParentRefs routeParentRefsFunc,
+) map[string]*kongstate.KongState {
+	if len(p) == 0 {
+		return map[string]*kongstate.KongState{"": s}
+	}
+
+	states := make(map[string]*kongstate.KongState, len(p)+1)
+	for _, partition := range p {
+		states[partition.name] = s.RoutesSubset(func(r kongstate.Route) bool {
+			return partition.matches(r.Ingress, routeParentRefs)
+		})
+	}
+	states[""] = s.RoutesSubset(func(r kongstate.Route) bool {
+		for _, partition := range p {
+			if partition.matches(r.Ingress, routeParentRefs) {
+				return false
+			}
+		}
+		return true
+	})
+	return states
+}
+
+// routeParentRefsFromCache returns a routeParentRefsFunc looking the Gateway API routes up in the cache.
+func routeParentRefsFromCache(cache *store.CacheStores) routeParentRefsFunc {
+	return func(source util.K8sObjectInfo) ([]gatewayapi.ParentReference, bool) {
+		if cache == nil || source.GroupVersionKind.Group != string(gatewayapi.V1Group) {
+			return nil, false
+		}
+		meta := metav1.ObjectMeta{Namespace: source.Namespace, Name: source.Name}
+		var obj client.Object
+		switch source.GroupVersionKind.Kind {
+		case "HTTPRoute":
+			obj = &gatewayapi.HTTPRoute{ObjectMeta: meta}
+		case "GRPCRoute":
+			obj = &gatewayapi.GRPCRoute{ObjectMeta: meta}
+		case "TCPRoute":
+			obj = &gatewayapi.TCPRoute{ObjectMeta: meta}
+		case "UDPRoute":
+			obj = &gatewayapi.UDPRoute{ObjectMeta: meta}
+		case "TLSRoute":
+			obj = &gatewayapi.TLSRoute{ObjectMeta: meta}
+		default:
+			return nil, false
+		}
+		item, exists, err := cache.Get(obj)
+		if err != nil || !exists {
+			return nil, false
+		}
+		var parentRefs []gatewayapi.ParentReference
+		switch route := item.(type) {
+		case *gatewayapi.HTTPRoute:
+			parentRefs = route.Spec.ParentRefs
+		case *gatewayapi.GRPCRoute:
+			parentRefs = route.Spec.ParentRefs
+		case *gatewayapi.TCPRoute:
+			parentRefs = route.Spec.ParentRefs
+		case *gatewayapi.UDPRoute:
+			parentRefs = route.Spec.ParentRefs
+		case *gatewayapi.TLSRoute:
+			parentRefs = route.Spec.ParentRefs
+		default:
+			return nil, false
+		}
+		return lo.FilterMap(parentRefs, func(parentRef gatewayapi.ParentReference, _ int) (gatewayapi.ParentReference, bool) {
+			if !gatewayapi.IsListenerSetParentRef(parentRef) {
+				return parentRef, true
+			}
+			return listenerSetParentRefToGatewayParentRef(cache, source.Namespace, parentRef)
+		}), true
+	}
+}
+
+// listenerSetParentRefToGatewayParentRef replaces the parentRef of a route attached to a ListenerSet with a
+// parentRef to the Gateway the ListenerSet is attached to. The listeners of a ListenerSet are not listeners of
+// the Gateway, so the returned parentRef has an empty sectionName: it only attaches the route to the listeners of
+// partitions which select the whole Gateway.
+func listenerSetParentRefToGatewayParentRef(
+	cache *store.CacheStores, routeNamespace string, parentRef gatewayapi.ParentReference,
+) (gatewayapi.ParentReference, bool) {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	item, exists, err := cache.Get(&gatewayapi.ListenerSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: string(parentRef.Name)},
+	})
+	if err != nil || !exists {
+		return gatewayapi.ParentReference{}, false
+	}
+	listenerSet, ok := item.(*gatewayapi.ListenerSet)
+	if !ok {
+		return gatewayapi.ParentReference{}, false
+	}
+	gatewayNN, ok := gatewayapi.ListenerSetParentGateway(listenerSet)
+	if !ok {
+		return gatewayapi.ParentReference{}, false
+	}
+	return gatewayapi.ParentReference{
+		Namespace:   new(gatewayapi.Namespace(gatewayNN.Namespace)),
+		Name:        gatewayapi.ObjectName(gatewayNN.Name),
+		SectionName: new(gatewayapi.SectionName("")),
+	}, true
+}
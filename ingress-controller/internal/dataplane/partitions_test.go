@@ -0,0 +1,251 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
+	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+)
+
+// noRouteParentRefs is a routeParentRefsFunc treating all objects as not being Gateway API routes.
+func noRouteParentRefs(util.K8sObjectInfo) ([]gatewayapi.ParentReference, bool) {
+	return nil, false
+}
+
+func TestConfigPartitions_States(t *testing.T) {
+	route := func(name, namespace string, labels map[string]string) kongstate.Route {
+		return kongstate.Route{
+			Route:   kong.Route{Name: new(name)},
+			Ingress: util.K8sObjectInfo{Name: name, Namespace: namespace, Labels: labels},
+		}
+	}
+	s := &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{Name: new("svc"), Host: new("svc.default.80.svc")},
+				Routes: []kongstate.Route{
+					route("team-a", "team-a", nil),
+					route("team-a-public", "team-a", map[string]string{"exposure": "external"}),
+					route("team-b-public", "team-b", map[string]string{"exposure": "external"}),
+					route("team-c", "team-c", nil),
+				},
+			},
+		},
+		Consumers: []kongstate.Consumer{{Consumer: kong.Consumer{Username: new("alice")}}},
+	}
+	routeNames := func(s *kongstate.KongState) []string {
+		var names []string
+		for _, svc := range s.Services {
+			for _, r := range svc.Routes {
+				names = append(names, *r.Name)
+			}
+		}
+		return names
+	}
+
+	t.Run("without partitions all gateways get the whole configuration", func(t *testing.T) {
+		states := ConfigPartitions(nil).States(s, noRouteParentRefs)
+		require.Equal(t, map[string]*kongstate.KongState{"": s}, states)
+	})
+
+	t.Run("routes are split across partitions", func(t *testing.T) {
+		partitions, err := NewConfigPartitions([]managercfg.ConfigPartition{
+			{
+				Name:         "team-a",
+				KongAdminSvc: k8stypes.NamespacedName{Namespace: "kong", Name: "team-a-admin"},
+				Namespaces:   []string{"team-a"},
+			},
+			{
+				Name:         "external",
+				KongAdminSvc: k8stypes.NamespacedName{Namespace: "kong", Name: "external-admin"},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"exposure": "external"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		states := partitions.States(s, noRouteParentRefs)
+		require.Len(t, states, 3)
+		require.Equal(t, []string{"team-a", "team-a-public"}, routeNames(states["team-a"]))
+		require.Equal(t, []string{"team-a-public", "team-b-public"}, routeNames(states["external"]))
+		require.Equal(t, []string{"team-c"}, routeNames(states[""]))
+		for name, state := range states {
+			require.Equalf(t, s.Consumers, state.Consumers, "consumers of partition %q", name)
+		}
+	})
+
+	t.Run("routes are split by Gateway listeners", func(t *testing.T) {
+		partitions, err := NewConfigPartitions([]managercfg.ConfigPartition{
+			{
+				Name:         "external",
+				KongAdminSvc: k8stypes.NamespacedName{Namespace: "kong", Name: "external-admin"},
+				Listeners: []managercfg.GatewayListener{
+					{Gateway: k8stypes.NamespacedName{Namespace: "kong", Name: "gateway"}, SectionName: "external"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// team-a is attached to the internal listener only, team-a-public to all the listeners of the Gateway,
+		// team-b-public to the external listener and team-c isn't a Gateway API route.
+		routeParentRefs := map[string][]gatewayapi.ParentReference{
+			"team-a": {{
+				Name:        "gateway",
+				Namespace:   new(gatewayapi.Namespace("kong")),
+				SectionName: new(gatewayapi.SectionName("internal")),
+			}},
+			"team-a-public": {{
+				Name:      "gateway",
+				Namespace: new(gatewayapi.Namespace("kong")),
+			}},
+			"team-b-public": {
+				{Name: "other-gateway", Namespace: new(gatewayapi.Namespace("kong"))},
+				{
+					Name:        "gateway",
+					Namespace:   new(gatewayapi.Namespace("kong")),
+					SectionName: new(gatewayapi.SectionName("external")),
+				},
+			},
+		}
+		states := partitions.States(s, func(source util.K8sObjectInfo) ([]gatewayapi.ParentReference, bool) {
+			parentRefs, ok := routeParentRefs[source.Name]
+			return parentRefs, ok
+		})
+		require.Equal(t, []string{"team-a-public", "team-b-public"}, routeNames(states["external"]))
+		require.Equal(t, []string{"team-a", "team-c"}, routeNames(states[""]))
+	})
+
+	t.Run("invalid selector is rejected", func(t *testing.T) {
+		_, err := NewConfigPartitions([]managercfg.ConfigPartition{
+			{
+				Name: "invalid",
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "exposure", Operator: "Unknown"}},
+				},
+			},
+		})
+		require.ErrorContains(t, err, "invalid selector of partition invalid")
+	})
+
+	t.Run("partition without matching routes gets no services", func(t *testing.T) {
+		partitions, err := NewConfigPartitions([]managercfg.ConfigPartition{
+			{Name: "empty", Namespaces: []string{"team-z"}},
+		})
+		require.NoError(t, err)
+
+		states := partitions.States(s, noRouteParentRefs)
+		require.Empty(t, states["empty"].Services)
+		require.Equal(t, lo.Map(s.Services[0].Routes, func(r kongstate.Route, _ int) string { return *r.Name }), routeNames(states[""]))
+	})
+}
+
+func TestParentRefAttachesToListener(t *testing.T) {
+	listener := managercfg.GatewayListener{
+		Gateway:     k8stypes.NamespacedName{Namespace: "kong", Name: "gateway"},
+		SectionName: "http",
+	}
+	testCases := []struct {
+		name      string
+		parentRef gatewayapi.ParentReference
+		listener  managercfg.GatewayListener
+		expected  bool
+	}{
+		{
+			name:      "same listener",
+			parentRef: gatewayapi.ParentReference{Name: "gateway", SectionName: new(gatewayapi.SectionName("http"))},
+			listener:  listener,
+			expected:  true,
+		},
+		{
+			name:      "other listener",
+			parentRef: gatewayapi.ParentReference{Name: "gateway", SectionName: new(gatewayapi.SectionName("https"))},
+			listener:  listener,
+		},
+		{
+			name:      "parentRef without sectionName attaches to all listeners",
+			parentRef: gatewayapi.ParentReference{Name: "gateway"},
+			listener:  listener,
+			expected:  true,
+		},
+		{
+			name:      "listener without sectionName selects all listeners",
+			parentRef: gatewayapi.ParentReference{Name: "gateway", SectionName: new(gatewayapi.SectionName("https"))},
+			listener:  managercfg.GatewayListener{Gateway: listener.Gateway},
+			expected:  true,
+		},
+		{
+			name: "parentRef namespace defaults to the route namespace",
+			parentRef: gatewayapi.ParentReference{
+				Name:      "gateway",
+				Namespace: new(gatewayapi.Namespace("default")),
+			},
+			listener: listener,
+		},
+		{
+			name: "parentRef to a non Gateway kind",
+			parentRef: gatewayapi.ParentReference{
+				Name: "gateway",
+				Kind: new(gatewayapi.Kind("Service")),
+			},
+			listener: listener,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, parentRefAttachesToListener("kong", tc.parentRef, tc.listener))
+		})
+	}
+}
+
+func TestRouteParentRefsFromCache(t *testing.T) {
+	cache, err := store.NewCacheStoresFromObjs(
+		&gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "route"},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{
+						{Name: "gateway", Namespace: new(gatewayapi.Namespace("kong")), SectionName: new(gatewayapi.SectionName("http"))},
+						{Name: "listenerset", Kind: new(gatewayapi.Kind(gatewayapi.ListenerSetKind))},
+						{Name: "missing", Kind: new(gatewayapi.Kind(gatewayapi.ListenerSetKind))},
+					},
+				},
+			},
+		},
+		&gatewayapi.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "listenerset"},
+			Spec: gatewayapi.ListenerSetSpec{
+				ParentRef: gatewayapi.ParentGatewayReference{Name: "gateway", Namespace: new(gatewayapi.Namespace("kong"))},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	parentRefs, ok := routeParentRefsFromCache(&cache)(util.K8sObjectInfo{
+		Namespace:        "default",
+		Name:             "route",
+		GroupVersionKind: schema.GroupVersion(gatewayapi.GroupVersion).WithKind("HTTPRoute"),
+	})
+	require.True(t, ok)
+	require.Equal(t, []gatewayapi.ParentReference{
+		{Name: "gateway", Namespace: new(gatewayapi.Namespace("kong")), SectionName: new(gatewayapi.SectionName("http"))},
+		{Name: "gateway", Namespace: new(gatewayapi.Namespace("kong")), SectionName: new(gatewayapi.SectionName(""))},
+	}, parentRefs)
+
+	wholeGateway := managercfg.GatewayListener{Gateway: k8stypes.NamespacedName{Namespace: "kong", Name: "gateway"}}
+	require.True(t, parentRefAttachesToListener("default", parentRefs[1], wholeGateway),
+		"routes attached to a ListenerSet must attach to partitions selecting the whole Gateway")
+	wholeGateway.SectionName = "https"
+	require.False(t, parentRefAttachesToListener("default", parentRefs[1], wholeGateway),
+		"routes attached to a ListenerSet must not attach to a specific listener of the Gateway")
+}
@@ -44,4 +44,8 @@ type Config struct {
 	// UseLastValidConfigForFallback indicates whether to use the last valid config cache to backfill broken objects
 	// when recovering from a config push failure.
 	UseLastValidConfigForFallback bool
+
+	// IncrementalTranslation indicates whether Kong configuration is translated incrementally, which requires
+	// the translator to be provided snapshots of the cache.
+	IncrementalTranslation bool
 }
@@ -0,0 +1,195 @@
+package atc
+
+import (
+	"regexp/syntax"
+	"strings"
+)
+
+// maxDisjunctiveTerms bounds the number of AND-ed groups a Matcher is expanded to when checking coverage, so that
+// matchers with many alternatives combined with ANDs don't blow up.
+const maxDisjunctiveTerms = 128
+
+// Covers reports whether a matches every request b matches. It is conservative: false is returned when the coverage
+// can't be proven by comparing the predicates of the matchers, which is the case e.g. for a regex against a prefix.
+func Covers(a, b Matcher) bool {
+	if a == nil || b == nil || a.IsEmpty() || b.IsEmpty() {
+		return false
+	}
+	aTerms, ok := disjunctiveTerms(a)
+	if !ok {
+		return false
+	}
+	bTerms, ok := disjunctiveTerms(b)
+	if !ok {
+		return false
+	}
+
+	for _, bTerm := range bTerms {
+		covered := false
+		for _, aTerm := range aTerms {
+			if termImplies(bTerm, aTerm) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// disjunctiveTerms expands the Matcher into OR-ed groups of AND-ed predicates and negations. It returns false when the
+// expansion exceeds maxDisjunctiveTerms.
+func disjunctiveTerms(m Matcher) ([][]Matcher, bool) {
+	switch m := m.(type) {
+	case *OrMatcher:
+		var terms [][]Matcher
+		for _, sub := range m.subMatchers {
+			subTerms, ok := disjunctiveTerms(sub)
+			if !ok {
+				return nil, false
+			}
+			terms = append(terms, subTerms...)
+			if len(terms) > maxDisjunctiveTerms {
+				return nil, false
+			}
+		}
+		return terms, true
+	case *AndMatcher:
+		terms := [][]Matcher{{}}
+		for _, sub := range m.subMatchers {
+			subTerms, ok := disjunctiveTerms(sub)
+			if !ok {
+				return nil, false
+			}
+			if len(terms)*len(subTerms) > maxDisjunctiveTerms {
+				return nil, false
+			}
+			product := make([][]Matcher, 0, len(terms)*len(subTerms))
+			for _, term := range terms {
+				for _, subTerm := range subTerms {
+					product = append(product, append(append([]Matcher{}, term...), subTerm...))
+				}
+			}
+			terms = product
+		}
+		return terms, true
+	default:
+		return [][]Matcher{{m}}, true
+	}
+}
+
+// termImplies reports whether every request matching all the atoms of b also matches all the atoms of a.
+func termImplies(b, a []Matcher) bool {
+	for _, aAtom := range a {
+		implied := false
+		for _, bAtom := range b {
+			if atomImplies(bAtom, aAtom) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
+
+// atomImplies reports whether every request matching b matches a, where both are single predicates or negations.
+func atomImplies(b, a Matcher) bool {
+	if b.Expression() == a.Expression() {
+		return true
+	}
+	bPredicate, ok := b.(Predicate)
+	if !ok {
+		return false
+	}
+	aPredicate, ok := a.(Predicate)
+	if !ok || aPredicate.field.String() != bPredicate.field.String() {
+		return false
+	}
+	aValue, aIsString := aPredicate.value.(StringLiteral)
+	bValue, bIsString := bPredicate.value.(StringLiteral)
+	if !aIsString || !bIsString {
+		return false
+	}
+
+	switch bPredicate.op {
+	case OpEqual:
+		// A single value matches a if a matches it.
+		return matchString(aPredicate.op, string(bValue), string(aValue))
+	case OpPrefixMatch:
+		return aPredicate.op == OpPrefixMatch && strings.HasPrefix(string(bValue), string(aValue))
+	case OpSuffixMatch:
+		return aPredicate.op == OpSuffixMatch && strings.HasSuffix(string(bValue), string(aValue))
+	case OpRegexMatch:
+		// A regex anchored at the start with a literal prefix only matches values with that prefix.
+		if aPredicate.op != OpPrefixMatch {
+			return false
+		}
+		prefix, ok := anchoredLiteralPrefix(string(bValue))
+		return ok && strings.HasPrefix(prefix, string(aValue))
+	default:
+		return false
+	}
+}
+
+// anchoredLiteralPrefix returns the literal every value matching the regex starts with, provided the regex is anchored
+// at the start of the value.
+func anchoredLiteralPrefix(expr string) (string, bool) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	re = re.Simplify()
+	if re.Op != syntax.OpConcat || len(re.Sub) == 0 || re.Sub[0].Op != syntax.OpBeginText {
+		return "", false
+	}
+	var prefix strings.Builder
+	for _, sub := range re.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix.WriteString(string(sub.Rune))
+	}
+	return prefix.String(), true
+}
+
+// RequiredValues returns the values the field is compared for equality with in every alternative of the Matcher: any
+// request matching it has the field set to one of them. It returns false when an alternative doesn't require the field
+// to be equal to a value, in which case the Matcher can match any value of the field.
+//
+// As a Matcher requiring the field to be equal to one of its values can only cover matchers requiring the field to be
+// equal to one of them too, it can be used to narrow down the matchers to check with Covers.
+func RequiredValues(m Matcher, field StringField) ([]string, bool) {
+	if m == nil || m.IsEmpty() {
+		return nil, false
+	}
+	terms, ok := disjunctiveTerms(m)
+	if !ok {
+		return nil, false
+	}
+
+	var values []string
+	for _, term := range terms {
+		found := false
+		for _, atom := range term {
+			predicate, ok := atom.(Predicate)
+			if !ok || predicate.op != OpEqual || predicate.field.String() != field.String() {
+				continue
+			}
+			value, ok := predicate.value.(StringLiteral)
+			if !ok {
+				continue
+			}
+			found = true
+			values = append(values, string(value))
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return values, true
+}
@@ -0,0 +1,138 @@
+package atc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCovers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "same expression",
+			a:        `http.path ^= "/api/"`,
+			b:        `http.path ^= "/api/"`,
+			expected: true,
+		},
+		{
+			name:     "shorter prefix covers longer prefix",
+			a:        `http.path ^= "/api"`,
+			b:        `http.path ^= "/api/v1"`,
+			expected: true,
+		},
+		{
+			name:     "longer prefix does not cover shorter prefix",
+			a:        `http.path ^= "/api/v1"`,
+			b:        `http.path ^= "/api"`,
+			expected: false,
+		},
+		{
+			name:     "prefix covers exact path",
+			a:        `http.path ^= "/api"`,
+			b:        `http.path == "/api/users"`,
+			expected: true,
+		},
+		{
+			name:     "prefix covers anchored regex with literal prefix",
+			a:        `http.path ^= "/api/"`,
+			b:        `http.path ~ "^/api/users/\\d+$"`,
+			expected: true,
+		},
+		{
+			name:     "prefix does not cover unanchored regex",
+			a:        `http.path ^= "/api/"`,
+			b:        `http.path ~ "/api/users/\\d+$"`,
+			expected: false,
+		},
+		{
+			name:     "fewer conditions cover more conditions",
+			a:        `http.path ^= "/"`,
+			b:        `(http.host == "example.com") && (http.path ^= "/api")`,
+			expected: true,
+		},
+		{
+			name:     "more conditions do not cover fewer conditions",
+			a:        `(http.host == "example.com") && (http.path ^= "/api")`,
+			b:        `http.path ^= "/"`,
+			expected: false,
+		},
+		{
+			name:     "all alternatives have to be covered",
+			a:        `(http.host == "example.com") && (http.path ^= "/")`,
+			b:        `((http.host == "example.com") || (http.host == "other.com")) && (http.path ^= "/api")`,
+			expected: false,
+		},
+		{
+			name:     "every alternative is covered",
+			a:        `(http.host =^ ".example.com") && (http.path ^= "/")`,
+			b:        `((http.host == "a.example.com") || (http.host == "b.example.com")) && (http.path ^= "/api")`,
+			expected: true,
+		},
+		{
+			name:     "different methods",
+			a:        `(http.method == "GET") && (http.path ^= "/")`,
+			b:        `(http.method == "POST") && (http.path ^= "/")`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := ParseExpression(tc.a)
+			require.NoError(t, err)
+			b, err := ParseExpression(tc.b)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, Covers(a, b))
+		})
+	}
+}
+
+func TestRequiredValues(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		expected   []string
+		expectedOK bool
+	}{
+		{
+			name:       "single host",
+			expression: `(http.host == "a.example.com") && (http.path ^= "/")`,
+			expected:   []string{"a.example.com"},
+			expectedOK: true,
+		},
+		{
+			name:       "host in every alternative",
+			expression: `((http.host == "a.example.com") || (http.host == "b.example.com")) && (http.path ^= "/")`,
+			expected:   []string{"a.example.com", "b.example.com"},
+			expectedOK: true,
+		},
+		{
+			name:       "alternative without host",
+			expression: `((http.host == "a.example.com") && (http.path ^= "/")) || (http.path ^= "/api/")`,
+		},
+		{
+			name:       "wildcard host",
+			expression: `(http.host =^ ".example.com") && (http.path ^= "/")`,
+		},
+		{
+			name:       "no host",
+			expression: `http.path ^= "/"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := ParseExpression(tc.expression)
+			require.NoError(t, err)
+			values, ok := RequiredValues(m, FieldHTTPHost)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, values)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package atc
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// Request is a synthetic request a Matcher can be evaluated against. It holds the values of the fields the router
+// extracts from a request.
+type Request struct {
+	// Protocol is the request protocol (net.protocol), e.g. http, https, grpc, grpcs, tcp, tls or udp.
+	Protocol string
+	// Method is the HTTP method (http.method).
+	Method string
+	// Host is the Host header (http.host). A port, if any, is ignored.
+	Host string
+	// Path is the request path (http.path), without the query string.
+	Path string
+	// Headers are the request headers (http.headers.*). Names are matched case-insensitively with dashes and
+	// underscores being equivalent.
+	Headers map[string][]string
+	// Queries are the query parameters (http.queries.*).
+	Queries map[string][]string
+	// SNI is the TLS server name indication (tls.sni).
+	SNI string
+	// Port is the destination port (net.dst.port). Zero means unset.
+	Port int
+}
+
+// Match reports whether the Matcher matches the request. As in Kong, a predicate on a field that the request does not
+// have a value for does not match, and a predicate on a multi-valued field matches if any of the values matches.
+func Match(m Matcher, req Request) bool {
+	switch m := m.(type) {
+	case *OrMatcher:
+		if m.IsEmpty() {
+			return false
+		}
+		for _, sub := range m.subMatchers {
+			if Match(sub, req) {
+				return true
+			}
+		}
+		return false
+	case *AndMatcher:
+		if m.IsEmpty() {
+			return false
+		}
+		for _, sub := range m.subMatchers {
+			if !Match(sub, req) {
+				return false
+			}
+		}
+		return true
+	case *NotMatcher:
+		if m.IsEmpty() {
+			return false
+		}
+		return !Match(m.subMatcher, req)
+	case Predicate:
+		return m.match(req)
+	case *Predicate:
+		return m != nil && m.match(req)
+	default:
+		return false
+	}
+}
+
+func (p Predicate) match(req Request) bool {
+	if p.IsEmpty() {
+		return false
+	}
+	if value, ok := p.value.(IntLiteral); ok {
+		fieldValue, ok := intFieldValue(p.field, req)
+		return ok && matchInt(p.op, fieldValue, int(value))
+	}
+	value, ok := p.value.(StringLiteral)
+	if !ok {
+		return false
+	}
+	for _, fieldValue := range stringFieldValues(p.field, req) {
+		if matchString(p.op, fieldValue, string(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func intFieldValue(field LHS, req Request) (int, bool) {
+	switch field {
+	case FieldNetDstPort:
+		return req.Port, req.Port != 0
+	case FieldHTTPPathSegmentsLen:
+		return len(pathSegments(req.Path)), true
+	default:
+		return 0, false
+	}
+}
+
+func stringFieldValues(field LHS, req Request) []string {
+	nonEmpty := func(v string) []string {
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	}
+
+	switch f := field.(type) {
+	case TransformLower:
+		values := stringFieldValues(f.inner, req)
+		lowered := make([]string, 0, len(values))
+		for _, v := range values {
+			lowered = append(lowered, strings.ToLower(v))
+		}
+		return lowered
+	case StringField:
+		switch f {
+		case FieldNetProtocol:
+			return nonEmpty(req.Protocol)
+		case FieldTLSSNI:
+			return nonEmpty(req.SNI)
+		case FieldHTTPMethod:
+			return nonEmpty(req.Method)
+		case FieldHTTPHost:
+			host := req.Host
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			return nonEmpty(host)
+		case FieldHTTPPath:
+			return nonEmpty(req.Path)
+		}
+	case HTTPHeaderField:
+		name := f.String()
+		var values []string
+		for k, v := range req.Headers {
+			if (HTTPHeaderField{HeaderName: k}).String() == name {
+				values = append(values, v...)
+			}
+		}
+		return values
+	case HTTPQueryField:
+		return req.Queries[f.QueryParamName]
+	case HTTPPathSingleSegmentField:
+		segments := pathSegments(req.Path)
+		if f.Index >= len(segments) {
+			return nil
+		}
+		return []string{segments[f.Index]}
+	case HTTPPathSegmentIntervalField:
+		segments := pathSegments(req.Path)
+		if f.End >= len(segments) {
+			return nil
+		}
+		return []string{strings.Join(segments[f.Start:f.End+1], "/")}
+	}
+	return nil
+}
+
+// pathSegments splits the path into its segments, ignoring the leading and trailing slashes.
+func pathSegments(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func matchString(op BinaryOperator, fieldValue, value string) bool {
+	switch op {
+	case OpEqual:
+		return fieldValue == value
+	case OpNotEqual:
+		return fieldValue != value
+	case OpPrefixMatch:
+		return strings.HasPrefix(fieldValue, value)
+	case OpSuffixMatch:
+		return strings.HasSuffix(fieldValue, value)
+	case OpContains:
+		return strings.Contains(fieldValue, value)
+	case OpRegexMatch:
+		re, err := regexp.Compile(value)
+		return err == nil && re.MatchString(fieldValue)
+	default:
+		return false
+	}
+}
+
+func matchInt(op BinaryOperator, fieldValue, value int) bool {
+	switch op {
+	case OpEqual:
+		return fieldValue == value
+	case OpNotEqual:
+		return fieldValue != value
+	case OpLessThan:
+		return fieldValue < value
+	case OpLessEqual:
+		return fieldValue <= value
+	case OpGreaterThan:
+		return fieldValue > value
+	case OpGreaterEqual:
+		return fieldValue >= value
+	default:
+		return false
+	}
+}
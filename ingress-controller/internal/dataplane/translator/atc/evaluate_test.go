@@ -0,0 +1,157 @@
+package atc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	testCases := []struct {
+		name    string
+		matcher Matcher
+	}{
+		{
+			name:    "single predicate",
+			matcher: NewPredicateHTTPPath(OpPrefixMatch, "/foo/"),
+		},
+		{
+			name:    "escaped string literal",
+			matcher: NewPredicateHTTPPath(OpRegexMatch, `^/foo/"bar"\d+$`),
+		},
+		{
+			name: "lower() transformer and integer field",
+			matcher: And(
+				mustNewPredicate(t, NewTransformerLower(FieldHTTPMethod), OpEqual, StringLiteral("get")),
+				mustNewPredicate(t, FieldNetDstPort, OpGreaterEqual, IntLiteral(8000)),
+			),
+		},
+		{
+			name: "nested groups with negation",
+			matcher: And(
+				Or(
+					NewPrediacteHTTPHost(OpEqual, "example.com"),
+					NewPrediacteHTTPHost(OpSuffixMatch, ".example.com"),
+				),
+				NewPredicateHTTPHeader("X-Foo", OpEqual, "bar"),
+				Not(NewPredicateHTTPQuery("debug", OpEqual, "true")),
+				NewPredicateHTTPPathSegmentInterval(1, 2, OpEqual, "a/b"),
+				NewPredicateHTTPPathSegmentLength(OpLessThan, 3),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := ParseExpression(tc.matcher.Expression())
+			require.NoError(t, err)
+			assert.Equal(t, tc.matcher.Expression(), parsed.Expression())
+		})
+	}
+
+	t.Run("raw string literal", func(t *testing.T) {
+		parsed, err := ParseExpression(`http.path ~ r#"^/foo\d+"#`)
+		require.NoError(t, err)
+		assert.Equal(t, `http.path ~ "^/foo\\d+"`, parsed.Expression())
+	})
+
+	for _, invalid := range []string{
+		`http.path`,
+		`http.path == `,
+		`http.path == "/foo`,
+		`net.src.ip == 10.0.0.1`,
+		`(http.path == "/foo"`,
+		`http.path == "/foo" &&`,
+		`http.path == 1`,
+		`http.path ~ "("`,
+	} {
+		t.Run("invalid: "+invalid, func(t *testing.T) {
+			_, err := ParseExpression(invalid)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	req := Request{
+		Protocol: "https",
+		Method:   "GET",
+		Host:     "api.example.com:8443",
+		Path:     "/v1/users/42",
+		Headers:  map[string][]string{"X-Kong-Test": {"a", "b"}},
+		Queries:  map[string][]string{"debug": {"true"}},
+		SNI:      "api.example.com",
+		Port:     8443,
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		expected   bool
+	}{
+		{
+			name:       "path prefix and host",
+			expression: `(http.path ^= "/v1/") && (http.host == "api.example.com")`,
+			expected:   true,
+		},
+		{
+			name:       "wildcard host",
+			expression: `http.host =^ ".example.com"`,
+			expected:   true,
+		},
+		{
+			name:       "path regex",
+			expression: `http.path ~ "^/v1/users/\\d+$"`,
+			expected:   true,
+		},
+		{
+			name:       "any header value matches",
+			expression: `http.headers.x_kong_test == "b"`,
+			expected:   true,
+		},
+		{
+			name:       "missing header does not match",
+			expression: `http.headers.x_other != "b"`,
+			expected:   false,
+		},
+		{
+			name:       "query parameter",
+			expression: `http.queries.debug == "true"`,
+			expected:   true,
+		},
+		{
+			name:       "path segments",
+			expression: `(http.path.segments.0 == "v1") && (http.path.segments.1_2 == "users/42") && (http.path.segments.len == 3)`,
+			expected:   true,
+		},
+		{
+			name:       "lowercased method",
+			expression: `lower(http.method) == "get"`,
+			expected:   true,
+		},
+		{
+			name:       "negation",
+			expression: `(net.protocol == "https") && !(http.method == "GET")`,
+			expected:   false,
+		},
+		{
+			name:       "SNI and port",
+			expression: `(tls.sni == "api.example.com") && (net.dst.port == 8443)`,
+			expected:   true,
+		},
+		{
+			name:       "no alternative matches",
+			expression: `(http.path == "/v1") || (http.path ^= "/v2/")`,
+			expected:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := ParseExpression(tc.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, Match(m, req))
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package atc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseExpression parses a Kong router expression into a Matcher. It is the inverse of Matcher.Expression() and
+// accepts the subset of the expression language that the translator generates: string and integer literals, the
+// fields defined in field.go and the lower() transform. IP literals and fields are not supported.
+func ParseExpression(expression string) (Matcher, error) {
+	p := &expressionParser{input: expression}
+	m, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return nil, p.errorf("unexpected %q", p.input[p.pos:])
+	}
+	return m, nil
+}
+
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+func (p *expressionParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid expression at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips spaces and advances past token if the input continues with it.
+func (p *expressionParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *expressionParser) parseOr() (Matcher, error) {
+	m, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	matchers := []Matcher{m}
+	for p.consume("||") {
+		m, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	if len(matchers) == 1 {
+		return matchers[0], nil
+	}
+	return Or(matchers...), nil
+}
+
+func (p *expressionParser) parseAnd() (Matcher, error) {
+	m, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	matchers := []Matcher{m}
+	for p.consume("&&") {
+		m, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	if len(matchers) == 1 {
+		return matchers[0], nil
+	}
+	return And(matchers...), nil
+}
+
+func (p *expressionParser) parseTerm() (Matcher, error) {
+	switch {
+	case p.consume("!"):
+		if !p.consume("(") {
+			return nil, p.errorf("expected ( after !")
+		}
+		m, err := p.parseGroupRest()
+		if err != nil {
+			return nil, err
+		}
+		return Not(m), nil
+	case p.consume("("):
+		return p.parseGroupRest()
+	default:
+		return p.parsePredicate()
+	}
+}
+
+// parseGroupRest parses the rest of a parenthesized group after its opening parenthesis.
+func (p *expressionParser) parseGroupRest() (Matcher, error) {
+	m, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(")") {
+		return nil, p.errorf("expected )")
+	}
+	return m, nil
+}
+
+// binaryOperators lists the operators so that the ones being a prefix of another come after it.
+var binaryOperators = []BinaryOperator{
+	OpEqual, OpNotEqual, OpPrefixMatch, OpSuffixMatch, OpRegexMatch,
+	OpLessEqual, OpLessThan, OpGreaterEqual, OpGreaterThan,
+	OpNotIn, OpIn, OpContains,
+}
+
+func (p *expressionParser) parsePredicate() (Matcher, error) {
+	lhs, err := p.parseLHS()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	var op BinaryOperator
+	for _, candidate := range binaryOperators {
+		if p.consume(string(candidate)) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return nil, p.errorf("expected operator after %s", lhs)
+	}
+
+	rhs, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+	if op == OpRegexMatch {
+		if s, ok := rhs.(StringLiteral); ok {
+			if _, err := regexp.Compile(string(s)); err != nil {
+				return nil, p.errorf("invalid regex %q: %v", string(s), err)
+			}
+		}
+	}
+
+	predicate, err := NewPredicate(lhs, op, rhs)
+	if err != nil {
+		return nil, p.errorf("%s %s %s: %v", lhs, op, rhs, err)
+	}
+	return predicate, nil
+}
+
+func (p *expressionParser) parseIdentifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c != '.' && c != '_' && c != '-' &&
+			(c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *expressionParser) parseLHS() (LHS, error) {
+	name := p.parseIdentifier()
+	if name == "lower" && p.consume("(") {
+		inner, err := p.parseLHS()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("expected ) after lower(%s", inner)
+		}
+		return NewTransformerLower(inner), nil
+	}
+	if name == "" {
+		return nil, p.errorf("expected field")
+	}
+	lhs, ok := parseField(name)
+	if !ok {
+		return nil, p.errorf("unsupported field %q", name)
+	}
+	return lhs, nil
+}
+
+// parseField returns the field with the given name.
+func parseField(name string) (LHS, bool) {
+	switch name {
+	case FieldNetProtocol.String(), FieldTLSSNI.String(), FieldHTTPMethod.String(),
+		FieldHTTPHost.String(), FieldHTTPPath.String():
+		return StringField(name), true
+	case FieldNetDstPort.String(), FieldHTTPPathSegmentsLen.String():
+		return IntField(name), true
+	}
+
+	if header, ok := strings.CutPrefix(name, "http.headers."); ok && header != "" {
+		return HTTPHeaderField{HeaderName: header}, true
+	}
+	if query, ok := strings.CutPrefix(name, "http.queries."); ok && query != "" {
+		return HTTPQueryField{QueryParamName: query}, true
+	}
+	if segments, ok := strings.CutPrefix(name, "http.path.segments."); ok {
+		if start, end, isInterval := strings.Cut(segments, "_"); isInterval {
+			startIndex, errStart := strconv.Atoi(start)
+			endIndex, errEnd := strconv.Atoi(end)
+			if errStart != nil || errEnd != nil || startIndex < 0 || endIndex < startIndex {
+				return nil, false
+			}
+			return HTTPPathSegmentIntervalField{Start: startIndex, End: endIndex}, true
+		}
+		index, err := strconv.Atoi(segments)
+		if err != nil || index < 0 {
+			return nil, false
+		}
+		return HTTPPathSingleSegmentField{Index: index}, true
+	}
+	return nil, false
+}
+
+func (p *expressionParser) parseLiteral() (Literal, error) {
+	p.skipSpaces()
+	rest := p.input[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, `"`):
+		return p.parseStringLiteral()
+	case strings.HasPrefix(rest, `r#"`):
+		end := strings.Index(rest[3:], `"#`)
+		if end < 0 {
+			return nil, p.errorf("unterminated raw string")
+		}
+		p.pos += 3 + end + 2
+		return StringLiteral(rest[3 : 3+end]), nil
+	}
+
+	start := p.pos
+	if p.pos < len(p.input) && p.input[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	value, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("expected string or integer literal")
+	}
+	return IntLiteral(value), nil
+}
+
+// parseStringLiteral parses a double-quoted string, reverting the escaping done by StringLiteral.String().
+func (p *expressionParser) parseStringLiteral() (Literal, error) {
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		c := p.input[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return StringLiteral(b.String()), nil
+		case '\\':
+			p.pos++
+			if p.pos == len(p.input) {
+				return nil, p.errorf("unterminated string")
+			}
+			switch escaped := p.input[p.pos]; escaped {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '\\', '"':
+				b.WriteByte(escaped)
+			default:
+				return nil, p.errorf("invalid escape sequence \\%c", escaped)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil, p.errorf("unterminated string")
+}
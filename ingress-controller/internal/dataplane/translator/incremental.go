@@ -0,0 +1,707 @@
+package translator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kong-operator/v2/api/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kong-operator/v2/api/incubator/v1alpha1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/failures"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/fallback"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/logging"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+)
+
+// DefaultIncrementalTranslationFullRebuildInterval is the default interval between full translations when
+// incremental translation is enabled.
+const DefaultIncrementalTranslationFullRebuildInterval = 5 * time.Minute
+
+// translationUnitName identifies a part of the Kong configuration that is translated as a whole.
+type translationUnitName string
+
+const (
+	// translationUnitRouting groups the units translating the routing components, see routingComponent.
+	translationUnitRouting        translationUnitName = "routing"
+	translationUnitCertificates   translationUnitName = "certificates"
+	translationUnitRouteConflicts translationUnitName = "route-conflicts"
+	translationUnitConsumers      translationUnitName = "consumers"
+	translationUnitVaults         translationUnitName = "vaults"
+	translationUnitConsumerGroups translationUnitName = "consumer-groups"
+	translationUnitCACertificates translationUnitName = "ca-certificates"
+	translationUnitPlugins        translationUnitName = "plugins"
+	translationUnitCustomEntities translationUnitName = "custom-entities"
+)
+
+// routingComponentUnitPrefix prefixes the names of the units translating routing components.
+const routingComponentUnitPrefix = string(translationUnitRouting) + "/"
+
+// translationUnitSources are the types of the objects each translation unit reads from the store. A unit has to be
+// translated again when an object of one of these types, or an object depending on one of them, changes.
+// The sources of translationUnitRouting are the objects read by all routing components, the objects split into
+// routing components are listed in routingComponentSources.
+//
+// NOTE: if you're making a translation unit read a new type of objects, it needs to be added here.
+var translationUnitSources = map[translationUnitName][]reflect.Type{
+	translationUnitRouting: {
+		reflect.TypeFor[*netv1.IngressClass](),
+		reflect.TypeFor[*configurationv1alpha1.IngressClassParameters](),
+		reflect.TypeFor[*corev1.Secret](),
+		reflect.TypeFor[*corev1.ConfigMap](),
+		reflect.TypeFor[*corev1.Namespace](),
+		reflect.TypeFor[*gatewayapi.ReferenceGrant](),
+		reflect.TypeFor[*gatewayapi.Gateway](),
+		reflect.TypeFor[*gatewayapi.GatewayClass](),
+		reflect.TypeFor[*gatewayapi.ListenerSet](),
+		reflect.TypeFor[*gatewayapi.BackendTLSPolicy](),
+	},
+	translationUnitCertificates: {
+		reflect.TypeFor[*corev1.Secret](),
+		reflect.TypeFor[*gatewayapi.ReferenceGrant](),
+		reflect.TypeFor[*gatewayapi.Gateway](),
+		reflect.TypeFor[*gatewayapi.GatewayClass](),
+		reflect.TypeFor[*gatewayapi.ListenerSet](),
+	},
+	// Secrets with credentials are not listed, as KongConsumers depend on them in the cache graph.
+	translationUnitConsumers: {
+		reflect.TypeFor[*configurationv1.KongConsumer](),
+		reflect.TypeFor[*configurationv1beta1.KongConsumerGroup](),
+	},
+	translationUnitVaults: {
+		reflect.TypeFor[*configurationv1alpha1.KongVault](),
+	},
+	translationUnitConsumerGroups: {
+		reflect.TypeFor[*configurationv1beta1.KongConsumerGroup](),
+	},
+	translationUnitCACertificates: {
+		reflect.TypeFor[*corev1.Secret](),
+		reflect.TypeFor[*corev1.ConfigMap](),
+		reflect.TypeFor[*configurationv1.KongPlugin](),
+		reflect.TypeFor[*configurationv1.KongClusterPlugin](),
+	},
+	translationUnitPlugins: {
+		reflect.TypeFor[*configurationv1.KongPlugin](),
+		reflect.TypeFor[*configurationv1.KongClusterPlugin](),
+		reflect.TypeFor[*corev1.Secret](),
+		reflect.TypeFor[*corev1.ConfigMap](),
+		reflect.TypeFor[*corev1.Namespace](),
+		reflect.TypeFor[*gatewayapi.ReferenceGrant](),
+	},
+	translationUnitCustomEntities: {
+		reflect.TypeFor[*configurationv1alpha1.KongCustomEntity](),
+		reflect.TypeFor[*configurationv1.KongPlugin](),
+		reflect.TypeFor[*configurationv1.KongClusterPlugin](),
+		reflect.TypeFor[*corev1.Namespace](),
+		reflect.TypeFor[*gatewayapi.ReferenceGrant](),
+	},
+}
+
+// routingComponentSources are the types of the objects split into routing components.
+var routingComponentSources = []reflect.Type{
+	reflect.TypeFor[*netv1.Ingress](),
+	reflect.TypeFor[*gatewayapi.HTTPRoute](),
+	reflect.TypeFor[*gatewayapi.GRPCRoute](),
+	reflect.TypeFor[*gatewayapi.TCPRoute](),
+	reflect.TypeFor[*gatewayapi.UDPRoute](),
+	reflect.TypeFor[*gatewayapi.TLSRoute](),
+	reflect.TypeFor[*corev1.Service](),
+	reflect.TypeFor[*discoveryv1.EndpointSlice](),
+	reflect.TypeFor[*configurationv1beta1.KongUpstreamPolicy](),
+	reflect.TypeFor[*incubatorv1alpha1.KongServiceFacade](),
+}
+
+// translationUnit is a part of the Kong configuration translated from a fixed set of object types,
+// independently of the other parts.
+type translationUnit struct {
+	name translationUnitName
+	// group is set for the units translating a part of a larger unit, which is considered translated
+	// when any of its parts is.
+	group translationUnitName
+	// component are the objects the unit is translated from, when it translates a routing component.
+	component map[cacheObjectKey]struct{}
+	// dependsOn are the units the unit reads the results of. The unit is translated again when any of them is.
+	dependsOn []translationUnitName
+	// translate fills the fields of the result the unit is responsible for.
+	translate func(*translationUnitResult)
+	// merge copies the fields of the KongState the unit is responsible for from src to dst.
+	merge func(dst *kongstate.KongState, src *translationUnitResult)
+}
+
+// translationUnitResult is the outcome of a translation unit, kept to be reused in the next translations.
+type translationUnitResult struct {
+	state kongstate.KongState
+	// secretNameToSNIs are the certificates requested by the objects of a routing component.
+	secretNameToSNIs SecretNameToSNIs
+	// certIDs maps the IDs of the certificates to the IDs of the certificates they're merged into.
+	certIDs certIDToMergedCertID
+
+	translationFailures []failures.ResourceFailure
+	configuredObjects   []client.Object
+	routeConflicts      []failures.ResourceFailure
+	ignoredFields       []failures.ResourceFailure
+}
+
+// incrementalTranslation keeps the results of the translation units between translations, so that only the units
+// affected by the objects changed since the previous translation are translated again.
+type incrementalTranslation struct {
+	logger              logr.Logger
+	graphProvider       fallback.CacheGraphProvider
+	fullRebuildInterval time.Duration
+	now                 func() time.Time
+
+	// cache is the cache snapshot the next translation is going to be built from.
+	cache *store.CacheStores
+	// previousCache and previousGraph describe the cache snapshot the cached results were built from.
+	previousCache   *store.CacheStores
+	previousGraph   *fallback.ConfigGraph
+	lastFullRebuild time.Time
+	results         map[translationUnitName]translationUnitResult
+}
+
+func newIncrementalTranslation(logger logr.Logger, fullRebuildInterval time.Duration) *incrementalTranslation {
+	if fullRebuildInterval <= 0 {
+		fullRebuildInterval = DefaultIncrementalTranslationFullRebuildInterval
+	}
+	return &incrementalTranslation{
+		logger:              logger.WithName("incremental-translation"),
+		graphProvider:       fallback.NewDefaultCacheGraphProvider(),
+		fullRebuildInterval: fullRebuildInterval,
+		now:                 time.Now,
+	}
+}
+
+// translationPlan tells which translation units have to be translated in the current translation.
+type translationPlan struct {
+	incremental *incrementalTranslation
+	// graph is the cache graph of the snapshot the translation is built from.
+	graph *fallback.ConfigGraph
+	// routingComponents are the routing components of the snapshot the translation is built from.
+	routingComponents []routingComponent
+	// full is true when all units have to be translated.
+	full bool
+	// stale are the units that have to be translated when full is false.
+	stale map[translationUnitName]struct{}
+	// changed are the objects changed since the previous snapshot, including the deleted ones.
+	changed map[cacheObjectKey]struct{}
+	// translated are the units translated in the current translation.
+	translated map[translationUnitName]struct{}
+}
+
+// plan compares the cache snapshot the translation is going to be built from with the previous one and
+// decides which translation units have to be translated. A nil plan is returned when the results of the
+// units can't be cached because no cache snapshot has been provided.
+func (i *incrementalTranslation) plan() *translationPlan {
+	if i == nil || i.cache == nil {
+		return nil
+	}
+
+	graph, err := i.graphProvider.CacheToGraph(*i.cache)
+	if err != nil {
+		i.logger.Error(err, "Failed to build cache graph, falling back to full translation")
+		i.reset()
+		return nil
+	}
+	components, err := routingComponents(*i.cache, graph)
+	if err != nil {
+		i.logger.Error(err, "Failed to split routing objects into components, falling back to full translation")
+		i.reset()
+		return nil
+	}
+
+	p := &translationPlan{
+		incremental:       i,
+		graph:             graph,
+		routingComponents: components,
+		translated:        map[translationUnitName]struct{}{},
+	}
+	switch {
+	case i.previousCache == nil || i.previousGraph == nil || i.results == nil:
+		p.full = true
+	case i.now().Sub(i.lastFullRebuild) >= i.fullRebuildInterval:
+		i.logger.V(logging.DebugLevel).Info("Full translation interval elapsed, translating all objects")
+		p.full = true
+	default:
+		p.stale, p.changed = i.staleUnits(graph)
+		i.logger.V(logging.DebugLevel).Info("Translating units affected by changed objects",
+			"units", len(p.stale), "objects", len(p.changed))
+	}
+	if p.full {
+		i.results = map[translationUnitName]translationUnitResult{}
+		i.lastFullRebuild = i.now()
+	}
+
+	// Results of the components which don't exist anymore are dropped. Their services and routes are not part
+	// of the configuration anymore, so the units reading them have to be translated again.
+	current := make(map[translationUnitName]struct{}, len(components))
+	for _, c := range components {
+		current[c.name] = struct{}{}
+	}
+	for name := range i.results {
+		if _, ok := current[name]; !ok && strings.HasPrefix(string(name), routingComponentUnitPrefix) {
+			delete(i.results, name)
+			p.translated[translationUnitRouting] = struct{}{}
+		}
+	}
+	return p
+}
+
+// finish records the cache snapshot and its graph as the ones the cached results were built from.
+func (p *translationPlan) finish() {
+	if p == nil {
+		return
+	}
+	p.incremental.previousCache = p.incremental.cache
+	p.incremental.previousGraph = p.graph
+}
+
+// reusableResult returns the cached result of the unit if the unit doesn't have to be translated.
+func (p *translationPlan) reusableResult(unit translationUnit) (translationUnitResult, bool) {
+	if p == nil || p.full {
+		return translationUnitResult{}, false
+	}
+	if _, ok := p.stale[unit.name]; ok {
+		return translationUnitResult{}, false
+	}
+	if _, ok := p.stale[unit.group]; ok {
+		return translationUnitResult{}, false
+	}
+	for _, dependency := range unit.dependsOn {
+		if _, ok := p.translated[dependency]; ok {
+			return translationUnitResult{}, false
+		}
+	}
+	for key := range p.changed {
+		if _, ok := unit.component[key]; ok {
+			return translationUnitResult{}, false
+		}
+	}
+	result, ok := p.incremental.results[unit.name]
+	return result, ok
+}
+
+// storeResult caches the result of the unit and records the unit as translated.
+func (p *translationPlan) storeResult(unit translationUnit, result translationUnitResult) {
+	p.incremental.results[unit.name] = result
+	p.translated[unit.name] = struct{}{}
+	if unit.group != "" {
+		p.translated[unit.group] = struct{}{}
+	}
+}
+
+// reset drops the cached results, so that the next translation is a full one.
+func (i *incrementalTranslation) reset() {
+	i.previousCache = nil
+	i.previousGraph = nil
+	i.results = nil
+}
+
+// staleUnits returns the translation units reading objects of the types of the objects changed between the previous
+// and the current cache snapshots, or of the types of the objects depending on them, along with the changed objects.
+func (i *incrementalTranslation) staleUnits(
+	graph *fallback.ConfigGraph,
+) (map[translationUnitName]struct{}, map[cacheObjectKey]struct{}) {
+	changed := map[cacheObjectKey]struct{}{}
+	affectedTypes := map[reflect.Type]struct{}{}
+	markAffected := func(g *fallback.ConfigGraph, key cacheObjectKey, obj client.Object) {
+		changed[key] = struct{}{}
+		affectedTypes[key.typ] = struct{}{}
+		dependants, err := g.SubgraphObjects(fallback.GetObjectHash(obj))
+		if err != nil {
+			i.logger.Error(err, "Failed to find dependants of changed object", "object", fallback.GetObjectHash(obj))
+			return
+		}
+		for _, dependant := range dependants {
+			affectedTypes[reflect.TypeOf(dependant)] = struct{}{}
+		}
+	}
+
+	previousObjects := cacheObjectsByKey(*i.previousCache)
+	for key, obj := range cacheObjectsByKey(*i.cache) {
+		previous, ok := previousObjects[key]
+		delete(previousObjects, key)
+		if ok && sameObjectVersion(previous, obj) {
+			continue
+		}
+		markAffected(graph, key, obj)
+	}
+	// Objects left are the ones deleted since the previous snapshot, their dependants are in the previous graph.
+	for key, obj := range previousObjects {
+		markAffected(i.previousGraph, key, obj)
+	}
+
+	stale := map[translationUnitName]struct{}{}
+	for unit, sources := range translationUnitSources {
+		if slices.ContainsFunc(sources, func(t reflect.Type) bool {
+			_, ok := affectedTypes[t]
+			return ok
+		}) {
+			stale[unit] = struct{}{}
+		}
+	}
+	return stale, changed
+}
+
+type cacheObjectKey struct {
+	typ reflect.Type
+	key string
+}
+
+func newCacheObjectKey(typ reflect.Type, namespace, name string) cacheObjectKey {
+	if namespace == "" {
+		return cacheObjectKey{typ: typ, key: name}
+	}
+	return cacheObjectKey{typ: typ, key: namespace + "/" + name}
+}
+
+func cacheObjectKeyFor(obj client.Object) cacheObjectKey {
+	return newCacheObjectKey(reflect.TypeOf(obj), obj.GetNamespace(), obj.GetName())
+}
+
+func cacheObjectsByKey(c store.CacheStores) map[cacheObjectKey]client.Object {
+	objects := map[cacheObjectKey]client.Object{}
+	for _, s := range c.ListAllStores() {
+		if s == nil {
+			continue
+		}
+		for _, o := range s.List() {
+			obj, ok := o.(client.Object)
+			if !ok {
+				continue
+			}
+			objects[cacheObjectKeyFor(obj)] = obj
+		}
+	}
+	return objects
+}
+
+// sameObjectVersion tells whether both objects are the same version of an object. ResourceVersion is compared when
+// set, as it reflects every change of the object in Kubernetes.
+func sameObjectVersion(a, b client.Object) bool {
+	if a.GetUID() != b.GetUID() {
+		return false
+	}
+	if a.GetResourceVersion() != "" && b.GetResourceVersion() != "" {
+		return a.GetResourceVersion() == b.GetResourceVersion()
+	}
+	return equality.Semantic.DeepEqual(a, b)
+}
+
+// routingComponent is a group of objects translated to Kong services, routes and upstreams independently of the
+// objects of the other routing components. Components are the connected components of the cache graph restricted
+// to routingComponentSources, joined where the translation of their objects depends on each other:
+//   - Gateway API routes are in a single component, as priorities and conflicts are resolved across all of them,
+//   - Ingresses with a default backend are in a single component, as only the oldest one is used,
+//   - canary Ingresses are in the component of their primary Ingress,
+//   - objects referring to the same Service or KongServiceFacade are in the same component, even if it doesn't exist,
+//   - KongServiceFacades and EndpointSlices are in the component of their Service.
+type routingComponent struct {
+	// name identifies the unit translating the component, it changes when objects join or leave the component.
+	name    translationUnitName
+	members map[cacheObjectKey]struct{}
+}
+
+var (
+	// gatewayAPIRoutesKey joins all Gateway API routes into one routing component.
+	gatewayAPIRoutesKey = cacheObjectKey{key: "gateway-api-routes"}
+	// ingressDefaultBackendsKey joins all Ingresses with a default backend into one routing component.
+	ingressDefaultBackendsKey = cacheObjectKey{key: "ingress-default-backends"}
+)
+
+// routingComponents splits the objects of the cache translated to Kong services and routes into routing components.
+// Only components including Ingresses or Gateway API routes are returned.
+func routingComponents(c store.CacheStores, graph *fallback.ConfigGraph) ([]routingComponent, error) {
+	sources := make(map[reflect.Type]struct{}, len(routingComponentSources))
+	for _, t := range routingComponentSources {
+		sources[t] = struct{}{}
+	}
+	objects := map[cacheObjectKey]client.Object{}
+	hashes := map[fallback.ObjectHash]cacheObjectKey{}
+	for key, obj := range cacheObjectsByKey(c) {
+		if _, ok := sources[key.typ]; !ok {
+			continue
+		}
+		objects[key] = obj
+		hashes[fallback.GetObjectHash(obj)] = key
+	}
+
+	parents := map[cacheObjectKey]cacheObjectKey{}
+	var find func(cacheObjectKey) cacheObjectKey
+	find = func(k cacheObjectKey) cacheObjectKey {
+		parent, ok := parents[k]
+		if !ok || parent == k {
+			return k
+		}
+		root := find(parent)
+		parents[k] = root
+		return root
+	}
+	union := func(a, b cacheObjectKey) {
+		if ra, rb := find(a), find(b); ra != rb {
+			parents[ra] = rb
+		}
+	}
+
+	adjacency, err := graph.AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+	for from, tos := range adjacency {
+		fromKey, ok := hashes[from]
+		if !ok {
+			continue
+		}
+		for _, to := range tos {
+			if toKey, ok := hashes[to]; ok {
+				union(fromKey, toKey)
+			}
+		}
+	}
+
+	serviceType := reflect.TypeFor[*corev1.Service]()
+	serviceFacadeType := reflect.TypeFor[*incubatorv1alpha1.KongServiceFacade]()
+	for key, obj := range objects {
+		switch obj := obj.(type) {
+		case *netv1.Ingress:
+			unionBackend := func(backend netv1.IngressBackend) {
+				if backend.Service != nil {
+					union(key, newCacheObjectKey(serviceType, obj.Namespace, backend.Service.Name))
+				}
+				if backend.Resource != nil && subtranslator.IsKongServiceFacade(backend.Resource) {
+					union(key, newCacheObjectKey(serviceFacadeType, obj.Namespace, backend.Resource.Name))
+				}
+			}
+			for _, rule := range obj.Spec.Rules {
+				if rule.HTTP == nil {
+					continue
+				}
+				for _, path := range rule.HTTP.Paths {
+					unionBackend(path.Backend)
+				}
+			}
+			if obj.Spec.DefaultBackend != nil {
+				unionBackend(*obj.Spec.DefaultBackend)
+				union(key, ingressDefaultBackendsKey)
+			}
+			if primary, ok := annotations.ExtractCanaryOf(obj.Annotations); ok {
+				union(key, newCacheObjectKey(key.typ, obj.Namespace, primary))
+			}
+		case *gatewayapi.HTTPRoute, *gatewayapi.GRPCRoute, *gatewayapi.TCPRoute, *gatewayapi.UDPRoute, *gatewayapi.TLSRoute:
+			union(key, gatewayAPIRoutesKey)
+		case *incubatorv1alpha1.KongServiceFacade:
+			union(key, newCacheObjectKey(serviceType, obj.Namespace, obj.Spec.Backend.Name))
+		case *discoveryv1.EndpointSlice:
+			if service, ok := obj.Labels[discoveryv1.LabelServiceName]; ok {
+				union(key, newCacheObjectKey(serviceType, obj.Namespace, service))
+			}
+		}
+	}
+
+	members := map[cacheObjectKey]map[cacheObjectKey]struct{}{}
+	withRoutes := map[cacheObjectKey]struct{}{}
+	for key, obj := range objects {
+		root := find(key)
+		if members[root] == nil {
+			members[root] = map[cacheObjectKey]struct{}{}
+		}
+		members[root][key] = struct{}{}
+		switch obj.(type) {
+		case *netv1.Ingress, *gatewayapi.HTTPRoute, *gatewayapi.GRPCRoute, *gatewayapi.TCPRoute, *gatewayapi.UDPRoute, *gatewayapi.TLSRoute:
+			withRoutes[root] = struct{}{}
+		}
+	}
+
+	components := make([]routingComponent, 0, len(withRoutes))
+	for root := range withRoutes {
+		components = append(components, routingComponent{
+			name:    routingComponentUnitName(members[root]),
+			members: members[root],
+		})
+	}
+	slices.SortFunc(components, func(a, b routingComponent) int {
+		return strings.Compare(string(a.name), string(b.name))
+	})
+	return components, nil
+}
+
+// routingComponentUnitName returns the name of the unit translating the routing component with the given members.
+func routingComponentUnitName(members map[cacheObjectKey]struct{}) translationUnitName {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, fmt.Sprintf("%s.%s:%s", key.typ.Elem().PkgPath(), key.typ.Elem().Name(), key.key))
+	}
+	slices.Sort(keys)
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return translationUnitName(routingComponentUnitPrefix + hex.EncodeToString(sum[:]))
+}
+
+// routingComponentStorer is a store.Storer listing only the Ingresses and Gateway API routes of a routing component.
+// Objects are still retrieved by name from the whole cache.
+type routingComponentStorer struct {
+	store.Storer
+	members map[cacheObjectKey]struct{}
+}
+
+func (s routingComponentStorer) ListIngressesV1() []*netv1.Ingress {
+	return filterRoutingComponentMembers(s.members, s.Storer.ListIngressesV1())
+}
+
+func (s routingComponentStorer) ListHTTPRoutes() ([]*gatewayapi.HTTPRoute, error) {
+	routes, err := s.Storer.ListHTTPRoutes()
+	return filterRoutingComponentMembers(s.members, routes), err
+}
+
+func (s routingComponentStorer) ListGRPCRoutes() ([]*gatewayapi.GRPCRoute, error) {
+	routes, err := s.Storer.ListGRPCRoutes()
+	return filterRoutingComponentMembers(s.members, routes), err
+}
+
+func (s routingComponentStorer) ListTCPRoutes() ([]*gatewayapi.TCPRoute, error) {
+	routes, err := s.Storer.ListTCPRoutes()
+	return filterRoutingComponentMembers(s.members, routes), err
+}
+
+func (s routingComponentStorer) ListUDPRoutes() ([]*gatewayapi.UDPRoute, error) {
+	routes, err := s.Storer.ListUDPRoutes()
+	return filterRoutingComponentMembers(s.members, routes), err
+}
+
+func (s routingComponentStorer) ListTLSRoutes() ([]*gatewayapi.TLSRoute, error) {
+	routes, err := s.Storer.ListTLSRoutes()
+	return filterRoutingComponentMembers(s.members, routes), err
+}
+
+func filterRoutingComponentMembers[T client.Object](members map[cacheObjectKey]struct{}, objs []T) []T {
+	filtered := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		if _, ok := members[cacheObjectKeyFor(obj)]; ok {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// routingUnits returns the units translating the objects configuring the routing of requests to Kong services,
+// routes and upstreams. Without a plan, all of them are translated by a single unit.
+func (t *Translator) routingUnits(plan *translationPlan) []translationUnit {
+	merge := func(dst *kongstate.KongState, src *translationUnitResult) {
+		dst.Services = append(dst.Services, cloneServices(src.state.Services)...)
+		dst.Upstreams = append(dst.Upstreams, src.state.Upstreams...)
+	}
+	if plan == nil {
+		return []translationUnit{{
+			name:      translationUnitRouting,
+			translate: t.translateRouting,
+			merge:     merge,
+		}}
+	}
+
+	units := make([]translationUnit, 0, len(plan.routingComponents))
+	for _, c := range plan.routingComponents {
+		componentTranslator := *t
+		componentTranslator.storer = routingComponentStorer{Storer: t.storer, members: c.members}
+		units = append(units, translationUnit{
+			name:      c.name,
+			group:     translationUnitRouting,
+			component: c.members,
+			translate: componentTranslator.translateRouting,
+			merge:     merge,
+		})
+	}
+	return units
+}
+
+// runTranslationUnit translates the unit into the result, or reuses its cached result if none of the objects
+// it's translated from changed. The result of the unit is returned.
+func (t *Translator) runTranslationUnit(
+	result *kongstate.KongState, unit translationUnit, plan *translationPlan,
+) translationUnitResult {
+	if plan == nil {
+		var unitResult translationUnitResult
+		unit.translate(&unitResult)
+		unit.merge(result, &unitResult)
+		return unitResult
+	}
+
+	if cached, ok := plan.reusableResult(unit); ok {
+		t.restoreTranslationUnitResult(cached)
+		unit.merge(result, &cached)
+		return cached
+	}
+
+	// Set aside what was collected by the previous units to collect only what the unit reports.
+	pendingFailures := t.failuresCollector.PopResourceFailures()
+	pendingObjects := t.translatedObjectsCollector.Pop()
+	pendingRouteConflicts := t.routeConflictsCollector.PopResourceFailures()
+	pendingIgnoredFields := t.ignoredFieldsCollector.PopResourceFailures()
+
+	var unitResult translationUnitResult
+	unit.translate(&unitResult)
+	unitResult.translationFailures = t.failuresCollector.PopResourceFailures()
+	unitResult.configuredObjects = t.translatedObjectsCollector.Pop()
+	unitResult.routeConflicts = t.routeConflictsCollector.PopResourceFailures()
+	unitResult.ignoredFields = t.ignoredFieldsCollector.PopResourceFailures()
+	plan.storeResult(unit, unitResult)
+
+	t.restoreTranslationUnitResult(translationUnitResult{
+		translationFailures: pendingFailures,
+		configuredObjects:   pendingObjects,
+		routeConflicts:      pendingRouteConflicts,
+		ignoredFields:       pendingIgnoredFields,
+	})
+	t.restoreTranslationUnitResult(unitResult)
+	unit.merge(result, &unitResult)
+	return unitResult
+}
+
+func (t *Translator) restoreTranslationUnitResult(result translationUnitResult) {
+	t.failuresCollector.PushResourceFailures(result.translationFailures...)
+	t.routeConflictsCollector.PushResourceFailures(result.routeConflicts...)
+	t.ignoredFieldsCollector.PushResourceFailures(result.ignoredFields...)
+	for _, obj := range result.configuredObjects {
+		t.translatedObjectsCollector.Add(obj)
+	}
+}
+
+// cloneServices copies the services and their routes, so that changes made to the returned services
+// don't affect the cached ones.
+func cloneServices(services []kongstate.Service) []kongstate.Service {
+	cloned := slices.Clone(services)
+	for i := range cloned {
+		cloned[i].Routes = slices.Clone(cloned[i].Routes)
+	}
+	return cloned
+}
+
+// cloneCustomEntities copies the collections of custom entities, so that changes made to the returned collections
+// don't affect the cached ones.
+func cloneCustomEntities(
+	collections map[string]*kongstate.KongCustomEntityCollection,
+) map[string]*kongstate.KongCustomEntityCollection {
+	if collections == nil {
+		return nil
+	}
+	cloned := make(map[string]*kongstate.KongCustomEntityCollection, len(collections))
+	for entityType, collection := range collections {
+		c := *collection
+		c.Entities = slices.Clone(collection.Entities)
+		cloned[entityType] = &c
+	}
+	return cloned
+}
@@ -0,0 +1,569 @@
+package translator
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/zapr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/failures"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/labels"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/manager/consts"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util/builder"
+	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+	"github.com/kong/kong-operator/v2/test/helpers/certificate"
+)
+
+// incrementalTranslationHarness translates cache snapshots both incrementally, with a single translator reusing its
+// previous results, and fully, with a new translator for every snapshot, and checks the results are identical.
+type incrementalTranslationHarness struct {
+	t            *testing.T
+	featureFlags FeatureFlags
+	cache        store.CacheStores
+	incremental  *Translator
+}
+
+func newIncrementalTranslationHarness(
+	t *testing.T, featureFlags FeatureFlags, objs ...client.Object,
+) *incrementalTranslationHarness {
+	cache, err := store.NewCacheStoresFromObjs(lo.Map(objs, func(obj client.Object, _ int) runtime.Object { return obj })...)
+	require.NoError(t, err)
+	h := &incrementalTranslationHarness{t: t, featureFlags: featureFlags, cache: cache}
+	h.incremental = h.newTranslator(cache, true)
+	return h
+}
+
+func (h *incrementalTranslationHarness) newTranslator(cache store.CacheStores, incremental bool) *Translator {
+	logger := zapr.NewLogger(zap.NewNop())
+	translator, err := NewTranslator(logger, store.New(cache, annotations.DefaultIngressClass, logger), "",
+		semver.MustParse("3.9.1"),
+		h.featureFlags,
+		customEntitySchemaServiceProvider{},
+		Config{
+			EnableDrainSupport:     consts.DefaultEnableDrainSupport,
+			ClusterDomain:          managercfg.DefaultClusterDomain,
+			IncrementalTranslation: incremental,
+		},
+	)
+	require.NoError(h.t, err)
+	return translator
+}
+
+// plan returns the plan the incremental translator makes for the current content of the cache, without translating it.
+func (h *incrementalTranslationHarness) plan() *translationPlan {
+	h.t.Helper()
+
+	snapshot, err := h.cache.TakeSnapshot()
+	require.NoError(h.t, err)
+
+	h.incremental.UpdateCache(snapshot)
+	return h.incremental.incremental.plan()
+}
+
+// requireIdenticalTranslations returns the result of the incremental translation, after checking it's identical to
+// the one of the full translation.
+func (h *incrementalTranslationHarness) requireIdenticalTranslations() KongConfigBuildingResult {
+	h.t.Helper()
+
+	snapshot, err := h.cache.TakeSnapshot()
+	require.NoError(h.t, err)
+
+	h.incremental.UpdateCache(snapshot)
+	incremental := h.incremental.BuildKongConfig()
+
+	full := h.newTranslator(snapshot, false)
+	full.UpdateCache(snapshot)
+	expected := full.BuildKongConfig()
+
+	require.Equal(h.t, normalizedKongState(expected.KongState), normalizedKongState(incremental.KongState))
+	require.ElementsMatch(h.t, failureMessages(expected.TranslationFailures), failureMessages(incremental.TranslationFailures))
+	require.ElementsMatch(h.t, objectKeys(expected.ConfiguredKubernetesObjects), objectKeys(incremental.ConfiguredKubernetesObjects))
+	require.ElementsMatch(h.t, failureMessages(expected.RouteConflicts), failureMessages(incremental.RouteConflicts))
+	return incremental
+}
+
+func (h *incrementalTranslationHarness) update(obj client.Object) {
+	h.t.Helper()
+	require.NoError(h.t, h.cache.Add(obj))
+}
+
+func (h *incrementalTranslationHarness) delete(obj client.Object) {
+	h.t.Helper()
+	require.NoError(h.t, h.cache.Delete(obj))
+}
+
+func normalizedKongState(ks *kongstate.KongState) *kongstate.KongState {
+	normalized := *ks
+	normalized.Services = slices.Clone(ks.Services)
+	for i := range normalized.Services {
+		normalized.Services[i].Routes = slices.Clone(normalized.Services[i].Routes)
+		slices.SortFunc(normalized.Services[i].Routes, func(a, b kongstate.Route) int {
+			return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+		})
+	}
+	slices.SortFunc(normalized.Services, func(a, b kongstate.Service) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	normalized.Upstreams = slices.Clone(ks.Upstreams)
+	slices.SortFunc(normalized.Upstreams, func(a, b kongstate.Upstream) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	normalized.Consumers = slices.Clone(ks.Consumers)
+	slices.SortFunc(normalized.Consumers, func(a, b kongstate.Consumer) int {
+		return cmp.Compare(lo.FromPtr(a.Username), lo.FromPtr(b.Username))
+	})
+	normalized.Plugins = slices.Clone(ks.Plugins)
+	slices.SortFunc(normalized.Plugins, func(a, b kongstate.Plugin) int {
+		return cmp.Or(
+			cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name)),
+			cmp.Compare(lo.FromPtr(a.InstanceName), lo.FromPtr(b.InstanceName)),
+		)
+	})
+	normalized.Certificates = slices.Clone(ks.Certificates)
+	for i := range normalized.Certificates {
+		normalized.Certificates[i].SNIs = slices.Clone(normalized.Certificates[i].SNIs)
+		slices.SortFunc(normalized.Certificates[i].SNIs, func(a, b *string) int {
+			return cmp.Compare(lo.FromPtr(a), lo.FromPtr(b))
+		})
+	}
+	slices.SortFunc(normalized.Certificates, func(a, b kongstate.Certificate) int {
+		return cmp.Compare(lo.FromPtr(a.ID), lo.FromPtr(b.ID))
+	})
+	normalized.CACertificates = slices.Clone(ks.CACertificates)
+	slices.SortFunc(normalized.CACertificates, func(a, b kong.CACertificate) int {
+		return cmp.Compare(lo.FromPtr(a.ID), lo.FromPtr(b.ID))
+	})
+	normalized.CustomEntities = make(map[string]*kongstate.KongCustomEntityCollection, len(ks.CustomEntities))
+	for entityType, collection := range ks.CustomEntities {
+		normalizedCollection := *collection
+		normalizedCollection.Entities = slices.Clone(collection.Entities)
+		slices.SortFunc(normalizedCollection.Entities, func(a, b kongstate.CustomEntity) int {
+			return cmp.Or(
+				cmp.Compare(client.ObjectKeyFromObject(a.K8sKongCustomEntity).String(),
+					client.ObjectKeyFromObject(b.K8sKongCustomEntity).String()),
+				cmp.Compare(fmt.Sprint(a.Object), fmt.Sprint(b.Object)),
+			)
+		})
+		normalized.CustomEntities[entityType] = &normalizedCollection
+	}
+	return &normalized
+}
+
+// customEntitySchemaServiceProvider provides a schema service knowing the schema of the "sessions" custom entities.
+type customEntitySchemaServiceProvider struct{}
+
+func (customEntitySchemaServiceProvider) GetSchemaService() kong.AbstractSchemaService {
+	return customEntitySchemaService{}
+}
+
+type customEntitySchemaService struct{}
+
+var _ kong.AbstractSchemaService = customEntitySchemaService{}
+
+func (customEntitySchemaService) Get(_ context.Context, entityType string) (kong.Schema, error) {
+	if entityType != "sessions" {
+		return nil, errors.New("schema not found")
+	}
+	return kong.Schema{
+		"fields": []any{
+			map[string]any{
+				"name": map[string]any{
+					"type":     "string",
+					"required": true,
+				},
+			},
+		},
+	}, nil
+}
+
+func (customEntitySchemaService) Validate(context.Context, kong.EntityType, any) (bool, string, error) {
+	return true, "", nil
+}
+
+// ingressRoute returns the route translated from the Ingress with the given name.
+func ingressRoute(t *testing.T, ks *kongstate.KongState, ingressName string) kongstate.Route {
+	t.Helper()
+	for _, service := range ks.Services {
+		for _, route := range service.Routes {
+			if route.Ingress.Name == ingressName {
+				return route
+			}
+		}
+	}
+	require.Failf(t, "route not found", "no route translated from Ingress %s", ingressName)
+	return kongstate.Route{}
+}
+
+func failureMessages(fs []failures.ResourceFailure) []string {
+	return lo.Map(fs, func(f failures.ResourceFailure, _ int) string { return f.Message() })
+}
+
+func objectKeys(objs []client.Object) []string {
+	return lo.Map(objs, func(obj client.Object, _ int) string {
+		return string(obj.GetUID()) + "/" + client.ObjectKeyFromObject(obj).String()
+	})
+}
+
+func TestIncrementalTranslation(t *testing.T) {
+	testCases := []struct {
+		name         string
+		featureFlags FeatureFlags
+	}{
+		{
+			name: "traditional router",
+			featureFlags: FeatureFlags{
+				FillIDs:                           true,
+				ReportConfiguredKubernetesObjects: true,
+			},
+		},
+		{
+			name: "expression router",
+			featureFlags: FeatureFlags{
+				FillIDs:                           true,
+				ReportConfiguredKubernetesObjects: true,
+				ExpressionRoutes:                  true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testIncrementalTranslation(t, tc.featureFlags)
+		})
+	}
+}
+
+func testIncrementalTranslation(t *testing.T, featureFlags FeatureFlags) {
+	classAnnotations := map[string]string{annotations.IngressClassKey: annotations.DefaultIngressClass}
+	objectMeta := func(name, uid, resourceVersion string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			UID:             k8stypes.UID(uid),
+			ResourceVersion: resourceVersion,
+			Annotations:     classAnnotations,
+		}
+	}
+	ingressTo := func(name, uid, resourceVersion, path, serviceName string) *netv1.Ingress {
+		ing := &netv1.Ingress{
+			ObjectMeta: objectMeta(name, uid, resourceVersion),
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{
+								Path:     path,
+								PathType: new(netv1.PathTypePrefix),
+								Backend: netv1.IngressBackend{
+									Service: &netv1.IngressServiceBackend{
+										Name: serviceName,
+										Port: netv1.ServiceBackendPort{Number: 80},
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}
+		ing.Annotations = lo.Assign(classAnnotations, map[string]string{
+			annotations.AnnotationPrefix + annotations.PluginsKey: "rate-limiting",
+		})
+		return ing
+	}
+	ingress := func(name, uid, resourceVersion, path string) *netv1.Ingress {
+		return ingressTo(name, uid, resourceVersion, path, "echo")
+	}
+	canaryIngress := func(resourceVersion, canaryKey, canaryValue string) *netv1.Ingress {
+		ing := ingressTo("foo-canary", "foo-canary-uid", resourceVersion, "/foo-v2", "other")
+		ing.Annotations = lo.Assign(ing.Annotations, map[string]string{
+			annotations.AnnotationPrefix + annotations.CanaryOfKey: "foo",
+			annotations.AnnotationPrefix + canaryKey:               canaryValue,
+		})
+		return ing
+	}
+	tlsIngress := ingress("secure", "secure-uid", "1", "/secure")
+	tlsIngress.Spec.TLS = []netv1.IngressTLS{{Hosts: []string{"example.com"}, SecretName: "example-tls"}}
+	newService := func(name, uid string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: objectMeta(name, uid, "1"),
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}},
+			},
+		}
+	}
+	service := newService("echo", "svc-uid")
+	plugin := &configurationv1.KongPlugin{
+		ObjectMeta: objectMeta("rate-limiting", "plugin-uid", "1"),
+		PluginName: "rate-limiting",
+		Config:     apiextensionsv1.JSON{Raw: []byte(`{"minute": 10}`)},
+	}
+	secret := func(resourceVersion, key string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: objectMeta("alice-key", "secret-uid", resourceVersion),
+			Data:       map[string][]byte{"key": []byte(key)},
+		}
+		s.Labels = map[string]string{labels.CredentialTypeLabel: "key-auth"}
+		return s
+	}
+	tlsSecret := func(resourceVersion, commonName string) *corev1.Secret {
+		cert, key := certificate.MustGenerateCertPEMFormat(certificate.WithCommonName(commonName))
+		return &corev1.Secret{
+			ObjectMeta: objectMeta("example-tls", "tls-secret-uid", resourceVersion),
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       cert,
+				corev1.TLSPrivateKeyKey: key,
+			},
+		}
+	}
+	consumer := func(resourceVersion, username string) *configurationv1.KongConsumer {
+		return &configurationv1.KongConsumer{
+			ObjectMeta:  objectMeta("alice", "consumer-uid", resourceVersion),
+			Username:    username,
+			Credentials: []string{"alice-key"},
+		}
+	}
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: objectMeta("kong", "gateway-uid", "1"),
+		Spec: gatewayapi.GatewaySpec{
+			GatewayClassName: "kong",
+			Listeners: []gatewayapi.Listener{{
+				Name:     "https",
+				Protocol: gatewayapi.HTTPSProtocolType,
+				Port:     443,
+				Hostname: new(gatewayapi.Hostname("gateway.example.com")),
+				TLS: &gatewayapi.GatewayTLSConfig{
+					CertificateRefs: []gatewayapi.SecretObjectReference{{Name: "example-tls"}},
+				},
+			}},
+		},
+		Status: gatewayapi.GatewayStatus{
+			Listeners: []gatewayapi.ListenerStatus{{Name: "https"}},
+		},
+	}
+	httpRoute := func(resourceVersion, path string) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: string(gatewayapi.V1Group) + "/" + gatewayapi.V1GroupVersion,
+				Kind:       "HTTPRoute",
+			},
+			ObjectMeta: objectMeta("echo", "httproute-uid", resourceVersion),
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: commonRouteSpecMock("kong"),
+				Hostnames:       []gatewayapi.Hostname{"gateway.example.com"},
+				Rules: []gatewayapi.HTTPRouteRule{{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix(path).Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("other").WithPort(80).Build(),
+					},
+				}},
+			},
+		}
+	}
+	customEntity := func(resourceVersion, sessionName string) *configurationv1alpha1.KongCustomEntity {
+		return &configurationv1alpha1.KongCustomEntity{
+			ObjectMeta: objectMeta("session", "custom-entity-uid", resourceVersion),
+			Spec: configurationv1alpha1.KongCustomEntitySpec{
+				EntityType:     "sessions",
+				ControllerName: annotations.DefaultIngressClass,
+				Fields:         apiextensionsv1.JSON{Raw: fmt.Appendf(nil, `{"name":%q}`, sessionName)},
+			},
+		}
+	}
+
+	h := newIncrementalTranslationHarness(t, featureFlags,
+		ingress("foo", "foo-uid", "1", "/foo"),
+		ingressTo("baz", "baz-uid", "1", "/baz", "other"),
+		service,
+		newService("other", "other-svc-uid"),
+		plugin,
+		secret("1", "initial"),
+		consumer("1", "alice"),
+	)
+	translated := h.requireIdenticalTranslations().KongState
+
+	t.Run("routes of unrelated objects are not translated again", func(t *testing.T) {
+		fooRoute := ingressRoute(t, translated, "foo")
+		bazRoute := ingressRoute(t, translated, "baz")
+
+		h.update(ingressTo("baz", "baz-uid", "2", "/baz-v2", "other"))
+		plan := h.plan()
+		require.NotNil(t, plan)
+		require.False(t, plan.full)
+		require.Len(t, plan.routingComponents, 2)
+
+		translated = h.requireIdenticalTranslations().KongState
+		// Routes reused from the previous translation share their fields with it.
+		require.Same(t, fooRoute.Name, ingressRoute(t, translated, "foo").Name)
+		require.NotSame(t, bazRoute.Name, ingressRoute(t, translated, "baz").Name)
+	})
+
+	t.Run("only units reading changed objects are translated again", func(t *testing.T) {
+		h.update(consumer("2", "alice-renamed"))
+		plan := h.plan()
+		require.NotNil(t, plan)
+		require.False(t, plan.full)
+		require.Equal(t, map[translationUnitName]struct{}{translationUnitConsumers: {}}, plan.stale)
+	})
+
+	steps := []struct {
+		name   string
+		change func()
+		// shadowConflicting is whether the routes of the "shadow" Ingress are expected to be reported as conflicting
+		// with the routes of other objects after the change, when translating to expression routes.
+		shadowConflicting bool
+	}{
+		{
+			name:   "consumer updated",
+			change: func() { h.update(consumer("3", "alice-updated")) },
+		},
+		{
+			name:   "credential Secret updated",
+			change: func() { h.update(secret("2", "rotated")) },
+		},
+		{
+			name:   "Ingress added",
+			change: func() { h.update(ingress("bar", "bar-uid", "1", "/bar")) },
+		},
+		{
+			name:   "Ingress updated",
+			change: func() { h.update(ingress("foo", "foo-uid", "2", "/foo-v2")) },
+		},
+		{
+			name: "KongPlugin updated",
+			change: func() {
+				updated := plugin.DeepCopy()
+				updated.ResourceVersion = "2"
+				updated.Config = apiextensionsv1.JSON{Raw: []byte(`{"minute": 20}`)}
+				h.update(updated)
+			},
+		},
+		{
+			name:   "canary Ingress by header added",
+			change: func() { h.update(canaryIngress("1", annotations.CanaryByHeaderKey, "x-canary")) },
+		},
+		{
+			name:   "canary Ingress updated to route by cookie",
+			change: func() { h.update(canaryIngress("2", annotations.CanaryByCookieKey, "canary")) },
+		},
+		{
+			name:   "canary Ingress updated to route by weight",
+			change: func() { h.update(canaryIngress("3", annotations.CanaryWeightKey, "20")) },
+		},
+		{
+			name:   "primary Ingress of the canary updated",
+			change: func() { h.update(ingress("foo", "foo-uid", "3", "/foo-v2")) },
+		},
+		{
+			name:   "canary Ingress deleted",
+			change: func() { h.delete(canaryIngress("3", annotations.CanaryWeightKey, "20")) },
+		},
+		{
+			name: "Ingress with TLS added",
+			change: func() {
+				h.update(tlsSecret("1", "example.com"))
+				h.update(tlsIngress)
+			},
+		},
+		{
+			name:   "Gateway added",
+			change: func() { h.update(gateway) },
+		},
+		{
+			name:   "TLS Secret updated",
+			change: func() { h.update(tlsSecret("2", "rotated.example.com")) },
+		},
+		{
+			name:   "HTTPRoute added",
+			change: func() { h.update(httpRoute("1", "/route")) },
+		},
+		{
+			name:   "HTTPRoute updated",
+			change: func() { h.update(httpRoute("2", "/route-v2")) },
+		},
+		{
+			name:   "KongCustomEntity added",
+			change: func() { h.update(customEntity("1", "session")) },
+		},
+		{
+			name:   "KongCustomEntity updated",
+			change: func() { h.update(customEntity("2", "session-updated")) },
+		},
+		{
+			name:              "conflicting Ingress added",
+			change:            func() { h.update(ingressTo("shadow", "shadow-uid", "1", "/baz-v2", "other")) },
+			shadowConflicting: true,
+		},
+		{
+			name:   "conflicting Ingress updated",
+			change: func() { h.update(ingressTo("shadow", "shadow-uid", "2", "/shadow", "other")) },
+		},
+		{
+			name:   "KongCustomEntity deleted",
+			change: func() { h.delete(customEntity("2", "session-updated")) },
+		},
+		{
+			name:   "Gateway deleted",
+			change: func() { h.delete(gateway) },
+		},
+		{
+			name:   "consumer deleted",
+			change: func() { h.delete(consumer("3", "alice-updated")) },
+		},
+		{
+			name:   "Service deleted",
+			change: func() { h.delete(service) },
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			step.change()
+			result := h.requireIdenticalTranslations()
+			if !featureFlags.ExpressionRoutes {
+				require.Empty(t, result.RouteConflicts)
+				return
+			}
+			shadowConflicting := lo.ContainsBy(result.RouteConflicts, func(f failures.ResourceFailure) bool {
+				return lo.ContainsBy(f.CausingObjects(), func(obj client.Object) bool {
+					_, ok := obj.(*netv1.Ingress)
+					return ok && obj.GetName() == "shadow"
+				})
+			})
+			require.Equal(t, step.shadowConflicting, shadowConflicting)
+		})
+	}
+
+	t.Run("full translation after the rebuild interval", func(t *testing.T) {
+		h.incremental.incremental.now = func() time.Time { return time.Now().Add(time.Hour) }
+		h.update(ingress("bar", "bar-uid", "2", "/bar-v2"))
+		plan := h.plan()
+		require.NotNil(t, plan)
+		require.True(t, plan.full)
+	})
+}
@@ -0,0 +1,201 @@
+package translator
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/atc"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
+)
+
+// RouteConflictReason describes how a Kong route conflicts with another one.
+type RouteConflictReason string
+
+const (
+	// RouteConflictReasonShadowed is used when another route with a higher priority matches every request the route
+	// matches, so the route never gets any traffic.
+	RouteConflictReasonShadowed RouteConflictReason = "Shadowed"
+	// RouteConflictReasonAmbiguous is used when another route with the same priority matches every request the route
+	// matches, so which of them gets the traffic is up to Kong.
+	RouteConflictReasonAmbiguous RouteConflictReason = "Ambiguous"
+)
+
+// RouteConflict is a Kong route that conflicts with a route translated from another Kubernetes object.
+type RouteConflict struct {
+	Reason RouteConflictReason
+	// Route is the name of the conflicting Kong route.
+	Route string
+	// Source is the Kubernetes object Route was translated from.
+	Source util.K8sObjectInfo
+	// WinningRoute is the name of the Kong route getting the traffic of Route.
+	WinningRoute string
+	// WinningSource is the Kubernetes object WinningRoute was translated from.
+	WinningSource util.K8sObjectInfo
+}
+
+// Message returns a human-readable description of the conflict.
+func (c RouteConflict) Message() string {
+	switch c.Reason {
+	case RouteConflictReasonShadowed:
+		return fmt.Sprintf("Kong route %s is shadowed by route %s of %s with a higher priority: no request can match it",
+			c.Route, c.WinningRoute, sourceString(c.WinningSource))
+	default:
+		return fmt.Sprintf("Kong route %s matches the same requests as route %s of %s with the same priority: "+
+			"Kong picks one of them arbitrarily", c.Route, c.WinningRoute, sourceString(c.WinningSource))
+	}
+}
+
+func sourceString(source util.K8sObjectInfo) string {
+	return fmt.Sprintf("%s %s/%s", source.GroupVersionKind.Kind, source.Namespace, source.Name)
+}
+
+// routeConflictSourceKinds are the kinds of the objects whose routes are checked for conflicts.
+var routeConflictSourceKinds = []string{"Ingress", "HTTPRoute", "GRPCRoute"}
+
+type routeConflictCandidate struct {
+	route    *kongstate.Route
+	matcher  atc.Matcher
+	priority uint64
+	// hosts are the exact hosts one of which the route requires, nil when it matches any host.
+	hosts []string
+}
+
+// DetectRouteConflicts looks for expression routes translated from Ingresses, HTTPRoutes and GRPCRoutes that are
+// fully shadowed by, or ambiguous with, a route translated from another object. A route is reported at most once,
+// against the route with the highest priority matching all of its requests. Routes from the same object are not
+// compared with each other. Routes are only compared with the ones matching any host or requiring one of their exact
+// hosts, as a route requiring other hosts can't match all of their requests.
+func DetectRouteConflicts(services []kongstate.Service) []RouteConflict {
+	var candidates []routeConflictCandidate
+	for i := range services {
+		for j := range services[i].Routes {
+			route := &services[i].Routes[j]
+			if !slices.Contains(routeConflictSourceKinds, route.Ingress.GroupVersionKind.Kind) || route.Expression == nil {
+				continue
+			}
+			matcher, err := atc.ParseExpression(*route.Expression)
+			if err != nil {
+				continue
+			}
+			hosts, _ := atc.RequiredValues(matcher, atc.FieldHTTPHost)
+			candidates = append(candidates, routeConflictCandidate{
+				route:    route,
+				matcher:  matcher,
+				priority: lo.FromPtr(route.Priority),
+				hosts:    hosts,
+			})
+		}
+	}
+	// Compare routes with the ones of higher priority first, so that the winning route reported is the one Kong picks.
+	slices.SortStableFunc(candidates, func(a, b routeConflictCandidate) int {
+		return cmp.Or(
+			cmp.Compare(b.priority, a.priority),
+			cmp.Compare(lo.FromPtr(a.route.Name), lo.FromPtr(b.route.Name)),
+		)
+	})
+
+	// Index the routes by the exact hosts they require, keeping the order of the candidates in each bucket.
+	var anyHost []int
+	byHost := make(map[string][]int)
+	for i, candidate := range candidates {
+		if candidate.hosts == nil {
+			anyHost = append(anyHost, i)
+			continue
+		}
+		for _, host := range lo.Uniq(candidate.hosts) {
+			byHost[host] = append(byHost[host], i)
+		}
+	}
+
+	var conflicts []RouteConflict
+	for i, loser := range candidates {
+		winners := anyHost
+		if loser.hosts != nil {
+			winners = slices.Clone(anyHost)
+			for _, host := range lo.Uniq(loser.hosts) {
+				winners = append(winners, byHost[host]...)
+			}
+			slices.Sort(winners)
+			winners = slices.Compact(winners)
+		}
+		for _, j := range winners {
+			winner := candidates[j]
+			if winner.priority < loser.priority {
+				// The remaining routes all have a lower priority.
+				break
+			}
+			if i == j {
+				continue
+			}
+			if sameSource(winner.route.Ingress, loser.route.Ingress) || !atc.Covers(winner.matcher, loser.matcher) {
+				continue
+			}
+			reason := RouteConflictReasonShadowed
+			if winner.priority == loser.priority {
+				reason = RouteConflictReasonAmbiguous
+			}
+			conflicts = append(conflicts, RouteConflict{
+				Reason:        reason,
+				Route:         lo.FromPtr(loser.route.Name),
+				Source:        loser.route.Ingress,
+				WinningRoute:  lo.FromPtr(winner.route.Name),
+				WinningSource: winner.route.Ingress,
+			})
+			break
+		}
+	}
+	return conflicts
+}
+
+func sameSource(a, b util.K8sObjectInfo) bool {
+	return a.GroupVersionKind == b.GroupVersionKind && a.Namespace == b.Namespace && a.Name == b.Name
+}
+
+// registerRouteConflicts detects the conflicting routes of the services and registers them for the objects they were
+// translated from.
+func (t *Translator) registerRouteConflicts(services []kongstate.Service) {
+	conflicts := DetectRouteConflicts(services)
+	if len(conflicts) == 0 {
+		return
+	}
+
+	objects := t.routeSourceObjects()
+	for _, conflict := range conflicts {
+		obj, ok := objects[routeSourceKey(conflict.Source)]
+		if !ok {
+			continue
+		}
+		t.routeConflictsCollector.PushResourceFailure(conflict.Message(), obj)
+	}
+}
+
+// routeSourceObjects indexes the objects whose routes are checked for conflicts by routeSourceKey.
+func (t *Translator) routeSourceObjects() map[string]client.Object {
+	objects := map[string]client.Object{}
+	add := func(obj client.Object) {
+		objects[routeSourceKey(util.FromK8sObject(obj))] = obj
+	}
+	for _, ingress := range t.storer.ListIngressesV1() {
+		add(ingress)
+	}
+	if httpRoutes, err := t.storer.ListHTTPRoutes(); err == nil {
+		for _, route := range httpRoutes {
+			add(route)
+		}
+	}
+	if grpcRoutes, err := t.storer.ListGRPCRoutes(); err == nil {
+		for _, route := range grpcRoutes {
+			add(route)
+		}
+	}
+	return objects
+}
+
+func routeSourceKey(source util.K8sObjectInfo) string {
+	return source.GroupVersionKind.Kind + "/" + source.Namespace + "/" + source.Name
+}
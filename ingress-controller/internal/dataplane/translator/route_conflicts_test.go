@@ -0,0 +1,115 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
+)
+
+func TestDetectRouteConflicts(t *testing.T) {
+	httpRouteInfo := func(name string) util.K8sObjectInfo {
+		return util.K8sObjectInfo{
+			Name:             name,
+			Namespace:        "default",
+			GroupVersionKind: schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"},
+		}
+	}
+	route := func(name, expression string, priority uint64, source util.K8sObjectInfo) kongstate.Route {
+		return kongstate.Route{
+			Route: kong.Route{
+				Name:       new(name),
+				Expression: new(expression),
+				Priority:   new(priority),
+			},
+			Ingress: source,
+		}
+	}
+
+	services := []kongstate.Service{
+		{
+			Service: kong.Service{Name: new("default.echo.80")},
+			Routes: []kongstate.Route{
+				route("catch-all", `http.path ^= "/"`, 100, httpRouteInfo("catch-all")),
+				route("api", `http.path ^= "/api/"`, 10, httpRouteInfo("api")),
+				route("same-object", `http.path ^= "/"`, 50, httpRouteInfo("catch-all")),
+				route("tie-a", `http.path == "/tie"`, 5, httpRouteInfo("tie-a")),
+				route("tie-b", `http.path == "/tie"`, 5, httpRouteInfo("tie-b")),
+				route("invalid", `http.path ^=`, 1000, httpRouteInfo("invalid")),
+			},
+		},
+		{
+			Service: kong.Service{Name: new("default.other.80")},
+			Routes: []kongstate.Route{
+				route("other-host", `(http.host == "other.example.com") && (http.path ^= "/")`, 1, httpRouteInfo("other-host")),
+				route("kong-route", `http.path ^= "/kong"`, 1, util.K8sObjectInfo{
+					Name:             "kong-route",
+					Namespace:        "default",
+					GroupVersionKind: schema.GroupVersionKind{Group: "configuration.konghq.com", Version: "v1", Kind: "KongRoute"},
+				}),
+			},
+		},
+	}
+
+	conflicts := DetectRouteConflicts(services)
+	byRoute := map[string]RouteConflict{}
+	for _, c := range conflicts {
+		byRoute[c.Route] = c
+	}
+
+	require.Contains(t, byRoute, "api")
+	assert.Equal(t, RouteConflictReasonShadowed, byRoute["api"].Reason)
+	assert.Equal(t, "catch-all", byRoute["api"].WinningRoute)
+	assert.Equal(t,
+		"Kong route api is shadowed by route catch-all of HTTPRoute default/catch-all with a higher priority: no request can match it",
+		byRoute["api"].Message(),
+	)
+
+	require.Contains(t, byRoute, "other-host")
+	assert.Equal(t, RouteConflictReasonShadowed, byRoute["other-host"].Reason)
+
+	require.Contains(t, byRoute, "tie-b")
+	assert.Equal(t, RouteConflictReasonShadowed, byRoute["tie-b"].Reason, "tie-b should be shadowed by catch-all first")
+
+	assert.NotContains(t, byRoute, "catch-all", "route with the highest priority can't be shadowed")
+	assert.NotContains(t, byRoute, "same-object", "routes of the same object are not compared")
+	assert.NotContains(t, byRoute, "kong-route", "routes of KongRoutes are not checked")
+	assert.NotContains(t, byRoute, "invalid", "routes with invalid expressions are skipped")
+
+	t.Run("routes with the same priority are ambiguous", func(t *testing.T) {
+		conflicts := DetectRouteConflicts([]kongstate.Service{
+			{
+				Routes: []kongstate.Route{
+					route("tie-a", `http.path == "/tie"`, 5, httpRouteInfo("tie-a")),
+					route("tie-b", `http.path == "/tie"`, 5, httpRouteInfo("tie-b")),
+				},
+			},
+		})
+		require.Len(t, conflicts, 2)
+		for _, c := range conflicts {
+			assert.Equal(t, RouteConflictReasonAmbiguous, c.Reason)
+		}
+	})
+
+	t.Run("routes are only compared with the ones matching their hosts", func(t *testing.T) {
+		conflicts := DetectRouteConflicts([]kongstate.Service{
+			{
+				Routes: []kongstate.Route{
+					route("hosts-a-b", `((http.host == "a.example.com") || (http.host == "b.example.com")) && (http.path ^= "/")`, 10, httpRouteInfo("hosts-a-b")),
+					route("host-a-api", `(http.host == "a.example.com") && (http.path ^= "/api/")`, 5, httpRouteInfo("host-a-api")),
+					route("host-c", `(http.host == "c.example.com") && (http.path ^= "/")`, 1, httpRouteInfo("host-c")),
+					route("any-host-api", `http.path ^= "/api/"`, 1, httpRouteInfo("any-host-api")),
+				},
+			},
+		})
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, "host-a-api", conflicts[0].Route)
+		assert.Equal(t, "hosts-a-b", conflicts[0].WinningRoute)
+		assert.Equal(t, RouteConflictReasonShadowed, conflicts[0].Reason)
+	})
+}
@@ -77,13 +77,15 @@ func generateKongPathFromGRPCMethodMatch(methodMatch *gatewayapi.GRPCMethodMatch
 	return new(KongPathRegexPrefix + fmt.Sprintf("/%s/%s", service, method))
 }
 
+// GenerateKongRoutesFromGRPCRouteRule generates Kong routes for the rule with the given number of the GRPCRoute.
+// Filters of the rule are translated to plugins attached to each of the generated routes.
 func GenerateKongRoutesFromGRPCRouteRule(
 	grpcroute *gatewayapi.GRPCRoute,
 	ruleNumber int,
 	storer store.Storer,
-) []kongstate.Route {
+) ([]kongstate.Route, error) {
 	if ruleNumber >= len(grpcroute.Spec.Rules) {
-		return nil
+		return nil, nil
 	}
 
 	routeName := func(namespace string, name string, ruleNumber int, matchNumber int) *string {
@@ -96,8 +98,6 @@ func GenerateKongRoutesFromGRPCRouteRule(
 		))
 	}
 
-	// Gather the K8s object information and hostnames from the GRPCRoute.
-	ingressObjectInfo := util.FromK8sObject(grpcroute)
 	tags := generateTagsForGRPCRoute(grpcroute)
 	grpcProtocols := kong.StringSlice("grpc", "grpcs")
 	rule := grpcroute.Spec.Rules[ruleNumber]
@@ -105,7 +105,7 @@ func GenerateKongRoutesFromGRPCRouteRule(
 	// For no matches it can be a catch-all or route based on hostnames.
 	if len(rule.Matches) == 0 {
 		r := kongstate.Route{
-			Ingress: ingressObjectInfo,
+			Ingress: util.FromK8sObject(grpcroute),
 			Route: kong.Route{
 				Name:      routeName(grpcroute.Namespace, grpcroute.Name, ruleNumber, 0),
 				Protocols: grpcProtocols,
@@ -120,14 +120,19 @@ func GenerateKongRoutesFromGRPCRouteRule(
 			// https://docs.konghq.com/gateway/latest/production/configuring-a-grpc-service/#single-grpc-service-and-route
 			r.Paths = kong.StringSlice("/")
 		}
-		return []kongstate.Route{r}
+		if err := setGRPCRoutePlugins(&r, rule.Filters, tags); err != nil {
+			return nil, err
+		}
+		return []kongstate.Route{r}, nil
 	}
 
 	// Rule matches are configured, hostname may be specified too.
 	routes := make([]kongstate.Route, 0, len(rule.Matches))
 	for matchNumber, match := range rule.Matches {
 		r := kongstate.Route{
-			Ingress: ingressObjectInfo,
+			// Each route gets its own copy of the object info as plugins
+			// from ExtensionRef filters are set in its annotations.
+			Ingress: util.FromK8sObject(grpcroute),
 			Route: kong.Route{
 				Name:      routeName(grpcroute.Namespace, grpcroute.Name, ruleNumber, matchNumber),
 				Protocols: grpcProtocols,
@@ -145,9 +150,53 @@ func GenerateKongRoutesFromGRPCRouteRule(
 			r.Headers[name] = append(r.Headers[name], hmatch.Value)
 		}
 
+		if err := setGRPCRoutePlugins(&r, rule.Filters, tags); err != nil {
+			return nil, err
+		}
 		routes = append(routes, r)
 	}
-	return routes
+	return routes, nil
+}
+
+// setGRPCRoutePlugins converts GRPCRouteFilters into Kong plugins and sets them into the given kongstate.Route.
+// The supported GRPCRoute filters have the same semantics as their HTTPRoute counterparts,
+// so they're translated the same way as HTTPRoute filters are.
+func setGRPCRoutePlugins(route *kongstate.Route, filters []gatewayapi.GRPCRouteFilter, tags []*string) error {
+	return setRoutePlugins(route, grpcRouteFiltersToHTTPRouteFilters(filters), "", tags, setKongRoutePluginsOptions{})
+}
+
+// grpcRouteFilterTypeToHTTPRouteFilterType maps the GRPCRoute filter types supported by the translator
+// to the HTTPRoute filter types they are translated as.
+var grpcRouteFilterTypeToHTTPRouteFilterType = map[gatewayapi.GRPCRouteFilterType]gatewayapi.HTTPRouteFilterType{
+	gatewayapi.GRPCRouteFilterRequestHeaderModifier:  gatewayapi.HTTPRouteFilterRequestHeaderModifier,
+	gatewayapi.GRPCRouteFilterResponseHeaderModifier: gatewayapi.HTTPRouteFilterResponseHeaderModifier,
+	gatewayapi.GRPCRouteFilterExtensionRef:           gatewayapi.HTTPRouteFilterExtensionRef,
+}
+
+// IsGRPCRouteFilterSupported returns true if GRPCRoute filters of the given type can be translated.
+func IsGRPCRouteFilterSupported(filterType gatewayapi.GRPCRouteFilterType) bool {
+	_, ok := grpcRouteFilterTypeToHTTPRouteFilterType[filterType]
+	return ok
+}
+
+// grpcRouteFiltersToHTTPRouteFilters converts GRPCRouteFilters into the equivalent HTTPRouteFilters.
+// Filters of unsupported types are skipped, the translator reports them as ignored.
+func grpcRouteFiltersToHTTPRouteFilters(filters []gatewayapi.GRPCRouteFilter) []gatewayapi.HTTPRouteFilter {
+	httpFilters := make([]gatewayapi.HTTPRouteFilter, 0, len(filters))
+	for _, filter := range filters {
+		httpFilterType, ok := grpcRouteFilterTypeToHTTPRouteFilterType[filter.Type]
+		if !ok {
+			continue
+		}
+		// Only the field matching the filter type is set, as enforced by the GRPCRoute CRD validation.
+		httpFilters = append(httpFilters, gatewayapi.HTTPRouteFilter{
+			Type:                   httpFilterType,
+			RequestHeaderModifier:  filter.RequestHeaderModifier,
+			ResponseHeaderModifier: filter.ResponseHeaderModifier,
+			ExtensionRef:           filter.ExtensionRef,
+		})
+	}
+	return httpFilters
 }
 
 // -----------------------------------------------------------------------------
@@ -179,50 +228,33 @@ func getEffectiveHostnamesForGRPCRoute(grpcroute *gatewayapi.GRPCRoute, storer s
 	}
 
 	// If no hostnames are specified, we will use the hostname from the Gateway
-	// that the GRPCRoute is attached to.
-	namespace := grpcroute.GetNamespace()
-
+	// (or the ListenerSet) that the GRPCRoute is attached to.
 	if grpcroute.Spec.ParentRefs == nil {
 		return nil
 	}
 
 	hostnames := make([]gatewayapi.Hostname, 0)
 	for _, parentRef := range grpcroute.Spec.ParentRefs {
-		// we only care about Gateways
-		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		// we only care about Gateways and ListenerSets
+		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" && !gatewayapi.IsListenerSetParentRef(parentRef) {
 			continue
 		}
 
-		if parentRef.Namespace != nil {
-			namespace = string(*parentRef.Namespace)
-		}
-
-		name := string(parentRef.Name)
-
-		gateway, err := storer.GetGateway(namespace, name)
-		// As parentRef has already been validated before, the error here will not actually occur.
+		_, listeners, ok := gatewayapi.ParentRefListeners(parentRef, grpcroute.GetNamespace(), storer.GetGateway, storer.GetListenerSet)
+		// As parentRef has already been validated before, the lookup here will not actually fail.
 		// This is where defensive programming takes place.
-		if err != nil {
+		if !ok {
 			// TODO: Add logging.
 			// https://github.com/Kong/kubernetes-ingress-controller/pull/6166#discussion_r1631250776
 			return nil
 		}
 
-		if parentRef.SectionName != nil {
-			sectionName := string(*parentRef.SectionName)
-
-			for _, listener := range gateway.Spec.Listeners {
-				if string(listener.Name) == sectionName {
-					if listener.Hostname != nil {
-						hostnames = append(hostnames, *listener.Hostname)
-					}
-				}
+		for _, listener := range listeners {
+			if parentRef.SectionName != nil && string(listener.Name) != string(*parentRef.SectionName) {
+				continue
 			}
-		} else {
-			for _, listener := range gateway.Spec.Listeners {
-				if listener.Hostname != nil {
-					hostnames = append(hostnames, *listener.Hostname)
-				}
+			if listener.Hostname != nil {
+				hostnames = append(hostnames, *listener.Hostname)
 			}
 		}
 	}
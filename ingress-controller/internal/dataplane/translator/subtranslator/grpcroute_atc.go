@@ -391,10 +391,11 @@ func compareSplitGRPCRouteMatchesRelativePriority(match1, match2 SplitGRPCRouteM
 
 // KongExpressionRouteFromSplitGRPCRouteMatchWithPriority generates expression based
 // Kong route from split GRPCRoute match which contains one or no hostname, and a GRPCRoute match,
-// with its priority is beforehand.
+// with its priority is beforehand. Filters of the rule the match was split from are translated
+// to plugins attached to the route.
 func KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(
 	matchWithPriority SplitGRPCRouteMatchToPriority,
-) kongstate.Route {
+) (kongstate.Route, error) {
 	grpcRoute := matchWithPriority.Match.Source
 	tags := generateTagsForGRPCRoute(grpcRoute)
 	// since we split GRPCRoute by hostname, rule and match, we generate the route name in
@@ -435,7 +436,11 @@ func KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(
 		r.Priority = &matchWithPriority.Priority
 	}
 
-	return r
+	if err := setGRPCRoutePlugins(&r, grpcRoute.Spec.Rules[matchWithPriority.Match.RuleIndex].Filters, tags); err != nil {
+		return kongstate.Route{}, err
+	}
+
+	return r, nil
 }
 
 // KongServiceNameFromSplitGRPCRouteMatch generates the name of translated Kong service
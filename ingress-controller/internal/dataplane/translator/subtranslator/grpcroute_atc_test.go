@@ -1117,7 +1117,8 @@ func TestKongExpressionRouteFromSplitGRPCRouteWithPriority(t *testing.T) {
 
 	for i, tc := range testCases {
 		t.Run(strconv.Itoa(i)+"-"+tc.name, func(t *testing.T) {
-			r := KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(tc.splitGRPCMatchWithPriority)
+			r, err := KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(tc.splitGRPCMatchWithPriority)
+			require.NoError(t, err)
 			grpcRoute := tc.splitGRPCMatchWithPriority.Match.Source
 			tc.expectedRoute.Tags = util.GenerateTagsForObject(grpcRoute)
 			require.Equal(t, tc.expectedRoute.Route, r.Route)
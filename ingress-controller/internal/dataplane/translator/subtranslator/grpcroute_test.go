@@ -408,12 +408,144 @@ func TestGenerateKongRoutesFromGRPCRouteRule(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			grpcroute := makeTestGRPCRoute(tc.objectName, "default", tc.annotations, tc.hostnames, []gatewayapi.GRPCRouteRule{tc.rule}, tc.parentRef)
-			routes := GenerateKongRoutesFromGRPCRouteRule(grpcroute, 0, tc.storer)
+			routes, err := GenerateKongRoutesFromGRPCRouteRule(grpcroute, 0, tc.storer)
+			require.NoError(t, err)
 			require.Equal(t, tc.expectedRoutes, routes)
 		})
 	}
 }
 
+func TestGenerateKongRoutesFromGRPCRouteRuleWithFilters(t *testing.T) {
+	matches := []gatewayapi.GRPCRouteMatch{
+		{
+			Method: &gatewayapi.GRPCMethodMatch{
+				Service: new("pets"),
+				Method:  new("List"),
+			},
+		},
+		{
+			Method: &gatewayapi.GRPCMethodMatch{
+				Service: new("pets"),
+				Method:  new("Get"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		filters             []gatewayapi.GRPCRouteFilter
+		expectedPlugins     []string
+		expectedAnnotations map[string]string
+		expectedErr         string
+	}{
+		{
+			name: "header modifiers are translated to transformer plugins",
+			filters: []gatewayapi.GRPCRouteFilter{
+				{
+					Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+						Add: []gatewayapi.HTTPHeader{{Name: "x-request", Value: "foo"}},
+					},
+				},
+				{
+					Type: gatewayapi.GRPCRouteFilterResponseHeaderModifier,
+					ResponseHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+						Remove: []string{"x-response"},
+					},
+				},
+			},
+			expectedPlugins: []string{"request-transformer", "response-transformer"},
+		},
+		{
+			name: "extension refs are translated to the plugins annotation of each route",
+			filters: []gatewayapi.GRPCRouteFilter{
+				{
+					Type: gatewayapi.GRPCRouteFilterExtensionRef,
+					ExtensionRef: &gatewayapi.LocalObjectReference{
+						Group: "configuration.konghq.com",
+						Kind:  "KongPlugin",
+						Name:  "rate-limit",
+					},
+				},
+				{
+					Type: gatewayapi.GRPCRouteFilterExtensionRef,
+					ExtensionRef: &gatewayapi.LocalObjectReference{
+						Group: "configuration.konghq.com",
+						Kind:  "KongPlugin",
+						Name:  "auth",
+					},
+				},
+			},
+			expectedAnnotations: map[string]string{
+				"konghq.com/plugins": "rate-limit,auth",
+			},
+		},
+		{
+			name: "extension ref to an unsupported kind is rejected",
+			filters: []gatewayapi.GRPCRouteFilter{
+				{
+					Type: gatewayapi.GRPCRouteFilterExtensionRef,
+					ExtensionRef: &gatewayapi.LocalObjectReference{
+						Group: "example.com",
+						Kind:  "Plugin",
+						Name:  "foo",
+					},
+				},
+			},
+			expectedErr: "plugin example.com/Plugin unsupported",
+		},
+		{
+			name: "request mirror is ignored",
+			filters: []gatewayapi.GRPCRouteFilter{
+				{
+					Type: gatewayapi.GRPCRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{Name: "mirror"},
+					},
+				},
+				{
+					Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+						Add: []gatewayapi.HTTPHeader{{Name: "x-request", Value: "foo"}},
+					},
+				},
+			},
+			expectedPlugins: []string{"request-transformer"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := gatewayapi.GRPCRouteRule{
+				Matches: matches,
+				Filters: tc.filters,
+			}
+			grpcroute := makeTestGRPCRoute("pets", "default", nil, []string{"pets.example"}, []gatewayapi.GRPCRouteRule{rule}, nil)
+			routes, err := GenerateKongRoutesFromGRPCRouteRule(grpcroute, 0, lo.Must(store.NewFakeStore(store.FakeObjects{})))
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, routes, len(matches))
+			for _, r := range routes {
+				pluginNames := lo.Map(r.Plugins, func(p kong.Plugin, _ int) string { return *p.Name })
+				require.ElementsMatch(t, tc.expectedPlugins, pluginNames)
+				for _, p := range r.Plugins {
+					require.Equal(t, util.GenerateTagsForObject(grpcroute), p.Tags)
+				}
+				if tc.expectedAnnotations == nil {
+					require.Empty(t, r.Ingress.Annotations)
+				} else {
+					require.Equal(t, tc.expectedAnnotations, r.Ingress.Annotations)
+				}
+			}
+			// The source object must not be modified by the translation.
+			require.Empty(t, grpcroute.Annotations)
+		})
+	}
+}
+
 func TestGetGRPCRouteHostnamesAsSliceOfStringPointers(t *testing.T) {
 	for _, tC := range []struct {
 		name      string
@@ -149,10 +149,10 @@ func groupRulesFromHTTPRoutesByKongServiceName(
 				cache.addRule(ruleMeta)
 			}
 		}
-		// Rules are grouped by backendRefs only. Split a group into per-timeout services
-		// only when its rules carry more than one distinct backendRequest timeout, so that
-		// enabling backendRequest timeouts does not rename existing Kong services.
-		return splitServiceGroupsByBackendRequestTimeout(cache.ruleGroups)
+		// Rules are grouped by backendRefs only. Split a group into per-settings services
+		// only when its rules carry more than one distinct timeout or retries setting, so that
+		// enabling timeouts or retries does not rename existing Kong services.
+		return splitServiceGroupsByServiceSettings(cache.ruleGroups)
 	}
 
 	// Otherwise, we still group rules in the same HTTPRoute sharing the same backends,
@@ -298,10 +298,11 @@ func translateHTTPRouteRulesMetaToKongstateService(
 		}
 	}
 
-	// applyTimeoutToServiceFromHTTPRouteRule applies timeouts from HTTPRoute to the service.
-	// If the service is translated from multiple rules, the timeout from the last rule which has specific timeout will be applied to the service in the loop.
+	// applyTimeoutToServiceFromHTTPRouteRule and applyRetriesToServiceFromHTTPRouteRule apply timeouts and retries
+	// from HTTPRoute to the service. Rules are grouped so that all the rules of a service share the same settings.
 	for _, ruleMeta := range rulesMeta {
 		applyTimeoutToServiceFromHTTPRouteRule(&service, ruleMeta.Rule)
+		applyRetriesToServiceFromHTTPRouteRule(&service, ruleMeta.Rule)
 	}
 
 	if options.ExpressionRoutes {
@@ -323,17 +324,26 @@ func translateHTTPRouteRulesMetaToKongstateService(
 
 // effectiveBackendRequestTimeout returns the Kong service timeout (in milliseconds) that a
 // rule's backendRequest timeout maps to, and whether that timeout differs from the default
-// service timeout. A zero duration maps to consts.MaxKongServiceTimeout (Kong's largest accepted
-// timeout). Rules with no timeout, an unparsable timeout, or a timeout equal to the default
-// report differsFromDefault=false so that they neither alter the service nor force a split.
+// service timeout. When the rule has no backendRequest timeout, its request timeout is used
+// instead, as it bounds the single request sent to the backend too. A zero duration maps to
+// consts.MaxKongServiceTimeout (Kong's largest accepted timeout). Rules with no timeout, an
+// unparsable timeout, or a timeout equal to the default report differsFromDefault=false so
+// that they neither alter the service nor force a split.
 // Grouping (getBackendRequestTimeoutKey) and application (applyTimeoutToServiceFromHTTPRouteRule)
 // both rely on this so they stay in sync.
 func effectiveBackendRequestTimeout(rule gatewayapi.HTTPRouteRule) (timeoutMS int, differsFromDefault bool) {
-	if rule.Timeouts == nil || rule.Timeouts.BackendRequest == nil {
+	if rule.Timeouts == nil {
 		return DefaultServiceTimeout, false
 	}
-	duration, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
-	// We ignore the error here because the rule.Timeouts.BackendRequest is validated
+	timeout := rule.Timeouts.BackendRequest
+	if timeout == nil {
+		timeout = rule.Timeouts.Request
+	}
+	if timeout == nil {
+		return DefaultServiceTimeout, false
+	}
+	duration, err := time.ParseDuration(string(*timeout))
+	// We ignore the error here because the timeouts are validated
 	// to be a strict subset of Golang time.ParseDuration so it should never happen.
 	if err != nil {
 		return DefaultServiceTimeout, false
@@ -346,6 +356,34 @@ func effectiveBackendRequestTimeout(rule gatewayapi.HTTPRouteRule) (timeoutMS in
 	return timeoutMS, timeoutMS != DefaultServiceTimeout
 }
 
+// effectiveRetries returns the Kong service retries that a rule's retry configuration maps to,
+// and whether they differ from the default service retries. Rules without a retry configuration
+// keep the default service retries.
+// Retry.Attempts sets the retries. As the request timeout bounds the whole transaction, including
+// the retries, while Kong applies the service timeout to each attempt, the retries are limited
+// to the number of attempts fitting in the request timeout.
+// Grouping (getRetriesKey) and application (applyRetriesToServiceFromHTTPRouteRule)
+// both rely on this so they stay in sync.
+func effectiveRetries(rule gatewayapi.HTTPRouteRule) (retries int, differsFromDefault bool) {
+	if rule.Retry == nil {
+		return DefaultRetries, false
+	}
+	retries = DefaultRetries
+	if rule.Retry.Attempts != nil {
+		retries = min(*rule.Retry.Attempts, maxKongServiceRetries)
+	}
+	if rule.Timeouts != nil && rule.Timeouts.Request != nil {
+		requestTimeout, err := time.ParseDuration(string(*rule.Timeouts.Request))
+		// A zero request timeout disables the timeout, so it doesn't limit the retries.
+		if err == nil && requestTimeout != 0 {
+			timeoutMS, _ := effectiveBackendRequestTimeout(rule)
+			attempts := int(requestTimeout.Milliseconds()) / timeoutMS
+			retries = min(retries, max(attempts-1, 0))
+		}
+	}
+	return retries, retries != DefaultRetries
+}
+
 // applyTimeoutToServiceFromHTTPRouteRule applies timeout on the translated Kong service from the timeout settings in the rule.
 func applyTimeoutToServiceFromHTTPRouteRule(svc *kongstate.Service, rule gatewayapi.HTTPRouteRule) {
 	timeoutMS, differsFromDefault := effectiveBackendRequestTimeout(rule)
@@ -358,6 +396,19 @@ func applyTimeoutToServiceFromHTTPRouteRule(svc *kongstate.Service, rule gateway
 	svc.WriteTimeout = new(timeoutMS)
 }
 
+// applyRetriesToServiceFromHTTPRouteRule applies retries on the translated Kong service from the retry and
+// timeout settings in the rule. Kong retries the requests to the backend on connection errors and timeouts
+// only, it can't be configured to retry on response status codes (Retry.Codes) or to wait between the
+// attempts (Retry.Backoff) for a single service, so these aren't translated. The translator reports
+// them as ignored on the HTTPRoute.
+func applyRetriesToServiceFromHTTPRouteRule(svc *kongstate.Service, rule gatewayapi.HTTPRouteRule) {
+	retries, differsFromDefault := effectiveRetries(rule)
+	if !differsFromDefault {
+		return
+	}
+	svc.Retries = new(retries)
+}
+
 // getHTTPRouteHostnamesAsSliceOfStringPointers translates the hostnames defined
 // in an HTTPRoute specification into a []*string slice, which is the type required
 // by kong.Route{}.
@@ -650,24 +701,25 @@ func groupRulesByBackendRefs(ruleEntries []httpRouteRuleMeta) map[string][]httpR
 	return groupSliceByKeyFn(ruleEntries, httpRouteRuleMeta.getHTTPBackendRefsKey)
 }
 
-// splitServiceGroupsByBackendRequestTimeout splits each Kong service group whose rules carry
-// more than one distinct backendRequest timeout into separate groups, one per timeout, since a
-// Kong service can only hold a single timeout. Groups with a single (or no) distinct timeout keep
-// their original service name, so enabling backendRequest timeouts does not rename existing Kong
-// services. When a split happens, every resulting group is suffixed with its timeout
-// (".timeout.<ms>"); the default-timeout group keeps the original name as it carries no suffix.
-func splitServiceGroupsByBackendRequestTimeout(groups map[string][]httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
+// splitServiceGroupsByServiceSettings splits each Kong service group whose rules carry more than
+// one distinct set of service settings (backendRequest timeout and retries) into separate groups,
+// one per settings, since a Kong service can only hold a single timeout and retries. Groups with a
+// single distinct set of settings keep their original service name, so enabling timeouts or retries
+// does not rename existing Kong services. When a split happens, every resulting group is suffixed with
+// its non-default settings (".timeout.<ms>" and ".retries.<n>"); the default-settings group keeps the
+// original name as it carries no suffix.
+func splitServiceGroupsByServiceSettings(groups map[string][]httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
 	result := make(map[string][]httpRouteRuleMeta, len(groups))
 	for serviceName, rulesMeta := range groups {
-		rulesByTimeout := groupSliceByKeyFn(rulesMeta, httpRouteRuleMeta.getBackendRequestTimeoutKey)
-		if len(rulesByTimeout) <= 1 {
+		rulesBySettings := groupSliceByKeyFn(rulesMeta, httpRouteRuleMeta.getServiceSettingsKey)
+		if len(rulesBySettings) <= 1 {
 			result[serviceName] = rulesMeta
 			continue
 		}
-		for timeoutKey, rules := range rulesByTimeout {
-			// All rules in the group share the same backendRefs, so any of them produces the same
-			// base name; use the first to build the length-safe suffixed name.
-			splitName := rules[0].kongServiceNameByBackendRefsWithSuffix(backendRequestTimeoutServiceNameSuffix(timeoutKey))
+		for _, rules := range rulesBySettings {
+			// All rules in the group share the same backendRefs and settings, so any of them produces
+			// the same name; use the first to build the length-safe suffixed name.
+			splitName := rules[0].kongServiceNameByBackendRefsWithSuffix(rules[0].serviceSettingsServiceNameSuffix())
 			result[splitName] = rules
 		}
 	}
@@ -706,7 +758,7 @@ type httpRouteRuleMeta struct {
 // getHTTPBackendRefsKey computes a key from a list of backendRefs.
 // The order of backedRefs is not important.
 func (m httpRouteRuleMeta) getHTTPBackendRefsKey() string {
-	return getSortedItemsString(m.Rule.BackendRefs) + ";backendRequestTimeout=" + m.getBackendRequestTimeoutKey()
+	return getSortedItemsString(m.Rule.BackendRefs) + ";" + m.getServiceSettingsKey()
 }
 
 func (m httpRouteRuleMeta) getRuleKey() string {
@@ -731,6 +783,28 @@ func (m httpRouteRuleMeta) getBackendRequestTimeoutKey() string {
 	return strconv.Itoa(timeoutMS)
 }
 
+// getRetriesKey returns a canonical key identifying the rule's effective retries.
+// Rules that map to the default service retries return an empty key.
+func (m httpRouteRuleMeta) getRetriesKey() string {
+	retries, differsFromDefault := effectiveRetries(m.Rule)
+	if !differsFromDefault {
+		return ""
+	}
+	return strconv.Itoa(retries)
+}
+
+// getServiceSettingsKey returns a key identifying the settings of the Kong service
+// the rule is translated to, i.e. its backendRequest timeout and retries.
+func (m httpRouteRuleMeta) getServiceSettingsKey() string {
+	return "backendRequestTimeout=" + m.getBackendRequestTimeoutKey() + ";retries=" + m.getRetriesKey()
+}
+
+// serviceSettingsServiceNameSuffix returns the Kong service name suffix used to disambiguate
+// services split by their settings. Default settings yield no suffix.
+func (m httpRouteRuleMeta) serviceSettingsServiceNameSuffix() string {
+	return backendRequestTimeoutServiceNameSuffix(m.getBackendRequestTimeoutKey()) + retriesServiceNameSuffix(m.getRetriesKey())
+}
+
 // backendRequestTimeoutServiceNameSuffix returns the Kong service name suffix used to
 // disambiguate services split by backendRequest timeout. The empty (default) timeout key
 // yields no suffix so that services keep their original names when no split is needed.
@@ -741,6 +815,15 @@ func backendRequestTimeoutServiceNameSuffix(timeoutKey string) string {
 	return ".timeout." + timeoutKey
 }
 
+// retriesServiceNameSuffix returns the Kong service name suffix used to disambiguate
+// services split by retries. The empty (default) retries key yields no suffix.
+func retriesServiceNameSuffix(retriesKey string) string {
+	if retriesKey == "" {
+		return ""
+	}
+	return ".retries." + retriesKey
+}
+
 // getKongServiceNameByBackendRefs generates service name based on rule's backendRefs and the namespace of the parent HTTPRoute
 // to group rules with same backends and from HTTPRoutes in the same namespace to the same Kong service.
 // Grouping by namespace of parent HTTPRoute is required, because HTTPRoute from different namespaces may have different reference grants
@@ -1079,23 +1162,19 @@ func generateKongstateHTTPRoute(routeName string, ingressObjectInfo util.K8sObje
 	return r
 }
 
-// protocolsFromHTTPRoutesGatewayListeners derives Kong route protocols from the Gateway listeners
-// referenced by all provided HTTPRoutes' parentRefs.
+// protocolsFromHTTPRoutesGatewayListeners derives Kong route protocols from the Gateway (or ListenerSet)
+// listeners referenced by all provided HTTPRoutes' parentRefs.
 // It collects unique protocols from all matching listeners.
 // It returns nil (relies on Kong Gateway defaults) as a fallback when no matching Gateway listeners are found.
 func protocolsFromHTTPRoutesGatewayListeners(storer store.Storer, routes []*gatewayapi.HTTPRoute) []*string {
 	protoSet := make(map[string]struct{})
 	for _, route := range routes {
 		for _, pr := range route.Spec.ParentRefs {
-			ns := route.Namespace
-			if pr.Namespace != nil && string(*pr.Namespace) != "" {
-				ns = string(*pr.Namespace)
-			}
-			gw, err := storer.GetGateway(ns, string(pr.Name))
-			if err != nil {
-				continue // Gateway not found, skip this parentRef.
+			_, listeners, ok := gatewayapi.ParentRefListeners(pr, route.Namespace, storer.GetGateway, storer.GetListenerSet)
+			if !ok {
+				continue // Gateway or ListenerSet not found, skip this parentRef.
 			}
-			for _, p := range gatewayutils.ProtocolsFromListeners(gw, pr.SectionName) {
+			for _, p := range gatewayutils.ProtocolsFromListenerList(listeners, pr.SectionName) {
 				protoSet[p] = struct{}{}
 			}
 		}
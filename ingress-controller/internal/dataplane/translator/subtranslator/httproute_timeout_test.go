@@ -242,3 +242,156 @@ func TestApplyTimeoutToServiceFromHTTPRouteRuleMapsZeroToMaxKongTimeout(t *testi
 	require.Equal(t, consts.MaxKongServiceTimeout, *service.ReadTimeout)
 	require.Equal(t, consts.MaxKongServiceTimeout, *service.WriteTimeout)
 }
+
+// httpRouteWithRetry builds a single-rule HTTPRoute with one backendRef and
+// the given retry configuration, for exercising combined-mode service grouping.
+func httpRouteWithRetry(name string, retry *gatewayapi.HTTPRouteRetry) *gatewayapi.HTTPRoute {
+	route := httpRouteWithBackendTimeout(name, nil)
+	route.Spec.Rules[0].Retry = retry
+	return route
+}
+
+func TestGroupRulesCombinedSplitsDifferentRetries(t *testing.T) {
+	groups := groupRulesFromHTTPRoutesByKongServiceName([]*gatewayapi.HTTPRoute{
+		httpRouteWithRetry("route-a", &gatewayapi.HTTPRouteRetry{Attempts: new(2)}),
+		httpRouteWithRetry("route-b", nil),
+	}, true)
+
+	require.Len(t, groups, 2)
+	require.True(t, hasServiceNameWithSuffix(groups, ".retries.2"))
+	for name, rules := range groups {
+		require.Len(t, rules, 1)
+		if strings.HasSuffix(name, ".retries.2") {
+			require.Equal(t, "route-a", rules[0].parentRoute.Name)
+		} else {
+			require.Equal(t, "route-b", rules[0].parentRoute.Name)
+		}
+	}
+}
+
+func TestGroupRulesCombinedDoesNotSplitDefaultRetries(t *testing.T) {
+	groups := groupRulesFromHTTPRoutesByKongServiceName([]*gatewayapi.HTTPRoute{
+		httpRouteWithRetry("route-a", &gatewayapi.HTTPRouteRetry{Attempts: new(DefaultRetries)}),
+		httpRouteWithRetry("route-b", &gatewayapi.HTTPRouteRetry{Codes: []gatewayapi.HTTPRouteRetryStatusCode{503}}),
+		httpRouteWithRetry("route-c", nil),
+	}, true)
+
+	require.Len(t, groups, 1)
+}
+
+func TestEffectiveBackendRequestTimeoutFallsBackToRequestTimeout(t *testing.T) {
+	request := gatewayapi.Duration("10s")
+	backendRequest := gatewayapi.Duration("2s")
+
+	timeoutMS, differsFromDefault := effectiveBackendRequestTimeout(gatewayapi.HTTPRouteRule{
+		Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: &request},
+	})
+	require.True(t, differsFromDefault)
+	require.Equal(t, 10000, timeoutMS)
+
+	timeoutMS, differsFromDefault = effectiveBackendRequestTimeout(gatewayapi.HTTPRouteRule{
+		Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: &request, BackendRequest: &backendRequest},
+	})
+	require.True(t, differsFromDefault)
+	require.Equal(t, 2000, timeoutMS)
+}
+
+func TestEffectiveRetries(t *testing.T) {
+	duration := func(d string) *gatewayapi.Duration {
+		return new(gatewayapi.Duration(d))
+	}
+
+	testCases := []struct {
+		name                         string
+		rule                         gatewayapi.HTTPRouteRule
+		expectedRetries              int
+		expectedDifferentFromDefault bool
+	}{
+		{
+			name:            "no retry and no timeouts",
+			expectedRetries: DefaultRetries,
+		},
+		{
+			name: "retry without attempts",
+			rule: gatewayapi.HTTPRouteRule{
+				Retry: &gatewayapi.HTTPRouteRetry{Codes: []gatewayapi.HTTPRouteRetryStatusCode{500}},
+			},
+			expectedRetries: DefaultRetries,
+		},
+		{
+			name: "retry attempts",
+			rule: gatewayapi.HTTPRouteRule{
+				Retry: &gatewayapi.HTTPRouteRetry{Attempts: new(2)},
+			},
+			expectedRetries:              2,
+			expectedDifferentFromDefault: true,
+		},
+		{
+			name: "retry attempts are capped to the maximum accepted by Kong",
+			rule: gatewayapi.HTTPRouteRule{
+				Retry: &gatewayapi.HTTPRouteRetry{Attempts: new(100000)},
+			},
+			expectedRetries:              maxKongServiceRetries,
+			expectedDifferentFromDefault: true,
+		},
+		{
+			name: "request timeout without retry keeps the default retries",
+			rule: gatewayapi.HTTPRouteRule{
+				Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: duration("1s")},
+			},
+			expectedRetries: DefaultRetries,
+		},
+		{
+			name: "request timeout limits the retries to the attempts fitting in it",
+			rule: gatewayapi.HTTPRouteRule{
+				Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: duration("10s"), BackendRequest: duration("3s")},
+				Retry:    &gatewayapi.HTTPRouteRetry{},
+			},
+			expectedRetries:              2,
+			expectedDifferentFromDefault: true,
+		},
+		{
+			name: "retry attempts fitting in the request timeout are kept",
+			rule: gatewayapi.HTTPRouteRule{
+				Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: duration("10s"), BackendRequest: duration("1s")},
+				Retry:    &gatewayapi.HTTPRouteRetry{Attempts: new(3)},
+			},
+			expectedRetries:              3,
+			expectedDifferentFromDefault: true,
+		},
+		{
+			name: "zero request timeout doesn't limit the retries",
+			rule: gatewayapi.HTTPRouteRule{
+				Timeouts: &gatewayapi.HTTPRouteTimeouts{Request: duration("0s")},
+				Retry:    &gatewayapi.HTTPRouteRetry{Attempts: new(3)},
+			},
+			expectedRetries:              3,
+			expectedDifferentFromDefault: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			retries, differsFromDefault := effectiveRetries(tc.rule)
+			require.Equal(t, tc.expectedRetries, retries)
+			require.Equal(t, tc.expectedDifferentFromDefault, differsFromDefault)
+		})
+	}
+}
+
+func TestApplyRetriesToServiceFromHTTPRouteRule(t *testing.T) {
+	service := kongstate.Service{
+		Service: kong.Service{
+			Retries: new(DefaultRetries),
+		},
+	}
+
+	applyRetriesToServiceFromHTTPRouteRule(&service, gatewayapi.HTTPRouteRule{
+		Retry: &gatewayapi.HTTPRouteRetry{
+			Codes:    []gatewayapi.HTTPRouteRetryStatusCode{503},
+			Attempts: new(1),
+		},
+	})
+
+	require.Equal(t, 1, *service.Retries)
+}
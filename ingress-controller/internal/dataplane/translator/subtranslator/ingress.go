@@ -2,6 +2,7 @@ package subtranslator
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
 	"slices"
 	"sort"
@@ -58,7 +59,8 @@ func TranslateIngresses(
 	failuresCollector FailuresCollector,
 	storer store.Storer,
 ) map[string]kongstate.Service {
-	index := newIngressTranslationIndex(flags, failuresCollector, storer)
+	canaries := newIngressCanaries(ingresses, failuresCollector)
+	index := newIngressTranslationIndex(flags, failuresCollector, storer, canaries)
 	for _, ingress := range ingresses {
 		prependRegexPrefix := MaybePrependRegexPrefixForIngressV1Fn(ingress, icp.EnableLegacyRegexDetection)
 		index.Add(ingress, prependRegexPrefix)
@@ -126,65 +128,81 @@ func defaultServiceTimeoutInKongFormat() *int {
 //
 // are unique. For ingress spec rules which are not unique along those
 // data-points, a separate kong.Service and separate kong.Routes will be created
-// for each unique combination.
+// for each unique combination. Rules sharing their requests with the backend of
+// a canary Ingress by weight are additionally keyed by that backend.
 type ingressTranslationIndex struct {
 	cache             map[string]*ingressTranslationMeta
 	featureFlags      TranslateIngressFeatureFlags
 	failuresCollector FailuresCollector
 	storer            store.Storer
+	canaries          ingressCanaries
 }
 
-func newIngressTranslationIndex(flags TranslateIngressFeatureFlags, failuresCollector FailuresCollector, storer store.Storer) *ingressTranslationIndex {
+func newIngressTranslationIndex(
+	flags TranslateIngressFeatureFlags,
+	failuresCollector FailuresCollector,
+	storer store.Storer,
+	canaries ingressCanaries,
+) *ingressTranslationIndex {
 	return &ingressTranslationIndex{
 		cache:             make(map[string]*ingressTranslationMeta),
 		featureFlags:      flags,
 		failuresCollector: failuresCollector,
 		storer:            storer,
+		canaries:          canaries,
 	}
 }
 
 type addRegexPrefixFn func(string) *string
 
 func (i *ingressTranslationIndex) Add(ingress *netv1.Ingress, addRegexPrefix addRegexPrefixFn) {
+	// Canary Ingresses routing requests only by weight are translated along with their primary Ingress.
+	if !i.canaries.translatesIntoRoutes(ingress) {
+		return
+	}
+	// Canary Ingresses routing requests by both header and cookie are translated into separate routes for
+	// each of them, as requests matching either of them are routed to the canary.
+	canaryByCookieVariants := []bool{false}
+	if _, ok := annotations.CanaryCookieAnnotations(ingress.Annotations); ok {
+		canaryByCookieVariants = append(canaryByCookieVariants, true)
+	}
+
 	for _, ingressRule := range ingress.Spec.Rules {
 		if ingressRule.HTTP == nil || len(ingressRule.HTTP.Paths) < 1 {
 			continue
 		}
 
 		for _, httpIngressPath := range ingressRule.HTTP.Paths {
-			httpIngressPath.Path = flattenMultipleSlashes(httpIngressPath.Path)
-
-			if httpIngressPath.Path == "" {
-				httpIngressPath.Path = "/"
-			}
-
-			if httpIngressPath.PathType == nil {
-				httpIngressPath.PathType = &defaultHTTPIngressPathType
-			}
+			httpIngressPath = normalizeHTTPIngressPath(httpIngressPath)
 
 			backend, err := i.getIngressPathBackend(ingress.Namespace, httpIngressPath)
 			if err != nil {
 				i.failuresCollector.PushResourceFailure(fmt.Sprintf("failed to get backend for ingress path %q: %s", httpIngressPath.Path, err), ingress)
 				continue
 			}
+			canary := i.getIngressPathCanary(ingress, ingressRule.Host, httpIngressPath, backend)
 
-			kongRouteName := backend.intoKongRouteName(k8stypes.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}, ingressRule.Host)
-			meta, ok := i.cache[kongRouteName]
-			if !ok {
-				meta = &ingressTranslationMeta{
+			for _, canaryByCookie := range canaryByCookieVariants {
+				meta := &ingressTranslationMeta{
 					ingressNamespace: ingress.Namespace,
 					ingressName:      ingress.Name,
 					ingressUID:       string(ingress.UID),
 					ingressHost:      ingressRule.Host,
 					ingressTags:      util.GenerateTagsForObject(ingress),
 					backend:          backend,
+					canary:           canary,
+					canaryByCookie:   canaryByCookie,
 					addRegexPrefixFn: addRegexPrefix,
 				}
-			}
+				kongRouteName := meta.kongRouteName(ingressRule.Host)
+				if cached, ok := i.cache[kongRouteName]; ok {
+					meta = cached
+				}
 
-			meta.parentIngress = ingress
-			meta.paths = append(meta.paths, httpIngressPath)
-			i.cache[kongRouteName] = meta
+				meta.parentIngress = ingress
+				meta.paths = append(meta.paths, httpIngressPath)
+				i.cache[kongRouteName] = meta
+			}
 		}
 	}
 }
@@ -224,6 +242,37 @@ func (i *ingressTranslationIndex) getIngressPathBackend(namespace string, httpIn
 	return ingressTranslationMetaBackend{}, fmt.Errorf("no Service or Resource specified for Ingress path")
 }
 
+// getIngressPathCanary returns the backend of the canary Ingress receiving a share of the path's requests by weight,
+// if any.
+func (i *ingressTranslationIndex) getIngressPathCanary(
+	ingress *netv1.Ingress,
+	host string,
+	httpIngressPath netv1.HTTPIngressPath,
+	backend ingressTranslationMetaBackend,
+) *ingressTranslationMetaCanary {
+	canary, canaryPath, ok := i.canaries.canaryFor(ingress, host, httpIngressPath)
+	if !ok {
+		return nil
+	}
+	if backend.isServiceFacade() {
+		i.failuresCollector.PushResourceFailure(
+			fmt.Sprintf("invalid canary Ingress: path %q of host %q of primary Ingress %q doesn't have a Service backend",
+				httpIngressPath.Path, host, ingress.Name,
+			),
+			canary.ingress,
+		)
+		return nil
+	}
+	return &ingressTranslationMetaCanary{
+		ingressName: canary.ingress.Name,
+		backend: newIngressTranslationMetaBackendForKubernetesService(
+			canaryPath.Backend.Service.Name,
+			PortDefFromServiceBackendPort(&canaryPath.Backend.Service.Port),
+		),
+		weight: int32(canary.weight), //nolint:gosec // The weight is a percentage.
+	}
+}
+
 // IsKongServiceFacade returns true if the given resource reference is a KongServiceFacade.
 func IsKongServiceFacade(resource *corev1.TypedLocalObjectReference) bool {
 	return resource.Kind == incubatorv1alpha1.KongServiceFacadeKind &&
@@ -272,10 +321,26 @@ type ingressTranslationMeta struct {
 	ingressHost      string
 	ingressTags      []*string
 	backend          ingressTranslationMetaBackend
+	canary           *ingressTranslationMetaCanary
+	// canaryByCookie marks the routes of a canary Ingress routing requests by both header and cookie
+	// which match the requests by the canary cookie.
+	canaryByCookie   bool
 	paths            []netv1.HTTPIngressPath
 	addRegexPrefixFn addRegexPrefixFn
 }
 
+// ingressTranslationMetaCanary is the backend of a canary Ingress receiving a share of the requests by weight.
+type ingressTranslationMetaCanary struct {
+	// ingressName is the name of the canary Ingress.
+	ingressName string
+
+	// backend is the canary Ingress' backend for the paths.
+	backend ingressTranslationMetaBackend
+
+	// weight is the percentage of the requests routed to the canary backend.
+	weight int32
+}
+
 type ingressPathBackendType string
 
 const (
@@ -337,6 +402,32 @@ func (b ingressTranslationMetaBackend) intoKongRouteName(ingress k8stypes.Namesp
 	return fmt.Sprintf("%s.%s.%s.%s.%s", ingress.Namespace, ingress.Name, b.name, host, b.port.CanonicalString())
 }
 
+// kongRouteName constructs the name of the Kong Route for the ingressTranslationMeta object's paths of the host.
+// Paths sharing their requests with a canary backend get a separate Kong Route with the name suffixed by
+// `.canary.<canary-service-name>.<canary-service-port>`, and the routes matching requests by the canary cookie
+// of canary Ingresses routing requests by both header and cookie are suffixed by `.canary-by-cookie`.
+func (m *ingressTranslationMeta) kongRouteName(host string) string {
+	name := m.backend.intoKongRouteName(k8stypes.NamespacedName{Namespace: m.ingressNamespace, Name: m.ingressName}, host)
+	if m.canary != nil {
+		name = fmt.Sprintf("%s.canary.%s.%s", name, m.canary.backend.name, m.canary.backend.port.CanonicalString())
+	}
+	if m.canaryByCookie {
+		name += ".canary-by-cookie"
+	}
+	return name
+}
+
+// annotations returns the annotations the Kong Route is translated with: the parent Ingress' annotations,
+// without the canary-by-header ones for the routes matching requests by the canary cookie.
+func (m *ingressTranslationMeta) annotations() map[string]string {
+	if m.canaryByCookie {
+		if anns, ok := annotations.CanaryCookieAnnotations(m.parentIngress.GetAnnotations()); ok {
+			return anns
+		}
+	}
+	return maps.Clone(m.parentIngress.GetAnnotations())
+}
+
 // isServiceFacade returns true if the backend is a KongServiceFacade.
 func (b ingressTranslationMetaBackend) isServiceFacade() bool {
 	return b.backendType == ingressPathBackendTypeKongServiceFacade
@@ -386,13 +477,33 @@ func (m *ingressTranslationMeta) translateIntoKongStateService(
 	if err != nil {
 		return kongstate.Service{}, fmt.Errorf("failed to create ServiceBackend for Kubernetes Service %q: %w", m.backend.name, err)
 	}
+	serviceBackends := []kongstate.ServiceBackend{serviceBackend}
+	host := fmt.Sprintf("%s.%s.%s.svc", m.backend.name, m.parentIngress.GetNamespace(), portDef.CanonicalString())
+
+	// Requests shared with a canary are load-balanced by a dedicated upstream between both backends.
+	if m.canary != nil {
+		canaryBackend, err := kongstate.NewServiceBackendForService(
+			k8stypes.NamespacedName{
+				Namespace: m.parentIngress.GetNamespace(),
+				Name:      m.canary.backend.name,
+			},
+			m.canary.backend.port,
+		)
+		if err != nil {
+			return kongstate.Service{}, fmt.Errorf("failed to create ServiceBackend for canary Kubernetes Service %q: %w", m.canary.backend.name, err)
+		}
+		serviceBackend.SetWeight(100 - m.canary.weight)
+		canaryBackend.SetWeight(m.canary.weight)
+		serviceBackends = []kongstate.ServiceBackend{serviceBackend, canaryBackend}
+		host = kongServiceName + ".svc"
+	}
 
 	// Otherwise, we assume it's a Kubernetes Service.
 	return kongstate.Service{
 		Namespace: m.parentIngress.GetNamespace(),
 		Service: kong.Service{
 			Name:           new(kongServiceName),
-			Host:           new(fmt.Sprintf("%s.%s.%s.svc", m.backend.name, m.parentIngress.GetNamespace(), portDef.CanonicalString())),
+			Host:           new(host),
 			Port:           new(defaultHTTPPort),
 			Protocol:       new("http"),
 			Path:           new("/"),
@@ -401,7 +512,7 @@ func (m *ingressTranslationMeta) translateIntoKongStateService(
 			WriteTimeout:   defaultServiceTimeoutInKongFormat(),
 			Retries:        new(defaultRetries),
 		},
-		Backends: []kongstate.ServiceBackend{serviceBackend},
+		Backends: serviceBackends,
 		Parent:   m.parentIngress,
 	}, nil
 }
@@ -413,6 +524,22 @@ func (m *ingressTranslationMeta) generateKongServiceName() string {
 		return fmt.Sprintf("%s.%s.svc.facade", m.parentIngress.GetNamespace(), m.backend.name)
 	}
 
+	// For Kubernetes Services sharing requests with a canary, we create one Kong Service per primary Ingress +
+	// Kubernetes Service + port + canary Kubernetes Service + port combination.
+	// The naming pattern is
+	// `<service-namespace>.<ingress-name>.<service-name>.<service-port>.canary.<canary-service-name>.<canary-service-port>`.
+	if m.canary != nil {
+		return fmt.Sprintf(
+			"%s.%s.%s.%s.canary.%s.%s",
+			m.parentIngress.GetNamespace(),
+			m.ingressName,
+			m.backend.name,
+			m.backend.port.CanonicalString(),
+			m.canary.backend.name,
+			m.canary.backend.port.CanonicalString(),
+		)
+	}
+
 	// For Kubernetes Services, we create one Kong Service per Kubernetes Service + port combination.
 	// The naming pattern is `<service-namespace>.<service-name>.<service-port>`.
 	return fmt.Sprintf(
@@ -429,7 +556,7 @@ func (m *ingressTranslationMeta) translateIntoKongRoute() *kongstate.Route {
 		// '_' is not allowed in host, so we use '_' to replace '*' since '*' is not allowed in Kong.
 		ingressHost = strings.ReplaceAll(ingressHost, "*", "_")
 	}
-	routeName := m.backend.intoKongRouteName(k8stypes.NamespacedName{Namespace: m.ingressNamespace, Name: m.ingressName}, ingressHost)
+	routeName := m.kongRouteName(ingressHost)
 
 	route := &kongstate.Route{
 		Ingress: util.FromK8sObject(m.parentIngress),
@@ -444,6 +571,7 @@ func (m *ingressTranslationMeta) translateIntoKongRoute() *kongstate.Route {
 			Tags:              m.ingressTags,
 		},
 	}
+	route.Ingress.Annotations = m.annotations()
 
 	if m.ingressHost != "" {
 		route.Hosts = append(route.Hosts, new(m.ingressHost))
@@ -509,6 +637,20 @@ func PathsFromIngressPaths(httpIngressPath netv1.HTTPIngressPath) []*string {
 	return kong.StringSlice(routePaths...)
 }
 
+// normalizeHTTPIngressPath flattens the path's slashes and defaults its empty path and path type.
+func normalizeHTTPIngressPath(httpIngressPath netv1.HTTPIngressPath) netv1.HTTPIngressPath {
+	httpIngressPath.Path = flattenMultipleSlashes(httpIngressPath.Path)
+
+	if httpIngressPath.Path == "" {
+		httpIngressPath.Path = "/"
+	}
+
+	if httpIngressPath.PathType == nil {
+		httpIngressPath.PathType = &defaultHTTPIngressPathType
+	}
+	return httpIngressPath
+}
+
 func flattenMultipleSlashes(path string) string {
 	out := make([]rune, 0, len(path))
 	in := []rune(path)
@@ -652,22 +794,37 @@ func MaybeRewriteURI(service *kongstate.Service, rewriteURIEnable bool) error {
 		if !rewriteURIEnable {
 			return fmt.Errorf("konghq.com/rewrite annotation not supported when rewrite uris disabled")
 		}
-		if rewriteURI == "" {
-			rewriteURI = "/"
-		}
-
-		config, err := generateRewriteURIConfig(rewriteURI)
+		config, err := RewriteURIPluginConfig(rewriteURI)
 		if err != nil {
 			return err
 		}
 		route.Plugins = append(route.Plugins, kong.Plugin{
-			Name: new("request-transformer"),
-			Config: kong.Configuration{
-				"replace": map[string]string{
-					"uri": config,
-				},
-			},
+			Name:   new(RewriteURIPluginName),
+			Config: config,
 		})
 	}
 	return nil
 }
+
+// RewriteURIPluginName is the name of the Kong plugin used to rewrite the upstream URI
+// according to the konghq.com/rewrite annotation.
+const RewriteURIPluginName = "request-transformer"
+
+// RewriteURIPluginConfig returns the configuration of the request-transformer plugin
+// replacing the upstream URI with the given konghq.com/rewrite annotation value.
+// An empty value rewrites the URI to "/".
+func RewriteURIPluginConfig(rewriteURI string) (kong.Configuration, error) {
+	if rewriteURI == "" {
+		rewriteURI = "/"
+	}
+
+	config, err := generateRewriteURIConfig(rewriteURI)
+	if err != nil {
+		return nil, err
+	}
+	return kong.Configuration{
+		"replace": map[string]string{
+			"uri": config,
+		},
+	}, nil
+}
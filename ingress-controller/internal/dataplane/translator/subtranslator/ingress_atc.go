@@ -23,12 +23,7 @@ const IngressDefaultBackendPriority RoutePriorityType = 0
 func (m *ingressTranslationMeta) translateIntoKongExpressionRoute() *kongstate.Route {
 	// '_' is not allowed in a host, so use '_' to replace a possible occurrence of  '*' since '*' is not allowed in Kong.
 	ingressHost := strings.ReplaceAll(m.ingressHost, "*", "_")
-	routeName := m.backend.intoKongRouteName(k8stypes.NamespacedName{
-		Namespace: m.parentIngress.GetNamespace(),
-		Name:      m.parentIngress.GetName(),
-	},
-		ingressHost,
-	)
+	routeName := m.kongRouteName(ingressHost)
 
 	route := &kongstate.Route{
 		Ingress: util.FromK8sObject(m.parentIngress),
@@ -43,8 +38,9 @@ func (m *ingressTranslationMeta) translateIntoKongExpressionRoute() *kongstate.R
 		},
 		ExpressionRoutes: true,
 	}
+	route.Ingress.Annotations = m.annotations()
 
-	ingressAnnotations := m.parentIngress.GetAnnotations()
+	ingressAnnotations := route.Ingress.Annotations
 
 	routeMatcher := atc.And()
 	// translate hosts.
@@ -201,6 +197,9 @@ type IngressRoutePriorityTraits struct {
 	HeaderCount   int
 	MaxPathLength int
 	HasRegexPath  bool
+	// CanaryByHeader is set for the routes of canary Ingresses matching requests by the canary header, which
+	// take precedence over the routes matching them by the canary cookie.
+	CanaryByHeader bool
 }
 
 // EncodeToPriority encodes the traits to `priority` field used in Kong expression based routes.
@@ -210,13 +209,14 @@ type IngressRoutePriorityTraits struct {
 //	3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0
 //
 // +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
-// | MF  | Header Number |P|         PRESERVED           |R|          Path Length          |
+// | MF  | Header Number |P|        PRESERVED          |C|R|          Path Length          |
 // +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
 //
 // Where:
 //   - MF (Match Fields): how many fields there are to match on (path, host, headers, methods, SNIs).
 //   - Header Number: number of headers to match.
 //   - PRESERVED: reserved for future use if we want add other fields into consideration.
+//   - C (Canary by header): set for canary routes matching requests by the canary header.
 //   - P (Plain Host): set if ALL hosts are non-wildcard.
 //   - R (Regex): if set, regex match is used.
 //   - Path Length: maximum length of the path to match.
@@ -228,7 +228,9 @@ func (t IngressRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 
 		// regexPathShiftBits uses the 16th bit for marking if regex match on path exists.
 		regexPathShiftBits = 16
-		// bits 17~31 are preserved.
+		// canaryByHeaderShiftBits uses the 17th bit for marking canary routes matching requests by header.
+		canaryByHeaderShiftBits = 17
+		// bits 18~31 are preserved.
 
 		// plainHostShiftBits uses the 32nd bit for marking if ALL hosts are non-wildcard.
 		plainHostShiftBits = 32
@@ -251,6 +253,10 @@ func (t IngressRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 	if t.HasRegexPath {
 		priority += (1 << regexPathShiftBits)
 	}
+	// add canary by header mark.
+	if t.CanaryByHeader {
+		priority += (1 << canaryByHeaderShiftBits)
+	}
 	// add plain host mark.
 	if t.PlainHostOnly {
 		priority += (1 << plainHostShiftBits)
@@ -349,6 +355,11 @@ func calculateIngressRoutePriorityTraits(
 		traits.MatchFields++
 		traits.HeaderCount = len(headers)
 	}
+	// canary routes matching requests by header are evaluated before the ones matching them by cookie.
+	if _, ok := annotations.ExtractCanaryOf(ingressAnnotations); ok &&
+		ingressAnnotations[annotations.AnnotationPrefix+annotations.CanaryByHeaderKey] != "" {
+		traits.CanaryByHeader = true
+	}
 	// add match fields by 1 if methods, snis exists in annotations.
 	methods := annotations.ExtractMethods(ingressAnnotations)
 	if len(methods) > 0 {
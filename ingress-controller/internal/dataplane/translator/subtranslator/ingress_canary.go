@@ -0,0 +1,189 @@
+package subtranslator
+
+import (
+	"fmt"
+
+	netv1 "k8s.io/api/networking/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+)
+
+// -----------------------------------------------------------------------------
+// Ingress Translation - Private - Canaries
+// -----------------------------------------------------------------------------
+
+// ingressCanary is a canary Ingress receiving a share of the requests matching the rules of its primary Ingress.
+type ingressCanary struct {
+	ingress *netv1.Ingress
+	// weight is the percentage of the requests routed to the canary Ingress' backends.
+	weight int
+	// backends maps the hosts and paths of the canary Ingress' rules to their backends.
+	backends map[ingressCanaryPathKey]netv1.HTTPIngressPath
+}
+
+// ingressCanaryPathKey identifies the rules of a primary Ingress and of its canary matching the same requests.
+type ingressCanaryPathKey struct {
+	host     string
+	path     string
+	pathType netv1.PathType
+}
+
+func newIngressCanaryPathKey(host string, httpIngressPath netv1.HTTPIngressPath) ingressCanaryPathKey {
+	httpIngressPath = normalizeHTTPIngressPath(httpIngressPath)
+	return ingressCanaryPathKey{
+		host:     host,
+		path:     httpIngressPath.Path,
+		pathType: *httpIngressPath.PathType,
+	}
+}
+
+// ingressCanaries indexes the canary Ingresses (the ones with the canary-of annotation) by their primary Ingresses.
+type ingressCanaries struct {
+	// weighted maps primary Ingresses to the canary Ingress receiving a share of their requests by weight.
+	weighted map[k8stypes.NamespacedName]*ingressCanary
+	// withoutRoutes are the canary Ingresses not translated into Kong Routes of their own: the ones routing requests
+	// only by weight (through their primary Ingress' routes) and the invalid ones.
+	withoutRoutes map[k8stypes.NamespacedName]struct{}
+}
+
+// newIngressCanaries indexes the canary Ingresses among ingresses by their primary Ingresses, which must be part of
+// ingresses too. When several canaries route requests of the same primary Ingress by weight, the first one is used.
+func newIngressCanaries(ingresses []*netv1.Ingress, failuresCollector FailuresCollector) ingressCanaries {
+	canaries := ingressCanaries{
+		weighted:      make(map[k8stypes.NamespacedName]*ingressCanary),
+		withoutRoutes: make(map[k8stypes.NamespacedName]struct{}),
+	}
+
+	all := make(map[k8stypes.NamespacedName]*netv1.Ingress, len(ingresses))
+	for _, ingress := range ingresses {
+		all[k8stypes.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}] = ingress
+	}
+
+	for _, ingress := range ingresses {
+		primaryName, ok := annotations.ExtractCanaryOf(ingress.Annotations)
+		if !ok {
+			continue
+		}
+		nn := k8stypes.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+		primaryNN := k8stypes.NamespacedName{Namespace: ingress.Namespace, Name: primaryName}
+		if err := validateIngressCanary(ingress, all[primaryNN]); err != nil {
+			failuresCollector.PushResourceFailure(fmt.Sprintf("invalid canary Ingress: %s", err), ingress)
+			canaries.withoutRoutes[nn] = struct{}{}
+			continue
+		}
+
+		if len(annotations.ExtractCanaryHeaders(ingress.Annotations)) == 0 {
+			canaries.withoutRoutes[nn] = struct{}{}
+		}
+		weight, ok := annotations.ExtractCanaryWeight(ingress.Annotations)
+		if !ok {
+			continue
+		}
+		if existing, ok := canaries.weighted[primaryNN]; ok {
+			failuresCollector.PushResourceFailure(
+				fmt.Sprintf("invalid canary Ingress: primary Ingress %q already has canary Ingress %q routing requests by weight",
+					primaryName, existing.ingress.Name,
+				),
+				ingress,
+			)
+			continue
+		}
+
+		canary := &ingressCanary{
+			ingress:  ingress,
+			weight:   weight,
+			backends: make(map[ingressCanaryPathKey]netv1.HTTPIngressPath),
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, httpIngressPath := range rule.HTTP.Paths {
+				canary.backends[newIngressCanaryPathKey(rule.Host, httpIngressPath)] = httpIngressPath
+			}
+		}
+		canaries.weighted[primaryNN] = canary
+	}
+
+	return canaries
+}
+
+// validateIngressCanary checks whether the canary Ingress can be used with its primary Ingress (nil when it
+// doesn't exist).
+func validateIngressCanary(canary *netv1.Ingress, primary *netv1.Ingress) error {
+	primaryName, _ := annotations.ExtractCanaryOf(canary.Annotations)
+	if primary == nil {
+		return fmt.Errorf("primary Ingress %q not found", primaryName)
+	}
+	if primary.Name == canary.Name {
+		return fmt.Errorf("an Ingress can't be a canary of itself")
+	}
+	if _, ok := annotations.ExtractCanaryOf(primary.Annotations); ok {
+		return fmt.Errorf("primary Ingress %q is a canary Ingress itself", primaryName)
+	}
+
+	weightKey := annotations.AnnotationPrefix + annotations.CanaryWeightKey
+	_, hasWeight := annotations.ExtractCanaryWeight(canary.Annotations)
+	if _, ok := canary.Annotations[weightKey]; ok && !hasWeight {
+		return fmt.Errorf("invalid %s value %q: must be an integer between 0 and 100", weightKey, canary.Annotations[weightKey])
+	}
+	if !hasWeight && len(annotations.ExtractCanaryHeaders(canary.Annotations)) == 0 {
+		return fmt.Errorf("one of %s, %s or %s annotations must be set",
+			weightKey,
+			annotations.AnnotationPrefix+annotations.CanaryByHeaderKey,
+			annotations.AnnotationPrefix+annotations.CanaryByCookieKey,
+		)
+	}
+	if !hasWeight {
+		return nil
+	}
+
+	// Requests are routed to the canary by weight through the primary Ingress' routes, so each of the canary's
+	// rules has to match one of the primary's.
+	primaryPaths := make(map[ingressCanaryPathKey]struct{})
+	for _, rule := range primary.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, httpIngressPath := range rule.HTTP.Paths {
+			primaryPaths[newIngressCanaryPathKey(rule.Host, httpIngressPath)] = struct{}{}
+		}
+	}
+	for _, rule := range canary.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, httpIngressPath := range rule.HTTP.Paths {
+			if _, ok := primaryPaths[newIngressCanaryPathKey(rule.Host, httpIngressPath)]; !ok {
+				return fmt.Errorf("path %q of host %q doesn't match any path of primary Ingress %q",
+					httpIngressPath.Path, rule.Host, primaryName,
+				)
+			}
+			if httpIngressPath.Backend.Service == nil {
+				return fmt.Errorf("path %q of host %q: canary Ingresses routing requests by weight only support Service backends",
+					httpIngressPath.Path, rule.Host,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// canaryFor returns the weighted canary backend of the primary Ingress' path, if any.
+func (c ingressCanaries) canaryFor(
+	primary *netv1.Ingress, host string, httpIngressPath netv1.HTTPIngressPath,
+) (*ingressCanary, netv1.HTTPIngressPath, bool) {
+	canary, ok := c.weighted[k8stypes.NamespacedName{Namespace: primary.Namespace, Name: primary.Name}]
+	if !ok {
+		return nil, netv1.HTTPIngressPath{}, false
+	}
+	canaryPath, ok := canary.backends[newIngressCanaryPathKey(host, httpIngressPath)]
+	return canary, canaryPath, ok
+}
+
+// translatesIntoRoutes tells whether the Ingress is translated into Kong Routes of its own.
+func (c ingressCanaries) translatesIntoRoutes(ingress *netv1.Ingress) bool {
+	_, ok := c.withoutRoutes[k8stypes.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}]
+	return !ok
+}
@@ -0,0 +1,178 @@
+package subtranslator
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	netv1 "k8s.io/api/networking/v1"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/failures"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util/builder"
+)
+
+func TestTranslateIngress_Canary(t *testing.T) {
+	newIngress := func(name string, anns map[string]string, paths ...string) *netv1.Ingress {
+		rule := netv1.IngressRule{
+			Host: "example.com",
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{},
+			},
+		}
+		for _, path := range paths {
+			rule.HTTP.Paths = append(rule.HTTP.Paths, netv1.HTTPIngressPath{
+				Path:     path,
+				PathType: &pathTypePrefix,
+				Backend: netv1.IngressBackend{
+					Service: &netv1.IngressServiceBackend{
+						Name: name,
+						Port: netv1.ServiceBackendPort{Number: 80},
+					},
+				},
+			})
+		}
+		return builder.NewIngress(name, "kong").
+			WithNamespace("default").
+			WithAnnotations(anns).
+			WithRules(rule).
+			Build()
+	}
+	canaryOf := func(primary string, anns map[string]string) map[string]string {
+		anns[annotations.AnnotationPrefix+annotations.CanaryOfKey] = primary
+		return anns
+	}
+	translate := func(t *testing.T, expressionRoutes bool, ingresses ...*netv1.Ingress) (map[string]kongstate.Service, []string) {
+		t.Helper()
+		failuresCollector := failures.NewResourceFailuresCollector(logr.Discard())
+		services := TranslateIngresses(
+			ingresses,
+			configurationv1alpha1.IngressClassParametersSpec{},
+			TranslateIngressFeatureFlags{ExpressionRoutes: expressionRoutes},
+			noopObjectsCollector{},
+			failuresCollector,
+			lo.Must(store.NewFakeStore(store.FakeObjects{})),
+		)
+		return services, lo.Map(failuresCollector.PopResourceFailures(), func(f failures.ResourceFailure, _ int) string {
+			return f.Message()
+		})
+	}
+	routeNames := func(svc kongstate.Service) []string {
+		return lo.Map(svc.Routes, func(r kongstate.Route, _ int) string { return *r.Name })
+	}
+
+	t.Run("canary by weight", func(t *testing.T) {
+		services, failures := translate(t, false,
+			newIngress("primary", nil, "/", "/api"),
+			newIngress("canary", canaryOf("primary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}), "/api"),
+		)
+		require.Empty(t, failures)
+		require.Len(t, services, 2, "expected no Kong Service for the canary Ingress")
+
+		svc, ok := services["default.primary.80"]
+		require.True(t, ok)
+		assert.Equal(t, []string{"default.primary.primary.example.com.80"}, routeNames(svc))
+		require.Len(t, svc.Backends, 1)
+
+		svc, ok = services["default.primary.primary.80.canary.canary.80"]
+		require.True(t, ok, "expected a Kong Service balancing requests of the canary's paths between both backends")
+		assert.Equal(t, "default.primary.primary.80.canary.canary.80.svc", *svc.Host)
+		assert.Equal(t, []string{"default.primary.primary.example.com.80.canary.canary.80"}, routeNames(svc))
+		require.Len(t, svc.Backends, 2)
+		assert.Equal(t, "primary", svc.Backends[0].Name())
+		assert.Equal(t, 80, svc.Backends[0].Weight().MustGet())
+		assert.Equal(t, "canary", svc.Backends[1].Name())
+		assert.Equal(t, 20, svc.Backends[1].Weight().MustGet())
+	})
+
+	t.Run("canary by header", func(t *testing.T) {
+		services, failures := translate(t, true,
+			newIngress("primary", nil, "/"),
+			newIngress("canary", canaryOf("primary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryByHeaderKey: "x-canary",
+			}), "/"),
+		)
+		require.Empty(t, failures)
+		require.Len(t, services, 2)
+
+		primary := services["default.primary.80"].Routes[0]
+		canary := services["default.canary.80"].Routes[0]
+		assert.Equal(t,
+			`(http.host == "example.com") && (http.path ^= "/") && (http.headers.x_canary == "always")`,
+			*canary.Expression,
+		)
+		assert.Greater(t, *canary.Priority, *primary.Priority, "expected the canary route to take precedence")
+	})
+
+	t.Run("canary by header and cookie", func(t *testing.T) {
+		ingresses := []*netv1.Ingress{
+			newIngress("primary", nil, "/"),
+			newIngress("canary", canaryOf("primary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryByHeaderKey: "x-canary",
+				annotations.AnnotationPrefix + annotations.CanaryByCookieKey: "canary",
+			}), "/"),
+		}
+
+		services, failures := translate(t, true, ingresses...)
+		require.Empty(t, failures)
+		require.Len(t, services, 2)
+
+		primary := services["default.primary.80"].Routes[0]
+		canaryRoutes := services["default.canary.80"].Routes
+		require.Len(t, canaryRoutes, 2, "expected separate routes matching requests by header and by cookie")
+		byHeader, byCookie := canaryRoutes[0], canaryRoutes[1]
+		assert.Equal(t, "default.canary.canary.example.com.80", *byHeader.Name)
+		assert.Equal(t,
+			`(http.host == "example.com") && (http.path ^= "/") && (http.headers.x_canary == "always")`,
+			*byHeader.Expression,
+		)
+		assert.Equal(t, "default.canary.canary.example.com.80.canary-by-cookie", *byCookie.Name)
+		assert.Equal(t,
+			`(http.host == "example.com") && (http.path ^= "/") && (http.headers.cookie ~ "(^|;\\s*)canary=always(;|$)")`,
+			*byCookie.Expression,
+		)
+		assert.Greater(t, *byHeader.Priority, *byCookie.Priority, "expected the header route to take precedence")
+		assert.Greater(t, *byCookie.Priority, *primary.Priority, "expected the canary routes to take precedence")
+
+		services, failures = translate(t, false, ingresses...)
+		require.Empty(t, failures)
+		canaryRoutes = services["default.canary.80"].Routes
+		require.Len(t, canaryRoutes, 2, "expected separate routes matching requests by header and by cookie")
+		for i := range canaryRoutes {
+			canaryRoutes[i].Override(logr.Discard(), semver.MustParse("3.9.0"))
+		}
+		assert.Equal(t, map[string][]string{"x-canary": {"always"}}, canaryRoutes[0].Headers)
+		assert.Equal(t, map[string][]string{"cookie": {`~*(^|;\s*)canary=always(;|$)`}}, canaryRoutes[1].Headers)
+	})
+
+	t.Run("invalid canaries", func(t *testing.T) {
+		services, failures := translate(t, false,
+			newIngress("primary", nil, "/"),
+			newIngress("missing-primary", canaryOf("missing", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}), "/"),
+			newIngress("no-routing", canaryOf("primary", map[string]string{}), "/"),
+			newIngress("canary", canaryOf("primary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "20",
+			}), "/"),
+			newIngress("another-canary", canaryOf("primary", map[string]string{
+				annotations.AnnotationPrefix + annotations.CanaryWeightKey: "50",
+			}), "/"),
+		)
+		assert.ElementsMatch(t, []string{
+			`invalid canary Ingress: primary Ingress "missing" not found`,
+			`invalid canary Ingress: one of konghq.com/canary-weight, konghq.com/canary-by-header or konghq.com/canary-by-cookie annotations must be set`,
+			`invalid canary Ingress: primary Ingress "primary" already has canary Ingress "canary" routing requests by weight`,
+		}, failures)
+		require.Len(t, services, 1)
+		require.Contains(t, services, "default.primary.primary.80.canary.canary.80")
+	})
+}
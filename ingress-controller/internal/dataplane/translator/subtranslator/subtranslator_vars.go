@@ -21,6 +21,9 @@ const (
 	// retried by default.
 	DefaultRetries = 5
 
+	// maxKongServiceRetries is the largest number of retries Kong accepts for a Service.
+	maxKongServiceRetries = 32767
+
 	// DefaultKongServiceProtocol is the default protocol in translated Kong service.
 	DefaultKongServiceProtocol = "http"
 
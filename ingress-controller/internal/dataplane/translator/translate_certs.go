@@ -11,6 +11,7 @@ import (
 	"github.com/kong/go-kong/kong"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
@@ -76,64 +77,130 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 			// But here we do not need to do the check because the gateways are always managed by Kong operator.
 			// Ref: https://github.com/Kong/kong-operator/issues/1769
 
-			if listener.TLS != nil {
-				if len(listener.TLS.CertificateRefs) > 0 {
-					if len(listener.TLS.CertificateRefs) > 1 {
-						// TODO support cert_alt and key_alt if there are 2 SecretObjectReferences
-						// https://github.com/Kong/kubernetes-ingress-controller/issues/2604
-						t.registerTranslationFailure("listener '%s' has more than one certificateRef, it's not supported", gateway)
-						continue
-					}
-
-					// determine the Secret Namespace
-					ref := listener.TLS.CertificateRefs[0]
-					namespace := gateway.Namespace
-					if ref.Namespace != nil {
-						namespace = string(*ref.Namespace)
-					}
-
-					// retrieve the Secret and extract the PEM strings
-					secret, err := s.GetSecret(namespace, string(ref.Name))
-					if err != nil {
-						logger.Error(err, "Failed to fetch secret",
-							"gateway", gateway.Name,
-							"listener", listener.Name,
-							"secret_name", string(ref.Name),
-							"secret_namespace", namespace,
-						)
-						continue
-					}
-					cert, key, err := getCertFromSecret(secret)
-					if err != nil {
-						t.registerTranslationFailure("failed to construct certificate from secret", secret, gateway)
-						continue
-					}
-
-					// determine the SNI
-					hostname := "*"
-					if listener.Hostname != nil {
-						hostname = string(*listener.Hostname)
-					}
-
-					// create a Kong certificate, wrap it in metadata, and add it to the certs slice
-					certs = append(certs, certWrapper{
-						identifier: cert + key,
-						cert: kong.Certificate{
-							ID:   new(string(secret.UID)),
-							Cert: new(cert),
-							Key:  new(key),
-							Tags: util.GenerateTagsForObject(secret),
-						},
-						CreationTimestamp: secret.CreationTimestamp,
-						snis:              []string{hostname},
-					})
+			if cert, ok := t.getListenerCert(gateway, listener.Name, listener.Hostname, listener.TLS); ok {
+				certs = append(certs, cert)
+			}
+		}
+	}
+	return append(certs, t.getListenerSetCerts(gateways)...)
+}
+
+// getListenerSetCerts returns the certificates of listeners defined in ListenerSets
+// attached to one of the provided Gateways. Only listeners accepted by the
+// Gateway controller (as reported in the ListenerSet's status) are considered.
+func (t *Translator) getListenerSetCerts(gateways []*gatewayapi.Gateway) []certWrapper {
+	logger := t.logger
+	certs := []certWrapper{}
+	listenerSets, err := t.storer.ListListenerSets()
+	if err != nil {
+		logger.Error(err, "Failed to list ListenerSets")
+		return certs
+	}
+
+	knownGateways := make(map[string]struct{}, len(gateways))
+	for _, gateway := range gateways {
+		knownGateways[gateway.Namespace+"/"+gateway.Name] = struct{}{}
+	}
+
+	for _, listenerSet := range listenerSets {
+		parentNamespace := listenerSet.Namespace
+		if listenerSet.Spec.ParentRef.Namespace != nil {
+			parentNamespace = string(*listenerSet.Spec.ParentRef.Namespace)
+		}
+		if _, ok := knownGateways[parentNamespace+"/"+string(listenerSet.Spec.ParentRef.Name)]; !ok {
+			continue
+		}
+
+		accepted := make(map[gatewayapi.SectionName]struct{}, len(listenerSet.Status.Listeners))
+		for _, status := range listenerSet.Status.Listeners {
+			for _, cond := range status.Conditions {
+				if cond.Type == string(gatewayapi.ListenerConditionAccepted) && cond.Status == metav1.ConditionTrue {
+					accepted[status.Name] = struct{}{}
 				}
 			}
 		}
+
+		for _, listener := range listenerSet.Spec.Listeners {
+			if _, ok := accepted[listener.Name]; !ok {
+				logger.V(logging.DebugLevel).Info("ListenerSet listener not accepted",
+					"listenerset", listenerSet.Name,
+					"listener", listener.Name,
+					"listener_protocol", listener.Protocol,
+					"listener_port", listener.Port,
+				)
+				continue
+			}
+			if cert, ok := t.getListenerCert(listenerSet, listener.Name, listener.Hostname, listener.TLS); ok {
+				certs = append(certs, cert)
+			}
+		}
 	}
 	return certs
 }
 
+// getListenerCert builds a certificate for a listener of the provided parent
+// (a Gateway or a ListenerSet) using the Secret referenced in its TLS configuration.
+// Secrets without an explicit namespace are looked up in the parent's namespace.
+func (t *Translator) getListenerCert(
+	parent client.Object,
+	listenerName gatewayapi.SectionName,
+	listenerHostname *gatewayapi.Hostname,
+	tlsConfig *gatewayapi.GatewayTLSConfig,
+) (certWrapper, bool) {
+	if tlsConfig == nil || len(tlsConfig.CertificateRefs) == 0 {
+		return certWrapper{}, false
+	}
+	if len(tlsConfig.CertificateRefs) > 1 {
+		// TODO support cert_alt and key_alt if there are 2 SecretObjectReferences
+		// https://github.com/Kong/kubernetes-ingress-controller/issues/2604
+		t.registerTranslationFailure(fmt.Sprintf("listener '%s' has more than one certificateRef, it's not supported", listenerName), parent)
+		return certWrapper{}, false
+	}
+
+	// determine the Secret Namespace
+	ref := tlsConfig.CertificateRefs[0]
+	namespace := parent.GetNamespace()
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+
+	// retrieve the Secret and extract the PEM strings
+	secret, err := t.storer.GetSecret(namespace, string(ref.Name))
+	if err != nil {
+		t.logger.Error(err, "Failed to fetch secret",
+			"parent", parent.GetName(),
+			"listener", listenerName,
+			"secret_name", string(ref.Name),
+			"secret_namespace", namespace,
+		)
+		return certWrapper{}, false
+	}
+	cert, key, err := getCertFromSecret(secret)
+	if err != nil {
+		t.registerTranslationFailure("failed to construct certificate from secret", secret, parent)
+		return certWrapper{}, false
+	}
+
+	// determine the SNI
+	hostname := "*"
+	if listenerHostname != nil {
+		hostname = string(*listenerHostname)
+	}
+
+	// create a Kong certificate and wrap it in metadata
+	return certWrapper{
+		identifier: cert + key,
+		cert: kong.Certificate{
+			ID:   new(string(secret.UID)),
+			Cert: new(cert),
+			Key:  new(key),
+			Tags: util.GenerateTagsForObject(secret),
+		},
+		CreationTimestamp: secret.CreationTimestamp,
+		snis:              []string{hostname},
+	}, true
+}
+
 func (t *Translator) getCerts(secretsToSNIs SecretNameToSNIs) []certWrapper {
 	certs := []certWrapper{}
 
@@ -1,9 +1,13 @@
 package translator
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kong/go-kong/kong"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
@@ -24,6 +28,16 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 		return result
 	}
 
+	for _, grpcRoute := range grpcRouteList {
+		// Fields which can't be translated are ignored, but reported so that users know they don't take effect.
+		if ignored := ignoredGRPCRouteFields(grpcRoute); len(ignored) > 0 {
+			t.registerIgnoredFields(
+				fmt.Sprintf("GRPCRoute fields are unsupported and ignored: %s", strings.Join(ignored, ", ")),
+				grpcRoute,
+			)
+		}
+	}
+
 	if t.featureFlags.ExpressionRoutes {
 		t.ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRouteList, &result)
 		return result
@@ -32,6 +46,7 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 	var errs []error
 	for _, grpcRoute := range grpcRouteList {
 		if err := t.ingressRulesFromGRPCRoute(&result, grpcRoute); err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("GRPCRoute can't be routed: %v", err), grpcRoute)
 			err = fmt.Errorf("GRPCRoute %s/%s can't be routed: %w", grpcRoute.Namespace, grpcRoute.Name, err)
 			errs = append(errs, err)
 		} else {
@@ -48,6 +63,28 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 	return result
 }
 
+// ignoredGRPCRouteFields returns the paths of the fields set in the GRPCRoute rules which the translator
+// can't translate: filters of unsupported types, filters of backendRefs and session persistence.
+func ignoredGRPCRouteFields(grpcroute *gatewayapi.GRPCRoute) []string {
+	var ignored []string
+	for ruleIndex, rule := range grpcroute.Spec.Rules {
+		for filterIndex, filter := range rule.Filters {
+			if !subtranslator.IsGRPCRouteFilterSupported(filter.Type) {
+				ignored = append(ignored, fmt.Sprintf("rules[%d].filters[%d] (%s)", ruleIndex, filterIndex, filter.Type))
+			}
+		}
+		for refIndex, ref := range rule.BackendRefs {
+			if len(ref.Filters) != 0 {
+				ignored = append(ignored, fmt.Sprintf("rules[%d].backendRefs[%d].filters", ruleIndex, refIndex))
+			}
+		}
+		if rule.SessionPersistence != nil {
+			ignored = append(ignored, fmt.Sprintf("rules[%d].sessionPersistence", ruleIndex))
+		}
+	}
+	return ignored
+}
+
 func (t *Translator) ingressRulesFromGRPCRoute(result *ingressRules, grpcroute *gatewayapi.GRPCRoute) error {
 	// first we grab the spec and gather some metadata about the object
 	spec := grpcroute.Spec
@@ -62,7 +99,11 @@ func (t *Translator) ingressRulesFromGRPCRoute(result *ingressRules, grpcroute *
 		if err != nil {
 			return err
 		}
-		service.Routes = append(service.Routes, subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber, t.storer)...)
+		routes, err := subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber, t.storer)
+		if err != nil {
+			return err
+		}
+		service.Routes = append(service.Routes, routes...)
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Name] = service
@@ -79,23 +120,28 @@ func (t *Translator) ingressRulesFromGRPCRoute(result *ingressRules, grpcroute *
 func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes []*gatewayapi.GRPCRoute, result *ingressRules) {
 	// first, split GRPCRoutes by hostname and match.
 	splitGRPCRouteMatches := []subtranslator.SplitGRPCRouteMatch{}
-	// record GRPCRoutes passing the validation and get translated.
-	// after they are translated, register the success event in the translator.
-	translatedGRPCRoutes := []*gatewayapi.GRPCRoute{}
 	for _, grpcRoute := range grpcRoutes {
 		splitGRPCRouteMatches = append(splitGRPCRouteMatches, subtranslator.SplitGRPCRoute(grpcRoute, t.storer)...)
-		translatedGRPCRoutes = append(translatedGRPCRoutes, grpcRoute)
 	}
 
 	// assign priorities to split GRPCRoutes.
 	splitGRPCRouteMatchesWithPriorities := subtranslator.AssignRoutePriorityToSplitGRPCRouteMatches(t.logger, splitGRPCRouteMatches)
 	// generate Kong service and route from each split GRPC route with its assigned priority of Kong route.
+	// record GRPCRoutes failing the translation so that the success event is registered only for the other ones.
+	translationErrors := map[k8stypes.NamespacedName][]error{}
 	for _, splitGRPCRouteMatchWithPriority := range splitGRPCRouteMatchesWithPriorities {
-		t.ingressRulesFromGRPCRouteWithPriority(result, splitGRPCRouteMatchWithPriority)
+		if err := t.ingressRulesFromGRPCRouteWithPriority(result, splitGRPCRouteMatchWithPriority); err != nil {
+			nn := client.ObjectKeyFromObject(splitGRPCRouteMatchWithPriority.Match.Source)
+			translationErrors[nn] = append(translationErrors[nn], err)
+		}
 	}
 
-	// register successful translation of GRPCRoutes.
-	for _, grpcRoute := range translatedGRPCRoutes {
+	for _, grpcRoute := range grpcRoutes {
+		if errs := translationErrors[client.ObjectKeyFromObject(grpcRoute)]; len(errs) > 0 {
+			t.registerTranslationFailure(fmt.Sprintf("GRPCRoute can't be routed: %v", errors.Join(errs...)), grpcRoute)
+			continue
+		}
+		// register successful translation of GRPCRoutes.
 		t.registerSuccessfullyTranslatedObject(grpcRoute)
 	}
 }
@@ -103,7 +149,7 @@ func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes
 func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	rules *ingressRules,
 	splitGRPCRouteMatchWithPriority subtranslator.SplitGRPCRouteMatchToPriority,
-) {
+) error {
 	match := splitGRPCRouteMatchWithPriority.Match
 	grpcRoute := splitGRPCRouteMatchWithPriority.Match.Source
 	// (very unlikely that) the rule index split from the source GRPCRoute is larger then length of original rules.
@@ -111,7 +157,7 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 		t.logger.Error(nil, "Split rule index is greater than the length of rules in source GRPCRoute",
 			"rule_index", match.RuleIndex,
 			"rule_count", len(grpcRoute.Spec.Rules))
-		return
+		return nil
 	}
 	grpcRouteRule := grpcRoute.Spec.Rules[match.RuleIndex]
 
@@ -127,7 +173,10 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 		t.getProtocolForKongService(grpcRoute),
 		grpcBackendRefsToBackendRefs(grpcRouteRule.BackendRefs)...,
 	)
-	route := subtranslator.KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(splitGRPCRouteMatchWithPriority)
+	route, err := subtranslator.KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(splitGRPCRouteMatchWithPriority)
+	if err != nil {
+		return err
+	}
 	route.Protocols = t.getProtocolsForKongRoute(grpcRoute)
 	kongService.Routes = append(
 		kongService.Routes,
@@ -136,6 +185,7 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	// cache the service to avoid duplicates in further loop iterations
 	rules.ServiceNameToServices[serviceName] = kongService
 	rules.ServiceNameToParent[serviceName] = grpcRoute
+	return nil
 }
 
 func grpcBackendRefsToBackendRefs(grpcBackendRef []gatewayapi.GRPCBackendRef) []gatewayapi.BackendRef {
@@ -464,6 +464,105 @@ func TestIngressRulesFromGRPCRoutesUsingExpressionRoutes(t *testing.T) {
 	}
 }
 
+func TestIgnoredGRPCRouteFields(t *testing.T) {
+	testCases := []struct {
+		name            string
+		rule            gatewayapi.GRPCRouteRule
+		expectedIgnored []string
+	}{
+		{
+			name: "header modifiers and extension refs are supported",
+			rule: gatewayapi.GRPCRouteRule{
+				Filters: []gatewayapi.GRPCRouteFilter{
+					{
+						Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+						RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+							Set: []gatewayapi.HTTPHeader{{Name: "x-foo", Value: "bar"}},
+						},
+					},
+					{
+						Type: gatewayapi.GRPCRouteFilterResponseHeaderModifier,
+						ResponseHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+							Remove: []string{"x-bar"},
+						},
+					},
+					{
+						Type: gatewayapi.GRPCRouteFilterExtensionRef,
+						ExtensionRef: &gatewayapi.LocalObjectReference{
+							Group: "configuration.konghq.com",
+							Kind:  "KongPlugin",
+							Name:  "plugin",
+						},
+					},
+				},
+				BackendRefs: []gatewayapi.GRPCBackendRef{
+					{BackendRef: builder.NewBackendRef("service").WithPort(80).Build()},
+				},
+			},
+		},
+		{
+			name: "request mirror is ignored",
+			rule: gatewayapi.GRPCRouteRule{
+				Filters: []gatewayapi.GRPCRouteFilter{
+					{
+						Type: gatewayapi.GRPCRouteFilterRequestMirror,
+						RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+							BackendRef: gatewayapi.BackendObjectReference{Name: "mirror"},
+						},
+					},
+				},
+			},
+			expectedIgnored: []string{"rules[0].filters[0] (RequestMirror)"},
+		},
+		{
+			name: "filters in backendRefs are ignored",
+			rule: gatewayapi.GRPCRouteRule{
+				BackendRefs: []gatewayapi.GRPCBackendRef{
+					{
+						BackendRef: builder.NewBackendRef("service").WithPort(80).Build(),
+						Filters: []gatewayapi.GRPCRouteFilter{
+							{
+								Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+								RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+									Set: []gatewayapi.HTTPHeader{{Name: "x-foo", Value: "bar"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedIgnored: []string{"rules[0].backendRefs[0].filters"},
+		},
+		{
+			name: "session persistence is ignored",
+			rule: gatewayapi.GRPCRouteRule{
+				BackendRefs: []gatewayapi.GRPCBackendRef{
+					{BackendRef: builder.NewBackendRef("service").WithPort(80).Build()},
+				},
+				SessionPersistence: &gatewayapi.SessionPersistence{
+					SessionName: new("session"),
+				},
+			},
+			expectedIgnored: []string{"rules[0].sessionPersistence"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grpcRoute := &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "grpcroute",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: gatewayapi.GRPCRouteSpec{
+					Rules: []gatewayapi.GRPCRouteRule{tc.rule},
+				},
+			}
+			require.Equal(t, tc.expectedIgnored, ignoredGRPCRouteFields(grpcRoute))
+		})
+	}
+}
+
 func TestGetProtocolsForKongRoute(t *testing.T) {
 	testCases := []struct {
 		name              string
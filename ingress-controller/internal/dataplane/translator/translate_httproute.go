@@ -3,6 +3,7 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
@@ -32,6 +33,13 @@ func (t *Translator) ingressRulesFromHTTPRoutes() ingressRules {
 			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %v", err), httproute)
 			continue
 		}
+		// Fields which can't be translated are ignored, but reported so that users know they don't take effect.
+		if ignored := ignoredHTTPRouteRetryFields(httproute); len(ignored) > 0 {
+			t.registerIgnoredFields(
+				fmt.Sprintf("HTTPRoute fields are unsupported and ignored: %s", strings.Join(ignored, ", ")),
+				httproute,
+			)
+		}
 		httpRoutesToTranslate = append(httpRoutesToTranslate, httproute)
 	}
 
@@ -65,6 +73,25 @@ func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlag
 	return nil
 }
 
+// ignoredHTTPRouteRetryFields returns the paths of the retry fields set in the HTTPRoute rules
+// which Kong can't apply to the translated services: retrying on response status codes
+// and waiting between the attempts.
+func ignoredHTTPRouteRetryFields(httproute *gatewayapi.HTTPRoute) []string {
+	var ignored []string
+	for ruleIndex, rule := range httproute.Spec.Rules {
+		if rule.Retry == nil {
+			continue
+		}
+		if len(rule.Retry.Codes) > 0 {
+			ignored = append(ignored, fmt.Sprintf("rules[%d].retry.codes", ruleIndex))
+		}
+		if rule.Retry.Backoff != nil {
+			ignored = append(ignored, fmt.Sprintf("rules[%d].retry.backoff", ruleIndex))
+		}
+	}
+	return ignored
+}
+
 // ingressRulesFromHTTPRoutesWithCombinedService translates a list of HTTPRoutes to ingress rules.
 // When the feature flag CombinedServicesFromDifferentHTTPRoutes is true, it combines rules with same backends
 // to a single Kong gateway service across different HTTPRoutes in the same namespace.
@@ -163,6 +163,81 @@ func TestValidateHTTPRoute(t *testing.T) {
 	}
 }
 
+func TestIgnoredHTTPRouteRetryFields(t *testing.T) {
+	backoff := gatewayapi.Duration("100ms")
+	httpRoute := &gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httproute-retry",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Retry: &gatewayapi.HTTPRouteRetry{Attempts: new(2)},
+				},
+				{
+					Retry: &gatewayapi.HTTPRouteRetry{
+						Codes:   []gatewayapi.HTTPRouteRetryStatusCode{503},
+						Backoff: &backoff,
+					},
+				},
+				{},
+				{
+					Retry: &gatewayapi.HTTPRouteRetry{Backoff: &backoff},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []string{
+		"rules[1].retry.codes",
+		"rules[1].retry.backoff",
+		"rules[3].retry.backoff",
+	}, ignoredHTTPRouteRetryFields(httpRoute))
+}
+
+func TestIngressRulesFromHTTPRoutesReportsIgnoredRetryFields(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: string(gatewayapi.V1Group) + "/" + gatewayapi.V1GroupVersion,
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httproute-retry",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					Retry: &gatewayapi.HTTPRouteRetry{
+						Attempts: new(2),
+						Codes:    []gatewayapi.HTTPRouteRetryStatusCode{503},
+					},
+				},
+			},
+		},
+	}
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{httpRoute},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+
+	result := translator.ingressRulesFromHTTPRoutes()
+
+	require.Len(t, result.ServiceNameToServices, 1, "the HTTPRoute should be translated despite the ignored fields")
+	require.Empty(t, translator.popTranslationFailures())
+	ignored := translator.ignoredFieldsCollector.PopResourceFailures()
+	require.Len(t, ignored, 1)
+	require.Equal(t, "HTTPRoute fields are unsupported and ignored: rules[0].retry.codes", ignored[0].Message())
+	require.Equal(t, []client.Object{httpRoute}, ignored[0].CausingObjects())
+}
+
 func TestIngressRulesFromHTTPRoutes(t *testing.T) {
 	testCases := []testCaseIngressRulesFromHTTPRoutes{
 		{
@@ -55,16 +55,20 @@ func l4RouteLess[T L4Route](a, b T) bool {
 // l4Listener pairs a Gateway listener with its owning Gateway's listener
 // statuses, so that per-route attachment predicates (AllowedRoutes,
 // SupportedKinds, Programmed) can be evaluated later, once a candidate route
-// is known.
+// is known. Listeners defined in a ListenerSet attached to the Gateway carry
+// the ListenerSet's NN and the statuses of its listener entries.
 type l4Listener struct {
-	listener gatewayv1.Listener
-	gwStatus []gatewayv1.ListenerStatus
+	listener    gatewayv1.Listener
+	gwStatus    []gatewayv1.ListenerStatus
+	listenerSet types.NamespacedName
 }
 
 // l4ListenerKey identifies a single Gateway listener: gateway NN + listener
-// name + port. Used as a map key to group routes by listener.
+// name + port, plus the ListenerSet NN for listeners defined in a ListenerSet.
+// Used as a map key to group routes by listener.
 type l4ListenerKey struct {
 	gateway      types.NamespacedName
+	listenerSet  types.NamespacedName
 	listenerName string
 	port         gatewayv1.PortNumber
 }
@@ -90,48 +94,101 @@ func parentRefGatewayNN(pr gatewayv1.ParentReference, routeNamespace string) typ
 	return types.NamespacedName{Namespace: ns, Name: string(pr.Name)}
 }
 
+// l4ParentRefTarget resolves a ParentRef of a layer-4 route to the NN of the
+// Gateway serving the route and, for ParentRefs pointing at a ListenerSet, to
+// the ListenerSet itself. It returns false if the ListenerSet cannot be resolved.
+func l4ParentRefTarget(
+	storer store.Storer, pr gatewayv1.ParentReference, routeNamespace string,
+) (types.NamespacedName, *gatewayapi.ListenerSet, bool) {
+	nn := parentRefGatewayNN(pr, routeNamespace)
+	if !gatewayapi.IsListenerSetParentRef(pr) {
+		return nn, nil, true
+	}
+	listenerSet, err := storer.GetListenerSet(nn.Namespace, nn.Name)
+	if err != nil {
+		return types.NamespacedName{}, nil, false
+	}
+	gwNN, ok := gatewayapi.ListenerSetParentGateway(listenerSet)
+	if !ok {
+		return types.NamespacedName{}, nil, false
+	}
+	return gwNN, listenerSet, true
+}
+
 // collectL4ListenersByGateway resolves every Gateway referenced by any
 // ParentRef across the given routes and returns a map keyed by Gateway NN of
-// its listeners matching the given protocol. Gateways not found in storer
-// are omitted.
+// its listeners matching the given protocol. Listeners of ListenerSets
+// referenced by the routes are indexed under the Gateway the ListenerSet is
+// attached to. Gateways not found in storer are omitted.
 func collectL4ListenersByGateway[T L4Route](
 	storer store.Storer,
 	routes []T,
 	protocol gatewayv1.ProtocolType,
 ) map[types.NamespacedName][]l4Listener {
+	controlledGateway := func(gwNN types.NamespacedName) (*gatewayapi.Gateway, bool) {
+		gw, err := storer.GetGateway(gwNN.Namespace, gwNN.Name)
+		if err != nil {
+			return nil, false
+		}
+		gwc, err := storer.GetGatewayClass(string(gw.Spec.GatewayClassName))
+		if err != nil || !gatewayapi.GatewayClassControlledBy(gwc, mgrconsts.GetControllerName()) {
+			return nil, false
+		}
+		return gw, true
+	}
+
 	out := make(map[types.NamespacedName][]l4Listener)
 	seen := make(map[types.NamespacedName]struct{})
+	seenListenerSets := make(map[types.NamespacedName]struct{})
 	for _, r := range routes {
 		for _, pr := range l4RouteParentRefs(r) {
-			gwNN := parentRefGatewayNN(pr, r.GetNamespace())
-			if _, ok := seen[gwNN]; ok {
+			gwNN, listenerSet, ok := l4ParentRefTarget(storer, pr, r.GetNamespace())
+			if !ok {
 				continue
 			}
-			seen[gwNN] = struct{}{}
 
-			gw, err := storer.GetGateway(gwNN.Namespace, gwNN.Name)
-			if err != nil {
+			if listenerSet != nil {
+				lsNN := types.NamespacedName{Namespace: listenerSet.Namespace, Name: listenerSet.Name}
+				if _, ok := seenListenerSets[lsNN]; ok {
+					continue
+				}
+				seenListenerSets[lsNN] = struct{}{}
+
+				if _, ok := controlledGateway(gwNN); !ok {
+					continue
+				}
+				statuses := gatewayapi.ListenerSetListenerStatuses(listenerSet)
+				for _, l := range gatewayapi.ListenerSetListeners(listenerSet) {
+					if l.Protocol != protocol {
+						continue
+					}
+					out[gwNN] = append(out[gwNN], l4Listener{
+						listener:    l,
+						gwStatus:    statuses,
+						listenerSet: lsNN,
+					})
+				}
 				continue
 			}
 
-			gwc, err := storer.GetGatewayClass(string(gw.Spec.GatewayClassName))
-			if err != nil || !gatewayapi.GatewayClassControlledBy(gwc, mgrconsts.GetControllerName()) {
+			if _, ok := seen[gwNN]; ok {
 				continue
 			}
+			seen[gwNN] = struct{}{}
 
-			var ls []l4Listener
+			gw, ok := controlledGateway(gwNN)
+			if !ok {
+				continue
+			}
 			for _, l := range gw.Spec.Listeners {
 				if l.Protocol != protocol {
 					continue
 				}
-				ls = append(ls, l4Listener{
+				out[gwNN] = append(out[gwNN], l4Listener{
 					listener: l,
 					gwStatus: gw.Status.Listeners,
 				})
 			}
-			if len(ls) > 0 {
-				out[gwNN] = ls
-			}
 		}
 	}
 	return out
@@ -155,12 +212,26 @@ func l4RouteListenerAttachments[T L4Route](
 
 	var out []l4ListenerKey
 	for _, pr := range l4RouteParentRefs(route) {
-		gwNN := parentRefGatewayNN(pr, route.GetNamespace())
+		gwNN, listenerSet, ok := l4ParentRefTarget(storer, pr, route.GetNamespace())
+		if !ok {
+			continue
+		}
 		listeners, ok := listenersByGateway[gwNN]
 		if !ok {
 			continue
 		}
+		// AllowedRoutes of the listeners defined in a ListenerSet are relative
+		// to the ListenerSet's namespace.
+		var lsNN types.NamespacedName
+		parentNamespace := gwNN.Namespace
+		if listenerSet != nil {
+			lsNN = types.NamespacedName{Namespace: listenerSet.Namespace, Name: listenerSet.Name}
+			parentNamespace = listenerSet.Namespace
+		}
 		for _, l := range listeners {
+			if l.listenerSet != lsNN {
+				continue
+			}
 			if pr.SectionName != nil && string(*pr.SectionName) != string(l.listener.Name) {
 				continue
 			}
@@ -170,7 +241,7 @@ func l4RouteListenerAttachments[T L4Route](
 			if !gatewayapi.ListenerAcceptsRouteKind(l.listener, route) {
 				continue
 			}
-			if ok, err := gatewayapi.ListenerAllowsNamespace(l.listener, route, gwNN.Namespace, pr.Namespace, getNamespace); err != nil {
+			if ok, err := gatewayapi.ListenerAllowsNamespace(l.listener, route, parentNamespace, pr.Namespace, getNamespace); err != nil {
 				logger.V(1).Info(
 					"skipping L4 arbitration candidate: failed to evaluate listener AllowedRoutes namespaces",
 					"gateway", gwNN, "listener", l.listener.Name,
@@ -188,6 +259,7 @@ func l4RouteListenerAttachments[T L4Route](
 			}
 			out = append(out, l4ListenerKey{
 				gateway:      gwNN,
+				listenerSet:  lsNN,
 				listenerName: string(l.listener.Name),
 				port:         l.listener.Port,
 			})
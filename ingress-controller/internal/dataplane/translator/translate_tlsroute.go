@@ -121,32 +121,50 @@ func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool,
 			continue
 		}
 
-		if parentRef.Kind != nil && *parentRef.Kind != KindGateway {
-			continue
-		}
-
-		gatewayNamespace := tlsroute.Namespace
+		parentNamespace := tlsroute.Namespace
 		if parentRef.Namespace != nil {
-			gatewayNamespace = string(*parentRef.Namespace)
+			parentNamespace = string(*parentRef.Namespace)
 		}
 
-		gateway, err := t.storer.GetGateway(gatewayNamespace, string(parentRef.Name))
-		if err != nil {
-			if errors.As(err, &store.NotFoundError{}) {
-				// log an error if the gateway expected to support the TLSRoute is not found in our cache.
-				t.logger.Error(err, "Gateway not found for TLSRoute",
-					"gateway_namespace", gatewayNamespace,
-					"gateway_name", parentRef.Name,
-					"tlsroute_namespace", tlsroute.Namespace,
-					"tlsroute_name", tlsroute.Name)
-				continue
+		var listeners []gatewayapi.Listener
+		switch {
+		case gatewayapi.IsListenerSetParentRef(parentRef):
+			listenerSet, err := t.storer.GetListenerSet(parentNamespace, string(parentRef.Name))
+			if err != nil {
+				if errors.As(err, &store.NotFoundError{}) {
+					// log an error if the ListenerSet expected to support the TLSRoute is not found in our cache.
+					t.logger.Error(err, "ListenerSet not found for TLSRoute",
+						"listenerset_namespace", parentNamespace,
+						"listenerset_name", parentRef.Name,
+						"tlsroute_namespace", tlsroute.Namespace,
+						"tlsroute_name", tlsroute.Name)
+					continue
+				}
+				return false, err
+			}
+			listeners = gatewayapi.ListenerSetListeners(listenerSet)
+		case parentRef.Kind != nil && *parentRef.Kind != KindGateway:
+			continue
+		default:
+			gateway, err := t.storer.GetGateway(parentNamespace, string(parentRef.Name))
+			if err != nil {
+				if errors.As(err, &store.NotFoundError{}) {
+					// log an error if the gateway expected to support the TLSRoute is not found in our cache.
+					t.logger.Error(err, "Gateway not found for TLSRoute",
+						"gateway_namespace", parentNamespace,
+						"gateway_name", parentRef.Name,
+						"tlsroute_namespace", tlsroute.Namespace,
+						"tlsroute_name", tlsroute.Name)
+					continue
+				}
+				return false, err
 			}
-			return false, err
+			listeners = gateway.Spec.Listeners
 		}
 
-		// If any of the gateway's listeners is configured to passthrough
+		// If any of the parent's listeners is configured to passthrough
 		// TLS requests, we return true.
-		for _, listener := range gateway.Spec.Listeners {
+		for _, listener := range listeners {
 			if parentRef.SectionName == nil || listener.Name == *parentRef.SectionName {
 				if listener.TLS != nil && listener.TLS.Mode != nil &&
 					*listener.TLS.Mode == gatewayapi.TLSModePassthrough {
@@ -3,6 +3,8 @@ package translator
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/go-logr/logr"
@@ -107,10 +109,15 @@ type Translator struct {
 	customEntityTypes     []string
 
 	failuresCollector          *failures.ResourceFailuresCollector
+	routeConflictsCollector    *failures.ResourceFailuresCollector
+	ignoredFieldsCollector     *failures.ResourceFailuresCollector
 	translatedObjectsCollector *ObjectsCollector
 
 	clusterDomain      string
 	enableDrainSupport bool
+
+	// incremental is set when the translator reuses the results of the previous translations.
+	incremental *incrementalTranslation
 }
 
 // Config is a configuration for the Translator.
@@ -120,6 +127,15 @@ type Config struct {
 
 	// ClusterDomain is the cluster domain used for translating Kubernetes objects.
 	ClusterDomain string
+
+	// IncrementalTranslation makes the translator translate again only the parts of the configuration affected
+	// by the objects changed since the previous translation. It takes effect only when the translator is provided
+	// cache snapshots with UpdateCache.
+	IncrementalTranslation bool
+
+	// IncrementalTranslationFullRebuildInterval is the interval between full translations when IncrementalTranslation
+	// is enabled. DefaultIncrementalTranslationFullRebuildInterval is used when not set.
+	IncrementalTranslationFullRebuildInterval time.Duration
 }
 
 // NewTranslator produces a new Translator object provided a logging mechanism
@@ -141,6 +157,11 @@ func NewTranslator(
 		translatedObjectsCollector = NewObjectsCollector()
 	}
 
+	var incremental *incrementalTranslation
+	if config.IncrementalTranslation {
+		incremental = newIncrementalTranslation(logger, config.IncrementalTranslationFullRebuildInterval)
+	}
+
 	return &Translator{
 		logger:                     logger,
 		storer:                     storer,
@@ -149,9 +170,12 @@ func NewTranslator(
 		featureFlags:               featureFlags,
 		schemaServiceProvider:      schemaServiceProvider,
 		failuresCollector:          failuresCollector,
+		routeConflictsCollector:    failures.NewResourceFailuresCollector(logger),
+		ignoredFieldsCollector:     failures.NewResourceFailuresCollector(logger),
 		translatedObjectsCollector: translatedObjectsCollector,
 		clusterDomain:              config.ClusterDomain,
 		enableDrainSupport:         config.EnableDrainSupport,
+		incremental:                incremental,
 	}, nil
 }
 
@@ -170,12 +194,23 @@ type KongConfigBuildingResult struct {
 
 	// ConfiguredKubernetesObjects is a list of Kubernetes objects that were successfully translated.
 	ConfiguredKubernetesObjects []client.Object
+
+	// RouteConflicts is a list of Kubernetes objects translated to expression routes that are shadowed by, or
+	// ambiguous with, routes of other objects. They are translated successfully, but can't get all of their traffic.
+	RouteConflicts []failures.ResourceFailure
+
+	// IgnoredFields is a list of Kubernetes objects setting fields which can't be translated to Kong configuration.
+	// They are translated successfully, but the listed fields don't take effect.
+	IgnoredFields []failures.ResourceFailure
 }
 
 // UpdateCache updates the store cache used by the translator.
 // This method can be used to swap the cache with another one (e.g. the last valid snapshot).
 func (t *Translator) UpdateCache(c store.CacheStores) {
 	t.storer.UpdateCache(c)
+	if t.incremental != nil {
+		t.incremental.cache = &c
+	}
 }
 
 // BuildKongConfig creates a Kong configuration from Ingress and Custom resources
@@ -183,100 +218,160 @@ func (t *Translator) UpdateCache(c store.CacheStores) {
 func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	ctx := context.Background()
 
-	// Translate and merge all rules together from all Kubernetes API sources
-	ingressRules := mergeIngressRules(
-		t.ingressRulesFromIngressV1(),
-
-		t.ingressRulesFromHTTPRoutes(),
-		t.ingressRulesFromUDPRoutes(),
-		t.ingressRulesFromTCPRoutes(),
-		t.ingressRulesFromTLSRoutes(),
-		t.ingressRulesFromGRPCRoutes(),
-	)
+	// When translating incrementally, only the units affected by the objects changed since the previous translation
+	// are translated, the results of the other ones are reused.
+	plan := t.incremental.plan()
+	defer plan.finish()
 
-	// populate any Kubernetes Service objects relevant objects and get the
-	// services to be skipped because of annotations inconsistency
-	servicesToBeSkipped := ingressRules.populateServices(t.logger, t.storer, t.failuresCollector, t.translatedObjectsCollector)
-
-	// add the routes and services to the state
 	var result kongstate.KongState
-
-	// generate Upstreams and Targets from service defs
-	// update ServiceNameToServices with resolved ports (translating any name references to their number, as Kong
-	// services require a number)
-	result.Upstreams, ingressRules.ServiceNameToServices = t.getUpstreams(ingressRules.ServiceNameToServices)
-
-	for key, service := range ingressRules.ServiceNameToServices {
-		// if the service doesn't need to be skipped, then add it to the
-		// list of services.
-		if _, ok := servicesToBeSkipped[key]; !ok {
-			result.Services = append(result.Services, service)
-		}
+	// Routing components are translated independently, the certificates they request are merged
+	// to be translated along with the certificates of Gateways.
+	secretNameToSNIs := newSecretNameToSNIs()
+	for _, unit := range t.routingUnits(plan) {
+		unitResult := t.runTranslationUnit(&result, unit, plan)
+		secretNameToSNIs.merge(unitResult.secretNameToSNIs)
 	}
 
-	// Apply overrides to Routes, Services and Upstream
-	result.FillOverrides(t.logger, t.storer, t.failuresCollector, t.kongVersion)
-
-	// generate consumers and credentials
-	result.FillConsumersAndCredentials(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Consumers {
-		t.registerSuccessfullyTranslatedObject(&result.Consumers[i].K8sKongConsumer)
+	units := []translationUnit{
+		{
+			name:      translationUnitCertificates,
+			dependsOn: []translationUnitName{translationUnitRouting},
+			translate: func(r *translationUnitResult) {
+				// generate Certificates and SNIs
+				ingressCerts := t.getCerts(secretNameToSNIs)
+				gatewayCerts := t.getGatewayCerts()
+				// note that ingress-derived certificates will take precedence over gateway-derived certificates for SNI assignment
+				r.state.Certificates, r.certIDs = mergeCerts(t.logger, ingressCerts, gatewayCerts)
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.Certificates = slices.Clone(src.state.Certificates)
+				// re-fill client certificate IDs of services after certificates are merged.
+				for i, s := range dst.Services {
+					if s.ClientCertificate != nil && s.ClientCertificate.ID != nil {
+						certID := s.ClientCertificate.ID
+						mergedCertID := src.certIDs[*certID]
+						dst.Services[i].ClientCertificate = &kong.Certificate{
+							ID: new(mergedCertID),
+						}
+					}
+				}
+			},
+		},
 	}
-
-	// generate vaults
-	result.FillVaults(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Vaults {
-		t.registerSuccessfullyTranslatedObject(result.Vaults[i].K8sKongVault)
+	// look for routes that can't get their traffic because of the routes of other objects
+	if t.featureFlags.ExpressionRoutes {
+		units = append(units, translationUnit{
+			name:      translationUnitRouteConflicts,
+			dependsOn: []translationUnitName{translationUnitRouting},
+			translate: func(*translationUnitResult) {
+				t.registerRouteConflicts(result.Services)
+			},
+			merge: func(*kongstate.KongState, *translationUnitResult) {},
+		})
 	}
-
-	// process consumer groups
-	result.FillConsumerGroups(t.logger, t.storer)
-	for i := range result.ConsumerGroups {
-		t.registerSuccessfullyTranslatedObject(&result.ConsumerGroups[i].K8sKongConsumerGroup)
+	units = append(units,
+		translationUnit{
+			name: translationUnitConsumers,
+			translate: func(r *translationUnitResult) {
+				// generate consumers and credentials
+				r.state.FillConsumersAndCredentials(t.logger, t.storer, t.failuresCollector)
+				for i := range r.state.Consumers {
+					t.registerSuccessfullyTranslatedObject(&r.state.Consumers[i].K8sKongConsumer)
+				}
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.Consumers = slices.Clone(src.state.Consumers)
+			},
+		},
+		translationUnit{
+			name: translationUnitVaults,
+			translate: func(r *translationUnitResult) {
+				// generate vaults
+				r.state.FillVaults(t.logger, t.storer, t.failuresCollector)
+				for i := range r.state.Vaults {
+					t.registerSuccessfullyTranslatedObject(r.state.Vaults[i].K8sKongVault)
+				}
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.Vaults = slices.Clone(src.state.Vaults)
+			},
+		},
+		translationUnit{
+			name: translationUnitConsumerGroups,
+			translate: func(r *translationUnitResult) {
+				// process consumer groups
+				r.state.FillConsumerGroups(t.logger, t.storer)
+				for i := range r.state.ConsumerGroups {
+					t.registerSuccessfullyTranslatedObject(&r.state.ConsumerGroups[i].K8sKongConsumerGroup)
+				}
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.ConsumerGroups = slices.Clone(src.state.ConsumerGroups)
+			},
+		},
+		translationUnit{
+			name: translationUnitCACertificates,
+			translate: func(r *translationUnitResult) {
+				// populate CA certificates in Kong
+				r.state.CACertificates = t.getCACerts()
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.CACertificates = slices.Clone(src.state.CACertificates)
+			},
+		},
+		translationUnit{
+			name: translationUnitPlugins,
+			dependsOn: []translationUnitName{
+				translationUnitRouting, translationUnitConsumers, translationUnitConsumerGroups,
+			},
+			translate: func(r *translationUnitResult) {
+				// process annotation plugins
+				ks := result
+				ks.FillPlugins(t.logger, t.storer, t.failuresCollector)
+				r.state.Plugins = ks.Plugins
+				for i := range r.state.Plugins {
+					t.registerSuccessfullyTranslatedObject(r.state.Plugins[i].K8sParent)
+				}
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.Plugins = slices.Clone(src.state.Plugins)
+			},
+		},
+	)
+	// process custom entities
+	if t.featureFlags.KongCustomEntity {
+		units = append(units, translationUnit{
+			name: translationUnitCustomEntities,
+			dependsOn: []translationUnitName{
+				translationUnitRouting, translationUnitConsumers, translationUnitConsumerGroups,
+			},
+			translate: func(r *translationUnitResult) {
+				ks := result
+				ks.FillCustomEntities(ctx, t.logger, t.storer, t.failuresCollector, t.schemaServiceProvider.GetSchemaService(), t.workspace)
+				r.state.CustomEntities = ks.CustomEntities
+				// Register successcully translated KCEs to set the status of these KCEs.
+				for _, collection := range r.state.CustomEntities {
+					for i := range collection.Entities {
+						t.registerSuccessfullyTranslatedObject(collection.Entities[i].K8sKongCustomEntity)
+					}
+				}
+			},
+			merge: func(dst *kongstate.KongState, src *translationUnitResult) {
+				dst.CustomEntities = cloneCustomEntities(src.state.CustomEntities)
+			},
+		})
 	}
-
-	// process annotation plugins
-	result.FillPlugins(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Plugins {
-		t.registerSuccessfullyTranslatedObject(result.Plugins[i].K8sParent)
+	for _, unit := range units {
+		t.runTranslationUnit(&result, unit, plan)
 	}
 
-	// process custom entities
 	if t.featureFlags.KongCustomEntity {
-		result.FillCustomEntities(ctx, t.logger, t.storer, t.failuresCollector, t.schemaServiceProvider.GetSchemaService(), t.workspace)
-		// Register successcully translated KCEs to set the status of these KCEs.
-		for _, collection := range result.CustomEntities {
-			for i := range collection.Entities {
-				t.registerSuccessfullyTranslatedObject(collection.Entities[i].K8sKongCustomEntity)
-			}
-		}
 		// Update types of translated custom entities in the round of translation
 		// for dumping them from Kong gateway in config fetcher,
 		// because running full build of Kong configuration to get KongState is a heavy operation.
 		t.customEntityTypes = result.CustomEntityTypes()
 	}
 
-	// generate Certificates and SNIs
-	ingressCerts := t.getCerts(ingressRules.SecretNameToSNIs)
-	gatewayCerts := t.getGatewayCerts()
-	// note that ingress-derived certificates will take precedence over gateway-derived certificates for SNI assignment
-	var certIDsSeen certIDToMergedCertID
-	result.Certificates, certIDsSeen = mergeCerts(t.logger, ingressCerts, gatewayCerts)
-
-	// re-fill client certificate IDs of services after certificates are merged.
-	for i, s := range result.Services {
-		if s.ClientCertificate != nil && s.ClientCertificate.ID != nil {
-			certID := s.ClientCertificate.ID
-			mergedCertID := certIDsSeen[*certID]
-			result.Services[i].ClientCertificate = &kong.Certificate{
-				ID: new(mergedCertID),
-			}
-		}
-	}
-
-	// populate CA certificates in Kong
-	result.CACertificates = t.getCACerts()
-
 	if t.licenseGetter != nil && t.featureFlags.EnterpriseEdition {
 		optionalLicense := t.licenseGetter.GetLicense()
 		if l, ok := optionalLicense.Get(); ok {
@@ -293,9 +388,49 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 		KongState:                   &result,
 		TranslationFailures:         t.popTranslationFailures(),
 		ConfiguredKubernetesObjects: t.popConfiguredKubernetesObjects(),
+		RouteConflicts:              t.routeConflictsCollector.PopResourceFailures(),
+		IgnoredFields:               t.ignoredFieldsCollector.PopResourceFailures(),
 	}
 }
 
+// translateRouting translates the objects configuring the routing of requests to Kong services, routes
+// and upstreams. The certificates requested by the objects are recorded to be translated along with
+// the certificates of Gateways.
+func (t *Translator) translateRouting(result *translationUnitResult) {
+	// Translate and merge all rules together from all Kubernetes API sources
+	ingressRules := mergeIngressRules(
+		t.ingressRulesFromIngressV1(),
+
+		t.ingressRulesFromHTTPRoutes(),
+		t.ingressRulesFromUDPRoutes(),
+		t.ingressRulesFromTCPRoutes(),
+		t.ingressRulesFromTLSRoutes(),
+		t.ingressRulesFromGRPCRoutes(),
+	)
+
+	// populate any Kubernetes Service objects relevant objects and get the
+	// services to be skipped because of annotations inconsistency
+	servicesToBeSkipped := ingressRules.populateServices(t.logger, t.storer, t.failuresCollector, t.translatedObjectsCollector)
+
+	// generate Upstreams and Targets from service defs
+	// update ServiceNameToServices with resolved ports (translating any name references to their number, as Kong
+	// services require a number)
+	result.state.Upstreams, ingressRules.ServiceNameToServices = t.getUpstreams(ingressRules.ServiceNameToServices)
+
+	for key, service := range ingressRules.ServiceNameToServices {
+		// if the service doesn't need to be skipped, then add it to the
+		// list of services.
+		if _, ok := servicesToBeSkipped[key]; !ok {
+			result.state.Services = append(result.state.Services, service)
+		}
+	}
+
+	// Apply overrides to Routes, Services and Upstream
+	result.state.FillOverrides(t.logger, t.storer, t.failuresCollector, t.kongVersion)
+
+	result.secretNameToSNIs = ingressRules.SecretNameToSNIs
+}
+
 // -----------------------------------------------------------------------------
 // Translator - Public Methods - Other Optional Features
 // -----------------------------------------------------------------------------
@@ -321,6 +456,12 @@ func (t *Translator) registerTranslationFailure(reason string, causingObjects ..
 	t.failuresCollector.PushResourceFailure(reason, causingObjects...)
 }
 
+// registerIgnoredFields should be called when a Kubernetes object is translated, but some of its fields
+// can't be translated and don't take effect.
+func (t *Translator) registerIgnoredFields(reason string, causingObjects ...client.Object) {
+	t.ignoredFieldsCollector.PushResourceFailure(reason, causingObjects...)
+}
+
 func (t *Translator) popTranslationFailures() []failures.ResourceFailure {
 	return t.failuresCollector.PopResourceFailures()
 }
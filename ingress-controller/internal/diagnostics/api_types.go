@@ -65,3 +65,45 @@ type DiffResponse struct {
 	// Available lists the currently available diff hashes and timestamps.
 	Available []DiffIndex `json:"available"`
 }
+
+// RouteMatchResponse is the GET /debug/config/route-match response schema.
+type RouteMatchResponse struct {
+	// Message provides explanatory information, if any.
+	Message string `json:"message,omitempty"`
+	// ConfigHash is the hash of the configuration the request was evaluated against.
+	ConfigHash string `json:"hash,omitempty"`
+	// Match is the route Kong picks for the request. It is empty if no route matches.
+	Match *RouteMatchCandidate `json:"match,omitempty"`
+	// Candidates are the other routes matching the request, from the next-best one.
+	Candidates []RouteMatchCandidate `json:"candidates,omitempty"`
+}
+
+// RouteMatchCandidate is a Kong route matching the request evaluated by the route-match endpoint.
+type RouteMatchCandidate struct {
+	// Route is the Kong route name.
+	Route string `json:"route"`
+	// Service is the name of the Kong service the route belongs to.
+	Service string `json:"service,omitempty"`
+	// Expression is the route expression.
+	Expression string `json:"expression"`
+	// Priority is the route priority. Routes with higher priority are evaluated first.
+	Priority uint64 `json:"priority"`
+	// Object is the Kubernetes object the route was translated from, if it is known.
+	Object *RouteSourceObjectMeta `json:"object,omitempty"`
+}
+
+// RouteSourceObjectMeta is the metadata of the Kubernetes object a Kong route was translated from.
+type RouteSourceObjectMeta struct {
+	// Group is the resource group.
+	Group string `json:"group"`
+	// Kind is the resource kind.
+	Kind string `json:"kind"`
+	// Version is the resource version.
+	Version string `json:"version,omitempty"`
+	// Namespace is the object namespace.
+	Namespace string `json:"namespace"`
+	// Name is the object name.
+	Name string `json:"name"`
+	// ID is the object UID.
+	ID string `json:"id,omitempty"`
+}
@@ -4,16 +4,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/kong/go-database-reconciler/pkg/file"
 	"github.com/samber/mo"
 
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/fallback"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/atc"
 )
 
 const (
 	// diffHashQuery is the query parameter used to request a specific diff by hash from the /diff-report endpoint.
 	diffHashQuery = "hash"
+
+	// Query parameters describing the request evaluated by the /route-match endpoint.
+	routeMatchProtocolQuery = "protocol"
+	routeMatchMethodQuery   = "method"
+	routeMatchHostQuery     = "host"
+	routeMatchPathQuery     = "path"
+	routeMatchHeaderQuery   = "header"
+	routeMatchSNIQuery      = "sni"
+	routeMatchPortQuery     = "port"
 )
 
 // Provider is an interface representing a provider of config diagnostics data (e.g. config dumps, diffs).
@@ -48,6 +61,7 @@ func NewConfigDiagnosticsHTTPHandler(diagnosticsProvider Provider, dumpsIncludeS
 	mux.HandleFunc("/fallback", h.handleCurrentFallback)
 	mux.HandleFunc("/raw-error", h.handleLastErrBody)
 	mux.HandleFunc("/diff-report", h.handleDiffReport)
+	mux.HandleFunc("/route-match", h.handleRouteMatch)
 
 	h.mux = mux
 	return h
@@ -173,3 +187,92 @@ func (h *HTTPHandler) handleDiffReport(rw http.ResponseWriter, r *http.Request)
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func (h *HTTPHandler) handleRouteMatch(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+
+	req, err := routeMatchRequestFromQuery(r.URL.Query())
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(rw).Encode(RouteMatchResponse{Message: err.Error()})
+		return
+	}
+
+	config, configHash, ok := h.diagnosticsProvider.LastSuccessfulConfigDump()
+	if !ok {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	result, err := MatchRoutes(config, req)
+	response := mapRouteMatchResultIntoRouteMatchResponse(result, configHash)
+	var notes []string
+	if response.Message != "" {
+		notes = append(notes, response.Message)
+	}
+	if result.NonExpressionRoutes > 0 {
+		notes = append(notes, fmt.Sprintf("%d routes without an expression were not evaluated", result.NonExpressionRoutes))
+	}
+	if err != nil {
+		notes = append(notes, fmt.Sprintf("routes with invalid expressions were not evaluated: %v", err))
+	}
+	response.Message = strings.Join(notes, "; ")
+
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// routeMatchRequestFromQuery builds the request evaluated by the /route-match endpoint from its query parameters.
+// The path may include a query string, headers are given as name:value and the method defaults to GET (POST for gRPC).
+func routeMatchRequestFromQuery(query url.Values) (atc.Request, error) {
+	req := atc.Request{
+		Protocol: query.Get(routeMatchProtocolQuery),
+		Method:   query.Get(routeMatchMethodQuery),
+		Host:     query.Get(routeMatchHostQuery),
+		SNI:      query.Get(routeMatchSNIQuery),
+		Path:     "/",
+	}
+	if req.Protocol == "" {
+		req.Protocol = "http"
+		if req.SNI != "" {
+			req.Protocol = "https"
+		}
+	}
+	if req.Method == "" {
+		req.Method = http.MethodGet
+		if req.Protocol == "grpc" || req.Protocol == "grpcs" {
+			req.Method = http.MethodPost
+		}
+	}
+
+	if path := query.Get(routeMatchPathQuery); path != "" {
+		u, err := url.ParseRequestURI(path)
+		if err != nil {
+			return atc.Request{}, fmt.Errorf("invalid %s %q: %w", routeMatchPathQuery, path, err)
+		}
+		req.Path = u.Path
+		req.Queries = u.Query()
+	}
+
+	for _, header := range query[routeMatchHeaderQuery] {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return atc.Request{}, fmt.Errorf("invalid %s %q: expected name:value", routeMatchHeaderQuery, header)
+		}
+		if req.Headers == nil {
+			req.Headers = map[string][]string{}
+		}
+		name = strings.TrimSpace(name)
+		req.Headers[name] = append(req.Headers[name], strings.TrimSpace(value))
+	}
+
+	if port := query.Get(routeMatchPortQuery); port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil || p <= 0 || p > 65535 {
+			return atc.Request{}, fmt.Errorf("invalid %s %q", routeMatchPortQuery, port)
+		}
+		req.Port = p
+	}
+	return req, nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 
@@ -193,6 +194,73 @@ func TestHTTPHandler(t *testing.T) {
       "timestamp": ""
     }
   ]
+}`,
+		},
+		{
+			name:               "route match without successful config dump",
+			provider:           MockDiagnosticsProvider{},
+			endpoint:           "/route-match?path=/",
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:               "route match with invalid header",
+			provider:           MockDiagnosticsProvider{},
+			endpoint:           "/route-match?header=invalid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"message": "invalid header \"invalid\": expected name:value"}`,
+		},
+		{
+			name: "route match",
+			provider: MockDiagnosticsProvider{
+				lastSuccessfulConfigDump: mo.Some(file.Content{
+					Services: []file.FService{
+						{
+							Service: kong.Service{Name: new("default.echo.80")},
+							Routes: []*file.FRoute{
+								{
+									Route: kong.Route{
+										Name:       new("default.echo.echo.0.0"),
+										Expression: new(`(http.path ^= "/echo") && (http.headers.x_env == "canary")`),
+										Priority:   new(uint64(2)),
+										Tags:       kong.StringSlice("k8s-name:echo", "k8s-namespace:default", "k8s-kind:Ingress", "k8s-group:networking.k8s.io"),
+									},
+								},
+								{
+									Route: kong.Route{
+										Name:       new("default.echo.echo.0.1"),
+										Expression: new(`http.path ^= "/"`),
+										Priority:   new(uint64(1)),
+									},
+								},
+							},
+						},
+					},
+				}),
+			},
+			endpoint:           "/route-match?path=/echo/1%3Fa%3Db&header=X-Env:canary",
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: `{
+  "hash": "success-hash",
+  "match": {
+    "route": "default.echo.echo.0.0",
+    "service": "default.echo.80",
+    "expression": "(http.path ^= \"/echo\") && (http.headers.x_env == \"canary\")",
+    "priority": 2,
+    "object": {
+      "group": "networking.k8s.io",
+      "kind": "Ingress",
+      "namespace": "default",
+      "name": "echo"
+    }
+  },
+  "candidates": [
+    {
+      "route": "default.echo.echo.0.1",
+      "service": "default.echo.80",
+      "expression": "http.path ^= \"/\"",
+      "priority": 1
+    }
+  ]
 }`,
 		},
 	}
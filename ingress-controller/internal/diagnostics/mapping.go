@@ -1,10 +1,13 @@
 package diagnostics
 
 import (
+	"strings"
+
 	"github.com/samber/lo"
 	"github.com/samber/mo"
 
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/fallback"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
 )
 
 // mapFallbackCacheMetadataIntoFallbackResponse maps the generated cache metadata into a FallbackResponse.
@@ -48,3 +51,53 @@ func mapFallbackCacheMetadataIntoFallbackResponse(m mo.Option[fallback.Generated
 		BackfilledObjects: mapAffectedObjectsMeta(meta.BackfilledObjects),
 	}
 }
+
+// mapRouteMatchResultIntoRouteMatchResponse maps the routes matching a request into a RouteMatchResponse.
+func mapRouteMatchResultIntoRouteMatchResponse(result RouteMatchResult, configHash string) RouteMatchResponse {
+	candidates := lo.Map(result.Matches, func(m MatchedRoute, _ int) RouteMatchCandidate {
+		return RouteMatchCandidate{
+			Route:      lo.FromPtr(m.Route.Name),
+			Service:    m.ServiceName,
+			Expression: lo.FromPtr(m.Route.Expression),
+			Priority:   lo.FromPtr(m.Route.Priority),
+			Object:     mapTagsIntoRouteSourceObjectMeta(lo.FromSlicePtr(m.Route.Tags)),
+		}
+	})
+	if len(candidates) == 0 {
+		return RouteMatchResponse{
+			ConfigHash: configHash,
+			Message:    "no route matches the request",
+		}
+	}
+	return RouteMatchResponse{
+		ConfigHash: configHash,
+		Match:      &candidates[0],
+		Candidates: candidates[1:],
+	}
+}
+
+// mapTagsIntoRouteSourceObjectMeta maps the Kubernetes metadata tags of a Kong entity into a RouteSourceObjectMeta.
+// It returns nil if the tags do not identify an object.
+func mapTagsIntoRouteSourceObjectMeta(tags []string) *RouteSourceObjectMeta {
+	var meta RouteSourceObjectMeta
+	for _, tag := range tags {
+		switch {
+		case strings.HasPrefix(tag, util.K8sNameTagPrefix):
+			meta.Name = strings.TrimPrefix(tag, util.K8sNameTagPrefix)
+		case strings.HasPrefix(tag, util.K8sNamespaceTagPrefix):
+			meta.Namespace = strings.TrimPrefix(tag, util.K8sNamespaceTagPrefix)
+		case strings.HasPrefix(tag, util.K8sKindTagPrefix):
+			meta.Kind = strings.TrimPrefix(tag, util.K8sKindTagPrefix)
+		case strings.HasPrefix(tag, util.K8sGroupTagPrefix):
+			meta.Group = strings.TrimPrefix(tag, util.K8sGroupTagPrefix)
+		case strings.HasPrefix(tag, util.K8sVersionTagPrefix):
+			meta.Version = strings.TrimPrefix(tag, util.K8sVersionTagPrefix)
+		case strings.HasPrefix(tag, util.K8sUIDTagPrefix):
+			meta.ID = strings.TrimPrefix(tag, util.K8sUIDTagPrefix)
+		}
+	}
+	if meta.Name == "" || meta.Kind == "" {
+		return nil
+	}
+	return &meta
+}
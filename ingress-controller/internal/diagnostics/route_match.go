@@ -0,0 +1,81 @@
+package diagnostics
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/atc"
+)
+
+// MatchedRoute is a Kong route matching a request.
+type MatchedRoute struct {
+	// Route is the matching route.
+	Route kong.Route
+	// ServiceName is the name of the service the route belongs to, if any.
+	ServiceName string
+}
+
+// RouteMatchResult is the result of evaluating the routes of a configuration against a request.
+type RouteMatchResult struct {
+	// Matches are the routes matching the request, in the order the router considers them: the first one is the
+	// route Kong picks and the following ones are the next-best candidates.
+	Matches []MatchedRoute
+	// NonExpressionRoutes is the number of routes without an expression, which are not evaluated.
+	NonExpressionRoutes int
+}
+
+// MatchRoutes evaluates the expressions of the routes in the configuration against the request and returns the
+// routes matching it ordered by priority. Routes with the same priority are ordered by their IDs, the same way Kong's
+// expression router breaks ties. Routes whose expression can't be parsed are skipped and reported in the returned
+// error, alongside the result for the other routes.
+func MatchRoutes(content file.Content, req atc.Request) (RouteMatchResult, error) {
+	var (
+		result RouteMatchResult
+		errs   []error
+	)
+	evaluate := func(route kong.Route, serviceName string) {
+		expression := lo.FromPtr(route.Expression)
+		if expression == "" {
+			result.NonExpressionRoutes++
+			return
+		}
+		matcher, err := atc.ParseExpression(expression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("route %s: %w", lo.FromPtr(route.Name), err))
+			return
+		}
+		if atc.Match(matcher, req) {
+			result.Matches = append(result.Matches, MatchedRoute{Route: route, ServiceName: serviceName})
+		}
+	}
+
+	for _, service := range content.Services {
+		for _, route := range service.Routes {
+			if route != nil {
+				evaluate(route.Route, lo.FromPtr(service.Name))
+			}
+		}
+	}
+	for _, route := range content.Routes {
+		serviceName := ""
+		if route.Service != nil {
+			serviceName = lo.FromPtr(route.Service.Name)
+		}
+		evaluate(route.Route, serviceName)
+	}
+
+	slices.SortStableFunc(result.Matches, func(a, b MatchedRoute) int {
+		return cmp.Or(
+			cmp.Compare(lo.FromPtr(b.Route.Priority), lo.FromPtr(a.Route.Priority)),
+			cmp.Compare(lo.FromPtr(b.Route.ID), lo.FromPtr(a.Route.ID)),
+			cmp.Compare(lo.FromPtr(a.Route.Name), lo.FromPtr(b.Route.Name)),
+		)
+	})
+	return result, errors.Join(errs...)
+}
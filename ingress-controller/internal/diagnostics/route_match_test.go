@@ -0,0 +1,82 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/atc"
+)
+
+func TestMatchRoutes(t *testing.T) {
+	route := func(name, id, expression string, priority uint64) *file.FRoute {
+		return &file.FRoute{
+			Route: kong.Route{
+				Name:       new(name),
+				ID:         new(id),
+				Expression: new(expression),
+				Priority:   new(priority),
+				Tags: kong.StringSlice(
+					"k8s-name:"+name,
+					"k8s-namespace:default",
+					"k8s-kind:HTTPRoute",
+					"k8s-group:gateway.networking.k8s.io",
+					"k8s-version:v1",
+				),
+			},
+		}
+	}
+	content := file.Content{
+		Services: []file.FService{
+			{
+				Service: kong.Service{Name: new("default.echo.80")},
+				Routes: []*file.FRoute{
+					route("prefix", "1", `http.path ^= "/api/"`, 10),
+					route("exact", "2", `http.path == "/api/users"`, 20),
+					route("other-host", "3", `(http.host == "other.example.com") && (http.path ^= "/")`, 30),
+					route("tie", "4", `http.path ^= "/api"`, 10),
+				},
+			},
+			{
+				Service: kong.Service{Name: new("default.legacy.80")},
+				Routes: []*file.FRoute{
+					{Route: kong.Route{Name: new("traditional"), Paths: kong.StringSlice("/")}},
+					route("invalid", "5", `http.path ^=`, 100),
+				},
+			},
+		},
+	}
+
+	result, err := MatchRoutes(content, atc.Request{
+		Protocol: "http",
+		Method:   "GET",
+		Host:     "example.com",
+		Path:     "/api/users",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "route invalid")
+	assert.Equal(t, 1, result.NonExpressionRoutes)
+	names := lo.Map(result.Matches, func(m MatchedRoute, _ int) string { return *m.Route.Name })
+	assert.Equal(t, []string{"exact", "tie", "prefix"}, names, "routes should be ordered by priority, then by ID")
+
+	response := mapRouteMatchResultIntoRouteMatchResponse(result, "hash")
+	require.NotNil(t, response.Match)
+	assert.Equal(t, RouteMatchCandidate{
+		Route:      "exact",
+		Service:    "default.echo.80",
+		Expression: `http.path == "/api/users"`,
+		Priority:   20,
+		Object: &RouteSourceObjectMeta{
+			Group:     "gateway.networking.k8s.io",
+			Kind:      "HTTPRoute",
+			Version:   "v1",
+			Namespace: "default",
+			Name:      "exact",
+		},
+	}, *response.Match)
+	assert.Len(t, response.Candidates, 2)
+}
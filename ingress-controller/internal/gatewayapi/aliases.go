@@ -45,6 +45,7 @@ type (
 	HTTPMethod                                = gatewayv1.HTTPMethod
 	HTTPPathMatch                             = gatewayv1.HTTPPathMatch
 	HTTPQueryParamMatch                       = gatewayv1.HTTPQueryParamMatch
+	HTTPRequestMirrorFilter                   = gatewayv1.HTTPRequestMirrorFilter
 	HTTPRequestRedirectFilter                 = gatewayv1.HTTPRequestRedirectFilter
 	HTTPRoute                                 = gatewayv1.HTTPRoute
 	HTTPRouteFilter                           = gatewayv1.HTTPRouteFilter
@@ -54,6 +55,8 @@ type (
 	HTTPPathModifierType                      = gatewayv1.HTTPPathModifierType
 	HTTPRouteList                             = gatewayv1.HTTPRouteList
 	HTTPRouteMatch                            = gatewayv1.HTTPRouteMatch
+	HTTPRouteRetry                            = gatewayv1.HTTPRouteRetry
+	HTTPRouteRetryStatusCode                  = gatewayv1.HTTPRouteRetryStatusCode
 	HTTPRouteRule                             = gatewayv1.HTTPRouteRule
 	HTTPRouteTimeouts                         = gatewayv1.HTTPRouteTimeouts
 	LocalObjectReference                      = gatewayv1.LocalObjectReference
@@ -64,11 +67,17 @@ type (
 	Listener                                  = gatewayv1.Listener
 	ListenerConditionReason                   = gatewayv1.ListenerConditionReason
 	ListenerConditionType                     = gatewayv1.ListenerConditionType
+	ListenerEntry                             = gatewayv1.ListenerEntry
+	ListenerEntryStatus                       = gatewayv1.ListenerEntryStatus
+	ListenerSet                               = gatewayv1.ListenerSet
+	ListenerSetList                           = gatewayv1.ListenerSetList
+	ListenerSetSpec                           = gatewayv1.ListenerSetSpec
 	ListenerStatus                            = gatewayv1.ListenerStatus
 	LocalPolicyTargetReferenceWithSectionName = gatewayv1.LocalPolicyTargetReferenceWithSectionName
 	LocalPolicyTargetReference                = gatewayv1.LocalPolicyTargetReference
 	Namespace                                 = gatewayv1.Namespace
 	ObjectName                                = gatewayv1.ObjectName
+	ParentGatewayReference                    = gatewayv1.ParentGatewayReference
 	ParentReference                           = gatewayv1.ParentReference
 	PathMatchType                             = gatewayv1.PathMatchType
 	PortNumber                                = gatewayv1.PortNumber
@@ -86,6 +95,7 @@ type (
 	RouteStatus                               = gatewayv1.RouteStatus
 	SecretObjectReference                     = gatewayv1.SecretObjectReference
 	SectionName                               = gatewayv1.SectionName
+	SessionPersistence                        = gatewayv1.SessionPersistence
 	GRPCBackendRef                            = gatewayv1.GRPCBackendRef
 	GRPCHeaderMatch                           = gatewayv1.GRPCHeaderMatch
 	GRPCHeaderName                            = gatewayv1.GRPCHeaderName
@@ -93,6 +103,8 @@ type (
 	GRPCMethodMatch                           = gatewayv1.GRPCMethodMatch
 	GRPCMethodMatchType                       = gatewayv1.GRPCMethodMatchType
 	GRPCRoute                                 = gatewayv1.GRPCRoute
+	GRPCRouteFilter                           = gatewayv1.GRPCRouteFilter
+	GRPCRouteFilterType                       = gatewayv1.GRPCRouteFilterType
 	GRPCRouteList                             = gatewayv1.GRPCRouteList
 	GRPCRouteMatch                            = gatewayv1.GRPCRouteMatch
 	GRPCRouteRule                             = gatewayv1.GRPCRouteRule
@@ -152,6 +164,10 @@ const (
 	GRPCHeaderMatchExact                  = gatewayv1.GRPCHeaderMatchExact
 	GRPCMethodMatchExact                  = gatewayv1.GRPCMethodMatchExact
 	GRPCMethodMatchRegularExpression      = gatewayv1.GRPCMethodMatchRegularExpression
+	GRPCRouteFilterExtensionRef           = gatewayv1.GRPCRouteFilterExtensionRef
+	GRPCRouteFilterRequestHeaderModifier  = gatewayv1.GRPCRouteFilterRequestHeaderModifier
+	GRPCRouteFilterRequestMirror          = gatewayv1.GRPCRouteFilterRequestMirror
+	GRPCRouteFilterResponseHeaderModifier = gatewayv1.GRPCRouteFilterResponseHeaderModifier
 	HostnameAddressType                   = gatewayv1.HostnameAddressType
 	IPAddressType                         = gatewayv1.IPAddressType
 	ListenerConditionAccepted             = gatewayv1.ListenerConditionAccepted
@@ -0,0 +1,135 @@
+package gatewayapi
+
+import (
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// ListenerSetKind is the kind of the ListenerSet resource, which can be used
+// as a route's parentRef to attach the route to the ListenerSet's listeners.
+const ListenerSetKind = "ListenerSet"
+
+// IsListenerSetParentRef reports whether parentRef points at a ListenerSet.
+func IsListenerSetParentRef(parentRef ParentReference) bool {
+	if parentRef.Group != nil && *parentRef.Group != "" && string(*parentRef.Group) != GroupVersion.Group {
+		return false
+	}
+	return parentRef.Kind != nil && *parentRef.Kind == ListenerSetKind
+}
+
+// ListenerSetParentGateway returns the namespaced name of the Gateway referenced
+// by the ListenerSet's spec.parentRef. It returns false if the parentRef does not
+// point at a Gateway.
+func ListenerSetParentGateway(listenerSet *ListenerSet) (k8stypes.NamespacedName, bool) {
+	parentRef := listenerSet.Spec.ParentRef
+	if parentRef.Group != nil && *parentRef.Group != "" && string(*parentRef.Group) != GroupVersion.Group {
+		return k8stypes.NamespacedName{}, false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "" && *parentRef.Kind != "Gateway" {
+		return k8stypes.NamespacedName{}, false
+	}
+	namespace := listenerSet.Namespace
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		namespace = string(*parentRef.Namespace)
+	}
+	return k8stypes.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}, true
+}
+
+// ListenerSetListeners returns the listener entries of the ListenerSet as Gateway
+// listeners, so that routes can be matched against them the same way as against
+// the listeners of a Gateway.
+func ListenerSetListeners(listenerSet *ListenerSet) []Listener {
+	return lo.Map(listenerSet.Spec.Listeners, func(entry ListenerEntry, _ int) Listener {
+		return Listener{
+			Name:          entry.Name,
+			Hostname:      entry.Hostname,
+			Port:          entry.Port,
+			Protocol:      entry.Protocol,
+			TLS:           entry.TLS,
+			AllowedRoutes: entry.AllowedRoutes,
+		}
+	})
+}
+
+// ListenerSetListenerStatuses returns the statuses of the ListenerSet's listener
+// entries as Gateway listener statuses. Listener entries use the same condition
+// types as Gateway listeners, so the returned statuses can be checked with the
+// helpers used for Gateways.
+func ListenerSetListenerStatuses(listenerSet *ListenerSet) []ListenerStatus {
+	return lo.Map(listenerSet.Status.Listeners, func(status ListenerEntryStatus, _ int) ListenerStatus {
+		return ListenerStatus{
+			Name:           status.Name,
+			SupportedKinds: status.SupportedKinds,
+			AttachedRoutes: status.AttachedRoutes,
+			Conditions:     status.Conditions,
+		}
+	})
+}
+
+// ListenerSetAcceptedListeners returns the listener entries of the ListenerSet
+// which have been accepted by the controller of its parent Gateway, as reported
+// in the ListenerSet's status.
+func ListenerSetAcceptedListeners(listenerSet *ListenerSet) []Listener {
+	accepted := make(map[SectionName]struct{}, len(listenerSet.Status.Listeners))
+	for _, status := range listenerSet.Status.Listeners {
+		if lo.ContainsBy(status.Conditions, func(cond metav1.Condition) bool {
+			return cond.Type == string(ListenerConditionAccepted) && cond.Status == metav1.ConditionTrue
+		}) {
+			accepted[status.Name] = struct{}{}
+		}
+	}
+	return lo.Filter(ListenerSetListeners(listenerSet), func(listener Listener, _ int) bool {
+		_, ok := accepted[listener.Name]
+		return ok
+	})
+}
+
+// GatewayGetter resolves a Gateway object by namespace and name.
+type GatewayGetter func(namespace, name string) (*Gateway, error)
+
+// ListenerSetGetter resolves a ListenerSet object by namespace and name.
+type ListenerSetGetter func(namespace, name string) (*ListenerSet, error)
+
+// ParentRefListeners resolves a parentRef of a route from routeNamespace to the
+// Gateway serving the route and the listeners the parentRef targets: the Gateway's
+// listeners for a Gateway parentRef, or the accepted listener entries of the
+// ListenerSet for a ListenerSet parentRef. Like the parentRef's sectionName, the
+// returned listeners are not filtered. It returns false if the parentRef points at
+// another kind of resource or if the referenced objects cannot be resolved.
+func ParentRefListeners(
+	parentRef ParentReference, routeNamespace string, getGateway GatewayGetter, getListenerSet ListenerSetGetter,
+) (*Gateway, []Listener, bool) {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+		namespace = string(*parentRef.Namespace)
+	}
+
+	if IsListenerSetParentRef(parentRef) {
+		listenerSet, err := getListenerSet(namespace, string(parentRef.Name))
+		if err != nil {
+			return nil, nil, false
+		}
+		gatewayNN, ok := ListenerSetParentGateway(listenerSet)
+		if !ok {
+			return nil, nil, false
+		}
+		gateway, err := getGateway(gatewayNN.Namespace, gatewayNN.Name)
+		if err != nil {
+			return nil, nil, false
+		}
+		return gateway, ListenerSetAcceptedListeners(listenerSet), true
+	}
+
+	if parentRef.Group != nil && *parentRef.Group != "" && string(*parentRef.Group) != GroupVersion.Group {
+		return nil, nil, false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "" && *parentRef.Kind != "Gateway" {
+		return nil, nil, false
+	}
+	gateway, err := getGateway(namespace, string(parentRef.Name))
+	if err != nil {
+		return nil, nil, false
+	}
+	return gateway, gateway.Spec.Listeners, true
+}
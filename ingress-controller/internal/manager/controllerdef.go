@@ -4,7 +4,9 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -65,6 +67,7 @@ func setupControllers(
 	featureGates managercfg.FeatureGates,
 	kongAdminAPIEndpointsNotifier configuration.EndpointsNotifier,
 	adminAPIsDiscoverer configuration.AdminAPIsDiscoverer,
+	upstreamTargetsHealth configuration.UpstreamTargetsHealthProvider,
 ) []ControllerDef {
 	// Resolve which ReferenceGrant API version (v1 or v1beta1) is served by the
 	// cluster, so the ReferenceGrant DynamicCRDController waits on the version
@@ -75,6 +78,10 @@ func setupControllers(
 		referenceGrantGV = schema.GroupVersion(gatewayv1.GroupVersion)
 	}
 
+	partitionServiceNNs := lo.SliceToMap(c.KongAdminSvcPartitions, func(p managercfg.ConfigPartition) (k8stypes.NamespacedName, string) {
+		return p.KongAdminSvc, p.Name
+	})
+
 	controllers := []ControllerDef{
 		// ---------------------------------------------------------------------------
 		// Kong Gateway Admin API Service discovery
@@ -84,6 +91,7 @@ func setupControllers(
 			Controller: &configuration.KongAdminAPIServiceReconciler{
 				Client:              mgr.GetClient(),
 				ServiceNN:           c.KongAdminSvc.OrEmpty(),
+				PartitionServiceNNs: partitionServiceNNs,
 				Log:                 ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongAdminAPIService"),
 				CacheSyncTimeout:    c.CacheSyncTimeout,
 				EndpointsNotifier:   kongAdminAPIEndpointsNotifier,
@@ -260,6 +268,7 @@ func setupControllers(
 				}),
 				IngressClassName:           c.IngressClassName,
 				DisableIngressClassLookups: !c.IngressClassNetV1Enabled,
+				UpstreamTargetsHealth:      upstreamTargetsHealth,
 			},
 		},
 		{
@@ -327,6 +336,26 @@ func setupControllers(
 				},
 			},
 		},
+		{
+			Enabled: c.GatewayAPIGatewayController,
+			Controller: &crds.DynamicCRDController{
+				Manager:          mgr,
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Dynamic/ListenerSet"),
+				CacheSyncTimeout: c.CacheSyncTimeout,
+				RequiredCRDs: append(baseGatewayCRDs(), schema.GroupVersionResource{
+					Group:    gatewayv1.GroupVersion.Group,
+					Version:  gatewayv1.GroupVersion.Version,
+					Resource: "listenersets",
+				}),
+				Controller: &gateway.ListenerSetReconciler{
+					Client:           mgr.GetClient(),
+					Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("ListenerSet"),
+					Scheme:           mgr.GetScheme(),
+					DataplaneClient:  dataplaneClient,
+					CacheSyncTimeout: c.CacheSyncTimeout,
+				},
+			},
+		},
 		{
 			Enabled: c.GatewayAPIHTTPRouteController,
 			Controller: &crds.DynamicCRDController{
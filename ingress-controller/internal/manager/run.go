@@ -25,6 +25,7 @@ import (
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/adminapi"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/admission"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/clients"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/configuration"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/gateway"
 	ctrlref "github.com/kong/kong-operator/v2/ingress-controller/internal/controllers/reference"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane"
@@ -43,6 +44,7 @@ import (
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/manager/kongconfig"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/metrics"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/upstreamhealth"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/util/kubernetes/object/status"
 	"github.com/kong/kong-operator/v2/ingress-controller/internal/versions"
@@ -168,6 +170,7 @@ func New(
 		SanitizeKonnectConfigDumps:    c.FeatureGates.Enabled(managercfg.SanitizeKonnectConfigDumpsFeature),
 		FallbackConfiguration:         c.FeatureGates.Enabled(managercfg.FallbackConfigurationFeature),
 		UseLastValidConfigForFallback: c.UseLastValidConfigForFallback,
+		IncrementalTranslation:        c.FeatureGates.Enabled(managercfg.IncrementalTranslationFeature),
 	}
 
 	setupLog.Info("Configuring and building the controller manager")
@@ -233,6 +236,9 @@ func New(
 		translator.Config{
 			ClusterDomain:      c.ClusterDomain,
 			EnableDrainSupport: c.EnableDrainSupport,
+
+			IncrementalTranslation:                    kongConfig.IncrementalTranslation,
+			IncrementalTranslationFullRebuildInterval: c.IncrementalTranslationFullRebuildInterval,
 		},
 	)
 	if err != nil {
@@ -249,6 +255,16 @@ func New(
 	if dc, ok := diagnosticsClient.Get(); ok {
 		dataplaneClientOpts = append(dataplaneClientOpts, dataplane.WithDiagnosticsClient(dc))
 	}
+	if len(c.KongAdminSvcPartitions) > 0 {
+		if !dbMode.IsDBLessMode() {
+			return nil, errors.New("configuration partitions are only supported with DB-less Kong Gateways")
+		}
+		configPartitions, err := dataplane.NewConfigPartitions(c.KongAdminSvcPartitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create configuration partitions: %w", err)
+		}
+		dataplaneClientOpts = append(dataplaneClientOpts, dataplane.WithConfigPartitions(configPartitions))
+	}
 	dataplaneClient, err := dataplane.NewKongClient(
 		logger,
 		c.ProxySyncTimeout,
@@ -269,6 +285,23 @@ func New(
 		return nil, fmt.Errorf("failed to initialize kong data-plane client: %w", err)
 	}
 
+	var upstreamTargetsHealth configuration.UpstreamTargetsHealthProvider
+	if c.FeatureGates.Enabled(managercfg.UpstreamTargetHealthFeature) {
+		setupLog.Info("Initializing upstream targets health watcher")
+		upstreamHealthWatcher := upstreamhealth.NewWatcher(
+			logger.WithName("upstream-targets-health"),
+			c.UpstreamTargetHealthPeriod,
+			clientsManager,
+			eventRecorder,
+			metricsRecorder,
+		)
+		if err := mgr.Add(upstreamHealthWatcher); err != nil {
+			return nil, fmt.Errorf("failed adding upstream targets health watcher runnable to the manager: %w", err)
+		}
+		dataplaneClient.SetUpstreamsUpdater(upstreamHealthWatcher)
+		upstreamTargetsHealth = upstreamHealthWatcher
+	}
+
 	setupLog.Info("Initializing Dataplane Synchronizer")
 	synchronizer, err := setupDataplaneSynchronizer(logger, mgr, dataplaneClient, c.ProxySyncInterval, c.InitCacheSyncDuration)
 	if err != nil {
@@ -306,7 +339,7 @@ func New(
 	if err != nil {
 		return nil, fmt.Errorf("unable to create all namespaces client for admission validator: %w", err)
 	}
-	m.kongValidator = admission.NewKongHTTPValidator(
+	kongValidator := admission.NewKongHTTPValidator(
 		logger,
 		allNamespacesClient,
 		c.IngressClassName,
@@ -316,6 +349,8 @@ func New(
 		storer,
 		referenceIndexers,
 	)
+	kongValidator.RejectConflictingRoutes = c.FeatureGates.Enabled(managercfg.RejectConflictingRoutesFeature)
+	m.kongValidator = kongValidator
 
 	setupLog.Info("Starting enabled Controllers")
 	controllers := setupControllers(
@@ -329,6 +364,7 @@ func New(
 		c.FeatureGates,
 		clientsManager,
 		adminAPIsDiscoverer,
+		upstreamTargetsHealth,
 	)
 	for _, c := range controllers {
 		if err := c.MaybeSetupWithManager(mgr); err != nil {
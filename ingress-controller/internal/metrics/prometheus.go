@@ -23,6 +23,7 @@ type Recorder interface {
 	RecordProcessedConfigSnapshotCacheMiss()
 	RecordTranslationFailure(duration time.Duration)
 	RecordTranslationBrokenResources(count int)
+	RecordTranslationRouteConflicts(count int)
 	RecordTranslationSuccess(duration time.Duration)
 	RecordFallbackTranslationBrokenResources(count int)
 	RecordFallbackTranslationFailure(duration time.Duration)
@@ -76,6 +77,17 @@ const (
 	InstanceIDKey string = "instance_id"
 )
 
+const (
+	// ServiceKey defines the name of the metric label indicating which Kubernetes Service this time series is relevant for.
+	ServiceKey string = "service"
+
+	// UpstreamKey defines the name of the metric label indicating which Kong upstream this time series is relevant for.
+	UpstreamKey string = "upstream"
+
+	// TargetKey defines the name of the metric label indicating which Kong upstream target this time series is relevant for.
+	TargetKey string = "target"
+)
+
 // Regular config push metrics names.
 const (
 	MetricNameConfigPushCount            = "ingress_controller_configuration_push_count"
@@ -84,6 +96,7 @@ const (
 	MetricNameConfigPushSize             = "ingress_controller_configuration_push_size"
 	MetricNameTranslationCount           = "ingress_controller_translation_count"
 	MetricNameTranslationBrokenResources = "ingress_controller_translation_broken_resource_count"
+	MetricNameTranslationRouteConflicts  = "ingress_controller_translation_route_conflict_count"
 	MetricNameTranslationDuration        = "ingress_controller_translation_duration_milliseconds"
 	MetricNameConfigPushDuration         = "ingress_controller_configuration_push_duration_milliseconds"
 )
@@ -103,6 +116,11 @@ const (
 	MetricNameProcessedConfigSnapshotCacheMiss   = "ingress_controller_processed_config_snapshot_cache_miss"
 )
 
+// Upstream target health metrics names.
+const (
+	MetricNameUpstreamTargetHealth = "ingress_controller_upstream_target_health"
+)
+
 // Metrics definitions for GlobalCtrlRuntimeMetricsRecorder.
 var (
 	configPushCount = prometheus.NewCounterVec(
@@ -183,6 +201,18 @@ var (
 		[]string{InstanceIDKey},
 	)
 
+	translationRouteConflicts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameTranslationRouteConflicts,
+			Help: fmt.Sprintf("The number of Kong routes that are shadowed by, or ambiguous with, routes translated "+
+				"from other resources. "+
+				"`%s` describes the instance of the controller that pushed the configuration.",
+				InstanceIDKey,
+			),
+		},
+		[]string{InstanceIDKey},
+	)
+
 	configPushDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: MetricNameConfigPushDuration,
@@ -397,6 +427,22 @@ var (
 	)
 )
 
+var upstreamTargetHealth = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricNameUpstreamTargetHealth,
+		Help: fmt.Sprintf("Health of the Kong upstream targets as reported by the Kong Gateways: 1 when the target "+
+			"is healthy, 0 otherwise. "+
+			"`%s` describes the data-plane reporting the health. "+
+			"`%s` describes the Kubernetes Service backing the upstream. "+
+			"`%s` describes the Kong upstream. "+
+			"`%s` describes the target address. "+
+			"`%s` describes the instance of the controller that read the health.",
+			DataplaneKey, ServiceKey, UpstreamKey, TargetKey, InstanceIDKey,
+		),
+	},
+	[]string{DataplaneKey, ServiceKey, UpstreamKey, TargetKey, InstanceIDKey},
+)
+
 func init() {
 	allMetrics := []prometheus.Collector{
 		configPushCount,
@@ -404,6 +450,7 @@ func init() {
 		translationCount,
 		translationDuration,
 		translationBrokenResources,
+		translationRouteConflicts,
 		configPushDuration,
 		configPushSize,
 		configPushSuccessTime,
@@ -418,6 +465,7 @@ func init() {
 		fallbackCacheGeneratingDuration,
 		processedConfigSnapshotCacheHit,
 		processedConfigSnapshotCacheMiss,
+		upstreamTargetHealth,
 	}
 	for _, m := range allMetrics {
 		metrics.Registry.MustRegister(m)
@@ -493,6 +541,13 @@ func (c *GlobalCtrlRuntimeMetricsRecorder) RecordTranslationBrokenResources(coun
 	}).Set(float64(count))
 }
 
+// RecordTranslationRouteConflicts records the number of conflicting routes found in the translated configuration.
+func (c *GlobalCtrlRuntimeMetricsRecorder) RecordTranslationRouteConflicts(count int) {
+	translationRouteConflicts.With(prometheus.Labels{
+		InstanceIDKey: c.instanceID.String(),
+	}).Set(float64(count))
+}
+
 // RecordFallbackTranslationFailure records a failed fallback configuration translation.
 func (c *GlobalCtrlRuntimeMetricsRecorder) RecordFallbackTranslationFailure(duration time.Duration) {
 	fallbackTranslationCount.With(prometheus.Labels{
@@ -573,6 +628,30 @@ func (c *GlobalCtrlRuntimeMetricsRecorder) RecordFallbackCacheGenerationDuration
 	fallbackCacheGeneratingDuration.With(labels).Observe(float64(d.Milliseconds()))
 }
 
+// RecordUpstreamTargetHealth records the health of an upstream target reported by a data-plane.
+func (c *GlobalCtrlRuntimeMetricsRecorder) RecordUpstreamTargetHealth(dataplane, service, upstream, target string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	upstreamTargetHealth.With(c.upstreamTargetHealthLabels(dataplane, service, upstream, target)).Set(value)
+}
+
+// ForgetUpstreamTargetHealth removes the health of an upstream target no longer reported by a data-plane.
+func (c *GlobalCtrlRuntimeMetricsRecorder) ForgetUpstreamTargetHealth(dataplane, service, upstream, target string) {
+	upstreamTargetHealth.Delete(c.upstreamTargetHealthLabels(dataplane, service, upstream, target))
+}
+
+func (c *GlobalCtrlRuntimeMetricsRecorder) upstreamTargetHealthLabels(dataplane, service, upstream, target string) prometheus.Labels {
+	return prometheus.Labels{
+		DataplaneKey:  dataplane,
+		ServiceKey:    service,
+		UpstreamKey:   upstream,
+		TargetKey:     target,
+		InstanceIDKey: c.instanceID.String(),
+	}
+}
+
 type recordOption func(prometheus.Labels) prometheus.Labels
 
 func withError(err error) recordOption {
@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	deckutils "github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	prom "github.com/prometheus/client_model/go"
 	"github.com/samber/lo"
 	"github.com/samber/mo"
@@ -95,6 +97,7 @@ func TestRecordTranslation(t *testing.T) {
 		require.NotPanics(t, func() {
 			m.RecordTranslationSuccess(10 * time.Millisecond)
 			m.RecordTranslationBrokenResources(0)
+			m.RecordTranslationRouteConflicts(2)
 		})
 	})
 	t.Run("recording translation failure works", func(t *testing.T) {
@@ -105,6 +108,26 @@ func TestRecordTranslation(t *testing.T) {
 	})
 }
 
+func TestRecordUpstreamTargetHealth(t *testing.T) {
+	m := NewGlobalCtrlRuntimeMetricsRecorder(uuid.New())
+	labels := prometheus.Labels{
+		DataplaneKey:  "https://10.0.0.1:8444",
+		ServiceKey:    "default/echo",
+		UpstreamKey:   "echo.default.80.svc",
+		TargetKey:     "10.244.0.5:80",
+		InstanceIDKey: m.instanceID.String(),
+	}
+
+	m.RecordUpstreamTargetHealth("https://10.0.0.1:8444", "default/echo", "echo.default.80.svc", "10.244.0.5:80", false)
+	require.Equal(t, 0.0, testutil.ToFloat64(upstreamTargetHealth.With(labels)))
+
+	m.RecordUpstreamTargetHealth("https://10.0.0.1:8444", "default/echo", "echo.default.80.svc", "10.244.0.5:80", true)
+	require.Equal(t, 1.0, testutil.ToFloat64(upstreamTargetHealth.With(labels)))
+
+	m.ForgetUpstreamTargetHealth("https://10.0.0.1:8444", "default/echo", "echo.default.80.svc", "10.244.0.5:80")
+	require.False(t, upstreamTargetHealth.Delete(labels), "series should have already been removed")
+}
+
 func TestPushFailureReason(t *testing.T) {
 	apiConflictErr := kong.NewAPIError(http.StatusConflict, "conflict api error")
 	networkErr := net.UnknownNetworkError("network error")
@@ -41,6 +41,7 @@ type FakeObjects struct {
 	Gateways           []*gatewayapi.Gateway
 	GatewayClasses     []*gatewayapi.GatewayClass
 	BackendTLSPolicies []*gatewayapi.BackendTLSPolicy
+	ListenerSets       []*gatewayapi.ListenerSet
 
 	IngressClassParametersV1alpha1 []*configurationv1alpha1.IngressClassParameters
 	Services                       []*corev1.Service
@@ -140,6 +141,12 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	listenerSetStore := cache.NewStore(namespacedKeyFunc)
+	for _, listenerSet := range objects.ListenerSets {
+		if err := listenerSetStore.Add(listenerSet); err != nil {
+			return nil, err
+		}
+	}
 
 	serviceStore := cache.NewStore(namespacedKeyFunc)
 	for _, s := range objects.Services {
@@ -244,6 +251,7 @@ func NewFakeStore(
 			Gateway:          gatewayStore,
 			GatewayClass:     gatewayClassStore,
 			BackendTLSPolicy: backendTLSPolicyStore,
+			ListenerSet:      listenerSetStore,
 
 			Service:       serviceStore,
 			EndpointSlice: endpointSliceStore,
@@ -296,6 +304,7 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 		reflect.TypeFor[*gatewayapi.Gateway]():                           schema.GroupVersion(gatewayv1.GroupVersion).WithKind("Gateway"),
 		reflect.TypeFor[*gatewayapi.GatewayClass]():                      schema.GroupVersion(gatewayv1.GroupVersion).WithKind("GatewayClass"),
 		reflect.TypeFor[*gatewayapi.BackendTLSPolicy]():                  schema.GroupVersion(gatewayv1alpha3.GroupVersion).WithKind("BackendTLSPolicy"),
+		reflect.TypeFor[*gatewayapi.ListenerSet]():                       schema.GroupVersion(gatewayv1.GroupVersion).WithKind("ListenerSet"),
 		reflect.TypeFor[*configurationv1alpha1.IngressClassParameters](): configurationv1alpha1.SchemeGroupVersion.WithKind("IngressClassParameters"),
 		reflect.TypeFor[*corev1.Service]():                               corev1.SchemeGroupVersion.WithKind("Service"),
 		reflect.TypeFor[*discoveryv1.EndpointSlice]():                    discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"),
@@ -340,6 +349,7 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Gateways)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.GatewayClasses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.BackendTLSPolicies)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.ListenerSets)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.IngressClassParametersV1alpha1)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Services)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.EndpointSlices)...)
@@ -95,6 +95,7 @@ type Storer interface {
 
 	// Gateway API resources.
 	GetGateway(namespace string, name string) (*gatewayapi.Gateway, error)
+	GetListenerSet(namespace string, name string) (*gatewayapi.ListenerSet, error)
 	GetGatewayClass(name string) (*gatewayapi.GatewayClass, error)
 	ListHTTPRoutes() ([]*gatewayapi.HTTPRoute, error)
 	ListUDPRoutes() ([]*gatewayapi.UDPRoute, error)
@@ -103,6 +104,7 @@ type Storer interface {
 	ListGRPCRoutes() ([]*gatewayapi.GRPCRoute, error)
 	ListReferenceGrants() ([]*gatewayapi.ReferenceGrant, error)
 	ListGateways() ([]*gatewayapi.Gateway, error)
+	ListListenerSets() ([]*gatewayapi.ListenerSet, error)
 	ListBackendTLSPoliciesByTargetService(service k8stypes.NamespacedName) ([]*gatewayapi.BackendTLSPolicy, error)
 }
 
@@ -323,6 +325,8 @@ func GetTypedStore[T client.Object](cs CacheStores) (cache.Store, error) {
 		return cs.Gateway, nil
 	case *gatewayapi.BackendTLSPolicy:
 		return cs.BackendTLSPolicy, nil
+	case *gatewayapi.ListenerSet:
+		return cs.ListenerSet, nil
 	case *configurationv1.KongPlugin:
 		return cs.Plugin, nil
 	default:
@@ -365,6 +369,11 @@ func (s Store) ListGateways() ([]*gatewayapi.Gateway, error) {
 	return List[*gatewayapi.Gateway](s.stores)
 }
 
+// ListListenerSets returns the list of ListenerSets in the ListenerSet cache store.
+func (s Store) ListListenerSets() ([]*gatewayapi.ListenerSet, error) {
+	return List[*gatewayapi.ListenerSet](s.stores)
+}
+
 // ListBackendTLSPoliciesByTargetService returns the list of BackendTLSPolicies in the BackendTLSPolicy cache store.
 // The policies are filtered by the target service.
 func (s Store) ListBackendTLSPoliciesByTargetService(service k8stypes.NamespacedName) ([]*gatewayapi.BackendTLSPolicy, error) {
@@ -560,6 +569,19 @@ func (s Store) GetGateway(namespace string, name string) (*gatewayapi.Gateway, e
 	return obj.(*gatewayapi.Gateway), nil
 }
 
+// GetListenerSet returns the ListenerSet resource having the specified namespace and name.
+func (s Store) GetListenerSet(namespace string, name string) (*gatewayapi.ListenerSet, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	obj, exists, err := s.stores.ListenerSet.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("ListenerSet %v not found", name)}
+	}
+	return obj.(*gatewayapi.ListenerSet), nil
+}
+
 // GetGatewayClass returns the GatewayClass resource having the specified name.
 func (s Store) GetGatewayClass(name string) (*gatewayapi.GatewayClass, error) {
 	obj, exists, err := s.stores.GatewayClass.GetByKey(name)
@@ -808,6 +830,8 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &gatewayapi.ReferenceGrant{}, nil
 	case schema.GroupVersion(gatewayv1alpha3.GroupVersion).WithKind("BackendTLSPolicy"):
 		return &gatewayapi.BackendTLSPolicy{}, nil
+	case schema.GroupVersion(gatewayv1.GroupVersion).WithKind("ListenerSet"):
+		return &gatewayapi.ListenerSet{}, nil
 	// ----------------------------------------------------------------------------
 	// Kong APIs
 	// ----------------------------------------------------------------------------
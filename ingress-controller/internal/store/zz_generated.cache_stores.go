@@ -39,6 +39,7 @@ type CacheStores struct {
 	Gateway                        cache.Store
 	GatewayClass                   cache.Store
 	BackendTLSPolicy               cache.Store
+	ListenerSet                    cache.Store
 	Plugin                         cache.Store
 	ClusterPlugin                  cache.Store
 	Consumer                       cache.Store
@@ -71,6 +72,7 @@ func NewCacheStores() CacheStores {
 		Gateway:                        cache.NewStore(namespacedKeyFunc),
 		GatewayClass:                   cache.NewStore(clusterWideKeyFunc),
 		BackendTLSPolicy:               cache.NewStore(namespacedKeyFunc),
+		ListenerSet:                    cache.NewStore(namespacedKeyFunc),
 		Plugin:                         cache.NewStore(namespacedKeyFunc),
 		ClusterPlugin:                  cache.NewStore(clusterWideKeyFunc),
 		Consumer:                       cache.NewStore(namespacedKeyFunc),
@@ -123,6 +125,8 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.GatewayClass.Get(obj)
 	case *gatewayapi.BackendTLSPolicy:
 		return c.BackendTLSPolicy.Get(obj)
+	case *gatewayapi.ListenerSet:
+		return c.ListenerSet.Get(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Get(obj)
 	case *kongv1.KongClusterPlugin:
@@ -184,6 +188,8 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.GatewayClass.Add(obj)
 	case *gatewayapi.BackendTLSPolicy:
 		return c.BackendTLSPolicy.Add(obj)
+	case *gatewayapi.ListenerSet:
+		return c.ListenerSet.Add(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Add(obj)
 	case *kongv1.KongClusterPlugin:
@@ -245,6 +251,8 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.GatewayClass.Delete(obj)
 	case *gatewayapi.BackendTLSPolicy:
 		return c.BackendTLSPolicy.Delete(obj)
+	case *gatewayapi.ListenerSet:
+		return c.ListenerSet.Delete(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Delete(obj)
 	case *kongv1.KongClusterPlugin:
@@ -286,6 +294,7 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.Gateway,
 		c.GatewayClass,
 		c.BackendTLSPolicy,
+		c.ListenerSet,
 		c.Plugin,
 		c.ClusterPlugin,
 		c.Consumer,
@@ -317,6 +326,7 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&gatewayapi.Gateway{},
 		&gatewayapi.GatewayClass{},
 		&gatewayapi.BackendTLSPolicy{},
+		&gatewayapi.ListenerSet{},
 		&kongv1.KongPlugin{},
 		&kongv1.KongClusterPlugin{},
 		&kongv1.KongConsumer{},
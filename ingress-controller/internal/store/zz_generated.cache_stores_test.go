@@ -88,6 +88,10 @@ func TestCacheStores(t *testing.T) {
 			name:          "BackendTLSPolicy",
 			objectToStore: &gatewayapi.BackendTLSPolicy{},
 		},
+		{
+			name:          "ListenerSet",
+			objectToStore: &gatewayapi.ListenerSet{},
+		},
 		{
 			name:          "KongPlugin",
 			objectToStore: &kongv1.KongPlugin{},
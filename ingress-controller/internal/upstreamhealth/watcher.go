@@ -0,0 +1,345 @@
+package upstreamhealth
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/adminapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/logging"
+)
+
+const (
+	// DefaultPeriod is the default period of reading the health of the upstream targets from Kong Gateways.
+	DefaultPeriod = 30 * time.Second
+
+	// notificationsBufferSize is the size of the buffer of the channel notifying about Services whose targets'
+	// health changed.
+	notificationsBufferSize = 1024
+)
+
+const (
+	// HealthHealthy is the health Kong reports for the targets considered healthy by its health checks.
+	HealthHealthy = "HEALTHY"
+	// HealthHealthchecksOff is the health Kong reports for the targets of upstreams without health checks.
+	HealthHealthchecksOff = "HEALTHCHECKS_OFF"
+)
+
+const (
+	// TargetUnhealthyEventReason is the reason of the Warning events recorded on Services when a Kong Gateway stops
+	// considering a target of the Service's upstream healthy.
+	TargetUnhealthyEventReason = "KongUpstreamTargetUnhealthy"
+	// TargetHealthyEventReason is the reason of the Normal events recorded on Services when all Kong Gateways consider
+	// a previously unhealthy target of the Service's upstream healthy again.
+	TargetHealthyEventReason = "KongUpstreamTargetHealthy"
+)
+
+// GatewayClientsProvider provides the Admin API clients of the Kong Gateways.
+type GatewayClientsProvider interface {
+	GatewayClients() []*adminapi.Client
+}
+
+// MetricsRecorder records the health of the upstream targets.
+type MetricsRecorder interface {
+	RecordUpstreamTargetHealth(dataplane, service, upstream, target string, healthy bool)
+	ForgetUpstreamTargetHealth(dataplane, service, upstream, target string)
+}
+
+// TargetHealth is the health of an upstream target as reported by the Kong Gateways.
+type TargetHealth struct {
+	// Upstream is the name of the Kong upstream of the target.
+	Upstream string
+	// Target is the address of the target.
+	Target string
+	// Gateways is the number of Kong Gateways that reported the health of the target.
+	Gateways int
+	// UnhealthyGateways maps the addresses of the Kong Gateways not considering the target healthy to the health
+	// they report (e.g. UNHEALTHY or DNS_ERROR).
+	UnhealthyGateways map[string]string
+}
+
+// Healthy tells whether all the Kong Gateways consider the target healthy.
+func (t TargetHealth) Healthy() bool {
+	return len(t.UnhealthyGateways) == 0
+}
+
+// String describes the target's health.
+func (t TargetHealth) String() string {
+	if t.Healthy() {
+		return fmt.Sprintf("%s of upstream %s is healthy", t.Target, t.Upstream)
+	}
+	healths := slices.Sorted(maps.Values(t.UnhealthyGateways))
+	return fmt.Sprintf("%s of upstream %s is %s on %d/%d gateways",
+		t.Target, t.Upstream, strings.Join(slices.Compact(healths), ","), len(t.UnhealthyGateways), t.Gateways,
+	)
+}
+
+// ServiceTargetsHealth is the health of the targets of the upstreams of a Kubernetes Service, sorted by upstream
+// and target.
+type ServiceTargetsHealth []TargetHealth
+
+// Unhealthy returns the targets not considered healthy by all the Kong Gateways.
+func (h ServiceTargetsHealth) Unhealthy() ServiceTargetsHealth {
+	var unhealthy ServiceTargetsHealth
+	for _, t := range h {
+		if !t.Healthy() {
+			unhealthy = append(unhealthy, t)
+		}
+	}
+	return unhealthy
+}
+
+// Watcher periodically reads the health of the targets of the upstreams with health checks from the Kong Gateways.
+// It records events and metrics for the Services backing the upstreams and notifies about the Services whose
+// targets' health changed, so their KongUpstreamPolicies' status can be updated.
+type Watcher struct {
+	logger          logr.Logger
+	period          time.Duration
+	clientsProvider GatewayClientsProvider
+	eventRecorder   record.EventRecorder
+	metricsRecorder MetricsRecorder
+	notifications   chan event.GenericEvent
+
+	upstreamsLock sync.RWMutex
+	// upstreams maps the names of the upstreams with health checks to the Services backing them.
+	upstreams map[string][]*corev1.Service
+
+	healthLock sync.RWMutex
+	health     map[k8stypes.NamespacedName]ServiceTargetsHealth
+	// services are the Services whose targets' health was read in the previous round.
+	services map[k8stypes.NamespacedName]*corev1.Service
+	// series are the metric series recorded in the previous round, to forget the ones of removed targets.
+	series map[metricSeries]struct{}
+}
+
+type metricSeries struct {
+	dataplane, service, upstream, target string
+}
+
+// NewWatcher creates a Watcher reading the targets' health every period, or DefaultPeriod when period is not set.
+func NewWatcher(
+	logger logr.Logger,
+	period time.Duration,
+	clientsProvider GatewayClientsProvider,
+	eventRecorder record.EventRecorder,
+	metricsRecorder MetricsRecorder,
+) *Watcher {
+	if period <= 0 {
+		period = DefaultPeriod
+	}
+	return &Watcher{
+		logger:          logger,
+		period:          period,
+		clientsProvider: clientsProvider,
+		eventRecorder:   eventRecorder,
+		metricsRecorder: metricsRecorder,
+		notifications:   make(chan event.GenericEvent, notificationsBufferSize),
+		upstreams:       map[string][]*corev1.Service{},
+		health:          map[k8stypes.NamespacedName]ServiceTargetsHealth{},
+		services:        map[k8stypes.NamespacedName]*corev1.Service{},
+		series:          map[metricSeries]struct{}{},
+	}
+}
+
+var _ manager.LeaderElectionRunnable = &Watcher{}
+
+// Start reads the targets' health periodically until the context is done.
+func (w *Watcher) Start(ctx context.Context) error {
+	w.logger.Info("Starting upstream targets health watcher", "period", w.period)
+	ticker := time.NewTicker(w.period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Context done, stopping upstream targets health watcher")
+			return nil
+		case <-ticker.C:
+			w.Poll(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection returns true as only the leader records events and updates statuses.
+func (w *Watcher) NeedLeaderElection() bool {
+	return true
+}
+
+// UpdateUpstreams sets the upstreams whose targets' health is watched from the upstreams of the configuration
+// applied to the Kong Gateways. Only the upstreams with health checks are watched.
+func (w *Watcher) UpdateUpstreams(upstreams []kongstate.Upstream) {
+	watched := make(map[string][]*corev1.Service, len(upstreams))
+	for _, u := range upstreams {
+		if u.Name == nil || u.Healthchecks == nil || len(u.Service.K8sServices) == 0 {
+			continue
+		}
+		services := slices.Collect(maps.Values(u.Service.K8sServices))
+		sort.Slice(services, func(i, j int) bool {
+			return serviceNN(services[i]).String() < serviceNN(services[j]).String()
+		})
+		watched[*u.Name] = services
+	}
+
+	w.upstreamsLock.Lock()
+	defer w.upstreamsLock.Unlock()
+	w.upstreams = watched
+}
+
+// Subscribe returns the channel notified about the Services whose targets' health changed.
+func (w *Watcher) Subscribe() <-chan event.GenericEvent {
+	return w.notifications
+}
+
+// ServiceTargetsHealth returns the health of the targets of the Service's upstreams. It returns false when the
+// Service doesn't back any upstream with health checks or the health of its targets hasn't been read yet.
+func (w *Watcher) ServiceTargetsHealth(nn k8stypes.NamespacedName) (ServiceTargetsHealth, bool) {
+	w.healthLock.RLock()
+	defer w.healthLock.RUnlock()
+	h, ok := w.health[nn]
+	return h, ok
+}
+
+// Poll reads the health of the targets from the Kong Gateways once.
+func (w *Watcher) Poll(ctx context.Context) {
+	w.upstreamsLock.RLock()
+	upstreams := w.upstreams
+	w.upstreamsLock.RUnlock()
+
+	// targets maps upstream names to target addresses to Kong Gateways' addresses to the health they report.
+	targets := make(map[string]map[string]map[string]string, len(upstreams))
+	for _, cl := range w.clientsProvider.GatewayClients() {
+		gateway := cl.BaseRootURL()
+		for upstream := range upstreams {
+			nodes, err := cl.AdminAPIClient().UpstreamNodeHealth.ListAll(ctx, new(upstream))
+			if err != nil {
+				// The upstream may not be configured on the gateway yet (or at all when using configuration
+				// partitions), in which case there's nothing to report.
+				if !kong.IsNotFoundErr(err) {
+					w.logger.Error(err, "Failed to read upstream targets health", "upstream", upstream, "dataplane", gateway)
+				}
+				continue
+			}
+			for _, node := range nodes {
+				if node.Target == nil || node.Health == nil {
+					continue
+				}
+				if targets[upstream] == nil {
+					targets[upstream] = map[string]map[string]string{}
+				}
+				if targets[upstream][*node.Target] == nil {
+					targets[upstream][*node.Target] = map[string]string{}
+				}
+				targets[upstream][*node.Target][gateway] = *node.Health
+			}
+		}
+	}
+
+	health := map[k8stypes.NamespacedName]ServiceTargetsHealth{}
+	services := map[k8stypes.NamespacedName]*corev1.Service{}
+	series := map[metricSeries]struct{}{}
+	for _, upstream := range slices.Sorted(maps.Keys(targets)) {
+		for _, target := range slices.Sorted(maps.Keys(targets[upstream])) {
+			gateways := targets[upstream][target]
+			th := TargetHealth{
+				Upstream: upstream,
+				Target:   target,
+				Gateways: len(gateways),
+			}
+			for gateway, h := range gateways {
+				if h != HealthHealthy && h != HealthHealthchecksOff {
+					if th.UnhealthyGateways == nil {
+						th.UnhealthyGateways = map[string]string{}
+					}
+					th.UnhealthyGateways[gateway] = h
+				}
+			}
+			for _, svc := range upstreams[upstream] {
+				nn := serviceNN(svc)
+				services[nn] = svc
+				health[nn] = append(health[nn], th)
+				for gateway, h := range gateways {
+					s := metricSeries{dataplane: gateway, service: nn.String(), upstream: upstream, target: target}
+					series[s] = struct{}{}
+					w.metricsRecorder.RecordUpstreamTargetHealth(s.dataplane, s.service, s.upstream, s.target,
+						h == HealthHealthy || h == HealthHealthchecksOff,
+					)
+				}
+			}
+		}
+	}
+
+	w.healthLock.Lock()
+	previous, previousServices := w.health, w.services
+	for s := range w.series {
+		if _, ok := series[s]; !ok {
+			w.metricsRecorder.ForgetUpstreamTargetHealth(s.dataplane, s.service, s.upstream, s.target)
+		}
+	}
+	w.health = health
+	w.services = services
+	w.series = series
+	w.healthLock.Unlock()
+
+	for nn, h := range health {
+		w.recordEvents(services[nn], previous[nn], h)
+		if !healthEqual(previous[nn], h) {
+			w.notify(ctx, services[nn])
+		}
+	}
+	for nn := range previous {
+		if _, ok := health[nn]; !ok {
+			w.notify(ctx, previousServices[nn])
+		}
+	}
+}
+
+// recordEvents records events on the Service for its targets whose health changed since the previous round.
+func (w *Watcher) recordEvents(svc *corev1.Service, previous, current ServiceTargetsHealth) {
+	wasUnhealthy := map[string]bool{}
+	for _, t := range previous {
+		wasUnhealthy[t.Upstream+"/"+t.Target] = !t.Healthy()
+	}
+	for _, t := range current {
+		unhealthy, known := wasUnhealthy[t.Upstream+"/"+t.Target]
+		switch {
+		case !t.Healthy() && !unhealthy:
+			w.eventRecorder.Event(svc, corev1.EventTypeWarning, TargetUnhealthyEventReason, "Target "+t.String())
+		case t.Healthy() && known && unhealthy:
+			w.eventRecorder.Event(svc, corev1.EventTypeNormal, TargetHealthyEventReason, "Target "+t.String())
+		}
+	}
+}
+
+func (w *Watcher) notify(ctx context.Context, svc *corev1.Service) {
+	w.logger.V(logging.DebugLevel).Info("Upstream targets health changed", "service", serviceNN(svc))
+	select {
+	case w.notifications <- event.GenericEvent{Object: svc.DeepCopy()}:
+	case <-ctx.Done():
+	}
+}
+
+func healthEqual(a, b ServiceTargetsHealth) bool {
+	return slices.EqualFunc(a, b, func(x, y TargetHealth) bool {
+		return x.Upstream == y.Upstream &&
+			x.Target == y.Target &&
+			x.Gateways == y.Gateways &&
+			maps.Equal(x.UnhealthyGateways, y.UnhealthyGateways)
+	})
+}
+
+func serviceNN(svc *corev1.Service) k8stypes.NamespacedName {
+	return k8stypes.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}
+}
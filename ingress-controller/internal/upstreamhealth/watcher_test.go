@@ -0,0 +1,230 @@
+package upstreamhealth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/adminapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+)
+
+// fakeGateway serves the health of the targets of its upstreams.
+type fakeGateway struct {
+	lock sync.Mutex
+	// health maps upstream names to target addresses to their health.
+	health map[string]map[string]string
+}
+
+func (g *fakeGateway) set(upstream, target, health string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.health[upstream] == nil {
+		g.health[upstream] = map[string]string{}
+	}
+	g.health[upstream][target] = health
+}
+
+func (g *fakeGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	upstream, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/upstreams/"), "/health")
+	targets, found := g.health[upstream]
+	if !ok || !found {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not found"}`))
+		return
+	}
+	data := []kong.UpstreamNodeHealth{}
+	for target, health := range targets {
+		data = append(data, kong.UpstreamNodeHealth{Target: new(target), Health: new(health)})
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "next": nil})
+}
+
+type fakeClientsProvider []*adminapi.Client
+
+func (p fakeClientsProvider) GatewayClients() []*adminapi.Client {
+	return p
+}
+
+type fakeMetricsRecorder struct {
+	health map[metricSeries]bool
+}
+
+func (r *fakeMetricsRecorder) RecordUpstreamTargetHealth(dataplane, service, upstream, target string, healthy bool) {
+	r.health[metricSeries{dataplane: dataplane, service: service, upstream: upstream, target: target}] = healthy
+}
+
+func (r *fakeMetricsRecorder) ForgetUpstreamTargetHealth(dataplane, service, upstream, target string) {
+	delete(r.health, metricSeries{dataplane: dataplane, service: service, upstream: upstream, target: target})
+}
+
+func TestWatcher(t *testing.T) {
+	const upstream = "echo.default.80.svc"
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"konghq.com/upstream-policy": "policy",
+			},
+		},
+	}
+	svcNN := k8stypes.NamespacedName{Namespace: "default", Name: "echo"}
+
+	var (
+		gateways = []*fakeGateway{
+			{health: map[string]map[string]string{}},
+			{health: map[string]map[string]string{}},
+		}
+		clients fakeClientsProvider
+	)
+	for _, g := range gateways {
+		server := httptest.NewServer(g)
+		t.Cleanup(server.Close)
+		cl, err := adminapi.NewTestClient(server.URL)
+		require.NoError(t, err)
+		clients = append(clients, cl)
+		g.set(upstream, "10.0.0.1:80", HealthHealthy)
+		g.set(upstream, "10.0.0.2:80", HealthHealthy)
+	}
+
+	eventRecorder := record.NewFakeRecorder(10)
+	metricsRecorder := &fakeMetricsRecorder{health: map[metricSeries]bool{}}
+	w := NewWatcher(logr.Discard(), 0, clients, eventRecorder, metricsRecorder)
+	ctx := context.Background()
+
+	requireNotified := func(t *testing.T) {
+		t.Helper()
+		select {
+		case e := <-w.Subscribe():
+			require.Equal(t, svc, e.Object)
+		default:
+			require.Fail(t, "expected a notification about the Service")
+		}
+	}
+	requireNotNotified := func(t *testing.T) {
+		t.Helper()
+		select {
+		case e := <-w.Subscribe():
+			require.Failf(t, "unexpected notification", "%v", e.Object)
+		default:
+		}
+	}
+
+	t.Run("upstreams without health checks are not watched", func(t *testing.T) {
+		w.UpdateUpstreams([]kongstate.Upstream{
+			{
+				Upstream: kong.Upstream{Name: new(upstream)},
+				Service:  kongstate.Service{K8sServices: map[string]*corev1.Service{"default/echo": svc}},
+			},
+		})
+		w.Poll(ctx)
+
+		_, ok := w.ServiceTargetsHealth(svcNN)
+		require.False(t, ok)
+		require.Empty(t, metricsRecorder.health)
+		requireNotNotified(t)
+	})
+
+	w.UpdateUpstreams([]kongstate.Upstream{
+		{
+			Upstream: kong.Upstream{
+				Name:         new(upstream),
+				Healthchecks: &kong.Healthcheck{Active: &kong.ActiveHealthcheck{Type: new("http")}},
+			},
+			Service: kongstate.Service{K8sServices: map[string]*corev1.Service{"default/echo": svc}},
+		},
+		{
+			// Upstream not configured on the gateways.
+			Upstream: kong.Upstream{
+				Name:         new("missing.default.80.svc"),
+				Healthchecks: &kong.Healthcheck{Active: &kong.ActiveHealthcheck{Type: new("http")}},
+			},
+			Service: kongstate.Service{K8sServices: map[string]*corev1.Service{"default/echo": svc}},
+		},
+	})
+
+	t.Run("healthy targets", func(t *testing.T) {
+		w.Poll(ctx)
+
+		health, ok := w.ServiceTargetsHealth(svcNN)
+		require.True(t, ok)
+		require.Equal(t, ServiceTargetsHealth{
+			{Upstream: upstream, Target: "10.0.0.1:80", Gateways: 2},
+			{Upstream: upstream, Target: "10.0.0.2:80", Gateways: 2},
+		}, health)
+		require.Empty(t, health.Unhealthy())
+		require.Len(t, metricsRecorder.health, 4)
+		for s, healthy := range metricsRecorder.health {
+			require.Truef(t, healthy, "series %+v", s)
+		}
+		require.Empty(t, eventRecorder.Events)
+		requireNotified(t)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		w.Poll(ctx)
+		require.Empty(t, eventRecorder.Events)
+		requireNotNotified(t)
+	})
+
+	t.Run("target ejected by one of the gateways", func(t *testing.T) {
+		gateways[1].set(upstream, "10.0.0.2:80", "UNHEALTHY")
+		w.Poll(ctx)
+
+		health, ok := w.ServiceTargetsHealth(svcNN)
+		require.True(t, ok)
+		unhealthy := health.Unhealthy()
+		require.Len(t, unhealthy, 1)
+		require.Equal(t, "10.0.0.2:80 of upstream echo.default.80.svc is UNHEALTHY on 1/2 gateways", unhealthy[0].String())
+		require.False(t, metricsRecorder.health[metricSeries{
+			dataplane: clients[1].BaseRootURL(),
+			service:   "default/echo",
+			upstream:  upstream,
+			target:    "10.0.0.2:80",
+		}])
+		require.Equal(t,
+			"Warning KongUpstreamTargetUnhealthy Target 10.0.0.2:80 of upstream echo.default.80.svc is UNHEALTHY on 1/2 gateways",
+			<-eventRecorder.Events,
+		)
+		requireNotified(t)
+	})
+
+	t.Run("target healthy again", func(t *testing.T) {
+		gateways[1].set(upstream, "10.0.0.2:80", HealthHealthy)
+		w.Poll(ctx)
+
+		health, ok := w.ServiceTargetsHealth(svcNN)
+		require.True(t, ok)
+		require.Empty(t, health.Unhealthy())
+		require.Equal(t,
+			"Normal KongUpstreamTargetHealthy Target 10.0.0.2:80 of upstream echo.default.80.svc is healthy",
+			<-eventRecorder.Events,
+		)
+		requireNotified(t)
+	})
+
+	t.Run("upstream removed from configuration", func(t *testing.T) {
+		w.UpdateUpstreams(nil)
+		w.Poll(ctx)
+
+		_, ok := w.ServiceTargetsHealth(svcNN)
+		require.False(t, ok)
+		require.Empty(t, metricsRecorder.health)
+		requireNotified(t)
+	})
+}
@@ -0,0 +1,54 @@
+package object
+
+import (
+	"strings"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type objectMessages struct {
+	generation int64
+	messages   []string
+}
+
+// MessageSet is a set of messages reported for kubernetes objects, e.g. the route conflicts found in the
+// configuration of the objects.
+type MessageSet struct {
+	store map[gvk]map[k8stypes.NamespacedName]objectMessages
+}
+
+// Insert adds a message for the object.
+func (s *MessageSet) Insert(obj client.Object, message string) {
+	if s.store == nil {
+		s.store = make(map[gvk]map[k8stypes.NamespacedName]objectMessages)
+	}
+
+	objGVK := gvk(obj.GetObjectKind().GroupVersionKind().String())
+	nsName := k8stypes.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	if s.store[objGVK] == nil {
+		s.store[objGVK] = make(map[k8stypes.NamespacedName]objectMessages)
+	}
+	entry := s.store[objGVK][nsName]
+	entry.generation = obj.GetGeneration()
+	entry.messages = append(entry.messages, message)
+	s.store[objGVK][nsName] = entry
+}
+
+// Get returns the messages reported for the object, joined by "; ". It returns false when there are none, or when
+// they were reported for an older generation of the object.
+func (s *MessageSet) Get(obj client.Object) (string, bool) {
+	objGVK := gvk(obj.GetObjectKind().GroupVersionKind().String())
+	nsName := k8stypes.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	entry, ok := s.store[objGVK][nsName]
+	if !ok || entry.generation < obj.GetGeneration() {
+		return "", false
+	}
+	return strings.Join(entry.messages, "; "), true
+}
@@ -0,0 +1,40 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMessageSet(t *testing.T) {
+	ing := &netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  corev1.NamespaceDefault,
+			Name:       "test-ingress",
+			Generation: 1,
+		},
+	}
+	ing.SetGroupVersionKind(ingGVK)
+
+	set := MessageSet{}
+	_, ok := set.Get(ing)
+	require.False(t, ok, "an empty set should have no messages")
+
+	set.Insert(ing, "first")
+	set.Insert(ing, "second")
+	message, ok := set.Get(ing)
+	require.True(t, ok)
+	require.Equal(t, "first; second", message)
+
+	other := ing.DeepCopy()
+	other.Name = "other-ingress"
+	_, ok = set.Get(other)
+	require.False(t, ok, "messages should not be reported for other objects")
+
+	ing.Generation = 2
+	_, ok = set.Get(ing)
+	require.False(t, ok, "messages reported for an older generation should be ignored")
+}
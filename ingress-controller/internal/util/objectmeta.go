@@ -13,6 +13,7 @@ type K8sObjectInfo struct {
 	Name             string
 	Namespace        string
 	Annotations      map[string]string
+	Labels           map[string]string
 	GroupVersionKind schema.GroupVersionKind
 }
 
@@ -34,6 +35,7 @@ func FromK8sObject(obj client.Object) K8sObjectInfo {
 		// We return a copy of annotations map here because translator functions may modify annotations
 		// and that change would then be stored in store which is not desired.
 		Annotations:      maps.Clone(obj.GetAnnotations()),
+		Labels:           maps.Clone(obj.GetLabels()),
 		GroupVersionKind: obj.GetObjectKind().GroupVersionKind(),
 	}
 }
@@ -44,6 +44,21 @@ func TestFromK8sObject(t *testing.T) {
 				Annotations: map[string]string{"a": "1", "b": "2"},
 			},
 		},
+		{
+			name: "has labels",
+			in: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+					Labels:    map[string]string{"exposure": "external"},
+				},
+			},
+			want: K8sObjectInfo{
+				Name:      "name",
+				Namespace: "namespace",
+				Labels:    map[string]string{"exposure": "external"},
+			},
+		},
 		{
 			name: "with group version kind",
 			in: &netv1.Ingress{
@@ -0,0 +1,70 @@
+package ingress2gateway
+
+import (
+	"strings"
+
+	configurationv1beta1 "github.com/kong/kong-operator/v2/api/configuration/v1beta1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/pkg/metadata"
+)
+
+const forceSSLRedirectAnnotation = "ingress.kubernetes.io/force-ssl-redirect"
+
+// routeAnnotations are the annotations the translator reads from any route
+// object. They are kept on the converted routes as they produce the same Kong
+// route fields for HTTPRoutes and GRPCRoutes.
+var routeAnnotations = []string{
+	annotations.AnnotationPrefix + annotations.StripPathKey,
+	annotations.AnnotationPrefix + annotations.PreserveHostKey,
+	annotations.AnnotationPrefix + annotations.HTTPSRedirectCodeKey,
+	annotations.AnnotationPrefix + annotations.RequestBuffering,
+	annotations.AnnotationPrefix + annotations.ResponseBuffering,
+	annotations.AnnotationPrefix + annotations.ProtocolsKey,
+	annotations.AnnotationPrefix + annotations.RegexPriorityKey,
+	annotations.AnnotationPrefix + annotations.SNIsKey,
+	annotations.AnnotationPrefix + annotations.HostAliasesKey,
+	annotations.AnnotationPrefix + annotations.PathHandlingKey,
+	annotations.AnnotationPrefix + annotations.UserTagKey,
+	forceSSLRedirectAnnotation,
+}
+
+// serviceAnnotations are the annotations the translator reads from the
+// Kubernetes Services only. They don't have any effect on Ingresses.
+var serviceAnnotations = []string{
+	annotations.AnnotationPrefix + annotations.PathKey,
+	annotations.AnnotationPrefix + annotations.ProtocolKey,
+	annotations.AnnotationPrefix + annotations.ConnectTimeoutKey,
+	annotations.AnnotationPrefix + annotations.ReadTimeoutKey,
+	annotations.AnnotationPrefix + annotations.WriteTimeoutKey,
+	annotations.AnnotationPrefix + annotations.RetriesKey,
+	annotations.AnnotationPrefix + annotations.HostHeaderKey,
+	annotations.AnnotationPrefix + annotations.ClientCertKey,
+	annotations.AnnotationPrefix + annotations.TLSVerifyKey,
+	annotations.AnnotationPrefix + annotations.TLSVerifyDepthKey,
+	annotations.AnnotationPrefix + annotations.CACertificatesSecretsKey,
+	annotations.AnnotationPrefix + annotations.CACertificatesConfigMapsKey,
+	configurationv1beta1.KongUpstreamPolicyAnnotationKey,
+	"ingress.kubernetes.io/service-upstream",
+}
+
+// convertedAnnotations are the annotations converted to Gateway API fields or
+// resources, or consumed while converting the Ingress paths.
+var convertedAnnotations = []string{
+	annotations.IngressClassKey,
+	metadata.AnnotationKeyPlugins,
+	annotations.AnnotationPrefix + annotations.MethodsKey,
+	annotations.AnnotationPrefix + annotations.HeadersSeparatorKey,
+	annotations.AnnotationPrefix + annotations.RewriteURIKey,
+	annotations.AnnotationPrefix + annotations.RegexPrefixKey,
+	annotations.AnnotationPrefix + annotations.ConfigurationKey,
+}
+
+// isHeadersAnnotation tells whether key is one of the konghq.com/headers.* annotations.
+func isHeadersAnnotation(key string) bool {
+	return strings.HasPrefix(key, annotations.AnnotationPrefix+annotations.HeadersKey+".")
+}
+
+// isKongAnnotation tells whether key is one of the konghq.com annotations.
+func isKongAnnotation(key string) bool {
+	return strings.HasPrefix(key, annotations.AnnotationPrefix+"/")
+}
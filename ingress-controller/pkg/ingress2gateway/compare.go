@@ -0,0 +1,445 @@
+package ingress2gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/manager/consts"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+)
+
+// Comparison is the difference between the Kong configurations translated
+// from the Ingresses and from the converted resources.
+type Comparison struct {
+	// Missing are the Kong routes translated from the Ingresses only.
+	Missing []string
+	// Unexpected are the Kong routes translated from the converted resources only.
+	Unexpected []string
+
+	// IngressFailures are the translation failures of the Ingresses.
+	IngressFailures []string
+	// GatewayFailures are the translation failures of the converted resources.
+	GatewayFailures []string
+}
+
+// Equal tells whether both inputs translate to the same Kong routes without
+// translation failures specific to the converted resources.
+func (c Comparison) Equal() bool {
+	return len(c.Missing) == 0 && len(c.Unexpected) == 0 && len(c.GatewayFailures) == 0
+}
+
+// Write writes the differences to w.
+func (c Comparison) Write(w io.Writer) error {
+	sections := []struct {
+		title string
+		lines []string
+	}{
+		{"Kong routes translated from the Ingresses only", c.Missing},
+		{"Kong routes translated from the Gateway API resources only", c.Unexpected},
+		{"Ingress translation failures", c.IngressFailures},
+		{"Gateway API resources translation failures", c.GatewayFailures},
+	}
+	for _, section := range sections {
+		if len(section.lines) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s:\n", section.title); err != nil {
+			return err
+		}
+		for _, line := range section.lines {
+			if _, err := fmt.Fprintf(w, "  %s\n", line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Compare translates objs and the resources converted from them, with the
+// Ingresses replaced by the converted resources, to Kong configurations and
+// returns the difference between their routes.
+//
+// Routes are compared by host, path, matching criteria, route options,
+// plugins and the Kong service they proxy to. Their names, tags and regex
+// priorities are expected to differ and are not compared. Gateways referenced
+// by the converted routes and missing from objs are assumed to have an HTTP
+// and an HTTPS listener.
+func Compare(logger logr.Logger, objs []client.Object, result Result, opts Options) (Comparison, error) {
+	opts = opts.withDefaults()
+
+	ingressState, err := translate(logger, objs, opts)
+	if err != nil {
+		return Comparison{}, fmt.Errorf("failed to translate Ingresses: %w", err)
+	}
+
+	gatewayObjs := slices.DeleteFunc(slices.Clone(objs), func(obj client.Object) bool {
+		_, ok := obj.(*netv1.Ingress)
+		return ok
+	})
+	gatewayObjs = append(gatewayObjs, result.Objects()...)
+	gatewayObjs = append(gatewayObjs, missingGateways(gatewayObjs, result)...)
+	gatewayState, err := translate(logger, gatewayObjs, opts)
+	if err != nil {
+		return Comparison{}, fmt.Errorf("failed to translate converted resources: %w", err)
+	}
+
+	ingressRoutes := flattenRoutes(ingressState.KongState, ingressState.storer)
+	gatewayRoutes := flattenRoutes(gatewayState.KongState, gatewayState.storer)
+	missing, unexpected := multisetDifference(ingressRoutes, gatewayRoutes)
+	return Comparison{
+		Missing:         missing,
+		Unexpected:      unexpected,
+		IngressFailures: ingressState.failures,
+		GatewayFailures: gatewayState.failures,
+	}, nil
+}
+
+type translation struct {
+	*kongstate.KongState
+	storer   store.Storer
+	failures []string
+}
+
+func translate(logger logr.Logger, objs []client.Object, opts Options) (translation, error) {
+	cs, _, err := newCacheStores(objs)
+	if err != nil {
+		return translation{}, err
+	}
+	s := store.New(cs, opts.IngressClass, logger)
+
+	t, err := translator.NewTranslator(
+		logger,
+		s,
+		"",
+		opts.KongVersion,
+		translator.FeatureFlags{
+			RewriteURIs: true,
+		},
+		unavailableSchemaServiceProvider{},
+		translator.Config{
+			ClusterDomain:      managercfg.DefaultClusterDomain,
+			EnableDrainSupport: consts.DefaultEnableDrainSupport,
+		},
+	)
+	if err != nil {
+		return translation{}, err
+	}
+
+	result := t.BuildKongConfig()
+	var failures []string
+	for _, f := range result.TranslationFailures {
+		refs := make([]string, 0, len(f.CausingObjects()))
+		for _, obj := range f.CausingObjects() {
+			refs = append(refs, objectRef(obj))
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", strings.Join(refs, ", "), f.Message()))
+	}
+	slices.Sort(failures)
+	return translation{
+		KongState: result.KongState,
+		storer:    s,
+		failures:  failures,
+	}, nil
+}
+
+type unavailableSchemaServiceProvider struct{}
+
+func (unavailableSchemaServiceProvider) GetSchemaService() kong.AbstractSchemaService {
+	return translator.UnavailableSchemaService{}
+}
+
+// missingGateways returns the Gateways referenced by the converted routes and
+// missing from objs, with an HTTP and an HTTPS listener.
+func missingGateways(objs []client.Object, result Result) []client.Object {
+	existing := make(map[k8stypes.NamespacedName]struct{})
+	for _, obj := range objs {
+		if gw, ok := obj.(*gatewayapi.Gateway); ok {
+			existing[client.ObjectKeyFromObject(gw)] = struct{}{}
+		}
+	}
+
+	var parents []k8stypes.NamespacedName
+	addParents := func(namespace string, refs []gatewayapi.ParentReference) {
+		for _, ref := range refs {
+			nn := k8stypes.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+			if ref.Namespace != nil {
+				nn.Namespace = string(*ref.Namespace)
+			}
+			if _, ok := existing[nn]; !ok && !slices.Contains(parents, nn) {
+				parents = append(parents, nn)
+			}
+		}
+	}
+	for _, route := range result.HTTPRoutes {
+		addParents(route.Namespace, route.Spec.ParentRefs)
+	}
+	for _, route := range result.GRPCRoutes {
+		addParents(route.Namespace, route.Spec.ParentRefs)
+	}
+
+	gateways := make([]client.Object, 0, len(parents))
+	for _, nn := range parents {
+		gateways = append(gateways, &gatewayapi.Gateway{
+			TypeMeta: gatewayapi.V1GatewayTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nn.Name,
+				Namespace: nn.Namespace,
+			},
+			Spec: gatewayapi.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners: []gatewayapi.Listener{
+					{Name: "http", Protocol: gatewayapi.HTTPProtocolType, Port: 80},
+					{Name: "https", Protocol: gatewayapi.HTTPSProtocolType, Port: 443},
+				},
+			},
+		})
+	}
+	return gateways
+}
+
+// flattenRoutes returns a line per host and path of every Kong route of the
+// Kong state, describing what the route matches and how it proxies requests.
+func flattenRoutes(ks *kongstate.KongState, s store.Storer) []string {
+	if ks == nil {
+		return nil
+	}
+
+	pluginsByRoute := make(map[string][]string)
+	pluginsByService := make(map[string][]string)
+	for _, p := range ks.Plugins {
+		switch {
+		case p.Route != nil && p.Route.ID != nil:
+			pluginsByRoute[*p.Route.ID] = append(pluginsByRoute[*p.Route.ID], pluginString(p.Plugin))
+		case p.Service != nil && p.Service.ID != nil:
+			pluginsByService[*p.Service.ID] = append(pluginsByService[*p.Service.ID], pluginString(p.Plugin))
+		}
+	}
+
+	var lines []string
+	for _, service := range ks.Services {
+		serviceName := kong.StringValue(service.Name)
+		serviceFields := serviceString(service, s)
+		for _, plugin := range service.Plugins {
+			pluginsByService[serviceName] = append(pluginsByService[serviceName], pluginString(plugin))
+		}
+
+		for _, route := range service.Routes {
+			routeName := kong.StringValue(route.Name)
+			plugins := slices.Clone(pluginsByRoute[routeName])
+			plugins = append(plugins, pluginsByService[serviceName]...)
+			for _, plugin := range route.Plugins {
+				plugins = append(plugins, pluginString(plugin))
+			}
+			slices.Sort(plugins)
+
+			fields := strings.Join([]string{
+				"methods=" + sortedJoin(lo.FromSlicePtr(route.Methods)),
+				"headers=" + headersString(route.Headers),
+				"snis=" + sortedJoin(lo.FromSlicePtr(route.SNIs)),
+				"protocols=" + defaultString(sortedJoin(lo.FromSlicePtr(route.Protocols)), "http,https"),
+				"strip_path=" + strconv.FormatBool(boolValue(route.StripPath, true)),
+				"preserve_host=" + strconv.FormatBool(boolValue(route.PreserveHost, false)),
+				"https_redirect_status_code=" + strconv.Itoa(intValue(route.HTTPSRedirectStatusCode, 426)),
+				"request_buffering=" + strconv.FormatBool(boolValue(route.RequestBuffering, true)),
+				"response_buffering=" + strconv.FormatBool(boolValue(route.ResponseBuffering, true)),
+				"path_handling=" + defaultString(kong.StringValue(route.PathHandling), "v0"),
+				"plugins=[" + strings.Join(plugins, " ") + "]",
+				"service={" + serviceFields + "}",
+			}, " ")
+
+			hosts := lo.FromSlicePtr(route.Hosts)
+			if len(hosts) == 0 {
+				hosts = []string{""}
+			}
+			for _, host := range hosts {
+				for _, path := range normalizePaths(lo.FromSlicePtr(route.Paths)) {
+					lines = append(lines, fmt.Sprintf("host=%q path=%q %s", host, path, fields))
+				}
+			}
+		}
+	}
+	slices.Sort(lines)
+	return lines
+}
+
+// normalizePaths returns the paths of a Kong route matching the same requests
+// whatever the way they were generated:
+//
+//   - ~/base$ is dropped when /base/ is also a path, as both are generated from
+//     prefix matches, and so is ~/$ when / is also a path,
+//   - regular expressions matching a literal path are converted to the plain
+//     path, prefixed with = when the expression is anchored to the end.
+func normalizePaths(paths []string) []string {
+	if len(paths) == 0 {
+		return []string{"/"}
+	}
+
+	var normalized []string
+	for _, path := range paths {
+		regex, isRegex := strings.CutPrefix(path, subtranslator.KongPathRegexPrefix)
+		if !isRegex {
+			normalized = append(normalized, path)
+			continue
+		}
+
+		literal, exact := strings.CutSuffix(regex, "$")
+		exact = exact && !strings.HasSuffix(literal, `\`)
+		if exact && (slices.Contains(paths, literal+"/") || (literal == "/" && slices.Contains(paths, "/"))) {
+			continue
+		}
+		if !exact {
+			literal = regex
+		}
+		if unquoted := unquoteMeta(literal); regexp.QuoteMeta(unquoted) == literal {
+			if exact {
+				normalized = append(normalized, "="+unquoted)
+			} else {
+				normalized = append(normalized, unquoted)
+			}
+			continue
+		}
+		normalized = append(normalized, path)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// unquoteMeta removes the backslashes escaping the characters of s.
+func unquoteMeta(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// serviceString describes the Kong service fields affecting how requests
+// are proxied, with the backends referenced by their resolved port number.
+func serviceString(service kongstate.Service, s store.Storer) string {
+	backends := make([]string, 0, len(service.Backends))
+	for _, backend := range service.Backends {
+		backends = append(backends, fmt.Sprintf("%s/%s:%s", backend.Namespace(), backend.Name(), backendPort(backend, s)))
+	}
+	slices.Sort(backends)
+
+	return strings.Join([]string{
+		"protocol=" + kong.StringValue(service.Protocol),
+		"path=" + defaultString(kong.StringValue(service.Path), "/"),
+		"connect_timeout=" + strconv.Itoa(lo.FromPtr(service.ConnectTimeout)),
+		"read_timeout=" + strconv.Itoa(lo.FromPtr(service.ReadTimeout)),
+		"write_timeout=" + strconv.Itoa(lo.FromPtr(service.WriteTimeout)),
+		"retries=" + strconv.Itoa(lo.FromPtr(service.Retries)),
+		"backends=" + strings.Join(backends, ","),
+	}, " ")
+}
+
+// backendPort returns the port number of a backend, resolving the named and
+// implicit ports with the Kubernetes Service.
+func backendPort(backend kongstate.ServiceBackend, s store.Storer) string {
+	portDef := backend.PortDef()
+	if portDef.Mode == kongstate.PortModeByNumber {
+		return strconv.Itoa(int(portDef.Number))
+	}
+	service, err := s.GetService(backend.Namespace(), backend.Name())
+	if err != nil {
+		return portDef.CanonicalString()
+	}
+	var ports []corev1.ServicePort
+	for _, port := range service.Spec.Ports {
+		if portDef.Mode == kongstate.PortModeImplicit || port.Name == portDef.Name {
+			ports = append(ports, port)
+		}
+	}
+	if len(ports) != 1 {
+		return portDef.CanonicalString()
+	}
+	return strconv.Itoa(int(ports[0].Port))
+}
+
+func pluginString(plugin kong.Plugin) string {
+	config, err := json.Marshal(plugin.Config)
+	if err != nil {
+		config = []byte(err.Error())
+	}
+	return kong.StringValue(plugin.Name) + string(config)
+}
+
+func headersString(headers map[string][]string) string {
+	values := make([]string, 0, len(headers))
+	for name, v := range headers {
+		values = append(values, strings.ToLower(name)+":"+sortedJoin(v))
+	}
+	slices.Sort(values)
+	return strings.Join(values, ";")
+}
+
+// multisetDifference returns the lines of a missing from b and the lines of
+// b missing from a. Both are expected to be sorted.
+func multisetDifference(a, b []string) (onlyA, onlyB []string) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case a[i] < b[j]:
+			onlyA = append(onlyA, a[i])
+			i++
+		default:
+			onlyB = append(onlyB, b[j])
+			j++
+		}
+	}
+	onlyA = append(onlyA, a[i:]...)
+	onlyB = append(onlyB, b[j:]...)
+	return onlyA, onlyB
+}
+
+func sortedJoin(values []string) string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	return strings.Join(values, ",")
+}
+
+func defaultString(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+func boolValue(value *bool, def bool) bool {
+	if value == nil {
+		return def
+	}
+	return *value
+}
+
+func intValue(value *int, def int) int {
+	if value == nil {
+		return def
+	}
+	return *value
+}
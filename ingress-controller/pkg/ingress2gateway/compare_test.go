@@ -0,0 +1,94 @@
+package ingress2gateway
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	f, err := os.Open("testdata/ingresses.yaml")
+	require.NoError(t, err)
+	defer f.Close()
+	objs, err := ReadObjects(f)
+	require.NoError(t, err)
+
+	result, err := Convert(objs, Options{})
+	require.NoError(t, err)
+	require.Len(t, result.HTTPRoutes, 2, "the Ingress of another class should be skipped")
+
+	t.Run("converted resources translate to the same Kong routes", func(t *testing.T) {
+		comparison, err := Compare(logr.Discard(), objs, result, Options{})
+		require.NoError(t, err)
+		assert.Empty(t, comparison.Missing)
+		assert.Empty(t, comparison.Unexpected)
+		assert.Empty(t, comparison.GatewayFailures)
+		assert.True(t, comparison.Equal())
+	})
+
+	t.Run("dropped rules are reported", func(t *testing.T) {
+		modified := result
+		modified.HTTPRoutes = nil
+		for _, route := range result.HTTPRoutes {
+			if route.Name == "echo" {
+				route = route.DeepCopy()
+				route.Spec.Rules = route.Spec.Rules[:1]
+			}
+			modified.HTTPRoutes = append(modified.HTTPRoutes, route)
+		}
+
+		comparison, err := Compare(logr.Discard(), objs, modified, Options{})
+		require.NoError(t, err)
+		assert.False(t, comparison.Equal())
+		require.Len(t, comparison.Missing, 1)
+		assert.Contains(t, comparison.Missing[0], `path="~/regex/(\\d+)"`)
+		assert.Empty(t, comparison.Unexpected)
+	})
+}
+
+func TestNormalizePaths(t *testing.T) {
+	testCases := []struct {
+		name     string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "no paths match everything",
+			expected: []string{"/"},
+		},
+		{
+			name:     "prefix match",
+			paths:    []string{"/base/", "~/base$"},
+			expected: []string{"/base/"},
+		},
+		{
+			name:     "root prefix match",
+			paths:    []string{"~/$", "/"},
+			expected: []string{"/"},
+		},
+		{
+			name:     "exact match",
+			paths:    []string{"~/exact$"},
+			expected: []string{"=/exact"},
+		},
+		{
+			name:     "escaped literal",
+			paths:    []string{`~/pkg\.Service/`},
+			expected: []string{"/pkg.Service/"},
+		},
+		{
+			name:     "regular expression",
+			paths:    []string{`~/regex/(\d+)`},
+			expected: []string{`~/regex/(\d+)`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizePaths(tc.paths))
+		})
+	}
+}
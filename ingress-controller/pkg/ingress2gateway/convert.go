@@ -0,0 +1,803 @@
+// Package ingress2gateway converts Ingresses configured with the konghq.com
+// annotations to Gateway API routes and Kong resources producing the same
+// Kong configuration, and reports the parts that can't be expressed with
+// Gateway API.
+package ingress2gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	netv1 "k8s.io/api/networking/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kong-operator/v2/api/configuration/v1beta1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/store"
+	"github.com/kong/kong-operator/v2/pkg/metadata"
+)
+
+const (
+	// DefaultGatewayName is the name of the Gateway the converted routes are
+	// attached to when Options.GatewayName is not set.
+	DefaultGatewayName = "kong"
+
+	// maxRulesPerRoute and maxMatchesPerRule are the Gateway API limits of
+	// rules per route and matches per rule.
+	maxRulesPerRoute  = 16
+	maxMatchesPerRule = 64
+)
+
+// DefaultKongVersion is the version of Kong the configurations are translated
+// for when Options.KongVersion is not set.
+var DefaultKongVersion = semver.MustParse("3.12.0")
+
+// Options configures the conversion.
+type Options struct {
+	// IngressClass is the class of the Ingresses to convert. Defaults to "kong".
+	IngressClass string
+
+	// GatewayName is the name of the Gateway the converted routes are attached to.
+	// Defaults to DefaultGatewayName.
+	GatewayName string
+
+	// GatewayNamespace is the namespace of the Gateway the converted routes are
+	// attached to. When empty, the routes are attached to a Gateway in their
+	// own namespace.
+	GatewayNamespace string
+
+	// KongVersion is the version of Kong the configurations are translated for
+	// when comparing them. Defaults to DefaultKongVersion.
+	KongVersion semver.Version
+}
+
+func (o Options) withDefaults() Options {
+	if o.IngressClass == "" {
+		o.IngressClass = annotations.DefaultIngressClass
+	}
+	if o.GatewayName == "" {
+		o.GatewayName = DefaultGatewayName
+	}
+	if o.KongVersion.EQ(semver.Version{}) {
+		o.KongVersion = DefaultKongVersion
+	}
+	return o
+}
+
+// Result holds the resources converted from Ingresses and the conversion report.
+type Result struct {
+	HTTPRoutes           []*gatewayapi.HTTPRoute
+	GRPCRoutes           []*gatewayapi.GRPCRoute
+	KongPlugins          []*configurationv1.KongPlugin
+	KongUpstreamPolicies []*configurationv1beta1.KongUpstreamPolicy
+
+	// Report lists the parts of the Ingresses that couldn't be converted as-is.
+	Report Report
+}
+
+// Objects returns all the converted resources.
+func (r Result) Objects() []client.Object {
+	objs := make([]client.Object, 0, len(r.HTTPRoutes)+len(r.GRPCRoutes)+len(r.KongPlugins)+len(r.KongUpstreamPolicies))
+	for _, p := range r.KongPlugins {
+		objs = append(objs, p)
+	}
+	for _, p := range r.KongUpstreamPolicies {
+		objs = append(objs, p)
+	}
+	for _, route := range r.HTTPRoutes {
+		objs = append(objs, route)
+	}
+	for _, route := range r.GRPCRoutes {
+		objs = append(objs, route)
+	}
+	return objs
+}
+
+// Convert converts the Ingresses of the configured class found in objs to
+// HTTPRoutes and GRPCRoutes. The konghq.com annotations are converted to
+// Gateway API matches and KongPlugin ExtensionRefs when possible and are kept
+// on the routes otherwise, as the translator reads them from any route object.
+// KongIngresses referenced with the konghq.com/override annotation are
+// converted to KongUpstreamPolicies.
+//
+// objs should also contain the Services, KongPlugins and KongIngresses the
+// Ingresses refer to: Services are required to resolve named ports.
+func Convert(objs []client.Object, opts Options) (Result, error) {
+	opts = opts.withDefaults()
+
+	cs, kongIngresses, err := newCacheStores(objs)
+	if err != nil {
+		return Result{}, err
+	}
+	s := store.New(cs, opts.IngressClass, logr.Discard())
+
+	c := &converter{
+		opts:             opts,
+		storer:           s,
+		kongIngresses:    kongIngresses,
+		upstreamPolicies: make(map[k8stypes.NamespacedName]*configurationv1beta1.KongUpstreamPolicy),
+	}
+
+	// Sort the Ingresses the same way the translator does, so the default
+	// backend of the oldest Ingress is picked.
+	ingresses := s.ListIngressesV1()
+	sort.SliceStable(ingresses, func(i, j int) bool {
+		return ingresses[i].CreationTimestamp.Before(&ingresses[j].CreationTimestamp)
+	})
+
+	legacyRegexDetection := ingressClassParameters(s).EnableLegacyRegexDetection
+	for _, ingress := range ingresses {
+		c.convertIngress(ingress, legacyRegexDetection)
+	}
+	c.convertDefaultBackend(ingresses)
+	c.convertServicesOverrides(objs)
+
+	c.result.KongUpstreamPolicies = slices.Collect(maps.Values(c.upstreamPolicies))
+	sortByNamespacedName(c.result.HTTPRoutes)
+	sortByNamespacedName(c.result.GRPCRoutes)
+	sortByNamespacedName(c.result.KongPlugins)
+	sortByNamespacedName(c.result.KongUpstreamPolicies)
+	c.result.Report = c.reporter.report
+	return c.result, nil
+}
+
+// newCacheStores returns the translator cache stores holding objs and the
+// KongIngresses found in objs. KongIngresses aren't read by the translator
+// anymore and are passed as unstructured objects.
+// Objects of kinds the translator doesn't read are ignored.
+func newCacheStores(objs []client.Object) (
+	store.CacheStores, map[k8stypes.NamespacedName]*unstructured.Unstructured, error,
+) {
+	cs := store.NewCacheStores()
+	kongIngresses := make(map[k8stypes.NamespacedName]*unstructured.Unstructured)
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			if u.GroupVersionKind().GroupKind() == kongIngressGVK.GroupKind() {
+				kongIngresses[client.ObjectKeyFromObject(u)] = u
+			}
+			continue
+		}
+		if err := cs.Add(obj); err != nil && !strings.Contains(err.Error(), "unsupported kind") {
+			return store.CacheStores{}, nil, fmt.Errorf("failed to add %s to the store: %w", objectRef(obj), err)
+		}
+	}
+	return cs, kongIngresses, nil
+}
+
+// ingressClassParameters returns the IngressClassParameters of the converted
+// Ingress class or the default ones.
+func ingressClassParameters(s store.Storer) configurationv1alpha1.IngressClassParametersSpec {
+	class, err := s.GetIngressClassV1(s.GetIngressClassName())
+	if err != nil {
+		return configurationv1alpha1.IngressClassParametersSpec{}
+	}
+	params, err := s.GetIngressClassParametersV1Alpha1(class)
+	if err != nil {
+		return configurationv1alpha1.IngressClassParametersSpec{}
+	}
+	return params.Spec
+}
+
+type converter struct {
+	opts             Options
+	storer           store.Storer
+	kongIngresses    map[k8stypes.NamespacedName]*unstructured.Unstructured
+	upstreamPolicies map[k8stypes.NamespacedName]*configurationv1beta1.KongUpstreamPolicy
+
+	result   Result
+	reporter reporter
+}
+
+// backendPaths are the paths of an Ingress rule routed to the same backend.
+type backendPaths struct {
+	service string
+	port    gatewayapi.PortNumber
+	paths   []netv1.HTTPIngressPath
+}
+
+// hostPaths are the paths of the Ingress rules for the same host.
+type hostPaths struct {
+	host     string
+	backends []*backendPaths
+}
+
+func (h *hostPaths) add(service string, port gatewayapi.PortNumber, path netv1.HTTPIngressPath) {
+	for _, b := range h.backends {
+		if b.service == service && b.port == port {
+			b.paths = append(b.paths, path)
+			return
+		}
+	}
+	h.backends = append(h.backends, &backendPaths{
+		service: service,
+		port:    port,
+		paths:   []netv1.HTTPIngressPath{path},
+	})
+}
+
+var multipleSlashes = regexp.MustCompile(`/{2,}`)
+
+func (c *converter) convertIngress(ingress *netv1.Ingress, legacyRegexDetection bool) {
+	c.reportIngressAnnotations(ingress)
+
+	var hosts []*hostPaths
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		idx := slices.IndexFunc(hosts, func(h *hostPaths) bool { return h.host == rule.Host })
+		if idx == -1 {
+			hosts = append(hosts, &hostPaths{host: rule.Host})
+			idx = len(hosts) - 1
+		}
+		for _, path := range rule.HTTP.Paths {
+			// Normalize the path the same way the translator does.
+			path.Path = multipleSlashes.ReplaceAllString(path.Path, "/")
+			if path.Path == "" {
+				path.Path = "/"
+			}
+			if path.PathType == nil {
+				path.PathType = new(netv1.PathTypeImplementationSpecific)
+			}
+			service, port, ok := c.backendRef(ingress, path.Backend)
+			if !ok {
+				continue
+			}
+			hosts[idx].add(service, port, path)
+		}
+	}
+
+	hosts = slices.DeleteFunc(hosts, func(h *hostPaths) bool { return len(h.backends) == 0 })
+
+	if len(hosts) > 0 {
+		grpc := isGRPCIngress(ingress)
+		meta := c.routeMetaForIngress(ingress, grpc, true)
+		prependRegexPrefix := subtranslator.MaybePrependRegexPrefixForIngressV1Fn(ingress, legacyRegexDetection)
+		for i, h := range hosts {
+			name := ingress.Name
+			if len(hosts) > 1 {
+				name = fmt.Sprintf("%s-%d", ingress.Name, i)
+			}
+			if grpc {
+				c.addGRPCRoute(ingress, name, h, meta)
+			} else {
+				c.addHTTPRoute(ingress, name, h, meta, prependRegexPrefix)
+			}
+		}
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		c.reporter.add(SeverityInfo, ingress, "",
+			"TLS for hosts %v uses Secret %q: add it to the certificateRefs of an HTTPS listener of Gateway %s",
+			tls.Hosts, tls.SecretName, c.gatewayRef(ingress.Namespace),
+		)
+	}
+	if len(hosts) == 0 && len(ingress.Spec.Rules) > 0 {
+		c.reporter.add(SeverityUnsupported, ingress, "", "no rule could be converted")
+	}
+}
+
+// convertDefaultBackend converts the default backend of the oldest Ingress
+// defining one: the translator ignores the others.
+func (c *converter) convertDefaultBackend(ingresses []*netv1.Ingress) {
+	var converted *netv1.Ingress
+	for _, ingress := range ingresses {
+		if ingress.Spec.DefaultBackend == nil {
+			continue
+		}
+		if converted != nil {
+			c.reporter.add(SeverityUnsupported, ingress, "",
+				"default backend is ignored by the translator in favor of the one of the older %s",
+				objectRef(converted),
+			)
+			continue
+		}
+		converted = ingress
+
+		path := netv1.HTTPIngressPath{
+			Path:     "/",
+			PathType: new(netv1.PathTypePrefix),
+			Backend:  *ingress.Spec.DefaultBackend,
+		}
+		service, port, ok := c.backendRef(ingress, path.Backend)
+		if !ok {
+			continue
+		}
+		h := &hostPaths{}
+		h.add(service, port, path)
+
+		// The konghq.com/rewrite annotation doesn't apply to the default backend.
+		grpc := isGRPCIngress(ingress)
+		meta := c.routeMetaForIngress(ingress, grpc, false)
+		name := ingress.Name + "-default-backend"
+		if grpc {
+			c.addGRPCRoute(ingress, name, h, meta)
+		} else {
+			c.addHTTPRoute(ingress, name, h, meta, func(path string) *string { return new(path) })
+		}
+	}
+}
+
+// backendRef returns the Service name and port number of an Ingress backend.
+func (c *converter) backendRef(ingress *netv1.Ingress, backend netv1.IngressBackend) (string, gatewayapi.PortNumber, bool) {
+	if backend.Resource != nil {
+		c.reporter.add(SeverityUnsupported, ingress, "",
+			"%s backend %q can't be referenced by Gateway API routes",
+			backend.Resource.Kind, backend.Resource.Name,
+		)
+		return "", 0, false
+	}
+	if backend.Service == nil {
+		return "", 0, false
+	}
+
+	name := backend.Service.Name
+	if number := backend.Service.Port.Number; number != 0 {
+		return name, gatewayapi.PortNumber(number), true
+	}
+
+	// Gateway API backends reference Service ports by number only: resolve
+	// the port name or pick the only port of the Service.
+	service, err := c.storer.GetService(ingress.Namespace, name)
+	if err != nil {
+		c.reporter.add(SeverityUnsupported, ingress, "",
+			"port %q of Service %q can't be resolved to a number: the Service wasn't found",
+			backend.Service.Port.Name, name,
+		)
+		return "", 0, false
+	}
+	portName := backend.Service.Port.Name
+	for _, port := range service.Spec.Ports {
+		if port.Name == portName || (portName == "" && len(service.Spec.Ports) == 1) {
+			return name, gatewayapi.PortNumber(port.Port), true
+		}
+	}
+	c.reporter.add(SeverityUnsupported, ingress, "",
+		"port %q of Service %q can't be resolved to a number: the Service doesn't define it",
+		portName, name,
+	)
+	return "", 0, false
+}
+
+// routeMeta holds the parts of the converted routes derived from the Ingress
+// annotations.
+type routeMeta struct {
+	// annotations are the annotations kept on the converted routes.
+	annotations map[string]string
+	// plugins are the names of the KongPlugins referenced with ExtensionRef filters.
+	plugins []string
+	// method is the HTTP method the converted routes match.
+	method *gatewayapi.HTTPMethod
+	// headers are the headers the converted routes match.
+	headers []gatewayapi.HTTPHeaderMatch
+}
+
+// routeMetaForIngress converts the annotations of an Ingress to routeMeta.
+// GRPCRoutes don't support ExtensionRef filters, so the plugins are kept in
+// the konghq.com/plugins annotation along with the methods and headers.
+func (c *converter) routeMetaForIngress(ingress *netv1.Ingress, grpc bool, rewrite bool) routeMeta {
+	anns := ingress.Annotations
+	meta := routeMeta{
+		annotations: make(map[string]string),
+	}
+	for _, key := range routeAnnotations {
+		if value, ok := anns[key]; ok {
+			meta.annotations[key] = value
+		}
+	}
+	// Kong routes translated from Ingresses preserve the host by default,
+	// unlike the ones translated from GRPCRoutes.
+	preserveHostKey := annotations.AnnotationPrefix + annotations.PreserveHostKey
+	if _, ok := meta.annotations[preserveHostKey]; grpc && !ok {
+		meta.annotations[preserveHostKey] = "true"
+	}
+
+	// Plugins from other namespaces can only be referenced with the annotation.
+	var annotationPlugins []string
+	for _, plugin := range metadata.ExtractPluginsNamespacedNames(ingress) {
+		switch {
+		case plugin.Namespace == "" || plugin.Namespace == ingress.Namespace:
+			meta.plugins = append(meta.plugins, plugin.Name)
+		case grpc:
+			annotationPlugins = append(annotationPlugins, plugin.Namespace+":"+plugin.Name)
+		default:
+			annotationPlugins = append(annotationPlugins, plugin.Namespace+":"+plugin.Name)
+			c.reporter.add(SeverityInfo, ingress, metadata.AnnotationKeyPlugins,
+				"KongPlugin %s/%s is kept in the annotation: make sure the ReferenceGrant allowing the reference lists the route kind",
+				plugin.Namespace, plugin.Name,
+			)
+		}
+	}
+	if rewriteURI, ok := annotations.ExtractRewriteURI(anns); ok && rewrite {
+		if plugin, ok := c.rewriteURIPlugin(ingress, rewriteURI); ok {
+			meta.plugins = append(meta.plugins, plugin)
+		}
+	}
+	if grpc {
+		annotationPlugins = append(annotationPlugins, meta.plugins...)
+		meta.plugins = nil
+	}
+	if len(annotationPlugins) > 0 {
+		meta.annotations[metadata.AnnotationKeyPlugins] = strings.Join(annotationPlugins, ",")
+	}
+
+	// GRPCRoutes don't match methods and their header matches don't support
+	// regular expressions: keep the annotations.
+	methodsKey := annotations.AnnotationPrefix + annotations.MethodsKey
+	methods := annotations.ExtractMethods(anns)
+	if len(methods) == 1 && !grpc {
+		meta.method = new(gatewayapi.HTTPMethod(methods[0]))
+	} else if value, ok := anns[methodsKey]; ok {
+		meta.annotations[methodsKey] = value
+	}
+
+	headers, ok := annotations.ExtractHeaders(anns)
+	if ok && !grpc && !slices.ContainsFunc(slices.Collect(maps.Values(headers)), func(values []string) bool {
+		return len(values) != 1
+	}) {
+		for _, name := range slices.Sorted(maps.Keys(headers)) {
+			value := headers[name][0]
+			match := gatewayapi.HTTPHeaderMatch{
+				Name:  gatewayapi.HTTPHeaderName(name),
+				Value: value,
+			}
+			if regex, ok := strings.CutPrefix(value, subtranslator.KongHeaderRegexPrefix); ok {
+				match.Type = new(gatewayapi.HeaderMatchRegularExpression)
+				match.Value = regex
+			}
+			meta.headers = append(meta.headers, match)
+		}
+	} else if ok {
+		for key, value := range anns {
+			if isHeadersAnnotation(key) || key == annotations.AnnotationPrefix+annotations.HeadersSeparatorKey {
+				meta.annotations[key] = value
+			}
+		}
+	}
+
+	if len(meta.annotations) == 0 {
+		meta.annotations = nil
+	}
+	return meta
+}
+
+// rewriteURIPlugin adds the KongPlugin equivalent to the konghq.com/rewrite
+// annotation of an Ingress and returns its name.
+func (c *converter) rewriteURIPlugin(ingress *netv1.Ingress, rewriteURI string) (string, bool) {
+	const key = annotations.AnnotationPrefix + annotations.RewriteURIKey
+
+	config, err := subtranslator.RewriteURIPluginConfig(rewriteURI)
+	if err != nil {
+		c.reporter.add(SeverityUnsupported, ingress, key, "invalid value: %s", err)
+		return "", false
+	}
+	raw, err := json.Marshal(config)
+	if err != nil {
+		c.reporter.add(SeverityUnsupported, ingress, key, "failed to marshal the plugin configuration: %s", err)
+		return "", false
+	}
+
+	name := ingress.Name + "-rewrite"
+	if slices.ContainsFunc(c.result.KongPlugins, func(p *configurationv1.KongPlugin) bool {
+		return p.Namespace == ingress.Namespace && p.Name == name
+	}) {
+		return name, true
+	}
+	c.result.KongPlugins = append(c.result.KongPlugins, &configurationv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "KongPlugin",
+			APIVersion: configurationv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ingress.Namespace,
+		},
+		PluginName: subtranslator.RewriteURIPluginName,
+		Config:     apiextensionsv1.JSON{Raw: raw},
+	})
+	return name, true
+}
+
+// reportIngressAnnotations reports the annotations of an Ingress that aren't
+// converted nor kept on the converted routes.
+func (c *converter) reportIngressAnnotations(ingress *netv1.Ingress) {
+	for _, key := range slices.Sorted(maps.Keys(ingress.Annotations)) {
+		switch {
+		case slices.Contains(serviceAnnotations, key):
+			c.reporter.add(SeverityUnsupported, ingress, key,
+				"the annotation has no effect on Ingresses: set it on the backend Services, where it also applies to Gateway API routes",
+			)
+		case key == annotations.AnnotationPrefix+annotations.ConfigurationKey:
+			c.convertOverride(ingress, ingress.Annotations[key], c.ingressServices(ingress))
+		case slices.Contains(routeAnnotations, key), slices.Contains(convertedAnnotations, key), isHeadersAnnotation(key):
+		case isKongAnnotation(key):
+			c.reporter.add(SeverityUnsupported, ingress, key, "unknown annotation")
+		}
+	}
+}
+
+// ingressServices returns the names of the Services the Ingress routes to.
+func (c *converter) ingressServices(ingress *netv1.Ingress) []string {
+	var services []string
+	add := func(backend *netv1.IngressBackend) {
+		if backend != nil && backend.Service != nil && !slices.Contains(services, backend.Service.Name) {
+			services = append(services, backend.Service.Name)
+		}
+	}
+	add(ingress.Spec.DefaultBackend)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			add(&path.Backend)
+		}
+	}
+	return services
+}
+
+func (c *converter) parentRef() gatewayapi.ParentReference {
+	ref := gatewayapi.ParentReference{
+		Name: gatewayapi.ObjectName(c.opts.GatewayName),
+	}
+	if c.opts.GatewayNamespace != "" {
+		ref.Namespace = new(gatewayapi.Namespace(c.opts.GatewayNamespace))
+	}
+	return ref
+}
+
+func (c *converter) gatewayRef(routeNamespace string) string {
+	if c.opts.GatewayNamespace != "" {
+		return c.opts.GatewayNamespace + "/" + c.opts.GatewayName
+	}
+	return routeNamespace + "/" + c.opts.GatewayName
+}
+
+func (c *converter) addHTTPRoute(
+	ingress *netv1.Ingress,
+	name string,
+	h *hostPaths,
+	meta routeMeta,
+	prependRegexPrefix func(string) *string,
+) {
+	route := &gatewayapi.HTTPRoute{
+		TypeMeta:   gatewayapi.V1HTTPRouteTypeMeta,
+		ObjectMeta: routeObjectMeta(ingress, name, meta),
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{c.parentRef()},
+			},
+		},
+	}
+	if h.host != "" {
+		route.Spec.Hostnames = []gatewayapi.Hostname{gatewayapi.Hostname(h.host)}
+	}
+
+	var filters []gatewayapi.HTTPRouteFilter
+	for _, plugin := range meta.plugins {
+		filters = append(filters, gatewayapi.HTTPRouteFilter{
+			Type: gatewayapi.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gatewayapi.LocalObjectReference{
+				Group: gatewayapi.Group(configurationv1.GroupVersion.Group),
+				Kind:  "KongPlugin",
+				Name:  gatewayapi.ObjectName(plugin),
+			},
+		})
+	}
+
+	for _, backend := range h.backends {
+		rule := gatewayapi.HTTPRouteRule{
+			Filters: filters,
+			BackendRefs: []gatewayapi.HTTPBackendRef{
+				{BackendRef: backendRef(backend)},
+			},
+		}
+		for _, path := range backend.paths {
+			kongPaths := subtranslator.PathsFromIngressPaths(path)
+			for i, kongPath := range kongPaths {
+				kongPaths[i] = prependRegexPrefix(*kongPath)
+			}
+			for _, m := range c.pathMatchesFromKongPaths(ingress, kongPaths) {
+				rule.Matches = append(rule.Matches, gatewayapi.HTTPRouteMatch{
+					Path:    &m,
+					Headers: meta.headers,
+					Method:  meta.method,
+				})
+			}
+		}
+		if len(rule.Matches) > maxMatchesPerRule {
+			c.reporter.add(SeverityWarning, ingress, "",
+				"HTTPRoute %s/%s has %d matches for Service %q, more than the %d allowed by Gateway API",
+				route.Namespace, route.Name, len(rule.Matches), backend.service, maxMatchesPerRule,
+			)
+		}
+		route.Spec.Rules = append(route.Spec.Rules, rule)
+	}
+	if len(route.Spec.Rules) > maxRulesPerRoute {
+		c.reporter.add(SeverityWarning, ingress, "",
+			"HTTPRoute %s/%s has %d rules, more than the %d allowed by Gateway API",
+			route.Namespace, route.Name, len(route.Spec.Rules), maxRulesPerRoute,
+		)
+	}
+
+	c.result.HTTPRoutes = append(c.result.HTTPRoutes, route)
+}
+
+// pathMatchesFromKongPaths returns the HTTPRoute path matches the translator
+// translates to the given Kong route paths:
+//
+//   - PathPrefix /base is translated to /base/ and ~/base$,
+//   - Exact /path is translated to ~/path$,
+//   - RegularExpression regex is translated to ~regex.
+//
+// Plain Kong paths are matched as prefixes without a path segment boundary:
+// they're converted to regular expressions to keep the same behavior.
+func (c *converter) pathMatchesFromKongPaths(ingress *netv1.Ingress, kongPaths []*string) []gatewayapi.HTTPPathMatch {
+	paths := lo.FromSlicePtr(kongPaths)
+
+	var matches []gatewayapi.HTTPPathMatch
+	for _, path := range paths {
+		regex, isRegex := strings.CutPrefix(path, subtranslator.KongPathRegexPrefix)
+		switch {
+		case path == "/":
+			matches = append(matches, pathMatch(gatewayapi.PathMatchPathPrefix, "/"))
+		case isRegex:
+			literal, exact := strings.CutSuffix(regex, "$")
+			if exact && slices.Contains(paths, literal+"/") {
+				// Covered by the PathPrefix match of the plain path.
+				continue
+			}
+			if exact && regexp.QuoteMeta(literal) == literal {
+				matches = append(matches, pathMatch(gatewayapi.PathMatchExact, literal))
+				continue
+			}
+			matches = append(matches, pathMatch(gatewayapi.PathMatchRegularExpression, regex))
+		case strings.HasSuffix(path, "/") &&
+			slices.Contains(paths, subtranslator.KongPathRegexPrefix+strings.TrimSuffix(path, "/")+"$"):
+			matches = append(matches, pathMatch(gatewayapi.PathMatchPathPrefix, strings.TrimSuffix(path, "/")))
+		default:
+			c.reporter.add(SeverityInfo, ingress, "",
+				"path %q is matched by Kong as a prefix not bound to path segments: it's converted to the %q RegularExpression match, consider using a PathPrefix or Exact match instead",
+				path, regexp.QuoteMeta(path),
+			)
+			matches = append(matches, pathMatch(gatewayapi.PathMatchRegularExpression, regexp.QuoteMeta(path)))
+		}
+	}
+	return matches
+}
+
+func pathMatch(pathType gatewayapi.PathMatchType, value string) gatewayapi.HTTPPathMatch {
+	return gatewayapi.HTTPPathMatch{
+		Type:  new(pathType),
+		Value: new(value),
+	}
+}
+
+func (c *converter) addGRPCRoute(ingress *netv1.Ingress, name string, h *hostPaths, meta routeMeta) {
+	route := &gatewayapi.GRPCRoute{
+		TypeMeta:   gatewayapi.GRPCRouteTypeMeta,
+		ObjectMeta: routeObjectMeta(ingress, name, meta),
+		Spec: gatewayapi.GRPCRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{c.parentRef()},
+			},
+		},
+	}
+	if h.host != "" {
+		route.Spec.Hostnames = []gatewayapi.Hostname{gatewayapi.Hostname(h.host)}
+	}
+
+	for _, backend := range h.backends {
+		rule := gatewayapi.GRPCRouteRule{
+			BackendRefs: []gatewayapi.GRPCBackendRef{
+				{BackendRef: backendRef(backend)},
+			},
+		}
+		catchAll := false
+		for _, path := range backend.paths {
+			method, ok := c.grpcMethodMatch(ingress, path)
+			switch {
+			case !ok:
+				continue
+			case method == nil:
+				catchAll = true
+			default:
+				rule.Matches = append(rule.Matches, gatewayapi.GRPCRouteMatch{Method: method})
+			}
+		}
+		if catchAll {
+			rule.Matches = nil
+		} else if len(rule.Matches) == 0 {
+			continue
+		}
+		route.Spec.Rules = append(route.Spec.Rules, rule)
+	}
+	if len(route.Spec.Rules) == 0 {
+		return
+	}
+
+	c.result.GRPCRoutes = append(c.result.GRPCRoutes, route)
+}
+
+// grpcMethodMatch converts the path of a gRPC Ingress to a GRPCRoute method
+// match: /<service> prefixes match a service and /<service>/<method> paths
+// match a method. It returns a nil match for the paths matching everything.
+func (c *converter) grpcMethodMatch(ingress *netv1.Ingress, path netv1.HTTPIngressPath) (*gatewayapi.GRPCMethodMatch, bool) {
+	trimmed := strings.Trim(path.Path, "/")
+	if trimmed == "" && *path.PathType != netv1.PathTypeExact {
+		return nil, true
+	}
+
+	segments := strings.Split(trimmed, "/")
+	switch {
+	case len(segments) == 1 && *path.PathType == netv1.PathTypePrefix:
+		return &gatewayapi.GRPCMethodMatch{
+			Type:    new(gatewayapi.GRPCMethodMatchExact),
+			Service: new(segments[0]),
+		}, true
+	case len(segments) == 2 && *path.PathType != netv1.PathTypeImplementationSpecific:
+		c.reporter.add(SeverityWarning, ingress, "",
+			"path %q is converted to an Exact method match: unlike the Ingress path, it matches the service and method names literally",
+			path.Path,
+		)
+		return &gatewayapi.GRPCMethodMatch{
+			Type:    new(gatewayapi.GRPCMethodMatchExact),
+			Service: new(segments[0]),
+			Method:  new(segments[1]),
+		}, true
+	default:
+		c.reporter.add(SeverityUnsupported, ingress, "",
+			"%s path %q can't be converted to a gRPC service or method match",
+			*path.PathType, path.Path,
+		)
+		return nil, false
+	}
+}
+
+func backendRef(backend *backendPaths) gatewayapi.BackendRef {
+	return gatewayapi.BackendRef{
+		BackendObjectReference: gatewayapi.BackendObjectReference{
+			Name: gatewayapi.ObjectName(backend.service),
+			Port: new(backend.port),
+		},
+	}
+}
+
+func routeObjectMeta(ingress *netv1.Ingress, name string, meta routeMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   ingress.Namespace,
+		Labels:      maps.Clone(ingress.Labels),
+		Annotations: maps.Clone(meta.annotations),
+	}
+}
+
+// isGRPCIngress tells whether the Ingress routes only gRPC traffic.
+func isGRPCIngress(ingress *netv1.Ingress) bool {
+	protocols := annotations.ExtractProtocolNames(ingress.Annotations)
+	return len(protocols) > 0 && !slices.ContainsFunc(protocols, func(p string) bool {
+		return p != "grpc" && p != "grpcs"
+	})
+}
+
+func sortByNamespacedName[T client.Object](objs []T) {
+	slices.SortFunc(objs, func(a, b T) int {
+		return strings.Compare(a.GetNamespace()+"/"+a.GetName(), b.GetNamespace()+"/"+b.GetName())
+	})
+}
@@ -0,0 +1,484 @@
+package ingress2gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1beta1 "github.com/kong/kong-operator/v2/api/configuration/v1beta1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+)
+
+func TestConvertHTTPRoutes(t *testing.T) {
+	ingress := &netv1.Ingress{
+		TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "echo"},
+			Annotations: map[string]string{
+				"konghq.com/strip-path":    "true",
+				"konghq.com/plugins":       "auth, other:cors",
+				"konghq.com/methods":       "GET",
+				"konghq.com/headers.x-env": "~*prod.*",
+				"konghq.com/rewrite":       "/api",
+			},
+		},
+		Spec: netv1.IngressSpec{
+			IngressClassName: new("kong"),
+			Rules: []netv1.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{
+								ingressPath("/prefix", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 80}),
+								ingressPath("/exact", netv1.PathTypeExact, "echo", netv1.ServiceBackendPort{Number: 80}),
+								ingressPath("/impl", netv1.PathTypeImplementationSpecific, "echo", netv1.ServiceBackendPort{Name: "http"}),
+								ingressPath(`/~/regex/(\d+)`, netv1.PathTypeImplementationSpecific, "other", netv1.ServiceBackendPort{Number: 8080}),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "echo", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+
+	result, err := Convert([]client.Object{ingress, service}, Options{})
+	require.NoError(t, err)
+	require.Len(t, result.HTTPRoutes, 1)
+	require.Len(t, result.KongPlugins, 1)
+
+	match := func(pathType gatewayapi.PathMatchType, value string) gatewayapi.HTTPRouteMatch {
+		return gatewayapi.HTTPRouteMatch{
+			Path: &gatewayapi.HTTPPathMatch{Type: new(pathType), Value: new(value)},
+			Headers: []gatewayapi.HTTPHeaderMatch{
+				{Name: "x-env", Type: new(gatewayapi.HeaderMatchRegularExpression), Value: "prod.*"},
+			},
+			Method: new(gatewayapi.HTTPMethod("GET")),
+		}
+	}
+	filters := []gatewayapi.HTTPRouteFilter{
+		extensionRef("auth"),
+		extensionRef("echo-rewrite"),
+	}
+	expected := &gatewayapi.HTTPRoute{
+		TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "echo"},
+			Annotations: map[string]string{
+				"konghq.com/strip-path": "true",
+				"konghq.com/plugins":    "other:cors",
+			},
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: "kong"}},
+			},
+			Hostnames: []gatewayapi.Hostname{"example.com"},
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						match(gatewayapi.PathMatchPathPrefix, "/prefix"),
+						match(gatewayapi.PathMatchExact, "/exact"),
+						match(gatewayapi.PathMatchRegularExpression, "/impl"),
+					},
+					Filters:     filters,
+					BackendRefs: []gatewayapi.HTTPBackendRef{httpBackendRef("echo", 80)},
+				},
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						match(gatewayapi.PathMatchRegularExpression, `/regex/(\d+)`),
+					},
+					Filters:     filters,
+					BackendRefs: []gatewayapi.HTTPBackendRef{httpBackendRef("other", 8080)},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, result.HTTPRoutes[0])
+
+	plugin := result.KongPlugins[0]
+	assert.Equal(t, "echo-rewrite", plugin.Name)
+	assert.Equal(t, "default", plugin.Namespace)
+	assert.Equal(t, "request-transformer", plugin.PluginName)
+	assert.JSONEq(t, `{"replace":{"uri":"/api"}}`, string(plugin.Config.Raw))
+
+	assert.Contains(t, result.Report, Finding{
+		Severity:   SeverityInfo,
+		Object:     "Ingress default/echo",
+		Annotation: "konghq.com/plugins",
+		Message:    "KongPlugin other/cors is kept in the annotation: make sure the ReferenceGrant allowing the reference lists the route kind",
+	})
+	assert.Contains(t, result.Report, Finding{
+		Severity: SeverityInfo,
+		Object:   "Ingress default/echo",
+		Message:  `path "/impl" is matched by Kong as a prefix not bound to path segments: it's converted to the "/impl" RegularExpression match, consider using a PathPrefix or Exact match instead`,
+	})
+}
+
+func TestConvertFindings(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ingress  *netv1.Ingress
+		expected []Finding
+	}{
+		{
+			name: "service annotations and unknown annotations are reported",
+			ingress: newIngress("echo", map[string]string{
+				"konghq.com/read-timeout": "1000",
+				"konghq.com/unknown":      "value",
+			}, ingressPath("/", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 80})),
+			expected: []Finding{
+				{
+					Severity:   SeverityUnsupported,
+					Object:     "Ingress default/echo",
+					Annotation: "konghq.com/read-timeout",
+					Message:    "the annotation has no effect on Ingresses: set it on the backend Services, where it also applies to Gateway API routes",
+				},
+				{
+					Severity:   SeverityUnsupported,
+					Object:     "Ingress default/echo",
+					Annotation: "konghq.com/unknown",
+					Message:    "unknown annotation",
+				},
+			},
+		},
+		{
+			name: "named port of a missing Service is reported",
+			ingress: newIngress("echo", nil,
+				ingressPath("/", netv1.PathTypePrefix, "missing", netv1.ServiceBackendPort{Name: "http"}),
+			),
+			expected: []Finding{
+				{
+					Severity: SeverityUnsupported,
+					Object:   "Ingress default/echo",
+					Message:  `port "http" of Service "missing" can't be resolved to a number: the Service wasn't found`,
+				},
+				{
+					Severity: SeverityUnsupported,
+					Object:   "Ingress default/echo",
+					Message:  "no rule could be converted",
+				},
+			},
+		},
+		{
+			name: "invalid rewrite is reported",
+			ingress: newIngress("echo", map[string]string{
+				"konghq.com/rewrite": "/$x",
+			}, ingressPath("/", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 80})),
+			expected: []Finding{
+				{
+					Severity:   SeverityUnsupported,
+					Object:     "Ingress default/echo",
+					Annotation: "konghq.com/rewrite",
+					Message:    "invalid value: unexpected x at pos 2",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Convert([]client.Object{tc.ingress}, Options{})
+			require.NoError(t, err)
+			assert.Equal(t, Report(tc.expected), result.Report)
+		})
+	}
+}
+
+func TestConvertMultipleHosts(t *testing.T) {
+	ingress := newIngress("echo", nil)
+	for _, host := range []string{"a.example.com", "b.example.com"} {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, netv1.IngressRule{
+			Host: host,
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{
+						ingressPath("/", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 80}),
+					},
+				},
+			},
+		})
+	}
+
+	result, err := Convert([]client.Object{ingress}, Options{
+		GatewayName:      "proxy",
+		GatewayNamespace: "kong",
+	})
+	require.NoError(t, err)
+	require.Len(t, result.HTTPRoutes, 2)
+	for i, host := range []gatewayapi.Hostname{"a.example.com", "b.example.com"} {
+		route := result.HTTPRoutes[i]
+		assert.Equal(t, []gatewayapi.Hostname{host}, route.Spec.Hostnames)
+		assert.Equal(t, []gatewayapi.ParentReference{
+			{Name: "proxy", Namespace: new(gatewayapi.Namespace("kong"))},
+		}, route.Spec.ParentRefs)
+	}
+	assert.Equal(t, "echo-0", result.HTTPRoutes[0].Name)
+	assert.Equal(t, "echo-1", result.HTTPRoutes[1].Name)
+}
+
+func TestConvertGRPCRoutes(t *testing.T) {
+	ingress := newIngress("grpc", map[string]string{
+		"konghq.com/protocols": "grpc,grpcs",
+		"konghq.com/plugins":   "auth",
+		"konghq.com/methods":   "POST",
+	},
+		ingressPath("/pkg.Echo", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 9000}),
+		ingressPath("/pkg.Other/Get", netv1.PathTypeExact, "echo", netv1.ServiceBackendPort{Number: 9000}),
+		ingressPath("/", netv1.PathTypePrefix, "fallback", netv1.ServiceBackendPort{Number: 9000}),
+	)
+
+	result, err := Convert([]client.Object{ingress}, Options{})
+	require.NoError(t, err)
+	require.Empty(t, result.HTTPRoutes)
+	require.Len(t, result.GRPCRoutes, 1)
+
+	route := result.GRPCRoutes[0]
+	assert.Equal(t, map[string]string{
+		"konghq.com/protocols":     "grpc,grpcs",
+		"konghq.com/preserve-host": "true",
+		"konghq.com/plugins":       "auth",
+		"konghq.com/methods":       "POST",
+	}, route.Annotations)
+	assert.Equal(t, []gatewayapi.GRPCRouteRule{
+		{
+			Matches: []gatewayapi.GRPCRouteMatch{
+				{
+					Method: &gatewayapi.GRPCMethodMatch{
+						Type:    new(gatewayapi.GRPCMethodMatchExact),
+						Service: new("pkg.Echo"),
+					},
+				},
+				{
+					Method: &gatewayapi.GRPCMethodMatch{
+						Type:    new(gatewayapi.GRPCMethodMatchExact),
+						Service: new("pkg.Other"),
+						Method:  new("Get"),
+					},
+				},
+			},
+			BackendRefs: []gatewayapi.GRPCBackendRef{{BackendRef: backendRef(&backendPaths{service: "echo", port: 9000})}},
+		},
+		{
+			BackendRefs: []gatewayapi.GRPCBackendRef{{BackendRef: backendRef(&backendPaths{service: "fallback", port: 9000})}},
+		},
+	}, route.Spec.Rules)
+	assert.Equal(t, Report{
+		{
+			Severity: SeverityWarning,
+			Object:   "Ingress default/grpc",
+			Message:  `path "/pkg.Other/Get" is converted to an Exact method match: unlike the Ingress path, it matches the service and method names literally`,
+		},
+	}, result.Report)
+}
+
+func TestConvertDefaultBackend(t *testing.T) {
+	now := time.Now()
+	older := newIngress("older", nil)
+	older.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	older.Spec.DefaultBackend = &netv1.IngressBackend{
+		Service: &netv1.IngressServiceBackend{Name: "default", Port: netv1.ServiceBackendPort{Number: 80}},
+	}
+	newer := newIngress("newer", nil)
+	newer.CreationTimestamp = metav1.NewTime(now)
+	newer.Spec.DefaultBackend = &netv1.IngressBackend{
+		Service: &netv1.IngressServiceBackend{Name: "ignored", Port: netv1.ServiceBackendPort{Number: 80}},
+	}
+
+	result, err := Convert([]client.Object{newer, older}, Options{})
+	require.NoError(t, err)
+	require.Len(t, result.HTTPRoutes, 1)
+	route := result.HTTPRoutes[0]
+	assert.Equal(t, "older-default-backend", route.Name)
+	assert.Empty(t, route.Spec.Hostnames)
+	assert.Equal(t, []gatewayapi.HTTPRouteRule{
+		{
+			Matches: []gatewayapi.HTTPRouteMatch{
+				{Path: &gatewayapi.HTTPPathMatch{Type: new(gatewayapi.PathMatchPathPrefix), Value: new("/")}},
+			},
+			BackendRefs: []gatewayapi.HTTPBackendRef{httpBackendRef("default", 80)},
+		},
+	}, route.Spec.Rules)
+	assert.Equal(t, Report{
+		{
+			Severity: SeverityUnsupported,
+			Object:   "Ingress default/newer",
+			Message:  "default backend is ignored by the translator in favor of the one of the older Ingress default/older",
+		},
+	}, result.Report)
+}
+
+func TestConvertKongIngressOverride(t *testing.T) {
+	kongIngress := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "configuration.konghq.com/v1",
+		"kind":       "KongIngress",
+		"metadata": map[string]any{
+			"name":      "hashing",
+			"namespace": "default",
+		},
+		"upstream": map[string]any{
+			"algorithm":      "consistent-hashing",
+			"hash_on":        "header",
+			"hash_on_header": "x-user",
+			"hash_fallback":  "ip",
+			"host_header":    "example.com",
+			"healthchecks": map[string]any{
+				"threshold": float64(2),
+				"active": map[string]any{
+					"http_path": "/status",
+					"healthy": map[string]any{
+						"http_statuses": []any{int64(200)},
+						"interval":      int64(5),
+					},
+				},
+			},
+		},
+		"route": map[string]any{
+			"strip_path": true,
+		},
+	}}
+	ingress := newIngress("echo", map[string]string{
+		"konghq.com/override": "hashing",
+	}, ingressPath("/", netv1.PathTypePrefix, "echo", netv1.ServiceBackendPort{Number: 80}))
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "other",
+			Namespace:   "default",
+			Annotations: map[string]string{"konghq.com/override": "hashing"},
+		},
+	}
+
+	result, err := Convert([]client.Object{ingress, service, kongIngress}, Options{})
+	require.NoError(t, err)
+	require.Len(t, result.KongUpstreamPolicies, 1)
+	policy := result.KongUpstreamPolicies[0]
+	assert.Equal(t, "hashing", policy.Name)
+	assert.Equal(t, "default", policy.Namespace)
+	assert.Equal(t, configurationv1beta1.KongUpstreamPolicySpec{
+		Algorithm: new("consistent-hashing"),
+		HashOn: &configurationv1beta1.KongUpstreamHash{
+			Header: new("x-user"),
+		},
+		HashOnFallback: &configurationv1beta1.KongUpstreamHash{
+			Input: new(configurationv1beta1.HashInput("ip")),
+		},
+		Healthchecks: &configurationv1beta1.KongUpstreamHealthcheck{
+			Threshold: new(2),
+			Active: &configurationv1beta1.KongUpstreamActiveHealthcheck{
+				HTTPPath: new("/status"),
+				Healthy: &configurationv1beta1.KongUpstreamHealthcheckHealthy{
+					HTTPStatuses: []configurationv1beta1.HTTPStatus{200},
+					Interval:     new(5),
+				},
+			},
+		},
+	}, policy.Spec)
+
+	const key = "konghq.com/override"
+	assert.Equal(t, Report{
+		{
+			Severity:   SeverityUnsupported,
+			Object:     "Ingress default/echo",
+			Annotation: key,
+			Message:    "the route section of KongIngress default/hashing has no Gateway API equivalent: use the konghq.com annotations of the routes and Services instead",
+		},
+		{
+			Severity:   SeverityUnsupported,
+			Object:     "Ingress default/echo",
+			Annotation: key,
+			Message:    "host_header, client_certificate and use_srv_name of KongIngress default/hashing can't be set with a KongUpstreamPolicy: use the Service annotations instead",
+		},
+		{
+			Severity:   SeverityInfo,
+			Object:     "Ingress default/echo",
+			Annotation: key,
+			Message:    "KongIngress default/hashing is converted to KongUpstreamPolicy default/hashing: annotate Services [echo] with konghq.com/upstream-policy: hashing",
+		},
+		{
+			Severity:   SeverityUnsupported,
+			Object:     "Service default/other",
+			Annotation: key,
+			Message:    "the route section of KongIngress default/hashing has no Gateway API equivalent: use the konghq.com annotations of the routes and Services instead",
+		},
+		{
+			Severity:   SeverityUnsupported,
+			Object:     "Service default/other",
+			Annotation: key,
+			Message:    "host_header, client_certificate and use_srv_name of KongIngress default/hashing can't be set with a KongUpstreamPolicy: use the Service annotations instead",
+		},
+		{
+			Severity:   SeverityInfo,
+			Object:     "Service default/other",
+			Annotation: key,
+			Message:    "KongIngress default/hashing is converted to KongUpstreamPolicy default/hashing: annotate Services [other] with konghq.com/upstream-policy: hashing",
+		},
+	}, result.Report)
+}
+
+func newIngress(name string, annotations map[string]string, paths ...netv1.HTTPIngressPath) *netv1.Ingress {
+	ingress := &netv1.Ingress{
+		TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: netv1.IngressSpec{
+			IngressClassName: new("kong"),
+		},
+	}
+	if len(paths) > 0 {
+		ingress.Spec.Rules = []netv1.IngressRule{
+			{
+				IngressRuleValue: netv1.IngressRuleValue{
+					HTTP: &netv1.HTTPIngressRuleValue{Paths: paths},
+				},
+			},
+		}
+	}
+	return ingress
+}
+
+func ingressPath(path string, pathType netv1.PathType, service string, port netv1.ServiceBackendPort) netv1.HTTPIngressPath {
+	return netv1.HTTPIngressPath{
+		Path:     path,
+		PathType: new(pathType),
+		Backend: netv1.IngressBackend{
+			Service: &netv1.IngressServiceBackend{Name: service, Port: port},
+		},
+	}
+}
+
+func extensionRef(plugin string) gatewayapi.HTTPRouteFilter {
+	return gatewayapi.HTTPRouteFilter{
+		Type: gatewayapi.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gatewayapi.LocalObjectReference{
+			Group: "configuration.konghq.com",
+			Kind:  "KongPlugin",
+			Name:  gatewayapi.ObjectName(plugin),
+		},
+	}
+}
+
+func httpBackendRef(service string, port gatewayapi.PortNumber) gatewayapi.HTTPBackendRef {
+	return gatewayapi.HTTPBackendRef{BackendRef: backendRef(&backendPaths{service: service, port: port})}
+}
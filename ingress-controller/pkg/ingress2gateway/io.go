@@ -0,0 +1,178 @@
+package ingress2gateway
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/gatewayapi"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/util"
+	"github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/scheme"
+)
+
+// ReadObjects reads the Kubernetes objects from a stream of YAML or JSON
+// documents. Lists are expanded and objects of kinds unknown to the
+// controller are skipped. KongIngresses are returned as unstructured objects.
+func ReadObjects(r io.Reader) ([]client.Object, error) {
+	var objs []client.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document: %w", err)
+		}
+
+		u := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &u.Object); err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+
+		if u.IsList() {
+			list, err := u.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", u.GetKind(), err)
+			}
+			for i := range list.Items {
+				obj, err := typedObject(&list.Items[i])
+				if err != nil {
+					return nil, err
+				}
+				if obj != nil {
+					objs = append(objs, obj)
+				}
+			}
+			continue
+		}
+
+		obj, err := typedObject(u)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+}
+
+// typedObject converts u to the typed object of its kind. It returns nil for
+// kinds unknown to the controller.
+func typedObject(u *unstructured.Unstructured) (client.Object, error) {
+	gvk := u.GroupVersionKind()
+	if gvk.GroupKind() == kongIngressGVK.GroupKind() {
+		return u, nil
+	}
+
+	s := scheme.Get()
+	if !s.Recognizes(gvk) {
+		return nil, nil
+	}
+	runtimeObj, err := s.New(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", gvk, err)
+	}
+	obj, ok := runtimeObj.(client.Object)
+	if !ok {
+		return nil, nil
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, fmt.Errorf("failed to decode %s %s/%s: %w", gvk.Kind, u.GetNamespace(), u.GetName(), err)
+	}
+	return obj, nil
+}
+
+// ListObjects lists the objects read when converting Ingresses from the
+// cluster. When namespace is empty, objects from all namespaces are listed.
+func ListObjects(ctx context.Context, cl client.Reader, namespace string) ([]client.Object, error) {
+	lists := []client.ObjectList{
+		&netv1.IngressList{},
+		&netv1.IngressClassList{},
+		&corev1.ServiceList{},
+		&discoveryv1.EndpointSliceList{},
+		&configurationv1.KongPluginList{},
+		&configurationv1.KongClusterPluginList{},
+		&configurationv1alpha1.IngressClassParametersList{},
+		&gatewayapi.GatewayList{},
+	}
+
+	var objs []client.Object
+	for _, list := range lists {
+		if err := cl.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %T: %w", list, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract items of %T: %w", list, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			if err := util.PopulateTypeMeta(obj, scheme.Get()); err != nil {
+				return nil, err
+			}
+			objs = append(objs, obj)
+		}
+	}
+
+	kongIngresses := &unstructured.UnstructuredList{}
+	kongIngresses.SetGroupVersionKind(kongIngressGVK.GroupVersion().WithKind("KongIngressList"))
+	if err := cl.List(ctx, kongIngresses, client.InNamespace(namespace)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("failed to list KongIngresses: %w", err)
+	}
+	for i := range kongIngresses.Items {
+		objs = append(objs, &kongIngresses.Items[i])
+	}
+
+	return objs, nil
+}
+
+// WriteYAML writes objs to w as a stream of YAML documents, omitting their
+// status and the fields set by the API server.
+func WriteYAML(w io.Writer, objs []client.Object) error {
+	for i, obj := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s: %w", objectRef(obj), err)
+		}
+		delete(content, "status")
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+		b, err := yaml.Marshal(content)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", objectRef(obj), err)
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
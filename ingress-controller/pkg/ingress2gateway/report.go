@@ -0,0 +1,100 @@
+package ingress2gateway
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Severity tells how a Finding affects the converted configuration.
+type Severity string
+
+const (
+	// SeverityInfo means the converted resources are equivalent, but require
+	// a manual step to be used (e.g. configuring a Gateway listener).
+	SeverityInfo Severity = "info"
+	// SeverityWarning means the converted resources are not strictly
+	// equivalent to the source ones and should be reviewed.
+	SeverityWarning Severity = "warning"
+	// SeverityUnsupported means a part of the source resources couldn't be
+	// expressed with Gateway API and was dropped.
+	SeverityUnsupported Severity = "unsupported"
+)
+
+// Finding is a note about a part of the source resources that couldn't be
+// converted as-is.
+type Finding struct {
+	// Severity tells how the finding affects the converted configuration.
+	Severity Severity
+	// Object is the kind, namespace and name of the source object.
+	Object string
+	// Annotation is the annotation the finding is about, if any.
+	Annotation string
+	// Message describes the finding.
+	Message string
+}
+
+// String returns a single line representation of the finding.
+func (f Finding) String() string {
+	if f.Annotation != "" {
+		return fmt.Sprintf("%s: %s: %s: %s", f.Severity, f.Object, f.Annotation, f.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", f.Severity, f.Object, f.Message)
+}
+
+// Report is the list of findings of a conversion.
+type Report []Finding
+
+// Unsupported returns the findings about parts of the source resources that
+// were dropped.
+func (r Report) Unsupported() Report {
+	return slices.DeleteFunc(slices.Clone(r), func(f Finding) bool {
+		return f.Severity != SeverityUnsupported
+	})
+}
+
+// Write writes the report as a table to w.
+func (r Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "SEVERITY\tOBJECT\tANNOTATION\tMESSAGE"); err != nil {
+		return err
+	}
+	for _, f := range r {
+		annotation := f.Annotation
+		if annotation == "" {
+			annotation = "-"
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", f.Severity, f.Object, annotation, f.Message); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// reporter collects the findings of a conversion.
+type reporter struct {
+	report Report
+}
+
+func (r *reporter) add(severity Severity, obj client.Object, annotation, format string, args ...any) {
+	r.report = append(r.report, Finding{
+		Severity:   severity,
+		Object:     objectRef(obj),
+		Annotation: annotation,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+// objectRef returns the kind, namespace and name of obj, e.g. Ingress default/echo.
+func objectRef(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	name := obj.GetName()
+	if ns := obj.GetNamespace(); ns != "" {
+		name = ns + "/" + name
+	}
+	return strings.TrimSpace(kind + " " + name)
+}
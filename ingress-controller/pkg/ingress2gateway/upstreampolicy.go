@@ -0,0 +1,226 @@
+package ingress2gateway
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kong-operator/v2/api/configuration/v1"
+	configurationv1beta1 "github.com/kong/kong-operator/v2/api/configuration/v1beta1"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/annotations"
+	"github.com/kong/kong-operator/v2/ingress-controller/internal/dataplane/kongstate"
+)
+
+// kongIngressGVK is the GroupVersionKind of the KongIngresses referenced with
+// the konghq.com/override annotation. KongIngress isn't served anymore, so the
+// KongIngresses are read as unstructured objects.
+var kongIngressGVK = configurationv1.SchemeGroupVersion.WithKind("KongIngress")
+
+// convertServicesOverrides converts the KongIngresses referenced by the
+// konghq.com/override annotation of the Services found in objs.
+func (c *converter) convertServicesOverrides(objs []client.Object) {
+	const key = annotations.AnnotationPrefix + annotations.ConfigurationKey
+	for _, obj := range objs {
+		service, ok := obj.(*corev1.Service)
+		if !ok {
+			continue
+		}
+		if name, ok := service.Annotations[key]; ok {
+			c.convertOverride(service, name, []string{service.Name})
+		}
+	}
+}
+
+// convertOverride converts the upstream section of the KongIngress referenced
+// by obj to a KongUpstreamPolicy with the same name. The other sections of
+// KongIngresses have no Gateway API equivalent and are reported.
+func (c *converter) convertOverride(obj client.Object, name string, services []string) {
+	const key = annotations.AnnotationPrefix + annotations.ConfigurationKey
+
+	nn := k8stypes.NamespacedName{Namespace: obj.GetNamespace(), Name: name}
+	kongIngress, ok := c.kongIngresses[nn]
+	if !ok {
+		c.reporter.add(SeverityWarning, obj, key, "KongIngress %s wasn't found", nn)
+		return
+	}
+
+	for _, section := range []string{"proxy", "route"} {
+		if _, ok := kongIngress.Object[section]; ok {
+			c.reporter.add(SeverityUnsupported, obj, key,
+				"the %s section of KongIngress %s has no Gateway API equivalent: use the konghq.com annotations of the routes and Services instead",
+				section, nn,
+			)
+		}
+	}
+
+	upstream, ok, err := upstreamFromKongIngress(kongIngress)
+	switch {
+	case err != nil:
+		c.reporter.add(SeverityUnsupported, obj, key, "invalid upstream section of KongIngress %s: %s", nn, err)
+		return
+	case !ok:
+		return
+	}
+	if upstream.HostHeader != nil || upstream.ClientCertificate != nil || upstream.UseSrvName != nil {
+		c.reporter.add(SeverityUnsupported, obj, key,
+			"host_header, client_certificate and use_srv_name of KongIngress %s can't be set with a KongUpstreamPolicy: use the Service annotations instead",
+			nn,
+		)
+	}
+
+	if _, ok := c.upstreamPolicies[nn]; !ok {
+		c.upstreamPolicies[nn] = &configurationv1beta1.KongUpstreamPolicy{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "KongUpstreamPolicy",
+				APIVersion: configurationv1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nn.Name,
+				Namespace: nn.Namespace,
+			},
+			Spec: kongUpstreamPolicySpec(upstream),
+		}
+	}
+	slices.Sort(services)
+	c.reporter.add(SeverityInfo, obj, key,
+		"KongIngress %s is converted to KongUpstreamPolicy %s: annotate Services %v with %s: %s",
+		nn, nn, services, configurationv1beta1.KongUpstreamPolicyAnnotationKey, nn.Name,
+	)
+}
+
+// upstreamFromKongIngress returns the upstream section of a KongIngress. It
+// returns false when the section is not set.
+func upstreamFromKongIngress(kongIngress *unstructured.Unstructured) (kong.Upstream, bool, error) {
+	section, ok := kongIngress.Object["upstream"]
+	if !ok {
+		return kong.Upstream{}, false, nil
+	}
+	raw, err := json.Marshal(section)
+	if err != nil {
+		return kong.Upstream{}, false, err
+	}
+	var upstream kong.Upstream
+	if err := json.Unmarshal(raw, &upstream); err != nil {
+		return kong.Upstream{}, false, err
+	}
+	return upstream, true, nil
+}
+
+// kongUpstreamPolicySpec is the reverse of kongstate.TranslateKongUpstreamPolicy.
+func kongUpstreamPolicySpec(upstream kong.Upstream) configurationv1beta1.KongUpstreamPolicySpec {
+	spec := configurationv1beta1.KongUpstreamPolicySpec{
+		Algorithm:    upstream.Algorithm,
+		Slots:        upstream.Slots,
+		Healthchecks: kongUpstreamHealthcheck(upstream.Healthchecks),
+		HashOn: kongUpstreamHash(
+			upstream.HashOn,
+			upstream.HashOnHeader,
+			upstream.HashOnCookie,
+			upstream.HashOnCookiePath,
+			upstream.HashOnQueryArg,
+			upstream.HashOnURICapture,
+		),
+		HashOnFallback: kongUpstreamHash(
+			upstream.HashFallback,
+			upstream.HashFallbackHeader,
+			nil,
+			nil,
+			upstream.HashFallbackQueryArg,
+			upstream.HashFallbackURICapture,
+		),
+	}
+	if upstream.StickySessionsCookie != nil {
+		spec.StickySessions = &configurationv1beta1.KongUpstreamStickySessions{
+			Cookie:     *upstream.StickySessionsCookie,
+			CookiePath: upstream.StickySessionsCookiePath,
+		}
+	}
+	return spec
+}
+
+func kongUpstreamHash(hashOn, header, cookie, cookiePath, queryArg, uriCapture *string) *configurationv1beta1.KongUpstreamHash {
+	if hashOn == nil {
+		return nil
+	}
+	switch *hashOn {
+	case kongstate.KongHashOnTypeHeader:
+		return &configurationv1beta1.KongUpstreamHash{Header: header}
+	case kongstate.KongHashOnTypeCookie:
+		return &configurationv1beta1.KongUpstreamHash{Cookie: cookie, CookiePath: cookiePath}
+	case kongstate.KongHashOnTypeQueryArg:
+		return &configurationv1beta1.KongUpstreamHash{QueryArg: queryArg}
+	case kongstate.KongHashOnTypeURICapture:
+		return &configurationv1beta1.KongUpstreamHash{URICapture: uriCapture}
+	default:
+		return &configurationv1beta1.KongUpstreamHash{Input: new(configurationv1beta1.HashInput(*hashOn))}
+	}
+}
+
+func kongUpstreamHealthcheck(healthcheck *kong.Healthcheck) *configurationv1beta1.KongUpstreamHealthcheck {
+	if healthcheck == nil {
+		return nil
+	}
+	spec := &configurationv1beta1.KongUpstreamHealthcheck{}
+	if healthcheck.Threshold != nil {
+		spec.Threshold = new(int(*healthcheck.Threshold))
+	}
+	if active := healthcheck.Active; active != nil {
+		spec.Active = &configurationv1beta1.KongUpstreamActiveHealthcheck{
+			Type:                   active.Type,
+			Concurrency:            active.Concurrency,
+			Healthy:                kongUpstreamHealthcheckHealthy(active.Healthy),
+			Unhealthy:              kongUpstreamHealthcheckUnhealthy(active.Unhealthy),
+			HTTPPath:               active.HTTPPath,
+			HTTPSSNI:               active.HTTPSSni,
+			HTTPSVerifyCertificate: active.HTTPSVerifyCertificate,
+			Timeout:                active.Timeout,
+			Headers:                active.Headers,
+		}
+	}
+	if passive := healthcheck.Passive; passive != nil {
+		spec.Passive = &configurationv1beta1.KongUpstreamPassiveHealthcheck{
+			Type:      passive.Type,
+			Healthy:   kongUpstreamHealthcheckHealthy(passive.Healthy),
+			Unhealthy: kongUpstreamHealthcheckUnhealthy(passive.Unhealthy),
+		}
+	}
+	return spec
+}
+
+func kongUpstreamHealthcheckHealthy(healthy *kong.Healthy) *configurationv1beta1.KongUpstreamHealthcheckHealthy {
+	if healthy == nil {
+		return nil
+	}
+	return &configurationv1beta1.KongUpstreamHealthcheckHealthy{
+		HTTPStatuses: httpStatuses(healthy.HTTPStatuses),
+		Interval:     healthy.Interval,
+		Successes:    healthy.Successes,
+	}
+}
+
+func kongUpstreamHealthcheckUnhealthy(unhealthy *kong.Unhealthy) *configurationv1beta1.KongUpstreamHealthcheckUnhealthy {
+	if unhealthy == nil {
+		return nil
+	}
+	return &configurationv1beta1.KongUpstreamHealthcheckUnhealthy{
+		HTTPFailures: unhealthy.HTTPFailures,
+		HTTPStatuses: httpStatuses(unhealthy.HTTPStatuses),
+		TCPFailures:  unhealthy.TCPFailures,
+		Timeouts:     unhealthy.Timeouts,
+		Interval:     unhealthy.Interval,
+	}
+}
+
+func httpStatuses(statuses []int) []configurationv1beta1.HTTPStatus {
+	if statuses == nil {
+		return nil
+	}
+	return lo.Map(statuses, func(s int, _ int) configurationv1beta1.HTTPStatus { return configurationv1beta1.HTTPStatus(s) })
+}
@@ -175,6 +175,14 @@ type Config struct {
 	CacheSyncTimeout                  time.Duration
 	GracefulShutdownTimeout           *time.Duration
 
+	// IncrementalTranslationFullRebuildInterval is the interval between full translations when the
+	// IncrementalTranslation feature gate is enabled. A default interval is used when not set.
+	IncrementalTranslationFullRebuildInterval time.Duration
+
+	// UpstreamTargetHealthPeriod is the period of reading the health of the upstream targets from Kong Gateways when
+	// the UpstreamTargetHealth feature gate is enabled. A default period is used when not set.
+	UpstreamTargetHealthPeriod time.Duration
+
 	// Kong Proxy configurations
 	APIServerHost                          string
 	APIServerQPS                           int
@@ -194,6 +202,10 @@ type Config struct {
 	InitCacheSyncDuration                  time.Duration
 	ProxySyncTimeout                       time.Duration
 
+	// KongAdminSvcPartitions are the partitions of the configuration sent to the Gateways discovered through
+	// their own Services. The Gateways discovered through KongAdminSvc receive the routes not belonging to any of them.
+	KongAdminSvcPartitions []ConfigPartition
+
 	// KubeRestConfig takes precedence over any fields related to what it configures,
 	// such as APIServerHost, APIServerQPS, etc. It's intended to be used when the controller
 	// is run as a part of Kong Operator. It bypass the mechanism of constructing this config.
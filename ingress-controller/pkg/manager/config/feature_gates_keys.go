@@ -26,6 +26,21 @@ const (
 	// for configuring custom Kong entities that KIC does not support yet.
 	// Requires feature gate `FillIDs` to be enabled.
 	KongCustomEntityFeature = "KongCustomEntity"
+
+	// RejectConflictingRoutesFeature is the name of the feature-gate that makes the admission webhook reject
+	// HTTPRoutes whose routes would be shadowed by, or ambiguous with, routes of other HTTPRoutes.
+	// Takes effect only with the expressions router.
+	RejectConflictingRoutesFeature = "RejectConflictingRoutes"
+
+	// IncrementalTranslationFeature is the name of the feature-gate that makes KIC translate again only the parts of
+	// Kong configuration affected by the Kubernetes objects changed since the previous translation, with a periodic
+	// full translation.
+	IncrementalTranslationFeature = "IncrementalTranslation"
+
+	// UpstreamTargetHealthFeature is the name of the feature-gate that makes KIC periodically read the health of the
+	// targets of the upstreams with health checks from Kong Gateways and report it in KongUpstreamPolicies' status,
+	// as events on the Services and as metrics.
+	UpstreamTargetHealthFeature = "UpstreamTargetHealth"
 )
 
 // GetFeatureGatesDefaults returns the default values for all feature gates.
@@ -40,5 +55,8 @@ func GetFeatureGatesDefaults() FeatureGates {
 		SanitizeKonnectConfigDumpsFeature: true,
 		FallbackConfigurationFeature:      false,
 		KongCustomEntityFeature:           true,
+		RejectConflictingRoutesFeature:    false,
+		IncrementalTranslationFeature:     false,
+		UpstreamTargetHealthFeature:       false,
 	}
 }
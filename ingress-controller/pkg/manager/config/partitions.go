@@ -0,0 +1,32 @@
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// ConfigPartition describes the Kong Gateways receiving only the routes translated from a subset of the Kubernetes
+// objects. The other entities of the configuration (e.g. consumers, certificates or vaults) are sent to all Gateways.
+type ConfigPartition struct {
+	// Name identifies the partition.
+	Name string
+	// KongAdminSvc is the Service exposing the Admin APIs of the Gateways of the partition.
+	KongAdminSvc k8stypes.NamespacedName
+	// Namespaces are the namespaces of the objects whose routes belong to the partition. All namespaces match when
+	// empty.
+	Namespaces []string
+	// Selector selects the objects whose routes belong to the partition by their labels. All objects match when nil.
+	Selector *metav1.LabelSelector
+	// Listeners select the Gateway API routes whose routes belong to the partition by the Gateway listeners they are
+	// attached to. All objects match when empty, otherwise only Gateway API routes can match.
+	Listeners []GatewayListener
+}
+
+// GatewayListener identifies a listener of a Gateway. It identifies all the listeners of the Gateway when SectionName
+// is empty.
+type GatewayListener struct {
+	// Gateway is the namespaced name of the Gateway.
+	Gateway k8stypes.NamespacedName
+	// SectionName is the name of the listener.
+	SectionName string
+}
@@ -3,6 +3,9 @@ package config
 import (
 	"errors"
 	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 // Validate validates the config. It should be used to validate the config variables' interdependencies.
@@ -23,6 +26,9 @@ func (c *Config) Validate() error {
 	if err := c.validateGatewayDiscovery(); err != nil {
 		return fmt.Errorf("invalid gateway discovery configuration: %w", err)
 	}
+	if err := c.validateConfigPartitions(); err != nil {
+		return fmt.Errorf("invalid configuration partitions: %w", err)
+	}
 
 	return nil
 }
@@ -86,6 +92,41 @@ func (c *Config) validateGatewayDiscovery() error {
 	return nil
 }
 
+func (c *Config) validateConfigPartitions() error {
+	if len(c.KongAdminSvcPartitions) == 0 {
+		return nil
+	}
+
+	kongAdminSvc, ok := c.KongAdminSvc.Get()
+	if !ok {
+		return errors.New("KongAdminSvc has to be set when configuration partitions are set")
+	}
+	names := make(map[string]struct{}, len(c.KongAdminSvcPartitions))
+	services := map[k8stypes.NamespacedName]struct{}{kongAdminSvc: {}}
+	for _, p := range c.KongAdminSvcPartitions {
+		if p.Name == "" {
+			return errors.New("partition name cannot be empty")
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("partition %s is defined more than once", p.Name)
+		}
+		names[p.Name] = struct{}{}
+		if _, ok := services[p.KongAdminSvc]; ok {
+			return fmt.Errorf("partition %s: Service %s is already used by another partition or KongAdminSvc", p.Name, p.KongAdminSvc)
+		}
+		services[p.KongAdminSvc] = struct{}{}
+		if _, err := metav1.LabelSelectorAsSelector(p.Selector); err != nil {
+			return fmt.Errorf("partition %s: invalid selector: %w", p.Name, err)
+		}
+		for _, l := range p.Listeners {
+			if l.Gateway.Name == "" || l.Gateway.Namespace == "" {
+				return fmt.Errorf("partition %s: listener Gateway name and namespace cannot be empty", p.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func validateClientTLS(clientTLS TLSClientConfig) error {
 	if clientTLS.Cert != "" && clientTLS.CertFile != "" {
 		return errors.New("both client certificate and client certificate file specified, only one allowed")
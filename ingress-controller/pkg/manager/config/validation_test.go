@@ -6,6 +6,7 @@ import (
 
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	managercfg "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
@@ -232,4 +233,66 @@ func TestConfigValidate(t *testing.T) {
 			require.ErrorContains(t, c.Validate(), "readiness check timeout must be less than readiness check reconciliation interval")
 		})
 	})
+
+	t.Run("configuration partitions", func(t *testing.T) {
+		valid := func() *managercfg.Config {
+			return &managercfg.Config{
+				KongAdminSvc:                           mo.Some(k8stypes.NamespacedName{Name: "admin-svc", Namespace: "ns"}),
+				GatewayDiscoveryReadinessCheckInterval: managercfg.DefaultDataPlanesReadinessReconciliationInterval,
+				GatewayDiscoveryReadinessCheckTimeout:  managercfg.DefaultDataPlanesReadinessCheckTimeout,
+				KongAdminSvcPartitions: []managercfg.ConfigPartition{
+					{
+						Name:         "internal",
+						KongAdminSvc: k8stypes.NamespacedName{Name: "internal-admin-svc", Namespace: "ns"},
+						Namespaces:   []string{"team-a"},
+					},
+					{
+						Name:         "external",
+						KongAdminSvc: k8stypes.NamespacedName{Name: "external-admin-svc", Namespace: "ns"},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"exposure": "external"},
+						},
+					},
+				},
+			}
+		}
+
+		t.Run("valid partitions are accepted", func(t *testing.T) {
+			require.NoError(t, valid().Validate())
+		})
+
+		t.Run("partitions without KongAdminSvc are rejected", func(t *testing.T) {
+			c := valid()
+			c.KongAdminSvc = mo.None[k8stypes.NamespacedName]()
+			require.ErrorContains(t, c.Validate(), "KongAdminSvc has to be set when configuration partitions are set")
+		})
+
+		t.Run("duplicated partition names are rejected", func(t *testing.T) {
+			c := valid()
+			c.KongAdminSvcPartitions[1].Name = "internal"
+			require.ErrorContains(t, c.Validate(), "partition internal is defined more than once")
+		})
+
+		t.Run("partitions sharing a Service are rejected", func(t *testing.T) {
+			c := valid()
+			c.KongAdminSvcPartitions[1].KongAdminSvc = k8stypes.NamespacedName{Name: "admin-svc", Namespace: "ns"}
+			require.ErrorContains(t, c.Validate(), "Service ns/admin-svc is already used by another partition or KongAdminSvc")
+		})
+
+		t.Run("invalid selector is rejected", func(t *testing.T) {
+			c := valid()
+			c.KongAdminSvcPartitions[1].Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "exposure", Operator: "Unknown"}},
+			}
+			require.ErrorContains(t, c.Validate(), "partition external: invalid selector")
+		})
+
+		t.Run("listener without Gateway namespace is rejected", func(t *testing.T) {
+			c := valid()
+			c.KongAdminSvcPartitions[1].Listeners = []managercfg.GatewayListener{
+				{Gateway: k8stypes.NamespacedName{Name: "gateway"}, SectionName: "http"},
+			}
+			require.ErrorContains(t, c.Validate(), "partition external: listener Gateway name and namespace cannot be empty")
+		})
+	})
 }
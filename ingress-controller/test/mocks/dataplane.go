@@ -16,6 +16,8 @@ type Dataplane struct {
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/3793
 	// which requires the status to be reported for route objects.
 	ObjectsStatuses map[string]map[string]k8sobj.ConfigurationStatus
+	// Mapping namespace to name to route conflict message
+	RouteConflicts map[string]map[string]string
 }
 
 // SetObjectStatus sets the mock dataplane report status for a single object.
@@ -58,3 +60,8 @@ func (d Dataplane) KubernetesObjectConfigurationStatus(obj client.Object) k8sobj
 func (d Dataplane) KubernetesObjectIsConfigured(obj client.Object) bool {
 	return d.ObjectsStatuses[obj.GetNamespace()][obj.GetName()] == k8sobj.ConfigurationStatusSucceeded
 }
+
+func (d Dataplane) KubernetesObjectRouteConflict(obj client.Object) (string, bool) {
+	message, ok := d.RouteConflicts[obj.GetNamespace()][obj.GetName()]
+	return message, ok
+}
@@ -35,6 +35,9 @@ func (m MetricsRecorder) RecordTranslationFailure(time.Duration) {
 func (m MetricsRecorder) RecordTranslationBrokenResources(int) {
 }
 
+func (m MetricsRecorder) RecordTranslationRouteConflicts(int) {
+}
+
 func (m MetricsRecorder) RecordTranslationSuccess(time.Duration) {
 }
 
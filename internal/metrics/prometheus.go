@@ -15,6 +15,15 @@ type Recorder interface {
 	RecordKonnectEntityOperationFailure(serverURL string, operationType KonnectEntityOperation, entityType string, duration time.Duration, statusCode int)
 }
 
+// OrphanedEntitiesRecorder is the interface for recording metrics about orphaned
+// Konnect entities, i.e. entities created by the operator whose Kubernetes objects
+// do not exist anymore.
+type OrphanedEntitiesRecorder interface {
+	RecordKonnectOrphanedEntities(serverURL string, controlPlaneID string, countsByEntityType map[string]int)
+	RecordKonnectOrphanedEntityDeletion(serverURL string, controlPlaneID string, entityType string, success bool)
+	ForgetKonnectOrphanedEntities(controlPlaneID string)
+}
+
 // KonnectEntityOperation specifies the type of Konnect entity operation, including `create`, `update`, and `delete`.
 type KonnectEntityOperation string
 
@@ -43,6 +52,8 @@ const (
 	// It is always `0` for successful operations.
 	// When the opertion fails, it will be the actual status code if we can get it. Otherwise it will also be `0`.
 	StatusCodeKey = "status_code"
+	// KonnectControlPlaneIDKey is the key for the ID of the Konnect control plane.
+	KonnectControlPlaneIDKey = "control_plane_id"
 )
 
 // metric names for konnect entity operations.
@@ -54,6 +65,16 @@ const (
 	MetricNameKonnectEntityOperationDuration = "gateway_operator_konnect_entity_operation_duration_milliseconds"
 )
 
+// metric names for orphaned konnect entities.
+const (
+	// MetricNameKonnectOrphanedEntities is the metric of number of orphaned entities found
+	// in a Konnect control plane during its last sweep, grouped by server URL, control plane ID and entity type.
+	MetricNameKonnectOrphanedEntities = "gateway_operator_konnect_orphaned_entities"
+	// MetricNameKonnectOrphanedEntityDeletionCount is the metric of number of deletions of orphaned entities,
+	// grouped by server URL, control plane ID, entity type and successful status.
+	MetricNameKonnectOrphanedEntityDeletionCount = "gateway_operator_konnect_orphaned_entity_deletion_count"
+)
+
 var (
 	konnectEntityOperationCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -100,6 +121,36 @@ var (
 		},
 		[]string{KonnectServerURLKey, KonnectEntityOperationTypeKey, KonnectEntityTypeKey, SuccessKey, StatusCodeKey},
 	)
+
+	konnectOrphanedEntities = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameKonnectOrphanedEntities,
+			Help: fmt.Sprintf(
+				"Number of orphaned entities found in a Konnect control plane during its last sweep. "+
+					"`%s` describes the URL of the Konnect server. "+
+					"`%s` describes the ID of the Konnect control plane. "+
+					"`%s` describes the type of the orphaned entity.",
+				KonnectServerURLKey, KonnectControlPlaneIDKey, KonnectEntityTypeKey,
+			),
+		},
+		[]string{KonnectServerURLKey, KonnectControlPlaneIDKey, KonnectEntityTypeKey},
+	)
+
+	konnectOrphanedEntityDeletionCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameKonnectOrphanedEntityDeletionCount,
+			Help: fmt.Sprintf(
+				"Count of successful/failed deletions of orphaned entities in Konnect. "+
+					"`%s` describes the URL of the Konnect server. "+
+					"`%s` describes the ID of the Konnect control plane. "+
+					"`%s` describes the type of the deleted entity. "+
+					"`%s` describes whether the deletion is successful (`%s`) or not (`%s`).",
+				KonnectServerURLKey, KonnectControlPlaneIDKey, KonnectEntityTypeKey,
+				SuccessKey, SuccessTrue, SuccessFalse,
+			),
+		},
+		[]string{KonnectServerURLKey, KonnectControlPlaneIDKey, KonnectEntityTypeKey, SuccessKey},
+	)
 )
 
 // GlobalCtrlRuntimeMetricsRecorder is a metrics recorder that uses a global Prometheus registry
@@ -110,7 +161,10 @@ var (
 // Upstream issue regarding this: https://github.com/kubernetes-sigs/controller-runtime/issues/210.
 type GlobalCtrlRuntimeMetricsRecorder struct{}
 
-var _ Recorder = &GlobalCtrlRuntimeMetricsRecorder{}
+var (
+	_ Recorder                 = &GlobalCtrlRuntimeMetricsRecorder{}
+	_ OrphanedEntitiesRecorder = &GlobalCtrlRuntimeMetricsRecorder{}
+)
 
 // NewGlobalCtrlRuntimeMetricsRecorder creates a new GlobalCtrlRuntimeMetricsRecorder instance.
 func NewGlobalCtrlRuntimeMetricsRecorder() *GlobalCtrlRuntimeMetricsRecorder {
@@ -147,6 +201,45 @@ func (r *GlobalCtrlRuntimeMetricsRecorder) recordKonnectEntityOperationDuration(
 	konnectEntityOperationDuration.With(labels).Observe(duration.Seconds())
 }
 
+// RecordKonnectOrphanedEntities is called after each sweep of a Konnect control plane
+// with the number of orphaned entities found per entity type.
+func (r *GlobalCtrlRuntimeMetricsRecorder) RecordKonnectOrphanedEntities(
+	serverURL string, controlPlaneID string, countsByEntityType map[string]int,
+) {
+	for entityType, count := range countsByEntityType {
+		konnectOrphanedEntities.With(prometheus.Labels{
+			KonnectServerURLKey:      serverURL,
+			KonnectControlPlaneIDKey: controlPlaneID,
+			KonnectEntityTypeKey:     entityType,
+		}).Set(float64(count))
+	}
+}
+
+// RecordKonnectOrphanedEntityDeletion is called when an orphaned entity has been deleted
+// (or the deletion has failed).
+func (r *GlobalCtrlRuntimeMetricsRecorder) RecordKonnectOrphanedEntityDeletion(
+	serverURL string, controlPlaneID string, entityType string, success bool,
+) {
+	labels := prometheus.Labels{
+		KonnectServerURLKey:      serverURL,
+		KonnectControlPlaneIDKey: controlPlaneID,
+		KonnectEntityTypeKey:     entityType,
+		SuccessKey:               SuccessFalse,
+	}
+	if success {
+		labels[SuccessKey] = SuccessTrue
+	}
+	konnectOrphanedEntityDeletionCount.With(labels).Inc()
+}
+
+// ForgetKonnectOrphanedEntities removes the orphaned entities gauges of the given
+// control plane, e.g. when the sweeper gets disabled for it.
+func (r *GlobalCtrlRuntimeMetricsRecorder) ForgetKonnectOrphanedEntities(controlPlaneID string) {
+	konnectOrphanedEntities.DeletePartialMatch(prometheus.Labels{
+		KonnectControlPlaneIDKey: controlPlaneID,
+	})
+}
+
 // konnectEntityOperationLabels generates the labels for recording metrics about Konnect entity opertions,
 // including: server URL, operation type, entity type, whether the opertion succeeded, and status code.
 func konnectEntityOperationLabels(
@@ -171,6 +264,8 @@ func init() {
 	allMetrics := []prometheus.Collector{
 		konnectEntityOperationCount,
 		konnectEntityOperationDuration,
+		konnectOrphanedEntities,
+		konnectOrphanedEntityDeletionCount,
 	}
 	for _, m := range allMetrics {
 		ctrlmetrics.Registry.MustRegister(m)
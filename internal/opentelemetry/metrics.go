@@ -0,0 +1,86 @@
+package opentelemetry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	otelprometheus "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// MetricsExporter periodically exports the metrics registered in a Prometheus
+// registry through OTLP, so that the OTLP metrics mirror the ones exposed on
+// the Prometheus endpoint.
+type MetricsExporter struct {
+	Config   Config
+	Gatherer prometheus.Gatherer
+	Resource *resource.Resource
+
+	Logger logr.Logger
+}
+
+var _ manager.Runnable = &MetricsExporter{}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface so that
+// all the replicas export their metrics.
+func (e *MetricsExporter) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements the Start method of manager.Runnable interface to add to the manager.
+// It exports the metrics every Config.MetricsExportInterval until ctx expires.
+func (e *MetricsExporter) Start(ctx context.Context) error {
+	endpoint, insecure := e.Config.endpoint("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT")
+	opts := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(endpoint),
+	}
+	if insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	exporter, err := otlpmetricgrpc.New(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP metrics exporter for %s: %w", endpoint, err)
+	}
+
+	interval := e.Config.MetricsExportInterval
+	if interval <= 0 {
+		interval = DefaultMetricsExportInterval
+	}
+	mp := e.newMeterProvider(sdkmetric.NewPeriodicReader(exporter,
+		sdkmetric.WithInterval(interval),
+		sdkmetric.WithProducer(e.newProducer()),
+	))
+
+	e.Logger.Info("Exporting metrics through OTLP", "endpoint", endpoint, "interval", interval)
+	<-ctx.Done()
+
+	// Shutting the meter provider down exports the metrics one last time so
+	// that the final values of the counters are not lost.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := mp.Shutdown(shutdownCtx); err != nil { //nolint:contextcheck
+		e.Logger.Error(err, "Failed to export metrics through OTLP")
+	}
+	return nil
+}
+
+// newProducer returns the producer converting the metrics of the Prometheus
+// registry into OpenTelemetry metrics every time they are exported.
+func (e *MetricsExporter) newProducer() sdkmetric.Producer {
+	return otelprometheus.NewMetricProducer(otelprometheus.WithGatherer(e.Gatherer))
+}
+
+// newMeterProvider returns the meter provider collecting the metrics through
+// the reader, described by the exporter's resource.
+func (e *MetricsExporter) newMeterProvider(reader sdkmetric.Reader) *sdkmetric.MeterProvider {
+	return sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(e.Resource),
+		sdkmetric.WithReader(reader),
+	)
+}
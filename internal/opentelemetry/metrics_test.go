@@ -0,0 +1,87 @@
+package opentelemetry
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestMetricsExporter(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "test_operations_total",
+		Help: "Number of operations.",
+	}, []string{"operation"})
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "test_entities",
+		Help: "Number of entities.",
+	})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_duration_milliseconds",
+		Help:    "Duration of operations.",
+		Buckets: []float64{10, 100},
+	})
+	reg.MustRegister(counter, gauge, histogram)
+
+	counter.WithLabelValues("create").Add(3)
+	gauge.Set(7)
+	for _, v := range []float64{5, 50, 60, 500} {
+		histogram.Observe(v)
+	}
+
+	res := resource.NewSchemaless(attribute.String("service.name", ServiceName))
+	e := &MetricsExporter{
+		Gatherer: reg,
+		Resource: res,
+		Logger:   logr.Discard(),
+	}
+	reader := sdkmetric.NewManualReader(sdkmetric.WithProducer(e.newProducer()))
+	mp := e.newMeterProvider(reader)
+	t.Cleanup(func() { require.NoError(t, mp.Shutdown(t.Context())) })
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	assert.Equal(t, res, rm.Resource)
+	require.Len(t, rm.ScopeMetrics, 1)
+	metrics := make(map[string]metricdata.Metrics)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+	require.Len(t, metrics, 3)
+
+	t.Run("counter", func(t *testing.T) {
+		sum, ok := metrics["test_operations_total"].Data.(metricdata.Sum[float64])
+		require.True(t, ok)
+		assert.True(t, sum.IsMonotonic)
+		assert.Equal(t, metricdata.CumulativeTemporality, sum.Temporality)
+		require.Len(t, sum.DataPoints, 1)
+		dp := sum.DataPoints[0]
+		assert.InDelta(t, 3, dp.Value, 0.001)
+		assert.Equal(t, attribute.NewSet(attribute.String("operation", "create")), dp.Attributes)
+	})
+
+	t.Run("gauge", func(t *testing.T) {
+		g, ok := metrics["test_entities"].Data.(metricdata.Gauge[float64])
+		require.True(t, ok)
+		require.Len(t, g.DataPoints, 1)
+		assert.InDelta(t, 7, g.DataPoints[0].Value, 0.001)
+	})
+
+	t.Run("histogram", func(t *testing.T) {
+		h, ok := metrics["test_duration_milliseconds"].Data.(metricdata.Histogram[float64])
+		require.True(t, ok)
+		require.Len(t, h.DataPoints, 1)
+		dp := h.DataPoints[0]
+		assert.Equal(t, uint64(4), dp.Count)
+		assert.InDelta(t, 615, dp.Sum, 0.001)
+		assert.Equal(t, []float64{10, 100}, dp.Bounds)
+		assert.Equal(t, []uint64{1, 2, 1}, dp.BucketCounts, "bucket counts are not cumulative")
+	})
+}
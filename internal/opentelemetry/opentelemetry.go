@@ -0,0 +1,129 @@
+// Package opentelemetry exports the traces and the metrics of the operator,
+// and of the ControlPlanes it runs, through OTLP.
+package opentelemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+const (
+	// ServiceName is the name of the service set in the resource of the
+	// exported traces and metrics.
+	ServiceName = "kong-operator"
+
+	// DefaultEndpoint is the OTLP gRPC endpoint used when neither the
+	// configuration nor the environment provide one.
+	DefaultEndpoint = "localhost:4317"
+	// DefaultMetricsExportInterval is the default interval between two
+	// exports of the metrics.
+	DefaultMetricsExportInterval = 30 * time.Second
+	// DefaultTraceSamplingRatio is the default ratio of the traces which are sampled.
+	DefaultTraceSamplingRatio = 1.0
+)
+
+// Config holds the configuration of the OTLP exporters.
+type Config struct {
+	// Endpoint is the host:port of the OTLP gRPC receiver. When empty, the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable is used, or DefaultEndpoint.
+	Endpoint string
+	// Insecure disables TLS when connecting to the OTLP receiver.
+	Insecure bool
+	// TraceSamplingRatio is the ratio of the root spans which are sampled.
+	// Child spans follow the sampling decision of their parent.
+	TraceSamplingRatio float64
+	// MetricsExportInterval is the interval between two exports of the metrics.
+	MetricsExportInterval time.Duration
+}
+
+// endpoint returns the OTLP gRPC endpoint to connect to and whether the
+// connection should be insecure.
+func (c Config) endpoint(signalEnv string) (string, bool) {
+	if c.Endpoint != "" {
+		return c.Endpoint, c.Insecure
+	}
+	for _, env := range []string{signalEnv, "OTEL_EXPORTER_OTLP_ENDPOINT"} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		// The environment variables hold URLs, whose scheme tells whether
+		// the connection is secure.
+		u, err := url.Parse(v)
+		if err != nil || u.Host == "" {
+			return v, c.Insecure
+		}
+		return u.Host, c.Insecure || u.Scheme == "http"
+	}
+	return DefaultEndpoint, c.Insecure
+}
+
+// NewResource returns the resource describing the operator in the exported
+// traces and metrics. Attributes set in the OTEL_RESOURCE_ATTRIBUTES
+// environment variable are added to it.
+func NewResource(ctx context.Context, version string) (*resource.Resource, error) {
+	attrs := []resource.Option{
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(version),
+		),
+	}
+	if pod := os.Getenv("POD_NAME"); pod != "" {
+		attrs = append(attrs, resource.WithAttributes(semconv.ServiceInstanceID(pod)))
+	}
+	return resource.New(ctx, attrs...)
+}
+
+// SetupTracing configures the global tracer provider to export the traces
+// through OTLP and the global propagator to propagate the trace context in
+// the W3C Trace Context format. It returns a function flushing the pending
+// spans and shutting the exporter down.
+func SetupTracing(ctx context.Context, cfg Config, res *resource.Resource) (func(context.Context) error, error) {
+	endpoint, insecure := cfg.endpoint("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(endpoint),
+	}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TraceSamplingRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return func(ctx context.Context) error {
+		return errors.Join(tp.ForceFlush(ctx), tp.Shutdown(ctx))
+	}, nil
+}
+
+// ValidateSamplingRatio returns an error when the ratio is not in [0, 1].
+func ValidateSamplingRatio(ratio float64) error {
+	if ratio < 0 || ratio > 1 {
+		return fmt.Errorf("trace sampling ratio must be between 0 and 1, got %g", ratio)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package opentelemetry
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// TracerName is the name of the tracer creating the spans of the operator.
+const TracerName = "github.com/kong/kong-operator"
+
+// Attribute keys set on the spans of reconciliations.
+const (
+	AttributeKeyKind           = attribute.Key("k8s.object.kind")
+	AttributeKeyNamespace      = attribute.Key("k8s.object.namespace")
+	AttributeKeyName           = attribute.Key("k8s.object.name")
+	AttributeKeyGeneration     = attribute.Key("k8s.object.generation")
+	AttributeKeyOwnerKind      = attribute.Key("k8s.object.owner.kind")
+	AttributeKeyOwnerName      = attribute.Key("k8s.object.owner.name")
+	AttributeKeyRequeue        = attribute.Key("reconcile.requeue")
+	AttributeKeyRequeueAfterMs = attribute.Key("reconcile.requeue_after_ms")
+)
+
+// StartSpan starts a span with the global tracer provider. When tracing is
+// not enabled, the span is a no-op.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, recording the error when it's not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ObjectAttributes returns the attributes identifying the object in a span.
+func ObjectAttributes(kind string, obj client.Object) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		AttributeKeyKind.String(kind),
+		AttributeKeyNamespace.String(obj.GetNamespace()),
+		AttributeKeyName.String(obj.GetName()),
+		AttributeKeyGeneration.Int64(obj.GetGeneration()),
+	}
+	// Spans of the reconciliations of objects provisioned by other objects,
+	// e.g. DataPlanes provisioned for Gateways, can be correlated with the
+	// ones of their owners.
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		attrs = append(attrs,
+			AttributeKeyOwnerKind.String(owner.Kind),
+			AttributeKeyOwnerName.String(owner.Name),
+		)
+	}
+	return attrs
+}
+
+// ObjectReconciler wraps the reconciler so that each reconciliation of an
+// object of the provided kind is traced in a span.
+func ObjectReconciler[T client.Object](kind string, r reconcile.ObjectReconciler[T]) reconcile.ObjectReconciler[T] {
+	return &tracingReconciler[T]{
+		kind: kind,
+		r:    r,
+	}
+}
+
+type tracingReconciler[T client.Object] struct {
+	kind string
+	r    reconcile.ObjectReconciler[T]
+}
+
+// Reconcile implements reconcile.ObjectReconciler.
+func (t *tracingReconciler[T]) Reconcile(ctx context.Context, obj T) (ctrl.Result, error) {
+	ctx, span := StartSpan(ctx, "Reconcile "+t.kind, ObjectAttributes(t.kind, obj)...)
+	res, err := t.r.Reconcile(ctx, obj)
+	span.SetAttributes(
+		AttributeKeyRequeue.Bool(res.Requeue || res.RequeueAfter > 0),
+		AttributeKeyRequeueAfterMs.Int64(res.RequeueAfter.Milliseconds()),
+	)
+	EndSpan(span, err)
+	return res, err
+}
+
+// HTTPTransport wraps the transport so that the requests are traced and
+// carry the trace context of their callers, e.g. to the Kong Admin API or
+// the Konnect API.
+func HTTPTransport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt)
+}
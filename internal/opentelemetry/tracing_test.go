@@ -0,0 +1,107 @@
+package opentelemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type objectReconcilerFunc[T client.Object] func(context.Context, T) (ctrl.Result, error)
+
+func (f objectReconcilerFunc[T]) Reconcile(ctx context.Context, obj T) (ctrl.Result, error) {
+	return f(ctx, obj)
+}
+
+func setupTestTracing(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevTP, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return exporter
+}
+
+func TestObjectReconciler(t *testing.T) {
+	exporter := setupTestTracing(t)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "svc",
+			Generation: 2,
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Gateway", Name: "gw", Controller: new(true)},
+			},
+		},
+	}
+	reconcileErr := errors.New("failed")
+	r := ObjectReconciler[*corev1.Service]("Service", objectReconcilerFunc[*corev1.Service](func(ctx context.Context, _ *corev1.Service) (ctrl.Result, error) {
+		_, span := StartSpan(ctx, "child")
+		EndSpan(span, nil)
+		return ctrl.Result{RequeueAfter: time.Second}, reconcileErr
+	}))
+
+	_, err := r.Reconcile(t.Context(), svc)
+	require.ErrorIs(t, err, reconcileErr)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	child, parent := spans[0], spans[1]
+	assert.Equal(t, "child", child.Name)
+	assert.Equal(t, parent.SpanContext.SpanID(), child.Parent.SpanID())
+
+	assert.Equal(t, "Reconcile Service", parent.Name)
+	assert.Equal(t, codes.Error, parent.Status.Code)
+	assert.Subset(t, parent.Attributes, []attribute.KeyValue{
+		AttributeKeyKind.String("Service"),
+		AttributeKeyNamespace.String("default"),
+		AttributeKeyName.String("svc"),
+		AttributeKeyGeneration.Int64(2),
+		AttributeKeyOwnerKind.String("Gateway"),
+		AttributeKeyOwnerName.String("gw"),
+		AttributeKeyRequeue.Bool(true),
+		AttributeKeyRequeueAfterMs.Int64(1000),
+	})
+}
+
+func TestHTTPTransport(t *testing.T) {
+	exporter := setupTestTracing(t)
+
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+
+	ctx, span := StartSpan(t.Context(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: HTTPTransport(http.DefaultTransport)}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	EndSpan(span, nil)
+
+	assert.Contains(t, traceparent, span.SpanContext().TraceID().String(), "the trace context is propagated")
+	assert.Len(t, exporter.GetSpans(), 2, "the request is traced")
+}
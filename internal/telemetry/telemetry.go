@@ -234,6 +234,8 @@ func createManager(
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialACL](w, metadataClient, cl.RESTMapper(), log, group, version)
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialJWT](w, metadataClient, cl.RESTMapper(), log, group, version)
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialHMAC](w, metadataClient, cl.RESTMapper(), log, group, version)
+				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialOAuth2](w, metadataClient, cl.RESTMapper(), log, group, version)
+				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialMTLS](w, metadataClient, cl.RESTMapper(), log, group, version)
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialAPIKey](w, metadataClient, cl.RESTMapper(), log, group, version)
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongCredentialBasicAuth](w, metadataClient, cl.RESTMapper(), log, group, version)
 				AddObjectCountProviderOrLog[configurationv1alpha1.KongSNI](w, metadataClient, cl.RESTMapper(), log, group, version)
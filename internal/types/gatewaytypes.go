@@ -33,6 +33,8 @@ type (
 	HTTPRouteStatus        = gatewayv1.HTTPRouteStatus
 	Kind                   = gatewayv1.Kind
 	Listener               = gatewayv1.Listener
+	ListenerSet            = gatewayv1.ListenerSet
+	ListenerSetList        = gatewayv1.ListenerSetList
 	LocalObjectReference   = gatewayv1.LocalObjectReference
 	Namespace              = gatewayv1.Namespace
 	ObjectName             = gatewayv1.ObjectName
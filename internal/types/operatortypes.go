@@ -25,6 +25,9 @@ type (
 	// ControlPlaneDataPlaneTargetRef is an alias for the v2beta1 ControlPlaneDataPlaneTargetRef type.
 	ControlPlaneDataPlaneTargetRef = operatorv2beta1.ControlPlaneDataPlaneTargetRef
 
+	// ControlPlaneDataPlanePartition is an alias for the v2beta1 ControlPlaneDataPlanePartition type.
+	ControlPlaneDataPlanePartition = operatorv2beta1.ControlPlaneDataPlanePartition
+
 	// ControlPlaneList is an alias for the v2beta1 ControlPlaneList type.
 	ControlPlaneList = operatorv2beta1.ControlPlaneList
 
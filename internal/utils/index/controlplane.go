@@ -33,7 +33,7 @@ func OptionsForControlPlane(konnectControllersEnabled bool) []Option {
 }
 
 // dataPlaneNameOnControlPlane indexes the ControlPlane .spec.dataplaneName field
-// on the "dataplane" key.
+// and the DataPlanes of its partitions on the "dataplane" key.
 func dataPlaneNameOnControlPlane(o client.Object) []string {
 	controlPlane, ok := o.(*gwtypes.ControlPlane)
 	if !ok {
@@ -43,7 +43,11 @@ func dataPlaneNameOnControlPlane(o client.Object) []string {
 	switch dp.Type {
 	case gwtypes.ControlPlaneDataPlaneTargetRefType:
 		// Note: .Name is a pointer, enforced to be non nil at the CRD level.
-		return []string{controlPlane.Spec.DataPlane.Ref.Name}
+		names := []string{controlPlane.Spec.DataPlane.Ref.Name}
+		for _, p := range dp.Partitions {
+			names = append(names, p.Ref.Name)
+		}
+		return names
 	case gwtypes.ControlPlaneDataPlaneTargetManagedByType:
 		if controlPlane.Status.DataPlane == nil {
 			return []string{}
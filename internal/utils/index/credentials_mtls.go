@@ -0,0 +1,32 @@
+package index
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+)
+
+const (
+	// IndexFieldKongCredentialMTLSReferencesKongConsumer is the index name for KongCredentialMTLS -> Consumer.
+	IndexFieldKongCredentialMTLSReferencesKongConsumer = "kongCredentialsMTLSConsumerRef"
+)
+
+// OptionsForCredentialsMTLS returns required Index options for KongCredentialMTLS.
+func OptionsForCredentialsMTLS() []Option {
+	return []Option{
+		{
+			Object:         &configurationv1alpha1.KongCredentialMTLS{},
+			Field:          IndexFieldKongCredentialMTLSReferencesKongConsumer,
+			ExtractValueFn: kongCredentialMTLSReferencesConsumer,
+		},
+	}
+}
+
+// kongCredentialMTLSReferencesConsumer returns the name of referenced Consumer.
+func kongCredentialMTLSReferencesConsumer(obj client.Object) []string {
+	cred, ok := obj.(*configurationv1alpha1.KongCredentialMTLS)
+	if !ok {
+		return nil
+	}
+	return []string{cred.Spec.ConsumerRef.Name}
+}
@@ -0,0 +1,32 @@
+package index
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+)
+
+const (
+	// IndexFieldKongCredentialOAuth2ReferencesKongConsumer is the index name for KongCredentialOAuth2 -> Consumer.
+	IndexFieldKongCredentialOAuth2ReferencesKongConsumer = "kongCredentialsOAuth2ConsumerRef"
+)
+
+// OptionsForCredentialsOAuth2 returns required Index options for KongCredentialOAuth2.
+func OptionsForCredentialsOAuth2() []Option {
+	return []Option{
+		{
+			Object:         &configurationv1alpha1.KongCredentialOAuth2{},
+			Field:          IndexFieldKongCredentialOAuth2ReferencesKongConsumer,
+			ExtractValueFn: kongCredentialOAuth2ReferencesConsumer,
+		},
+	}
+}
+
+// kongCredentialOAuth2ReferencesConsumer returns the name of referenced Consumer.
+func kongCredentialOAuth2ReferencesConsumer(obj client.Object) []string {
+	cred, ok := obj.(*configurationv1alpha1.KongCredentialOAuth2)
+	if !ok {
+		return nil
+	}
+	return []string{cred.Spec.ConsumerRef.Name}
+}
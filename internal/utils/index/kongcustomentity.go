@@ -0,0 +1,59 @@
+package index
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+)
+
+const (
+	// IndexFieldKongCustomEntityOnKonnectGatewayControlPlane is the index field for KongCustomEntity -> KonnectGatewayControlPlane.
+	IndexFieldKongCustomEntityOnKonnectGatewayControlPlane = "kongCustomEntityKonnectGatewayControlPlaneRef"
+
+	// IndexFieldKongCustomEntityOnKongServiceReference is the index field for KongCustomEntity -> KongService.
+	IndexFieldKongCustomEntityOnKongServiceReference = "kongCustomEntityKongServiceRef"
+
+	// IndexFieldKongCustomEntityOnKongRouteReference is the index field for KongCustomEntity -> KongRoute.
+	IndexFieldKongCustomEntityOnKongRouteReference = "kongCustomEntityKongRouteRef"
+)
+
+// OptionsForKongCustomEntity returns required Index options for KongCustomEntity reconciler.
+func OptionsForKongCustomEntity(cl client.Client) []Option {
+	return []Option{
+		{
+			Object:         &configurationv1alpha1.KongCustomEntity{},
+			Field:          IndexFieldKongCustomEntityOnKonnectGatewayControlPlane,
+			ExtractValueFn: indexKonnectGatewayControlPlaneRef[configurationv1alpha1.KongCustomEntity](cl),
+		},
+		{
+			Object:         &configurationv1alpha1.KongCustomEntity{},
+			Field:          IndexFieldKongCustomEntityOnKongServiceReference,
+			ExtractValueFn: kongServiceRefFromKongCustomEntity,
+		},
+		{
+			Object:         &configurationv1alpha1.KongCustomEntity{},
+			Field:          IndexFieldKongCustomEntityOnKongRouteReference,
+			ExtractValueFn: kongRouteRefFromKongCustomEntity,
+		},
+	}
+}
+
+// kongServiceRefFromKongCustomEntity returns the name of the KongService referenced by the KongCustomEntity.
+func kongServiceRefFromKongCustomEntity(obj client.Object) []string {
+	entity, ok := obj.(*configurationv1alpha1.KongCustomEntity)
+	if !ok || entity.Spec.ServiceRef == nil {
+		return nil
+	}
+
+	return []string{entity.Spec.ServiceRef.Name}
+}
+
+// kongRouteRefFromKongCustomEntity returns the name of the KongRoute referenced by the KongCustomEntity.
+func kongRouteRefFromKongCustomEntity(obj client.Object) []string {
+	entity, ok := obj.(*configurationv1alpha1.KongCustomEntity)
+	if !ok || entity.Spec.RouteRef == nil {
+		return nil
+	}
+
+	return []string{entity.Spec.RouteRef.Name}
+}
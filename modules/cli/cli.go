@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ingressmgrconfig "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/modules/manager"
 	mgrconfig "github.com/kong/kong-operator/v2/modules/manager/config"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
@@ -73,6 +74,16 @@ func New(m metadata.Info) *CLI {
 	// controllers for ControlPlane
 	flagSet.BoolVar(&cfg.ControlPlaneConfigurationDumpEnabled, "enable-controlplane-config-dump", false, "Enable the server to dump generated Kong configuration from ControlPlanes. Only effective when ControlPlane controller is enabled.")
 	flagSet.StringVar(&cfg.ControlPlaneConfigurationDumpAddr, "controlplane-config-dump-bind-address", manager.DefaultControlPlaneConfigurationDumpAddr, "The address where server dumps ControlPlane configuration. Only enabled when 'enable-controlplane-config-dump' is true.")
+	flagSet.BoolVar(&cfg.MetricsAdapterEnabled, "enable-metrics-adapter", false, "Enable the server serving the metrics scraped from DataPlanes through the custom.metrics.k8s.io and external.metrics.k8s.io APIs. Only effective when ControlPlane extensions controller is enabled.")
+	flagSet.StringVar(&cfg.MetricsAdapterAddr, "metrics-adapter-bind-address", manager.DefaultMetricsAdapterAddr, "The address the metrics adapter server binds to. Only enabled when 'enable-metrics-adapter' is true.")
+	flagSet.StringVar(&cfg.MetricsAdapterCertDir, "metrics-adapter-cert-dir", "", "The directory holding the tls.crt and tls.key serving certificate of the metrics adapter server. When empty, a self-signed certificate is generated on start, which requires the APIServices to skip TLS verification.")
+
+	// OpenTelemetry
+	flagSet.BoolVar(&cfg.OpenTelemetryEnabled, "enable-opentelemetry", false, "Enable the export of the traces of the reconciliations, of the requests to the Kong Admin API and to Konnect, and of the metrics through OTLP.")
+	flagSet.StringVar(&cfg.OpenTelemetry.Endpoint, "opentelemetry-endpoint", "", "The host:port of the OTLP gRPC receiver. Defaults to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable, or "+opentelemetry.DefaultEndpoint+".")
+	flagSet.BoolVar(&cfg.OpenTelemetry.Insecure, "opentelemetry-insecure", false, "Disable TLS when connecting to the OTLP receiver.")
+	flagSet.Float64Var(&cfg.OpenTelemetry.TraceSamplingRatio, "opentelemetry-trace-sampling-ratio", opentelemetry.DefaultTraceSamplingRatio, "Ratio (between 0 and 1) of the traces which are sampled.")
+	flagSet.DurationVar(&cfg.OpenTelemetry.MetricsExportInterval, "opentelemetry-metrics-export-interval", opentelemetry.DefaultMetricsExportInterval, "Interval between two exports of the metrics through OTLP.")
 
 	// controllers for specialized APIs and features
 	flagSet.BoolVar(&cfg.AIGatewayControllerEnabled, "enable-controller-aigateway", false, "Enable the AIGateway (v1) controller. (Deprecated: Use Konnect AI Gateway instead: Set enable-controller-konnect and enable-controller-aigatewaydataplane to true).")
@@ -281,6 +292,11 @@ func (c *CLI) Parse(arguments []string) manager.Config {
 		os.Exit(1)
 	}
 
+	if err := opentelemetry.ValidateSamplingRatio(c.cfg.OpenTelemetry.TraceSamplingRatio); err != nil {
+		fmt.Printf("ERROR: --opentelemetry-trace-sampling-ratio: %v\n", err)
+		os.Exit(1)
+	}
+
 	return *c.cfg
 }
 
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ingressmgrconfig "github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/config"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/modules/manager"
 	mgrconfig "github.com/kong/kong-operator/v2/modules/manager/config"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
@@ -359,34 +360,39 @@ func TestParse(t *testing.T) {
 
 func expectedDefaultCfg() manager.Config {
 	return manager.Config{
-		MetricsAddr:                              ":8080",
-		MetricsAccessFilter:                      "off",
-		ProbeAddr:                                ":8081",
-		LeaderElection:                           true,
-		LeaderElectionNamespace:                  "kong-system",
-		LeaderElectionLeaseDuration:              mgrconfig.DefaultLeaderElectionLeaseDuration,
-		LeaderElectionRenewDeadline:              mgrconfig.DefaultLeaderElectionRenewDeadline,
-		LeaderElectionRetryPeriod:                mgrconfig.DefaultLeaderElectionRetryPeriod,
-		LoggingMode:                              logging.ProductionMode,
-		ValidateImages:                           true,
-		EnforceConfig:                            true,
-		ControllerName:                           "",
-		ControllerNamespace:                      "kong-system",
-		AnonymousReports:                         true,
-		APIServerHost:                            "",
-		APIServerQPS:                             100,
-		APIServerBurst:                           300,
-		KubeconfigPath:                           "",
-		SecretLabelSelector:                      mgrconfig.DefaultSecretLabelSelector,
-		ConfigMapLabelSelector:                   mgrconfig.DefaultConfigMapLabelSelector,
-		ClusterCASecretName:                      "kong-operator-ca",
-		ClusterCASecretNamespace:                 "kong-system",
-		GatewayControllerEnabled:                 true,
-		ControlPlaneControllerEnabled:            true,
-		DataPlaneControllerEnabled:               true,
-		DataPlaneBlueGreenControllerEnabled:      true,
-		ControlPlaneConfigurationDumpEnabled:     false,
-		ControlPlaneConfigurationDumpAddr:        ":10256",
+		MetricsAddr:                          ":8080",
+		MetricsAccessFilter:                  "off",
+		ProbeAddr:                            ":8081",
+		LeaderElection:                       true,
+		LeaderElectionNamespace:              "kong-system",
+		LeaderElectionLeaseDuration:          mgrconfig.DefaultLeaderElectionLeaseDuration,
+		LeaderElectionRenewDeadline:          mgrconfig.DefaultLeaderElectionRenewDeadline,
+		LeaderElectionRetryPeriod:            mgrconfig.DefaultLeaderElectionRetryPeriod,
+		LoggingMode:                          logging.ProductionMode,
+		ValidateImages:                       true,
+		EnforceConfig:                        true,
+		ControllerName:                       "",
+		ControllerNamespace:                  "kong-system",
+		AnonymousReports:                     true,
+		APIServerHost:                        "",
+		APIServerQPS:                         100,
+		APIServerBurst:                       300,
+		KubeconfigPath:                       "",
+		SecretLabelSelector:                  mgrconfig.DefaultSecretLabelSelector,
+		ConfigMapLabelSelector:               mgrconfig.DefaultConfigMapLabelSelector,
+		ClusterCASecretName:                  "kong-operator-ca",
+		ClusterCASecretNamespace:             "kong-system",
+		GatewayControllerEnabled:             true,
+		ControlPlaneControllerEnabled:        true,
+		DataPlaneControllerEnabled:           true,
+		DataPlaneBlueGreenControllerEnabled:  true,
+		ControlPlaneConfigurationDumpEnabled: false,
+		ControlPlaneConfigurationDumpAddr:    ":10256",
+		MetricsAdapterAddr:                   ":6443",
+		OpenTelemetry: opentelemetry.Config{
+			TraceSamplingRatio:    opentelemetry.DefaultTraceSamplingRatio,
+			MetricsExportInterval: opentelemetry.DefaultMetricsExportInterval,
+		},
 		ControlPlaneExtensionsControllerEnabled:  true,
 		KonnectControllersEnabled:                false,
 		KEGDataPlaneControllerEnabled:            false,
@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"slices"
 	"time"
@@ -54,6 +55,7 @@ import (
 	gwtypes "github.com/kong/kong-operator/v2/internal/types"
 	"github.com/kong/kong-operator/v2/internal/utils/index"
 	"github.com/kong/kong-operator/v2/modules/manager/logging"
+	"github.com/kong/kong-operator/v2/modules/metricsadapter"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
 )
@@ -176,6 +178,8 @@ func SetupCacheIndexes(ctx context.Context, mgr manager.Manager, cfg Config) err
 			index.OptionsForCredentialsJWT(),
 			index.OptionsForCredentialsAPIKey(),
 			index.OptionsForCredentialsHMAC(),
+			index.OptionsForCredentialsOAuth2(),
+			index.OptionsForCredentialsMTLS(),
 			index.OptionsForKongConsumer(cl),
 			index.OptionsForKongConsumerGroup(cl),
 			index.OptionsForKongService(cl),
@@ -186,6 +190,7 @@ func SetupCacheIndexes(ctx context.Context, mgr manager.Manager, cfg Config) err
 			index.OptionsForKongKey(cl),
 			index.OptionsForKongKeySet(cl),
 			index.OptionsForKongDataPlaneCertificate(cl),
+			index.OptionsForKongCustomEntity(cl),
 			index.OptionsForKongVault(cl),
 			index.OptionsForKongCertificate(cl),
 			index.OptionsForKongCACertificate(cl),
@@ -481,6 +486,11 @@ func requiredCRDChecks(c *Config) []requiredCRDCheck {
 					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
 					Resource: "kongcredentialapikeys",
 				},
+				{
+					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
+					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
+					Resource: "kongcredentialapikeygenerators",
+				},
 				{
 					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
 					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
@@ -496,6 +506,16 @@ func requiredCRDChecks(c *Config) []requiredCRDCheck {
 					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
 					Resource: "kongcredentialjwts",
 				},
+				{
+					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
+					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
+					Resource: "kongcredentialmtlses",
+				},
+				{
+					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
+					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
+					Resource: "kongcredentialoauth2s",
+				},
 				{
 					Group:    configurationv1alpha1.SchemeGroupVersion.Group,
 					Version:  configurationv1alpha1.SchemeGroupVersion.Version,
@@ -623,6 +643,32 @@ func SetupControllers(mgr manager.Manager, c *Config, cpsMgr *multiinstance.Mana
 	if err := mgr.Add(scrapersMgr); err != nil {
 		return nil, fmt.Errorf("failed to add scrapers manager to controller-runtime manager: %w", err)
 	}
+	if c.MetricsAdapterEnabled && c.ControlPlaneExtensionsControllerEnabled {
+		adapterLogger := mgr.GetLogger().WithName("metrics_adapter")
+		adapterServer := &metricsadapter.Server{
+			Addr: c.MetricsAdapterAddr,
+			Handler: metricsadapter.NewHTTPHandler(
+				mgr.GetClient(),
+				adapterLogger.WithName("http_handler"),
+				scrapersMgr.Store(),
+			),
+			CertDir:   c.MetricsAdapterCertDir,
+			APIReader: mgr.GetAPIReader(),
+			Client:    mgr.GetClient(),
+			Logger:    adapterLogger,
+		}
+		if podName := os.Getenv("POD_NAME"); podName != "" {
+			podNamespace, err := k8sutils.GetSelfNamespace()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the namespace of the metrics adapter Pod: %w", err)
+			}
+			adapterServer.PodNamespace = podNamespace
+			adapterServer.PodName = podName
+		}
+		if err := mgr.Add(adapterServer); err != nil {
+			return nil, fmt.Errorf("failed to add metrics adapter server to controller-runtime manager: %w", err)
+		}
+	}
 	podLabels, err := k8sutils.GetSelfPodLabels()
 	if err != nil {
 		if k8sutils.RunningOnKubernetes() {
@@ -762,6 +808,15 @@ func SetupControllers(mgr manager.Manager, c *Config, cpsMgr *multiinstance.Mana
 				ctrlOpts,
 			),
 		},
+		// DataPlaneOwnedDaemonSetFinalizer controller
+		{
+			Enabled: c.DataPlaneControllerEnabled || c.DataPlaneBlueGreenControllerEnabled,
+			Controller: dataplane.NewDataPlaneOwnedResourceFinalizerReconciler[appsv1.DaemonSet](
+				mgr.GetClient(),
+				c.LoggingMode,
+				ctrlOpts,
+			),
+		},
 		// SecretCert controller
 		{
 			Enabled: c.DataPlaneControllerEnabled || c.DataPlaneBlueGreenControllerEnabled || c.ControlPlaneControllerEnabled,
@@ -896,6 +951,16 @@ func SetupControllers(mgr manager.Manager, c *Config, cpsMgr *multiinstance.Mana
 					mgr.GetScheme(),
 				),
 			},
+			// KongCredentialAPIKeyGenerator controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKongCredentialAPIKeyGeneratorReconciler(
+					ctrlOpts,
+					c.LoggingMode,
+					mgr.GetClient(),
+					mgr.GetScheme(),
+				),
+			},
 			// KonnectSecretReference controller
 			ControllerDef{
 				Enabled: c.KonnectControllersEnabled,
@@ -905,6 +970,62 @@ func SetupControllers(mgr manager.Manager, c *Config, cpsMgr *multiinstance.Mana
 					c.LoggingMode,
 				),
 			},
+			// KonnectGatewayControlPlane orphaned entities controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKonnectOrphanedEntitiesReconciler(
+					ctrlOpts,
+					sdkFactory,
+					c.LoggingMode,
+					mgr.GetClient(),
+					mgr.GetAPIReader(),
+					metricRecorder,
+				),
+			},
+			// KonnectRoleAssignment controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKonnectRoleAssignmentReconciler(
+					ctrlOpts,
+					sdkFactory,
+					c.LoggingMode,
+					mgr.GetClient(),
+					c.KonnectSyncPeriod,
+				),
+			},
+			// KonnectSystemAccountAccessToken controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewKonnectSystemAccountAccessTokenReconciler(
+					ctrlOpts,
+					sdkFactory,
+					c.LoggingMode,
+					mgr.GetClient(),
+					mgr.GetScheme(),
+				),
+			},
+			// APIImplementation controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewAPIImplementationReconciler(
+					ctrlOpts,
+					sdkFactory,
+					c.LoggingMode,
+					mgr.GetClient(),
+					c.KonnectSyncPeriod,
+				),
+			},
+			// APIPublication controller
+			ControllerDef{
+				Enabled: c.KonnectControllersEnabled,
+				Controller: konnect.NewAPIPublicationReconciler(
+					ctrlOpts,
+					sdkFactory,
+					c.LoggingMode,
+					mgr.GetClient(),
+					c.KonnectSyncPeriod,
+				),
+			},
 			// KonnectExtension controller
 			ControllerDef{
 				Enabled: (c.DataPlaneControllerEnabled || c.DataPlaneBlueGreenControllerEnabled) && c.KonnectControllersEnabled,
@@ -951,11 +1072,14 @@ func SetupControllers(mgr manager.Manager, c *Config, cpsMgr *multiinstance.Mana
 			newKonnectEntityController[configurationv1alpha1.KongCredentialACL](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongCredentialHMAC](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongCredentialJWT](controllerFactory),
+			newKonnectEntityController[configurationv1alpha1.KongCredentialOAuth2](controllerFactory),
+			newKonnectEntityController[configurationv1alpha1.KongCredentialMTLS](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongKey](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongKeySet](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongDataPlaneClientCertificate](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongVault](controllerFactory),
 			newKonnectEntityController[configurationv1alpha1.KongSNI](controllerFactory),
+			newKonnectEntityController[configurationv1alpha1.KongCustomEntity](controllerFactory),
 		)
 
 		controllers = append(
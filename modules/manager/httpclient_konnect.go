@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
+
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 )
 
 func httpClientForKonnect(c *Config) *http.Client {
@@ -22,7 +24,7 @@ func httpClientForKonnect(c *Config) *http.Client {
 	}
 
 	return &http.Client{
-		Transport: transport,
+		Transport: opentelemetry.HTTPTransport(transport),
 		Timeout:   c.KonnectRequestTimeout,
 	}
 }
@@ -60,6 +62,6 @@ func defaultLongPolledTransport() *http.Transport {
 func httpClientForKonnectLongPolling() *http.Client {
 	transport := defaultLongPolledTransport()
 	return &http.Client{
-		Transport: transport,
+		Transport: opentelemetry.HTTPTransport(transport),
 	}
 }
@@ -39,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -46,6 +47,7 @@ import (
 	controllerpkgssa "github.com/kong/kong-operator/v2/controller/pkg/ssa"
 	"github.com/kong/kong-operator/v2/ingress-controller/pkg/manager/multiinstance"
 	"github.com/kong/kong-operator/v2/ingress-controller/pkg/validation"
+	"github.com/kong/kong-operator/v2/internal/opentelemetry"
 	"github.com/kong/kong-operator/v2/internal/telemetry"
 	"github.com/kong/kong-operator/v2/internal/webhook/conversion"
 	"github.com/kong/kong-operator/v2/modules/diagnostics"
@@ -109,6 +111,16 @@ type Config struct {
 	ControlPlaneConfigurationDumpEnabled bool
 	ControlPlaneConfigurationDumpAddr    string
 
+	// Options for serving the metrics scraped from DataPlanes through the
+	// custom and external metrics APIs.
+	MetricsAdapterEnabled bool
+	MetricsAdapterAddr    string
+	MetricsAdapterCertDir string
+
+	// Options for exporting the traces and the metrics through OpenTelemetry.
+	OpenTelemetryEnabled bool
+	OpenTelemetry        opentelemetry.Config
+
 	// Controllers for specialty APIs and experimental features.
 	AIGatewayControllerEnabled              bool
 	KongPluginInstallationControllerEnabled bool
@@ -154,6 +166,8 @@ const (
 	DefaultProbeAddr = ":8081"
 	// DefaultControlPlaneConfigurationDumpAddr is the default bind address for the server to dump ControlPlane configuration.
 	DefaultControlPlaneConfigurationDumpAddr = ":10256"
+	// DefaultMetricsAdapterAddr is the default bind address for the server serving the custom and external metrics APIs.
+	DefaultMetricsAdapterAddr = ":6443"
 )
 
 // DefaultConfig returns a default configuration for the manager.
@@ -187,6 +201,10 @@ func DefaultConfig() Config {
 		KonnectRequestTimeout:         consts.DefaultKonnectRequestTimeout,
 		CertTTL:                       consts.DefaultCertTTL,
 		CertExpirationMargin:          consts.DefaultCertExpirationMargin,
+		OpenTelemetry: opentelemetry.Config{
+			TraceSamplingRatio:    opentelemetry.DefaultTraceSamplingRatio,
+			MetricsExportInterval: opentelemetry.DefaultMetricsExportInterval,
+		},
 	}
 }
 
@@ -303,6 +321,20 @@ func Run(
 		return fmt.Errorf("unable to add CP instances manager: %w", err)
 	}
 
+	if cfg.OpenTelemetryEnabled {
+		shutdownTracing, err := setupOpenTelemetry(ctx, setupLog, mgr, cfg, metadata)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				setupLog.Error(err, "failed to flush the OpenTelemetry traces")
+			}
+		}()
+	}
+
 	if cfg.ControlPlaneControllerEnabled && cfg.ControlPlaneConfigurationDumpEnabled {
 		diagLogger := ctrl.Log.WithName("cp_diagnostics_server")
 		exposer := diagnostics.NewControlPlaneDiagnosticsExposer(diagLogger.WithName("exposer"))
@@ -408,6 +440,41 @@ func Run(
 	return nil
 }
 
+// setupOpenTelemetry sets up the export of the traces of the reconciliations
+// and of the requests to the Kong Admin API and Konnect, and adds to the manager
+// the exporter of the metrics registered in the controller-runtime registry,
+// which hold the ones of the operator and of the ControlPlanes it runs.
+// It returns a function flushing the pending spans.
+func setupOpenTelemetry(
+	ctx context.Context,
+	logger logr.Logger,
+	mgr manager.Manager,
+	cfg Config,
+	metadata metadata.Info,
+) (func(context.Context) error, error) {
+	res, err := opentelemetry.NewResource(ctx, metadata.Release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpenTelemetry resource: %w", err)
+	}
+	shutdownTracing, err := opentelemetry.SetupTracing(ctx, cfg.OpenTelemetry, res)
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(&opentelemetry.MetricsExporter{
+		Config:   cfg.OpenTelemetry,
+		Gatherer: ctrlmetrics.Registry,
+		Resource: res,
+		Logger:   ctrl.Log.WithName("otlp_metrics_exporter"),
+	}); err != nil {
+		return nil, fmt.Errorf("unable to add OTLP metrics exporter: %w", err)
+	}
+	logger.Info("OpenTelemetry export enabled",
+		"traceSamplingRatio", cfg.OpenTelemetry.TraceSamplingRatio,
+		"metricsExportInterval", cfg.OpenTelemetry.MetricsExportInterval,
+	)
+	return shutdownTracing, nil
+}
+
 // warnIfLegacyDevelopmentModeEnabled logs a warning if any of the legacy development mode environment variables are set
 // and suggests the new environment variables to use instead.
 // This can be removed after a few releases.
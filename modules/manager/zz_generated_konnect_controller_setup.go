@@ -21,6 +21,7 @@ func generatedControllersForKonnectEntities(
 		newKonnectEntityController[konnectv1alpha1.AIGatewayModel](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.AIGatewayModelProvider](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.AIGatewayPolicy](controllerFactory),
+		newKonnectEntityController[konnectv1alpha1.APISpecification](controllerFactory),
 		newKonnectEntityController[configurationv1alpha1.EventGatewayBackendCluster](controllerFactory),
 		newKonnectEntityController[configurationv1alpha1.EventGatewayDataPlaneCertificate](controllerFactory),
 		newKonnectEntityController[configurationv1alpha1.EventGatewayListener](controllerFactory),
@@ -31,8 +32,11 @@ func generatedControllersForKonnectEntities(
 		newKonnectEntityController[configurationv1alpha1.EventGatewayVirtualClusterPolicy](controllerFactory),
 		newKonnectEntityController[configurationv1alpha1.EventGatewayVirtualClusterProducePolicy](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.KonnectAIGateway](controllerFactory),
+		newKonnectEntityController[konnectv1alpha1.KonnectAPI](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.KonnectConfigStore](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.KonnectEventGateway](controllerFactory),
+		newKonnectEntityController[konnectv1alpha1.KonnectSystemAccount](controllerFactory),
+		newKonnectEntityController[konnectv1alpha1.KonnectTeam](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.Portal](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.PortalCustomDomain](controllerFactory),
 		newKonnectEntityController[konnectv1alpha1.PortalCustomization](controllerFactory),
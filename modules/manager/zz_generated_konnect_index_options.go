@@ -24,6 +24,7 @@ func generatedIndexOptionsForKonnectEntities(
 		index.OptionsForAIGatewayModel(),
 		index.OptionsForAIGatewayModelProvider(),
 		index.OptionsForAIGatewayPolicy(),
+		index.OptionsForAPISpecification(),
 		index.OptionsForEventGatewayBackendCluster(),
 		index.OptionsForEventGatewayDataPlaneCertificate(),
 		index.OptionsForEventGatewayListener(),
@@ -34,8 +35,11 @@ func generatedIndexOptionsForKonnectEntities(
 		index.OptionsForEventGatewayVirtualClusterPolicy(),
 		index.OptionsForEventGatewayVirtualClusterProducePolicy(),
 		index.OptionsForKonnectAIGateway(),
+		index.OptionsForKonnectAPI(),
 		index.OptionsForKonnectConfigStore(),
 		index.OptionsForKonnectEventGateway(),
+		index.OptionsForKonnectSystemAccount(),
+		index.OptionsForKonnectTeam(),
 		index.OptionsForPortal(),
 		index.OptionsForPortalCustomDomain(),
 		index.OptionsForPortalCustomization(),
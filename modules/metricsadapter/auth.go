@@ -0,0 +1,243 @@
+package metricsadapter
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// authenticationConfigMapNamespace and authenticationConfigMapName identify the
+	// ConfigMap the Kubernetes API server publishes for extension API servers
+	// to authenticate the requests it proxies to them.
+	authenticationConfigMapNamespace = "kube-system"
+	authenticationConfigMapName      = "extension-apiserver-authentication"
+
+	requestHeaderClientCAKey        = "requestheader-client-ca-file"
+	requestHeaderAllowedNamesKey    = "requestheader-allowed-names"
+	requestHeaderUsernameHeadersKey = "requestheader-username-headers"
+	requestHeaderGroupHeadersKey    = "requestheader-group-headers"
+	requestHeaderExtraPrefixesKey   = "requestheader-extra-headers-prefix"
+
+	// authenticatorReloadInterval is the interval at which the authentication
+	// configuration is reloaded from the extension-apiserver-authentication ConfigMap.
+	authenticatorReloadInterval = time.Minute
+)
+
+// userInfo holds the identity of the user on behalf of whom the Kubernetes API
+// server proxies a request.
+type userInfo struct {
+	name   string
+	groups []string
+	extra  map[string][]string
+}
+
+type userInfoContextKey struct{}
+
+// requestHeaderAuthenticator authenticates the requests proxied by the Kubernetes
+// API server: those come with a client certificate signed by the request header
+// CA and convey the identity of the user in their headers.
+type requestHeaderAuthenticator struct {
+	clientCAs           *x509.CertPool
+	allowedNames        []string
+	usernameHeaders     []string
+	groupHeaders        []string
+	extraHeaderPrefixes []string
+}
+
+// loadRequestHeaderAuthenticator creates a requestHeaderAuthenticator from the
+// extension-apiserver-authentication ConfigMap.
+func loadRequestHeaderAuthenticator(ctx context.Context, cl client.Reader) (*requestHeaderAuthenticator, error) {
+	var cm corev1.ConfigMap
+	if err := cl.Get(ctx, client.ObjectKey{
+		Namespace: authenticationConfigMapNamespace,
+		Name:      authenticationConfigMapName,
+	}, &cm); err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", authenticationConfigMapNamespace, authenticationConfigMapName, err)
+	}
+
+	ca, ok := cm.Data[requestHeaderClientCAKey]
+	if !ok || ca == "" {
+		return nil, fmt.Errorf("ConfigMap %s/%s has no %s, the API server's aggregation layer is not configured",
+			authenticationConfigMapNamespace, authenticationConfigMapName, requestHeaderClientCAKey)
+	}
+	a := &requestHeaderAuthenticator{
+		clientCAs: x509.NewCertPool(),
+	}
+	if !a.clientCAs.AppendCertsFromPEM([]byte(ca)) {
+		return nil, fmt.Errorf("failed to parse %s from ConfigMap %s/%s",
+			requestHeaderClientCAKey, authenticationConfigMapNamespace, authenticationConfigMapName)
+	}
+
+	for key, target := range map[string]*[]string{
+		requestHeaderAllowedNamesKey:    &a.allowedNames,
+		requestHeaderUsernameHeadersKey: &a.usernameHeaders,
+		requestHeaderGroupHeadersKey:    &a.groupHeaders,
+		requestHeaderExtraPrefixesKey:   &a.extraHeaderPrefixes,
+	} {
+		v, ok := cm.Data[key]
+		if !ok || v == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(v), target); err != nil {
+			return nil, fmt.Errorf("failed to parse %s from ConfigMap %s/%s: %w",
+				key, authenticationConfigMapNamespace, authenticationConfigMapName, err)
+		}
+	}
+	if len(a.usernameHeaders) == 0 {
+		return nil, fmt.Errorf("ConfigMap %s/%s has no %s",
+			authenticationConfigMapNamespace, authenticationConfigMapName, requestHeaderUsernameHeadersKey)
+	}
+
+	return a, nil
+}
+
+// reloadingAuthenticator holds the requestHeaderAuthenticator loaded from the
+// extension-apiserver-authentication ConfigMap and reloads it periodically, so
+// that rotations of the request header CA are picked up without a restart.
+type reloadingAuthenticator struct {
+	reader  client.Reader
+	logger  logr.Logger
+	current atomic.Pointer[requestHeaderAuthenticator]
+}
+
+// newReloadingAuthenticator loads the authentication configuration and returns
+// a reloadingAuthenticator serving it.
+func newReloadingAuthenticator(ctx context.Context, reader client.Reader, logger logr.Logger) (*reloadingAuthenticator, error) {
+	a := &reloadingAuthenticator{
+		reader: reader,
+		logger: logger,
+	}
+	if err := a.reload(ctx); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// reload loads the authentication configuration again. The previous one is
+// kept when it can't be loaded.
+func (a *reloadingAuthenticator) reload(ctx context.Context) error {
+	authenticator, err := loadRequestHeaderAuthenticator(ctx, a.reader)
+	if err != nil {
+		return err
+	}
+	a.current.Store(authenticator)
+	return nil
+}
+
+// run reloads the authentication configuration every interval until ctx expires.
+func (a *reloadingAuthenticator) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.reload(ctx); err != nil {
+				a.logger.Error(err, "Failed to reload metrics adapter authentication configuration, keeping the previous one")
+			}
+		}
+	}
+}
+
+// get returns the current requestHeaderAuthenticator.
+func (a *reloadingAuthenticator) get() *requestHeaderAuthenticator {
+	return a.current.Load()
+}
+
+// authenticate returns the user on behalf of whom the request is made.
+// The client certificate of the request must have been verified against
+// the request header CA by the TLS server.
+func (a *requestHeaderAuthenticator) authenticate(r *http.Request) (userInfo, bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return userInfo{}, false
+	}
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, r.TLS.VerifiedChains[0][0].Subject.CommonName) {
+		return userInfo{}, false
+	}
+
+	var u userInfo
+	for _, h := range a.usernameHeaders {
+		if u.name = r.Header.Get(h); u.name != "" {
+			break
+		}
+	}
+	if u.name == "" {
+		return userInfo{}, false
+	}
+	for _, h := range a.groupHeaders {
+		u.groups = append(u.groups, r.Header.Values(h)...)
+	}
+	for name, values := range r.Header {
+		for _, prefix := range a.extraHeaderPrefixes {
+			if len(name) > len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+				if u.extra == nil {
+					u.extra = make(map[string][]string)
+				}
+				key := strings.ToLower(name[len(prefix):])
+				u.extra[key] = append(u.extra[key], values...)
+			}
+		}
+	}
+	return u, true
+}
+
+// authenticationMiddleware rejects the requests which can't be authenticated
+// and stores the authenticated user in the context of the others.
+func authenticationMiddleware(a *reloadingAuthenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, ok := a.get().authenticate(r)
+		if !ok {
+			writeStatus(w, http.StatusUnauthorized, metav1.StatusReasonUnauthorized, "unauthorized")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userInfoContextKey{}, u)))
+	})
+}
+
+// authorize checks with a SubjectAccessReview whether the authenticated user
+// of the request is allowed to access the provided resource, or non-resource
+// path when resource is nil.
+func authorize(r *http.Request, cl client.Client, resource *authorizationv1.ResourceAttributes) (bool, error) {
+	u, ok := r.Context().Value(userInfoContextKey{}).(userInfo)
+	if !ok {
+		return false, nil
+	}
+
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               u.name,
+			Groups:             u.groups,
+			ResourceAttributes: resource,
+		},
+	}
+	if len(u.extra) > 0 {
+		sar.Spec.Extra = make(map[string]authorizationv1.ExtraValue, len(u.extra))
+		for k, v := range u.extra {
+			sar.Spec.Extra[k] = v
+		}
+	}
+	if resource == nil {
+		sar.Spec.NonResourceAttributes = &authorizationv1.NonResourceAttributes{
+			Path: r.URL.Path,
+			Verb: "get",
+		}
+	}
+	if err := cl.Create(r.Context(), sar); err != nil {
+		return false, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
+	}
+	return sar.Status.Allowed, nil
+}
@@ -0,0 +1,58 @@
+package metricsadapter
+
+import (
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	managerscheme "github.com/kong/kong-operator/v2/modules/manager/scheme"
+)
+
+func TestReloadingAuthenticator(t *testing.T) {
+	caPEM := func() string {
+		cert, err := selfSignedCertificate()
+		require.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}))
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: authenticationConfigMapNamespace,
+			Name:      authenticationConfigMapName,
+		},
+		Data: map[string]string{
+			requestHeaderClientCAKey:        caPEM(),
+			requestHeaderAllowedNamesKey:    `["front-proxy-client"]`,
+			requestHeaderUsernameHeadersKey: `["X-Remote-User"]`,
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(managerscheme.Get()).
+		WithObjects(cm).
+		Build()
+
+	a, err := newReloadingAuthenticator(t.Context(), cl, logr.Discard())
+	require.NoError(t, err)
+	first := a.get()
+	assert.Equal(t, []string{"front-proxy-client"}, first.allowedNames)
+
+	t.Log("rotating the request header CA and changing the allowed names")
+	cm.Data[requestHeaderClientCAKey] = caPEM()
+	cm.Data[requestHeaderAllowedNamesKey] = `["aggregator"]`
+	require.NoError(t, cl.Update(t.Context(), cm))
+	require.NoError(t, a.reload(t.Context()))
+	second := a.get()
+	assert.Equal(t, []string{"aggregator"}, second.allowedNames)
+	assert.False(t, first.clientCAs.Equal(second.clientCAs))
+
+	t.Log("keeping the previous configuration when the ConfigMap is broken")
+	cm.Data[requestHeaderClientCAKey] = "not a certificate"
+	require.NoError(t, cl.Update(t.Context(), cm))
+	require.Error(t, a.reload(t.Context()))
+	assert.Same(t, second, a.get())
+}
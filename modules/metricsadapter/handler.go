@@ -0,0 +1,386 @@
+package metricsadapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/controller/cpextensions/metricsscraper"
+)
+
+// describedResource is a resource for which the custom metrics API serves metrics.
+type describedResource struct {
+	kind       string
+	apiVersion string
+	// key returns the key of the object of this resource the metrics are about.
+	key func(metricsscraper.RequestMetrics) types.NamespacedName
+	// list lists the keys of the objects of this resource matching the selector.
+	list func(r *http.Request, cl client.Client, namespace string, selector labels.Selector) ([]types.NamespacedName, error)
+}
+
+var describedResources = map[string]describedResource{
+	"services": {
+		kind:       "Service",
+		apiVersion: "v1",
+		key:        func(m metricsscraper.RequestMetrics) types.NamespacedName { return m.Service },
+		list: func(r *http.Request, cl client.Client, namespace string, selector labels.Selector) ([]types.NamespacedName, error) {
+			var list corev1.ServiceList
+			if err := cl.List(r.Context(), &list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return nil, err
+			}
+			keys := make([]types.NamespacedName, 0, len(list.Items))
+			for _, svc := range list.Items {
+				keys = append(keys, client.ObjectKeyFromObject(&svc))
+			}
+			return keys, nil
+		},
+	},
+	"dataplanes." + operatorv1beta1.SchemeGroupVersion.Group: {
+		kind:       "DataPlane",
+		apiVersion: operatorv1beta1.SchemeGroupVersion.String(),
+		key:        func(m metricsscraper.RequestMetrics) types.NamespacedName { return m.DataPlane },
+		list: func(r *http.Request, cl client.Client, namespace string, selector labels.Selector) ([]types.NamespacedName, error) {
+			var list operatorv1beta1.DataPlaneList
+			if err := cl.List(r.Context(), &list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return nil, err
+			}
+			keys := make([]types.NamespacedName, 0, len(list.Items))
+			for _, dp := range list.Items {
+				keys = append(keys, client.ObjectKeyFromObject(&dp))
+			}
+			return keys, nil
+		},
+	},
+}
+
+const (
+	// externalMetricLabelService is the label of external metrics set to the name of the Service.
+	externalMetricLabelService = "service"
+	// externalMetricLabelDataPlane is the label of external metrics set to the name of the DataPlane.
+	externalMetricLabelDataPlane = "dataplane"
+)
+
+// RequestMetricsLister lists the request metrics computed from the metrics scraped from DataPlanes.
+type RequestMetricsLister interface {
+	List() []metricsscraper.RequestMetrics
+}
+
+// HTTPHandler serves the custom and external metrics APIs.
+type HTTPHandler struct {
+	cl     client.Client
+	logger logr.Logger
+	store  RequestMetricsLister
+	mux    *http.ServeMux
+}
+
+// NewHTTPHandler returns a new HTTP Handler serving the metrics listed by the provided store.
+// Requests are expected to be authenticated, the client is used to authorize them
+// and to list the objects the metrics are requested for.
+func NewHTTPHandler(
+	cl client.Client,
+	logger logr.Logger,
+	store RequestMetricsLister,
+) *HTTPHandler {
+	h := &HTTPHandler{
+		cl:     cl,
+		logger: logger,
+		store:  store,
+	}
+
+	customPrefix := "/apis/" + CustomMetricsGroup + "/" + CustomMetricsVersion
+	externalPrefix := "/apis/" + ExternalMetricsGroup + "/" + ExternalMetricsVersion
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+customPrefix+"/{$}", h.handleCustomMetricsDiscovery)
+	mux.HandleFunc("GET "+customPrefix+"/namespaces/{namespace}/{resource}/{name}/{metric}", h.handleCustomMetric)
+	mux.HandleFunc("GET "+externalPrefix+"/{$}", h.handleExternalMetricsDiscovery)
+	mux.HandleFunc("GET "+externalPrefix+"/namespaces/{namespace}/{metric}", h.handleExternalMetric)
+	h.mux = mux
+
+	return h
+}
+
+// ServeHTTP serves HTTP requests to the metrics APIs.
+func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The API server requests the discovery documents without a trailing slash.
+	if r.URL.Path == "/apis/"+CustomMetricsGroup+"/"+CustomMetricsVersion ||
+		r.URL.Path == "/apis/"+ExternalMetricsGroup+"/"+ExternalMetricsVersion {
+		r.URL.Path += "/"
+	}
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *HTTPHandler) handleCustomMetricsDiscovery(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r, nil) {
+		return
+	}
+
+	list := metav1.APIResourceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "APIResourceList",
+			APIVersion: "v1",
+		},
+		GroupVersion: CustomMetricsGroup + "/" + CustomMetricsVersion,
+	}
+	for _, resource := range []string{"services", "dataplanes." + operatorv1beta1.SchemeGroupVersion.Group} {
+		for _, m := range metrics {
+			list.APIResources = append(list.APIResources, metav1.APIResource{
+				Name:       resource + "/" + m.name,
+				Namespaced: true,
+				Kind:       "MetricValueList",
+				Verbs:      metav1.Verbs{"get"},
+			})
+		}
+	}
+	h.writeJSON(w, list)
+}
+
+func (h *HTTPHandler) handleExternalMetricsDiscovery(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r, nil) {
+		return
+	}
+
+	list := metav1.APIResourceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "APIResourceList",
+			APIVersion: "v1",
+		},
+		GroupVersion: ExternalMetricsGroup + "/" + ExternalMetricsVersion,
+	}
+	for _, m := range metrics {
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name:       m.name,
+			Namespaced: true,
+			Kind:       "ExternalMetricValueList",
+			Verbs:      metav1.Verbs{"get"},
+		})
+	}
+	h.writeJSON(w, list)
+}
+
+func (h *HTTPHandler) handleCustomMetric(w http.ResponseWriter, r *http.Request) {
+	var (
+		namespace    = r.PathValue("namespace")
+		resourceName = r.PathValue("resource")
+		name         = r.PathValue("name")
+		metricName   = r.PathValue("metric")
+	)
+	if !h.authorize(w, r, &authorizationv1.ResourceAttributes{
+		Namespace:   namespace,
+		Verb:        "get",
+		Group:       CustomMetricsGroup,
+		Version:     CustomMetricsVersion,
+		Resource:    resourceName,
+		Subresource: metricName,
+		Name:        name,
+	}) {
+		return
+	}
+
+	resource, ok := describedResources[resourceName]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("no metrics are served for resource %q", resourceName))
+		return
+	}
+	m, ok := getMetric(metricName)
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("metric %q is not served", metricName))
+		return
+	}
+
+	// The metric selector of the HPA selects the series of the metric, by the
+	// labels they have in the external metrics API.
+	metricSelector, ok := parseSelector(w, r, "metricLabelSelector")
+	if !ok {
+		return
+	}
+	byObject := make(map[types.NamespacedName][]metricsscraper.RequestMetrics)
+	for _, rm := range h.store.List() {
+		key := resource.key(rm)
+		if key.Namespace == namespace && metricSelector.Matches(seriesLabels(rm)) {
+			byObject[key] = append(byObject[key], rm)
+		}
+	}
+
+	var keys []types.NamespacedName
+	if name == "*" {
+		selector, ok := parseSelector(w, r, "labelSelector")
+		if !ok {
+			return
+		}
+		var err error
+		keys, err = resource.list(r, h.cl, namespace, selector)
+		if err != nil {
+			h.logger.Error(err, "failed to list objects", "resource", resourceName, "namespace", namespace)
+			writeStatus(w, http.StatusInternalServerError, metav1.StatusReasonInternalError, "failed to list "+resourceName)
+			return
+		}
+	} else {
+		key := types.NamespacedName{Namespace: namespace, Name: name}
+		if _, ok := byObject[key]; !ok {
+			writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound,
+				fmt.Sprintf("the server could not find the metric %s for %s %s", metricName, resourceName, name))
+			return
+		}
+		keys = []types.NamespacedName{key}
+	}
+
+	list := MetricValueList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MetricValueList",
+			APIVersion: CustomMetricsGroup + "/" + CustomMetricsVersion,
+		},
+		Items: []MetricValue{},
+	}
+	for _, key := range keys {
+		objectMetrics, ok := byObject[key]
+		if !ok {
+			continue
+		}
+		merged := metricsscraper.MergeRequestMetrics(objectMetrics...)
+		list.Items = append(list.Items, MetricValue{
+			DescribedObject: corev1.ObjectReference{
+				Kind:       resource.kind,
+				APIVersion: resource.apiVersion,
+				Namespace:  key.Namespace,
+				Name:       key.Name,
+			},
+			Metric:        MetricIdentifier{Name: m.name},
+			Timestamp:     metav1.NewTime(merged.Timestamp),
+			WindowSeconds: new(int64(merged.Window.Seconds())),
+			Value:         quantity(m.value(merged)),
+		})
+	}
+	h.writeJSON(w, list)
+}
+
+func (h *HTTPHandler) handleExternalMetric(w http.ResponseWriter, r *http.Request) {
+	var (
+		namespace  = r.PathValue("namespace")
+		metricName = r.PathValue("metric")
+	)
+	if !h.authorize(w, r, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "list",
+		Group:     ExternalMetricsGroup,
+		Version:   ExternalMetricsVersion,
+		Resource:  metricName,
+	}) {
+		return
+	}
+
+	m, ok := getMetric(metricName)
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("metric %q is not served", metricName))
+		return
+	}
+	// The metric selector of the HPA is sent as the label selector of the
+	// request. It selects the series by their labels.
+	selector, ok := parseSelector(w, r, "labelSelector")
+	if !ok {
+		return
+	}
+
+	// The metrics of all the matching Services are merged into a single value,
+	// as percentiles can't be summed by the HPA.
+	var matching []metricsscraper.RequestMetrics
+	for _, rm := range h.store.List() {
+		if rm.Service.Namespace == namespace && selector.Matches(seriesLabels(rm)) {
+			matching = append(matching, rm)
+		}
+	}
+
+	list := ExternalMetricValueList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ExternalMetricValueList",
+			APIVersion: ExternalMetricsGroup + "/" + ExternalMetricsVersion,
+		},
+		Items: []ExternalMetricValue{},
+	}
+	if len(matching) > 0 {
+		merged := metricsscraper.MergeRequestMetrics(matching...)
+		metricLabels := map[string]string{}
+		if merged.Service.Name != "" {
+			metricLabels[externalMetricLabelService] = merged.Service.Name
+		}
+		if merged.DataPlane.Name != "" {
+			metricLabels[externalMetricLabelDataPlane] = merged.DataPlane.Name
+		}
+		list.Items = append(list.Items, ExternalMetricValue{
+			MetricName:    m.name,
+			MetricLabels:  metricLabels,
+			Timestamp:     metav1.NewTime(merged.Timestamp),
+			WindowSeconds: new(int64(merged.Window.Seconds())),
+			Value:         quantity(m.value(merged)),
+		})
+	}
+	h.writeJSON(w, list)
+}
+
+// seriesLabels returns the labels of the series of the request metrics, which
+// metric selectors are matched against.
+func seriesLabels(rm metricsscraper.RequestMetrics) labels.Set {
+	return labels.Set{
+		externalMetricLabelService:   rm.Service.Name,
+		externalMetricLabelDataPlane: rm.DataPlane.Name,
+	}
+}
+
+// parseSelector parses the label selector set in the query parameter and writes
+// an error response when it's invalid. It returns false if the request can't be served.
+func parseSelector(w http.ResponseWriter, r *http.Request, param string) (labels.Selector, bool) {
+	selector, err := labels.Parse(r.URL.Query().Get(param))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, fmt.Sprintf("invalid %s: %v", param, err))
+		return nil, false
+	}
+	return selector, true
+}
+
+// authorize authorizes the request and writes an error response when it's not allowed.
+// It returns true if the request can be served.
+func (h *HTTPHandler) authorize(w http.ResponseWriter, r *http.Request, resource *authorizationv1.ResourceAttributes) bool {
+	allowed, err := authorize(r, h.cl, resource)
+	if err != nil {
+		h.logger.Error(err, "failed to authorize request", "path", r.URL.Path)
+		writeStatus(w, http.StatusInternalServerError, metav1.StatusReasonInternalError, "failed to authorize request")
+		return false
+	}
+	if !allowed {
+		writeStatus(w, http.StatusForbidden, metav1.StatusReasonForbidden, "forbidden")
+		return false
+	}
+	return true
+}
+
+func (h *HTTPHandler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Error(err, "failed to write response")
+	}
+}
+
+// writeStatus writes a Kubernetes Status response with the provided code.
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(metav1.Status{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Status",
+			APIVersion: "v1",
+		},
+		Status:  metav1.StatusFailure,
+		Message: message,
+		Reason:  reason,
+		Code:    int32(code),
+	})
+}
@@ -0,0 +1,178 @@
+package metricsadapter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kong/kong-operator/v2/controller/cpextensions/metricsscraper"
+	managerscheme "github.com/kong/kong-operator/v2/modules/manager/scheme"
+)
+
+type fakeLister []metricsscraper.RequestMetrics
+
+func (l fakeLister) List() []metricsscraper.RequestMetrics {
+	return l
+}
+
+func TestHTTPHandler(t *testing.T) {
+	now := time.Now()
+	store := fakeLister{
+		{
+			DataPlane:          types.NamespacedName{Namespace: "default", Name: "dp-1"},
+			Service:            types.NamespacedName{Namespace: "default", Name: "echo"},
+			Timestamp:          now,
+			Window:             10 * time.Second,
+			RequestsPerSecond:  10,
+			ResponsesPerSecond: map[string]float64{"2xx": 9, "5xx": 1},
+		},
+		{
+			DataPlane:          types.NamespacedName{Namespace: "default", Name: "dp-2"},
+			Service:            types.NamespacedName{Namespace: "default", Name: "echo"},
+			Timestamp:          now,
+			Window:             10 * time.Second,
+			RequestsPerSecond:  2.5,
+			ResponsesPerSecond: map[string]float64{"2xx": 2.5},
+		},
+		{
+			DataPlane:         types.NamespacedName{Namespace: "default", Name: "dp-1"},
+			Service:           types.NamespacedName{Namespace: "default", Name: "other"},
+			Timestamp:         now,
+			Window:            10 * time.Second,
+			RequestsPerSecond: 1,
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(managerscheme.Get()).
+		WithObjects(
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "echo", Labels: map[string]string{"app": "echo"}}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"}},
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				sar, ok := obj.(*authorizationv1.SubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+				sar.Status.Allowed = sar.Spec.User != "denied"
+				return nil
+			},
+		}).
+		Build()
+	handler := NewHTTPHandler(cl, logr.Discard(), store)
+
+	get := func(t *testing.T, user, path string, v any) int {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r = r.WithContext(context.WithValue(r.Context(), userInfoContextKey{}, userInfo{name: user}))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code == http.StatusOK && v != nil {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), v))
+		}
+		return w.Code
+	}
+
+	t.Run("discovery", func(t *testing.T) {
+		var list metav1.APIResourceList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2", &list))
+		assert.Equal(t, "custom.metrics.k8s.io/v1beta2", list.GroupVersion)
+		assert.Len(t, list.APIResources, 2*len(metrics))
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1", &list))
+		assert.Equal(t, "external.metrics.k8s.io/v1beta1", list.GroupVersion)
+		assert.Len(t, list.APIResources, len(metrics))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, get(t, "denied", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/echo/kong_requests_per_second", nil))
+	})
+
+	t.Run("custom metric for a Service", func(t *testing.T) {
+		var list MetricValueList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/echo/kong_requests_per_second", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "Service", list.Items[0].DescribedObject.Kind)
+		assert.Equal(t, "echo", list.Items[0].DescribedObject.Name)
+		assert.Equal(t, MetricRequestsPerSecond, list.Items[0].Metric.Name)
+		assert.True(t, resource.MustParse("12500m").Equal(list.Items[0].Value), "the metrics of all the DataPlanes are summed")
+		assert.Equal(t, int64(10), *list.Items[0].WindowSeconds)
+	})
+
+	t.Run("custom metric for a DataPlane", func(t *testing.T) {
+		var list MetricValueList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/dataplanes.gateway-operator.konghq.com/dp-1/kong_responses_5xx_per_second", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "DataPlane", list.Items[0].DescribedObject.Kind)
+		assert.True(t, resource.MustParse("1").Equal(list.Items[0].Value))
+	})
+
+	t.Run("custom metric for Services matching a selector", func(t *testing.T) {
+		var list MetricValueList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/*/kong_requests_per_second?labelSelector=app%3Decho", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "echo", list.Items[0].DescribedObject.Name)
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/*/kong_requests_per_second", &list))
+		assert.Len(t, list.Items, 2)
+	})
+
+	t.Run("custom metric for a Service matching a metric selector", func(t *testing.T) {
+		var list MetricValueList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/echo/kong_requests_per_second?metricLabelSelector=dataplane%3Ddp-2", &list))
+		require.Len(t, list.Items, 1)
+		assert.True(t, resource.MustParse("2500m").Equal(list.Items[0].Value), "only the metrics of the selected DataPlane are summed")
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/*/kong_requests_per_second?metricLabelSelector=dataplane%3Ddp-2", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "echo", list.Items[0].DescribedObject.Name)
+
+		assert.Equal(t, http.StatusNotFound, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/other/kong_requests_per_second?metricLabelSelector=dataplane%3Ddp-2", nil))
+		assert.Equal(t, http.StatusBadRequest, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/echo/kong_requests_per_second?metricLabelSelector=dataplane%3D%3D%3D", nil))
+	})
+
+	t.Run("custom metric not found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/missing/kong_requests_per_second", nil))
+		assert.Equal(t, http.StatusNotFound, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/services/echo/unknown", nil))
+		assert.Equal(t, http.StatusNotFound, get(t, "hpa", "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/pods/echo/kong_requests_per_second", nil))
+	})
+
+	t.Run("external metric", func(t *testing.T) {
+		var list ExternalMetricValueList
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/kong_requests_per_second?labelSelector=service%3Decho", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, map[string]string{"service": "echo"}, list.Items[0].MetricLabels)
+		assert.True(t, resource.MustParse("12500m").Equal(list.Items[0].Value))
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/kong_requests_per_second?labelSelector=dataplane%3Ddp-1", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, map[string]string{"dataplane": "dp-1"}, list.Items[0].MetricLabels)
+		assert.True(t, resource.MustParse("11").Equal(list.Items[0].Value))
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1/namespaces/other/kong_requests_per_second", &list))
+		assert.Empty(t, list.Items)
+
+		require.Equal(t, http.StatusOK, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/kong_requests_per_second?labelSelector=service%3Decho%2Cdataplane%21%3Ddp-1", &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, map[string]string{"service": "echo", "dataplane": "dp-2"}, list.Items[0].MetricLabels)
+		assert.True(t, resource.MustParse("2500m").Equal(list.Items[0].Value))
+
+		assert.Equal(t, http.StatusBadRequest, get(t, "hpa", "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/kong_requests_per_second?labelSelector=service%3D%3D%3D", nil))
+	})
+}
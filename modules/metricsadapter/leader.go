@@ -0,0 +1,79 @@
+package metricsadapter
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+const (
+	// ServingPodLabel is the label set on the Pod of the replica serving the
+	// metrics APIs. Only the leader scrapes DataPlanes, so the Service the
+	// APIServices point to selects the Pod with this label.
+	ServingPodLabel = consts.OperatorLabelPrefix + "metrics-adapter-serving"
+	// ServingPodLabelValue is the value of the ServingPodLabel.
+	ServingPodLabelValue = "true"
+)
+
+// markServingPod sets the ServingPodLabel on the Pod with the provided name and
+// removes it from the other Pods in its namespace, which may have been labeled
+// by a previous leader.
+func markServingPod(ctx context.Context, cl client.Client, namespace, name string) error {
+	var pods corev1.PodList
+	if err := cl.List(ctx, &pods,
+		client.InNamespace(namespace),
+		client.MatchingLabels{ServingPodLabel: ServingPodLabelValue},
+	); err != nil {
+		return fmt.Errorf("failed to list Pods labeled with %s: %w", ServingPodLabel, err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Name == name {
+			continue
+		}
+		if err := unmarkServingPod(ctx, cl, namespace, pod.Name); err != nil {
+			return err
+		}
+	}
+
+	var pod corev1.Pod
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &pod); err != nil {
+		return fmt.Errorf("failed to get Pod %s/%s: %w", namespace, name, err)
+	}
+	if pod.Labels[ServingPodLabel] == ServingPodLabelValue {
+		return nil
+	}
+	old := pod.DeepCopy()
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string, 1)
+	}
+	pod.Labels[ServingPodLabel] = ServingPodLabelValue
+	if err := cl.Patch(ctx, &pod, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("failed to label Pod %s/%s with %s: %w", namespace, name, ServingPodLabel, err)
+	}
+	return nil
+}
+
+// unmarkServingPod removes the ServingPodLabel from the Pod with the provided name.
+// Pods which don't exist anymore are ignored.
+func unmarkServingPod(ctx context.Context, cl client.Client, namespace, name string) error {
+	var pod corev1.Pod
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &pod); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to get Pod %s/%s: %w", namespace, name, err)
+	}
+	if _, ok := pod.Labels[ServingPodLabel]; !ok {
+		return nil
+	}
+	old := pod.DeepCopy()
+	delete(pod.Labels, ServingPodLabel)
+	if err := cl.Patch(ctx, &pod, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to remove %s label from Pod %s/%s: %w", ServingPodLabel, namespace, name, err)
+	}
+	return nil
+}
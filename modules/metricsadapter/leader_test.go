@@ -0,0 +1,60 @@
+package metricsadapter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	managerscheme "github.com/kong/kong-operator/v2/modules/manager/scheme"
+)
+
+func TestMarkServingPod(t *testing.T) {
+	pod := func(name string, serving bool) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kong-system",
+				Labels:    map[string]string{"app.kubernetes.io/component": "ko"},
+			},
+		}
+		if serving {
+			p.Labels[ServingPodLabel] = ServingPodLabelValue
+		}
+		return p
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(managerscheme.Get()).
+		WithObjects(
+			pod("previous-leader", true),
+			pod("leader", false),
+			pod("other", false),
+		).
+		Build()
+
+	servingPods := func() []string {
+		var pods corev1.PodList
+		require.NoError(t, cl.List(t.Context(), &pods, client.MatchingLabels{ServingPodLabel: ServingPodLabelValue}))
+		names := make([]string, 0, len(pods.Items))
+		for _, p := range pods.Items {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	require.NoError(t, markServingPod(t.Context(), cl, "kong-system", "leader"))
+	assert.Equal(t, []string{"leader"}, servingPods())
+
+	var leader corev1.Pod
+	require.NoError(t, cl.Get(t.Context(), client.ObjectKey{Namespace: "kong-system", Name: "leader"}, &leader))
+	assert.Equal(t, "ko", leader.Labels["app.kubernetes.io/component"])
+
+	require.NoError(t, unmarkServingPod(t.Context(), cl, "kong-system", "leader"))
+	assert.Empty(t, servingPods())
+
+	require.NoError(t, unmarkServingPod(t.Context(), cl, "kong-system", "gone"), "Pods which don't exist anymore are ignored")
+}
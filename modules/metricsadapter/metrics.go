@@ -0,0 +1,78 @@
+package metricsadapter
+
+import (
+	"math"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kong/kong-operator/v2/controller/cpextensions/metricsscraper"
+)
+
+const (
+	// MetricRequestsPerSecond is the rate of requests proxied to a Service.
+	MetricRequestsPerSecond = "kong_requests_per_second"
+	// MetricUpstreamLatencyP50 is the median upstream latency of a Service in milliseconds.
+	MetricUpstreamLatencyP50 = "kong_upstream_latency_p50_ms"
+	// MetricUpstreamLatencyP90 is the 90th percentile of the upstream latency of a Service in milliseconds.
+	MetricUpstreamLatencyP90 = "kong_upstream_latency_p90_ms"
+	// MetricUpstreamLatencyP99 is the 99th percentile of the upstream latency of a Service in milliseconds.
+	MetricUpstreamLatencyP99 = "kong_upstream_latency_p99_ms"
+	// MetricResponses2xxPerSecond is the rate of 2xx responses of a Service.
+	MetricResponses2xxPerSecond = "kong_responses_2xx_per_second"
+	// MetricResponses4xxPerSecond is the rate of 4xx responses of a Service.
+	MetricResponses4xxPerSecond = "kong_responses_4xx_per_second"
+	// MetricResponses5xxPerSecond is the rate of 5xx responses of a Service.
+	MetricResponses5xxPerSecond = "kong_responses_5xx_per_second"
+)
+
+// metric is a metric served by the adapter, computed from RequestMetrics.
+type metric struct {
+	name  string
+	value func(metricsscraper.RequestMetrics) float64
+}
+
+var metrics = []metric{
+	{
+		name:  MetricRequestsPerSecond,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.RequestsPerSecond },
+	},
+	{
+		name:  MetricUpstreamLatencyP50,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.UpstreamLatencyMs(0.5) },
+	},
+	{
+		name:  MetricUpstreamLatencyP90,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.UpstreamLatencyMs(0.9) },
+	},
+	{
+		name:  MetricUpstreamLatencyP99,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.UpstreamLatencyMs(0.99) },
+	},
+	{
+		name:  MetricResponses2xxPerSecond,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.ResponsesPerSecond["2xx"] },
+	},
+	{
+		name:  MetricResponses4xxPerSecond,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.ResponsesPerSecond["4xx"] },
+	},
+	{
+		name:  MetricResponses5xxPerSecond,
+		value: func(m metricsscraper.RequestMetrics) float64 { return m.ResponsesPerSecond["5xx"] },
+	},
+}
+
+// getMetric returns the metric with the provided name.
+func getMetric(name string) (metric, bool) {
+	i := slices.IndexFunc(metrics, func(m metric) bool { return m.name == name })
+	if i < 0 {
+		return metric{}, false
+	}
+	return metrics[i], true
+}
+
+// quantity converts a metric value to a resource.Quantity with a milli precision.
+func quantity(v float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(math.Round(v*1000)), resource.DecimalSI)
+}
@@ -0,0 +1,174 @@
+package metricsadapter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+// Server serves the custom and external metrics APIs to the Kubernetes API
+// server's aggregation layer, so that HorizontalPodAutoscalers can scale on the
+// metrics scraped from DataPlanes.
+type Server struct {
+	Addr    string
+	Handler *HTTPHandler
+	// CertDir is the directory holding the tls.crt and tls.key files of the
+	// serving certificate, which are reloaded when they change.
+	// When empty, a self-signed certificate is generated on start.
+	CertDir string
+	// APIReader is used to read the API server's authentication configuration.
+	APIReader client.Reader
+	// Client is used to label the Pod of the replica serving the metrics APIs.
+	Client client.Client
+	// PodNamespace and PodName identify the Pod of this replica.
+	// When empty, no Pod is labeled.
+	PodNamespace string
+	PodName      string
+
+	Logger logr.Logger
+}
+
+var _ manager.Runnable = &Server{}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface so that
+// only the leader, which is the only replica scraping DataPlanes, serves the
+// metrics APIs. It labels its Pod with ServingPodLabel, which the Service
+// targeted by the APIServices selects.
+func (s *Server) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements the Start method of manager.Runnable interface to add to the manager.
+// It starts up the HTTPS server and blocks until ctx expires.
+func (s *Server) Start(ctx context.Context) error {
+	authenticator, err := newReloadingAuthenticator(ctx, s.APIReader, s.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to load metrics adapter authentication configuration: %w", err)
+	}
+	go authenticator.run(ctx, authenticatorReloadInterval)
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+	if s.CertDir != "" {
+		watcher, err := certwatcher.New(
+			filepath.Join(s.CertDir, consts.TLSCRT),
+			filepath.Join(s.CertDir, consts.TLSKey),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to load metrics adapter serving certificate: %w", err)
+		}
+		go func() {
+			if err := watcher.Start(ctx); err != nil {
+				s.Logger.Error(err, "Failed to watch metrics adapter serving certificate")
+			}
+		}()
+		tlsConfig.GetCertificate = watcher.GetCertificate
+	} else {
+		cert, err := selfSignedCertificate()
+		if err != nil {
+			return fmt.Errorf("failed to generate metrics adapter serving certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	// Verify client certificates against the request header CA loaded last.
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := tlsConfig.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.ClientCAs = authenticator.get().clientCAs
+		return cfg, nil
+	}
+
+	httpServer := &http.Server{
+		Addr:              s.Addr,
+		Handler:           authenticationMiddleware(authenticator, s.Handler),
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig:         tlsConfig,
+	}
+
+	// Listen before labeling the Pod so that it is ready for the requests it gets.
+	ln, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.Addr, err)
+	}
+	go func() {
+		if err := httpServer.ServeTLS(ln, "", ""); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				s.Logger.Error(err, "Could not start metrics adapter server")
+			}
+		}
+	}()
+
+	s.Logger.Info("Metrics adapter server is listening", "addr", s.Addr)
+
+	if s.PodName != "" {
+		if err := markServingPod(ctx, s.Client, s.PodNamespace, s.PodName); err != nil {
+			s.Logger.Error(err, "Failed to label the Pod serving the metrics APIs")
+		}
+	}
+
+	<-ctx.Done()
+
+	s.Logger.Info("Shutting down metrics adapter server")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if s.PodName != "" {
+		if err := unmarkServingPod(ctx, s.Client, s.PodNamespace, s.PodName); err != nil { //nolint:contextcheck
+			s.Logger.Error(err, "Failed to remove the label of the Pod serving the metrics APIs")
+		}
+	}
+	return httpServer.Shutdown(ctx) //nolint:contextcheck
+}
+
+// selfSignedCertificate generates the serving certificate of the server when
+// no CertDir is configured. It changes on every start, so it is only suitable
+// for APIServices registered with insecureSkipTLSVerify, e.g. in development.
+func selfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "kong-operator-metrics-adapter",
+			Organization: []string{"Kong, Inc."},
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.AddDate(10, 0, 0),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
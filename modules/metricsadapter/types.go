@@ -0,0 +1,83 @@
+package metricsadapter
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The types below mirror the ones from k8s.io/metrics for the versions of the
+// custom and external metrics APIs served by the adapter.
+
+const (
+	// CustomMetricsGroup is the API group of the custom metrics API.
+	CustomMetricsGroup = "custom.metrics.k8s.io"
+	// CustomMetricsVersion is the version of the custom metrics API served by the adapter.
+	CustomMetricsVersion = "v1beta2"
+	// ExternalMetricsGroup is the API group of the external metrics API.
+	ExternalMetricsGroup = "external.metrics.k8s.io"
+	// ExternalMetricsVersion is the version of the external metrics API served by the adapter.
+	ExternalMetricsVersion = "v1beta1"
+)
+
+// MetricIdentifier identifies a metric by name and, optionally, selector.
+type MetricIdentifier struct {
+	// Name is the name of the given metric.
+	Name string `json:"name"`
+	// Selector represents the label selector that could be used to select
+	// this metric, and will generally just be the selector passed in to
+	// the query used to fetch this metric.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// MetricValue is the metric value for some object of the custom metrics API.
+type MetricValue struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// DescribedObject is a reference to the described object.
+	DescribedObject corev1.ObjectReference `json:"describedObject"`
+	// Metric identifies the metric.
+	Metric MetricIdentifier `json:"metric"`
+	// Timestamp indicates the time at which the metrics were produced.
+	Timestamp metav1.Time `json:"timestamp"`
+	// WindowSeconds indicates the window ([Timestamp-Window, Timestamp]) from
+	// which these metrics were calculated.
+	WindowSeconds *int64 `json:"windowSeconds,omitempty"`
+	// Value is the value of the metric for this object.
+	Value resource.Quantity `json:"value"`
+}
+
+// MetricValueList is a list of values for a given metric for some set of objects.
+type MetricValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of metric values.
+	Items []MetricValue `json:"items"`
+}
+
+// ExternalMetricValue is a metric value for an external metric.
+type ExternalMetricValue struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// MetricName is the name of the metric.
+	MetricName string `json:"metricName"`
+	// MetricLabels is a set of labels which identify a single time series for the metric.
+	MetricLabels map[string]string `json:"metricLabels"`
+	// Timestamp indicates the time at which the metrics were produced.
+	Timestamp metav1.Time `json:"timestamp"`
+	// WindowSeconds indicates the window ([Timestamp-Window, Timestamp]) from
+	// which these metrics were calculated.
+	WindowSeconds *int64 `json:"window,omitempty"`
+	// Value is the value of the metric.
+	Value resource.Quantity `json:"value"`
+}
+
+// ExternalMetricValueList is a list of values for a given metric for some set of labels.
+type ExternalMetricValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of metric values.
+	Items []ExternalMetricValue `json:"items"`
+}
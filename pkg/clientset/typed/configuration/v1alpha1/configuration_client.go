@@ -33,9 +33,12 @@ type ConfigurationV1alpha1Interface interface {
 	KongCertificatesGetter
 	KongCredentialACLsGetter
 	KongCredentialAPIKeysGetter
+	KongCredentialAPIKeyGeneratorsGetter
 	KongCredentialBasicAuthsGetter
 	KongCredentialHMACsGetter
 	KongCredentialJWTsGetter
+	KongCredentialMTLSesGetter
+	KongCredentialOAuth2sGetter
 	KongCustomEntitiesGetter
 	KongDataPlaneClientCertificatesGetter
 	KongKeysGetter
@@ -75,6 +78,10 @@ func (c *ConfigurationV1alpha1Client) KongCredentialAPIKeys(namespace string) Ko
 	return newKongCredentialAPIKeys(c, namespace)
 }
 
+func (c *ConfigurationV1alpha1Client) KongCredentialAPIKeyGenerators(namespace string) KongCredentialAPIKeyGeneratorInterface {
+	return newKongCredentialAPIKeyGenerators(c, namespace)
+}
+
 func (c *ConfigurationV1alpha1Client) KongCredentialBasicAuths(namespace string) KongCredentialBasicAuthInterface {
 	return newKongCredentialBasicAuths(c, namespace)
 }
@@ -87,6 +94,14 @@ func (c *ConfigurationV1alpha1Client) KongCredentialJWTs(namespace string) KongC
 	return newKongCredentialJWTs(c, namespace)
 }
 
+func (c *ConfigurationV1alpha1Client) KongCredentialMTLSes(namespace string) KongCredentialMTLSInterface {
+	return newKongCredentialMTLSes(c, namespace)
+}
+
+func (c *ConfigurationV1alpha1Client) KongCredentialOAuth2s(namespace string) KongCredentialOAuth2Interface {
+	return newKongCredentialOAuth2s(c, namespace)
+}
+
 func (c *ConfigurationV1alpha1Client) KongCustomEntities(namespace string) KongCustomEntityInterface {
 	return newKongCustomEntities(c, namespace)
 }
@@ -48,6 +48,10 @@ func (c *FakeConfigurationV1alpha1) KongCredentialAPIKeys(namespace string) v1al
 	return newFakeKongCredentialAPIKeys(c, namespace)
 }
 
+func (c *FakeConfigurationV1alpha1) KongCredentialAPIKeyGenerators(namespace string) v1alpha1.KongCredentialAPIKeyGeneratorInterface {
+	return newFakeKongCredentialAPIKeyGenerators(c, namespace)
+}
+
 func (c *FakeConfigurationV1alpha1) KongCredentialBasicAuths(namespace string) v1alpha1.KongCredentialBasicAuthInterface {
 	return newFakeKongCredentialBasicAuths(c, namespace)
 }
@@ -60,6 +64,14 @@ func (c *FakeConfigurationV1alpha1) KongCredentialJWTs(namespace string) v1alpha
 	return newFakeKongCredentialJWTs(c, namespace)
 }
 
+func (c *FakeConfigurationV1alpha1) KongCredentialMTLSes(namespace string) v1alpha1.KongCredentialMTLSInterface {
+	return newFakeKongCredentialMTLSes(c, namespace)
+}
+
+func (c *FakeConfigurationV1alpha1) KongCredentialOAuth2s(namespace string) v1alpha1.KongCredentialOAuth2Interface {
+	return newFakeKongCredentialOAuth2s(c, namespace)
+}
+
 func (c *FakeConfigurationV1alpha1) KongCustomEntities(namespace string) v1alpha1.KongCustomEntityInterface {
 	return newFakeKongCustomEntities(c, namespace)
 }
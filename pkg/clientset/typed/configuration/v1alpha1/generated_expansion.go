@@ -28,12 +28,18 @@ type KongCredentialACLExpansion interface{}
 
 type KongCredentialAPIKeyExpansion interface{}
 
+type KongCredentialAPIKeyGeneratorExpansion interface{}
+
 type KongCredentialBasicAuthExpansion interface{}
 
 type KongCredentialHMACExpansion interface{}
 
 type KongCredentialJWTExpansion interface{}
 
+type KongCredentialMTLSExpansion interface{}
+
+type KongCredentialOAuth2Expansion interface{}
+
 type KongCustomEntityExpansion interface{}
 
 type KongDataPlaneClientCertificateExpansion interface{}
@@ -28,6 +28,14 @@ type FakeKonnectV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeKonnectV1alpha1) APIImplementations(namespace string) v1alpha1.APIImplementationInterface {
+	return newFakeAPIImplementations(c, namespace)
+}
+
+func (c *FakeKonnectV1alpha1) APIPublications(namespace string) v1alpha1.APIPublicationInterface {
+	return newFakeAPIPublications(c, namespace)
+}
+
 func (c *FakeKonnectV1alpha1) KonnectAPIAuthConfigurations(namespace string) v1alpha1.KonnectAPIAuthConfigurationInterface {
 	return newFakeKonnectAPIAuthConfigurations(c, namespace)
 }
@@ -52,6 +60,14 @@ func (c *FakeKonnectV1alpha1) KonnectGatewayControlPlanes(namespace string) v1al
 	return newFakeKonnectGatewayControlPlanes(c, namespace)
 }
 
+func (c *FakeKonnectV1alpha1) KonnectRoleAssignments(namespace string) v1alpha1.KonnectRoleAssignmentInterface {
+	return newFakeKonnectRoleAssignments(c, namespace)
+}
+
+func (c *FakeKonnectV1alpha1) KonnectSystemAccountAccessTokens(namespace string) v1alpha1.KonnectSystemAccountAccessTokenInterface {
+	return newFakeKonnectSystemAccountAccessTokens(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeKonnectV1alpha1) RESTClient() rest.Interface {
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+type APIImplementationExpansion interface{}
+
+type APIPublicationExpansion interface{}
+
 type KonnectAPIAuthConfigurationExpansion interface{}
 
 type KonnectCloudGatewayDataPlaneGroupConfigurationExpansion interface{}
@@ -29,3 +33,7 @@ type KonnectCloudGatewayTransitGatewayExpansion interface{}
 type KonnectExtensionExpansion interface{}
 
 type KonnectGatewayControlPlaneExpansion interface{}
+
+type KonnectRoleAssignmentExpansion interface{}
+
+type KonnectSystemAccountAccessTokenExpansion interface{}
@@ -28,12 +28,16 @@ import (
 
 type KonnectV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	APIImplementationsGetter
+	APIPublicationsGetter
 	KonnectAPIAuthConfigurationsGetter
 	KonnectCloudGatewayDataPlaneGroupConfigurationsGetter
 	KonnectCloudGatewayNetworksGetter
 	KonnectCloudGatewayTransitGatewaysGetter
 	KonnectExtensionsGetter
 	KonnectGatewayControlPlanesGetter
+	KonnectRoleAssignmentsGetter
+	KonnectSystemAccountAccessTokensGetter
 }
 
 // KonnectV1alpha1Client is used to interact with features provided by the konnect.konghq.com group.
@@ -41,6 +45,14 @@ type KonnectV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *KonnectV1alpha1Client) APIImplementations(namespace string) APIImplementationInterface {
+	return newAPIImplementations(c, namespace)
+}
+
+func (c *KonnectV1alpha1Client) APIPublications(namespace string) APIPublicationInterface {
+	return newAPIPublications(c, namespace)
+}
+
 func (c *KonnectV1alpha1Client) KonnectAPIAuthConfigurations(namespace string) KonnectAPIAuthConfigurationInterface {
 	return newKonnectAPIAuthConfigurations(c, namespace)
 }
@@ -65,6 +77,14 @@ func (c *KonnectV1alpha1Client) KonnectGatewayControlPlanes(namespace string) Ko
 	return newKonnectGatewayControlPlanes(c, namespace)
 }
 
+func (c *KonnectV1alpha1Client) KonnectRoleAssignments(namespace string) KonnectRoleAssignmentInterface {
+	return newKonnectRoleAssignments(c, namespace)
+}
+
+func (c *KonnectV1alpha1Client) KonnectSystemAccountAccessTokens(namespace string) KonnectSystemAccountAccessTokenInterface {
+	return newKonnectSystemAccountAccessTokens(c, namespace)
+}
+
 // NewForConfig creates a new KonnectV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
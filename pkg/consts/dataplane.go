@@ -30,6 +30,10 @@ const (
 	// Useful for progressive rollouts.
 	DataPlaneDeploymentStateLabel = "gateway-operator.konghq.com/dataplane-deployment-state"
 
+	// DataPlaneZoneLabel indicates the availability zone a DataPlane Deployment
+	// (and its HPA) runs in, when the DataPlane is spread across zones.
+	DataPlaneZoneLabel = "gateway-operator.konghq.com/dataplane-zone"
+
 	// AnnotationLastAppliedAnnotations is the annotation key to store the last annotations
 	// of a DataPlane-owned object (e.g. Ingress `Service`) applied by the DataPlane controller.
 	// It allows the controller to decide which annotations are outdated compared to the DataPlane spec and
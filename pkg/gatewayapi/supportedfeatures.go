@@ -39,6 +39,10 @@ var commonSupportedFeatures = []features.FeatureName{
 	features.SupportHTTPRoutePathRewrite,
 	features.SupportHTTPRouteHostRewrite,
 	features.SupportHTTPRouteBackendTimeout,
+	features.SupportHTTPRouteRequestTimeout,
+
+	// GRPCRoute extended.
+	features.SupportGRPCRouteNamedRouteRule,
 
 	// TLSRoute extended.
 	features.SupportTLSRouteModeTerminate,
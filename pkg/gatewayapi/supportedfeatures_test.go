@@ -21,3 +21,18 @@ func TestGetSupportedFeaturesIncludesTCPRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSupportedFeaturesIncludesGRPCRouteExtendedFeatures(t *testing.T) {
+	for _, routerFlavor := range []consts.RouterFlavor{
+		consts.RouterFlavorTraditionalCompatible,
+		consts.RouterFlavorExpressions,
+	} {
+		t.Run(string(routerFlavor), func(t *testing.T) {
+			supportedFeatures, err := GetSupportedFeatures(routerFlavor)
+			require.NoError(t, err)
+			for _, feature := range features.GRPCRouteExtendedFeatures.UnsortedList() {
+				require.Contains(t, supportedFeatures, feature.Name)
+			}
+		})
+	}
+}
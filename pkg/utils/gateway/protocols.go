@@ -14,8 +14,15 @@ import (
 //
 // Returns nil when no matching listeners are found.
 func ProtocolsFromListeners(gw *gatewayv1.Gateway, sectionName *gatewayv1.SectionName) []string {
+	return ProtocolsFromListenerList(gw.Spec.Listeners, sectionName)
+}
+
+// ProtocolsFromListenerList derives Kong route protocol strings from the provided
+// listeners (e.g. the listener entries of a ListenerSet) the same way as
+// ProtocolsFromListeners does for a Gateway's listeners.
+func ProtocolsFromListenerList(listeners []gatewayv1.Listener, sectionName *gatewayv1.SectionName) []string {
 	protoSet := make(map[string]struct{})
-	for _, l := range gw.Spec.Listeners {
+	for _, l := range listeners {
 		if sectionName != nil && *sectionName != l.Name {
 			continue
 		}
@@ -0,0 +1,274 @@
+package resources
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	pkgapisappsv1 "k8s.io/kubernetes/pkg/apis/apps/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// GenerateDaemonSetFromDataPlaneDeployment generates a DaemonSet running the same
+// Pods as the provided, fully generated DataPlane Deployment.
+// Object metadata, selector and Pod template are copied from the Deployment, while
+// the replica count and rollout strategy, which have no DaemonSet equivalent, are dropped.
+// The generated DaemonSet replaces at most one Pod per node at a time.
+func GenerateDaemonSetFromDataPlaneDeployment(deployment *appsv1.Deployment) *appsv1.DaemonSet {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: *deployment.ObjectMeta.DeepCopy(),
+		Spec: appsv1.DaemonSetSpec{
+			Selector: deployment.Spec.Selector.DeepCopy(),
+			Template: *deployment.Spec.Template.DeepCopy(),
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: new(intstr.FromInt32(1)),
+					MaxSurge:       new(intstr.FromInt32(0)),
+				},
+			},
+		},
+	}
+
+	// Set defaults for the DaemonSet so that we don't get a diff when we compare
+	// it with what's in the cluster.
+	pkgapisappsv1.SetDefaults_DaemonSet(daemonSet)
+
+	return daemonSet
+}
+
+// SetDataPlaneHostBinding configures the provided DataPlane Pod template to bind
+// the proxy to the network of the node it runs on, as requested by the DataPlane's
+// hostBinding option.
+//
+// With HostNetwork the Pod runs in the node's network namespace, with HostPort
+// every port of the DataPlane ingress Service is bound on the node and forwarded
+// to the proxy port it targets.
+//
+// With HostNetwork the proxy and stream listeners are bound on the ingress
+// Service ports, i.e. the Gateway listener ports, instead of the ports they
+// target, since no Service forwards the traffic reaching the node. The admin
+// API and status listeners bound on all interfaces are bound on the Pod IP,
+// which the ControlPlane, the kubelet probes and the metrics scrapers use, and
+// on the loopback interface only.
+func SetDataPlaneHostBinding(dataplane *operatorv1beta1.DataPlane, template *corev1.PodTemplateSpec) {
+	switch dataplane.Spec.Deployment.HostBinding {
+	case commonv1alpha1.HostBindingHostNetwork:
+		template.Spec.HostNetwork = true
+		// Without this Pods running in the host network would not resolve cluster DNS names.
+		template.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
+
+		container := k8sutils.GetPodContainerByName(&template.Spec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			return
+		}
+		setHostNetworkListenPorts(container, dataPlaneIngressServicePorts(dataplane))
+		setHostNetworkInternalListeners(container)
+
+	case commonv1alpha1.HostBindingHostPort:
+		container := k8sutils.GetPodContainerByName(&template.Spec, consts.DataPlaneProxyContainerName)
+		if container == nil {
+			return
+		}
+		for _, port := range dataPlaneIngressServicePorts(dataplane) {
+			// Named target ports are resolved against the container's ports by the
+			// Service. They cannot be mapped to a host port here.
+			if port.TargetPort.Type != intstr.Int {
+				continue
+			}
+			setContainerHostPort(container, port.TargetPort.IntVal, port.Port, port.Protocol)
+		}
+	}
+}
+
+// setHostNetworkListenPorts rewrites the proxy and stream listeners of the container
+// to listen on the provided ingress Service ports instead of the ports they target.
+// Listeners whose port is not targeted by any Service port are left as they are.
+// As Kong listens on the ports clients connect to, KONG_PORT_MAPS maps them onto themselves.
+func setHostNetworkListenPorts(container *corev1.Container, servicePorts []corev1.ServicePort) {
+	portsForTarget := make(map[string][]int32)
+	var ports []int32
+	privileged := false
+	for _, port := range servicePorts {
+		// Named target ports cannot be matched with the listeners.
+		if port.TargetPort.Type != intstr.Int {
+			continue
+		}
+		target := port.TargetPort.String()
+		portsForTarget[target] = append(portsForTarget[target], port.Port)
+		ports = append(ports, port.Port)
+		privileged = privileged || port.Port < 1024
+	}
+	if len(ports) == 0 {
+		return
+	}
+
+	for _, name := range []string{"KONG_PROXY_LISTEN", "KONG_STREAM_LISTEN"} {
+		listen := k8sutils.EnvValueByName(container.Env, name)
+		if listen == "" {
+			continue
+		}
+		var entries []string
+		for _, entry := range splitKongListen(listen) {
+			address, port, options, ok := parseKongListenEntry(entry)
+			targetingPorts, targeted := portsForTarget[port]
+			if !ok || !targeted {
+				entries = append(entries, entry)
+				continue
+			}
+			for _, p := range targetingPorts {
+				entries = append(entries, joinKongListenEntry(net.JoinHostPort(address, strconv.Itoa(int(p))), options))
+			}
+		}
+		k8sutils.SetContainerEnv(container, corev1.EnvVar{Name: name, Value: strings.Join(entries, ", ")})
+	}
+
+	slices.Sort(ports)
+	ports = slices.Compact(ports)
+	portMaps := make([]string, 0, len(ports))
+	for _, p := range ports {
+		portMaps = append(portMaps, fmt.Sprintf("%d:%d", p, p))
+	}
+	k8sutils.SetContainerEnv(container, corev1.EnvVar{Name: "KONG_PORT_MAPS", Value: strings.Join(portMaps, ", ")})
+
+	// Binding ports below 1024 requires the capability the proxy drops when hardened.
+	if privileged {
+		addContainerCapability(container, "NET_BIND_SERVICE")
+	}
+}
+
+// setHostNetworkInternalListeners binds the admin API and status listeners of the
+// container which listen on all IPv4 interfaces on the Pod IP and the loopback
+// interface, and the ones listening on all IPv6 interfaces on the loopback interface.
+// The Pod IP is exposed to the container in the POD_IP environment variable.
+func setHostNetworkInternalListeners(container *corev1.Container) {
+	rebound := false
+	for _, name := range []string{"KONG_ADMIN_LISTEN", "KONG_STATUS_LISTEN"} {
+		listen := k8sutils.EnvValueByName(container.Env, name)
+		if listen == "" {
+			continue
+		}
+		var entries []string
+		for _, entry := range splitKongListen(listen) {
+			address, port, options, ok := parseKongListenEntry(entry)
+			switch {
+			case ok && (address == "0.0.0.0" || address == "*"):
+				entries = append(entries,
+					joinKongListenEntry(net.JoinHostPort("$(POD_IP)", port), options),
+					joinKongListenEntry(net.JoinHostPort("127.0.0.1", port), options),
+				)
+				rebound = true
+			case ok && address == "::":
+				entries = append(entries, joinKongListenEntry(net.JoinHostPort("::1", port), options))
+			default:
+				entries = append(entries, entry)
+			}
+		}
+		k8sutils.SetContainerEnv(container, corev1.EnvVar{Name: name, Value: strings.Join(entries, ", ")})
+	}
+	if !rebound {
+		return
+	}
+	// Kubernetes only expands variables defined before the one referencing them.
+	container.Env = append([]corev1.EnvVar{{
+		Name: "POD_IP",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "status.podIP"},
+		},
+	}}, slices.DeleteFunc(container.Env, func(env corev1.EnvVar) bool { return env.Name == "POD_IP" })...)
+}
+
+// splitKongListen splits a Kong listen configuration value into its entries.
+func splitKongListen(listen string) []string {
+	var entries []string
+	for entry := range strings.SplitSeq(listen, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// parseKongListenEntry splits a Kong listen entry like "0.0.0.0:8000 reuseport"
+// into its address, port and options. An entry without an address listens on all
+// interfaces. ok is false for entries which are not an address and port, e.g. "off".
+func parseKongListenEntry(entry string) (address, port string, options []string, ok bool) {
+	fields := strings.Fields(entry)
+	hostPort := fields[0]
+	address, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		address, port = "0.0.0.0", hostPort
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", "", nil, false
+	}
+	return address, port, fields[1:], true
+}
+
+// joinKongListenEntry joins an address with its port and options into a Kong listen entry.
+func joinKongListenEntry(hostPort string, options []string) string {
+	return strings.Join(append([]string{hostPort}, options...), " ")
+}
+
+// addContainerCapability adds the capability to the container's security context
+// unless it is added already.
+func addContainerCapability(container *corev1.Container, capability corev1.Capability) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	if container.SecurityContext.Capabilities == nil {
+		container.SecurityContext.Capabilities = &corev1.Capabilities{}
+	}
+	if !slices.Contains(container.SecurityContext.Capabilities.Add, capability) {
+		container.SecurityContext.Capabilities.Add = append(container.SecurityContext.Capabilities.Add, capability)
+	}
+}
+
+// dataPlaneIngressServicePorts returns the ports of the DataPlane ingress Service.
+func dataPlaneIngressServicePorts(dataplane *operatorv1beta1.DataPlane) []corev1.ServicePort {
+	svc := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Ports: DefaultDataPlaneIngressServicePorts,
+		},
+	}
+	ServicePortsFromDataPlaneIngressOpt(dataplane)(svc)
+	return svc.Spec.Ports
+}
+
+// setContainerHostPort binds the provided container port on the node's hostPort.
+// If the container does not expose the port yet, a new container port is added.
+func setContainerHostPort(container *corev1.Container, containerPort, hostPort int32, protocol corev1.Protocol) {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	for i, p := range container.Ports {
+		pProtocol := p.Protocol
+		if pProtocol == "" {
+			pProtocol = corev1.ProtocolTCP
+		}
+		if p.ContainerPort != containerPort || pProtocol != protocol {
+			continue
+		}
+		if container.Ports[i].HostPort == 0 {
+			container.Ports[i].HostPort = hostPort
+			return
+		}
+		if container.Ports[i].HostPort == hostPort {
+			return
+		}
+	}
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		ContainerPort: containerPort,
+		HostPort:      hostPort,
+		Protocol:      protocol,
+	})
+}
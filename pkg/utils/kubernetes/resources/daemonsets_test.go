@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+func TestSetDataPlaneHostBinding(t *testing.T) {
+	proxyTemplate := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				DNSPolicy: corev1.DNSClusterFirst,
+				Containers: []corev1.Container{
+					{
+						Name: consts.DataPlaneProxyContainerName,
+						Ports: []corev1.ContainerPort{
+							{Name: "proxy", ContainerPort: consts.DataPlaneProxyPort, Protocol: corev1.ProtocolTCP},
+							{Name: "proxy-ssl", ContainerPort: consts.DataPlaneProxySSLPort, Protocol: corev1.ProtocolTCP},
+						},
+					},
+				},
+			},
+		}
+	}
+	dataPlane := func(hostBinding commonv1alpha1.HostBinding, ports ...operatorv1beta1.DataPlaneServicePort) *operatorv1beta1.DataPlane {
+		dp := &operatorv1beta1.DataPlane{}
+		dp.Spec.Deployment.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+		dp.Spec.Deployment.HostBinding = hostBinding
+		if len(ports) > 0 {
+			dp.Spec.Network.Services = &operatorv1beta1.DataPlaneServices{
+				Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+					Ports: ports,
+				},
+			}
+		}
+		return dp
+	}
+
+	testCases := []struct {
+		name          string
+		dataplane     *operatorv1beta1.DataPlane
+		expectedPorts []corev1.ContainerPort
+		hostNetwork   bool
+	}{
+		{
+			name:      "none",
+			dataplane: dataPlane(commonv1alpha1.HostBindingNone),
+			expectedPorts: []corev1.ContainerPort{
+				{Name: "proxy", ContainerPort: consts.DataPlaneProxyPort, Protocol: corev1.ProtocolTCP},
+				{Name: "proxy-ssl", ContainerPort: consts.DataPlaneProxySSLPort, Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name:      "host network",
+			dataplane: dataPlane(commonv1alpha1.HostBindingHostNetwork),
+			expectedPorts: []corev1.ContainerPort{
+				{Name: "proxy", ContainerPort: consts.DataPlaneProxyPort, Protocol: corev1.ProtocolTCP},
+				{Name: "proxy-ssl", ContainerPort: consts.DataPlaneProxySSLPort, Protocol: corev1.ProtocolTCP},
+			},
+			hostNetwork: true,
+		},
+		{
+			name:      "host port with default ingress Service ports",
+			dataplane: dataPlane(commonv1alpha1.HostBindingHostPort),
+			expectedPorts: []corev1.ContainerPort{
+				{Name: "proxy", ContainerPort: consts.DataPlaneProxyPort, HostPort: consts.DefaultHTTPPort, Protocol: corev1.ProtocolTCP},
+				{Name: "proxy-ssl", ContainerPort: consts.DataPlaneProxySSLPort, HostPort: consts.DefaultHTTPSPort, Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "host port with custom ingress Service ports",
+			dataplane: dataPlane(commonv1alpha1.HostBindingHostPort,
+				operatorv1beta1.DataPlaneServicePort{Name: "http", Port: 8080, TargetPort: intstr.FromInt(consts.DataPlaneProxyPort)},
+				operatorv1beta1.DataPlaneServicePort{Name: "http-alt", Port: 8081, TargetPort: intstr.FromInt(consts.DataPlaneProxyPort)},
+				operatorv1beta1.DataPlaneServicePort{Name: "tcp", Port: 5432, TargetPort: intstr.FromInt(9000)},
+				operatorv1beta1.DataPlaneServicePort{Name: "named", Port: 9443, TargetPort: intstr.FromString("proxy-ssl")},
+			),
+			expectedPorts: []corev1.ContainerPort{
+				{Name: "proxy", ContainerPort: consts.DataPlaneProxyPort, HostPort: 8080, Protocol: corev1.ProtocolTCP},
+				{Name: "proxy-ssl", ContainerPort: consts.DataPlaneProxySSLPort, Protocol: corev1.ProtocolTCP},
+				{ContainerPort: consts.DataPlaneProxyPort, HostPort: 8081, Protocol: corev1.ProtocolTCP},
+				{ContainerPort: 9000, HostPort: 5432, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			template := proxyTemplate()
+			SetDataPlaneHostBinding(tc.dataplane, template)
+
+			require.Equal(t, tc.expectedPorts, template.Spec.Containers[0].Ports)
+			require.Equal(t, tc.hostNetwork, template.Spec.HostNetwork)
+			if tc.hostNetwork {
+				require.Equal(t, corev1.DNSClusterFirstWithHostNet, template.Spec.DNSPolicy)
+			} else {
+				require.Equal(t, corev1.DNSClusterFirst, template.Spec.DNSPolicy)
+			}
+		})
+	}
+}
+
+func TestSetDataPlaneHostBindingHostNetworkListeners(t *testing.T) {
+	defaultEnv := func() []corev1.EnvVar {
+		return []corev1.EnvVar{
+			{Name: "KONG_ADMIN_LISTEN", Value: "0.0.0.0:8444 ssl reuseport backlog=16384"},
+			{Name: "KONG_PORT_MAPS", Value: "80:8000, 443:8443"},
+			{Name: "KONG_PROXY_LISTEN", Value: "0.0.0.0:8000 reuseport backlog=16384, 0.0.0.0:8443 http2 ssl reuseport backlog=16384"},
+			{Name: "KONG_STATUS_LISTEN", Value: "0.0.0.0:8100"},
+		}
+	}
+	podIP := corev1.EnvVar{
+		Name: "POD_IP",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "status.podIP"},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		env                []corev1.EnvVar
+		ports              []operatorv1beta1.DataPlaneServicePort
+		expectedEnv        []corev1.EnvVar
+		expectedCapability bool
+	}{
+		{
+			name: "default ingress Service ports",
+			env:  defaultEnv(),
+			expectedEnv: []corev1.EnvVar{
+				podIP,
+				{Name: "KONG_ADMIN_LISTEN", Value: "$(POD_IP):8444 ssl reuseport backlog=16384, 127.0.0.1:8444 ssl reuseport backlog=16384"},
+				{Name: "KONG_PORT_MAPS", Value: "80:80, 443:443"},
+				{Name: "KONG_PROXY_LISTEN", Value: "0.0.0.0:80 reuseport backlog=16384, 0.0.0.0:443 http2 ssl reuseport backlog=16384"},
+				{Name: "KONG_STATUS_LISTEN", Value: "$(POD_IP):8100, 127.0.0.1:8100"},
+			},
+			expectedCapability: true,
+		},
+		{
+			name: "Gateway listener ports",
+			env: append(defaultEnv(),
+				corev1.EnvVar{Name: "KONG_STREAM_LISTEN", Value: "0.0.0.0:8899 reuseport"},
+			),
+			ports: []operatorv1beta1.DataPlaneServicePort{
+				{Name: "http", Port: 8080, TargetPort: intstr.FromInt(consts.DataPlaneProxyPort)},
+				{Name: "http-alt", Port: 8081, TargetPort: intstr.FromInt(consts.DataPlaneProxyPort)},
+				{Name: "tcp", Port: 5432, TargetPort: intstr.FromInt(8899)},
+			},
+			expectedEnv: []corev1.EnvVar{
+				podIP,
+				{Name: "KONG_ADMIN_LISTEN", Value: "$(POD_IP):8444 ssl reuseport backlog=16384, 127.0.0.1:8444 ssl reuseport backlog=16384"},
+				{Name: "KONG_PORT_MAPS", Value: "5432:5432, 8080:8080, 8081:8081"},
+				{Name: "KONG_PROXY_LISTEN", Value: "0.0.0.0:8080 reuseport backlog=16384, 0.0.0.0:8081 reuseport backlog=16384, 0.0.0.0:8443 http2 ssl reuseport backlog=16384"},
+				{Name: "KONG_STATUS_LISTEN", Value: "$(POD_IP):8100, 127.0.0.1:8100"},
+				{Name: "KONG_STREAM_LISTEN", Value: "0.0.0.0:5432 reuseport"},
+			},
+		},
+		{
+			name: "user configured listeners",
+			env: []corev1.EnvVar{
+				{Name: "KONG_ADMIN_LISTEN", Value: "127.0.0.1:8444 ssl"},
+				{Name: "KONG_STATUS_LISTEN", Value: "off"},
+			},
+			ports: []operatorv1beta1.DataPlaneServicePort{
+				{Name: "http", Port: 8080, TargetPort: intstr.FromInt(consts.DataPlaneProxyPort)},
+			},
+			expectedEnv: []corev1.EnvVar{
+				{Name: "KONG_ADMIN_LISTEN", Value: "127.0.0.1:8444 ssl"},
+				{Name: "KONG_STATUS_LISTEN", Value: "off"},
+				{Name: "KONG_PORT_MAPS", Value: "8080:8080"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dataplane := &operatorv1beta1.DataPlane{}
+			dataplane.Spec.Deployment.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+			dataplane.Spec.Deployment.HostBinding = commonv1alpha1.HostBindingHostNetwork
+			if len(tc.ports) > 0 {
+				dataplane.Spec.Network.Services = &operatorv1beta1.DataPlaneServices{
+					Ingress: &operatorv1beta1.DataPlaneServiceOptions{Ports: tc.ports},
+				}
+			}
+			template := &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: consts.DataPlaneProxyContainerName, Env: tc.env},
+					},
+				},
+			}
+
+			SetDataPlaneHostBinding(dataplane, template)
+
+			container := template.Spec.Containers[0]
+			require.Equal(t, tc.expectedEnv, container.Env)
+			if tc.expectedCapability {
+				require.NotNil(t, container.SecurityContext)
+				require.Equal(t, []corev1.Capability{"NET_BIND_SERVICE"}, container.SecurityContext.Capabilities.Add)
+			} else {
+				require.Nil(t, container.SecurityContext)
+			}
+		})
+	}
+}
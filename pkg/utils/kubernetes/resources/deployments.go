@@ -13,7 +13,6 @@ import (
 	pkgapisappsv1 "k8s.io/kubernetes/pkg/apis/apps/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
-	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
 	"github.com/kong/kong-operator/v2/pkg/consts"
 	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
@@ -59,10 +58,7 @@ func GenerateNewDeploymentForDataPlane(
 	opts ...DeploymentOpt,
 ) (*Deployment, error) {
 	container := GenerateDataPlaneContainer(dataplaneImage, dataplane.Spec.Deployment.PodTemplateSpec)
-	var volumes []corev1.Volume
-	if dataplane.Spec.Deployment.Hardened == commonv1alpha1.HardeningStateEnabled {
-		container, volumes = HardenContainerWithSecurityContext(container, DataPlaneTypeGateway)
-	}
+	container, volumes := HardenContainer(container, DataPlaneTypeGateway, dataplane.Spec.Deployment.Hardened)
 
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -125,9 +121,9 @@ func GenerateNewDeploymentForDataPlane(
 
 	dpOpts := dataplane.Spec.Deployment
 	switch {
-	// When the replicas are set and scaling is unset then set the replicas
-	// to the value of the replicas field.
-	case dpOpts.Replicas != nil && dpOpts.Scaling == nil:
+	// When the replicas are set and horizontal scaling is unset then set the
+	// replicas to the value of the replicas field.
+	case dpOpts.Replicas != nil && (dpOpts.Scaling == nil || dpOpts.Scaling.HorizontalScaling == nil):
 		deployment.Spec.Replicas = dpOpts.Replicas
 
 	// When replicas field is unset and scaling is set, we set the replicas
@@ -144,10 +140,11 @@ func GenerateNewDeploymentForDataPlane(
 	// we cannot set the default in the CRD due to the fact that the default
 	// would prevent us from being able to use CRD Validation Rules to enforce
 	// wither replicas or scaling sections specified.
-	case dpOpts.Replicas == nil && dpOpts.Scaling == nil:
+	case dpOpts.Replicas == nil && (dpOpts.Scaling == nil || dpOpts.Scaling.HorizontalScaling == nil):
 		deployment.Spec.Replicas = new(int32(1))
 	}
 
+	HardenPodSpec(&deployment.Spec.Template.Spec, dataplane.Spec.Deployment.Hardened)
 	SetDefaultsPodTemplateSpec(&deployment.Spec.Template)
 	LabelObjectAsDataPlaneManaged(deployment)
 
@@ -178,6 +175,8 @@ const (
 	DataPlaneTypeAIGateway
 	// DataPlaneTypeKeg represents a Kong Event Gateway data plane.
 	DataPlaneTypeKeg
+	// DataPlaneTypeMCPServer represents a MCP Server data plane.
+	DataPlaneTypeMCPServer
 )
 
 // HardenContainerWithSecurityContext hardens a container with a security context and returns
@@ -221,7 +220,7 @@ func HardenContainerWithSecurityContext(container corev1.Container, dpType DataP
 
 	// For standard Kong Gateway runtime it is required to have
 	// additional writable path.
-	if dpType != DataPlaneTypeKeg {
+	if dpType == DataPlaneTypeGateway || dpType == DataPlaneTypeAIGateway {
 		const (
 			volumeVarKong          = "var-kong"
 			volumeVarKongMountPath = "/var/kong"
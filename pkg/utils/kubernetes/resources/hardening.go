@@ -0,0 +1,263 @@
+package resources
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+var (
+	// baselineAllowedCapabilities are the capabilities containers can add
+	// with the baseline Pod Security Standard.
+	baselineAllowedCapabilities = []corev1.Capability{
+		"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD",
+		"NET_BIND_SERVICE", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+	}
+	// restrictedAllowedCapabilities are the capabilities containers can add
+	// with the restricted Pod Security Standard.
+	restrictedAllowedCapabilities = []corev1.Capability{
+		"NET_BIND_SERVICE",
+	}
+	// baselineSafeSysctls are the sysctls Pods can set with the baseline Pod
+	// Security Standard.
+	baselineSafeSysctls = []string{
+		"kernel.shm_rmid_forced",
+		"net.ipv4.ip_local_port_range",
+		"net.ipv4.ip_local_reserved_ports",
+		"net.ipv4.ip_unprivileged_port_start",
+		"net.ipv4.ping_group_range",
+		"net.ipv4.tcp_fin_timeout",
+		"net.ipv4.tcp_keepalive_intvl",
+		"net.ipv4.tcp_keepalive_probes",
+		"net.ipv4.tcp_keepalive_time",
+		"net.ipv4.tcp_syncookies",
+	}
+)
+
+// HardenContainer applies the security settings of the hardening level to a
+// container and returns the volumes that have to be added to the Pod spec.
+// The Pod level settings are applied by HardenPodSpec.
+func HardenContainer(container corev1.Container, dpType DataPlaneType, state commonv1alpha1.HardeningState) (
+	corev1.Container, []corev1.Volume,
+) {
+	switch state {
+	case commonv1alpha1.HardeningStateEnabled:
+		return HardenContainerWithSecurityContext(container, dpType)
+	case commonv1alpha1.HardeningStateRestricted:
+		hardened, volumes := HardenContainerWithSecurityContext(container, dpType)
+		hardened.SecurityContext.SeccompProfile = runtimeDefaultSeccompProfile()
+		return hardened, volumes
+	case commonv1alpha1.HardeningStateBaseline:
+		container.SecurityContext = &corev1.SecurityContext{
+			AllowPrivilegeEscalation: new(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+				Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+			},
+			SeccompProfile: runtimeDefaultSeccompProfile(),
+		}
+		return container, nil
+	default:
+		return container, nil
+	}
+}
+
+// HardenPodSpec applies the Pod level security settings of the baseline and
+// restricted hardening levels to a PodSpec: the RuntimeDefault seccomp profile,
+// no service account token and, for restricted, a non-root user.
+func HardenPodSpec(spec *corev1.PodSpec, state commonv1alpha1.HardeningState) {
+	if state != commonv1alpha1.HardeningStateBaseline && state != commonv1alpha1.HardeningStateRestricted {
+		return
+	}
+
+	spec.AutomountServiceAccountToken = new(false)
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	spec.SecurityContext.SeccompProfile = runtimeDefaultSeccompProfile()
+	if state == commonv1alpha1.HardeningStateRestricted {
+		spec.SecurityContext.RunAsNonRoot = new(true)
+	}
+}
+
+func runtimeDefaultSeccompProfile() *corev1.SeccompProfile {
+	return &corev1.SeccompProfile{
+		Type: corev1.SeccompProfileTypeRuntimeDefault,
+	}
+}
+
+// PodSecurityViolations returns the settings of the PodSpec violating the Pod
+// Security Standard of the baseline and restricted hardening levels.
+// It's meant to validate the PodTemplateSpec patches provided by users, so
+// only the settings explicitly set are checked: the missing ones are set by
+// HardenContainer and HardenPodSpec.
+func PodSecurityViolations(spec *corev1.PodSpec, state commonv1alpha1.HardeningState) []string {
+	if spec == nil ||
+		(state != commonv1alpha1.HardeningStateBaseline && state != commonv1alpha1.HardeningStateRestricted) {
+		return nil
+	}
+	restricted := state == commonv1alpha1.HardeningStateRestricted
+
+	var violations []string
+	if spec.HostNetwork {
+		violations = append(violations, "hostNetwork is not allowed")
+	}
+	if spec.HostPID {
+		violations = append(violations, "hostPID is not allowed")
+	}
+	if spec.HostIPC {
+		violations = append(violations, "hostIPC is not allowed")
+	}
+	if sc := spec.SecurityContext; sc != nil {
+		if v := seccompProfileViolation(sc.SeccompProfile, restricted); v != "" {
+			violations = append(violations, "pod: "+v)
+		}
+		for _, sysctl := range sc.Sysctls {
+			if !slices.Contains(baselineSafeSysctls, sysctl.Name) {
+				violations = append(violations, fmt.Sprintf("pod: sysctl %s is not allowed", sysctl.Name))
+			}
+		}
+		if restricted {
+			violations = append(violations, prefixed("pod", runAsViolations(sc.RunAsNonRoot, sc.RunAsUser))...)
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			violations = append(violations, fmt.Sprintf("volume %q: hostPath volumes are not allowed", volume.Name))
+			continue
+		}
+		if restricted && !isRestrictedVolumeSource(volume.VolumeSource) {
+			violations = append(violations, fmt.Sprintf(
+				"volume %q: only configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, projected and secret volumes are allowed",
+				volume.Name,
+			))
+		}
+	}
+
+	allowedCapabilities := baselineAllowedCapabilities
+	if restricted {
+		allowedCapabilities = restrictedAllowedCapabilities
+	}
+	for _, container := range slices.Concat(spec.InitContainers, spec.Containers) {
+		var containerViolations []string
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				containerViolations = append(containerViolations, fmt.Sprintf("hostPort %d is not allowed", port.HostPort))
+			}
+		}
+		if sc := container.SecurityContext; sc != nil {
+			if sc.Privileged != nil && *sc.Privileged {
+				containerViolations = append(containerViolations, "privileged is not allowed")
+			}
+			if sc.Capabilities != nil {
+				for _, capability := range sc.Capabilities.Add {
+					if !slices.Contains(allowedCapabilities, capability) {
+						containerViolations = append(containerViolations, fmt.Sprintf("capability %s is not allowed", capability))
+					}
+				}
+			}
+			if sc.ProcMount != nil && *sc.ProcMount == corev1.UnmaskedProcMount {
+				containerViolations = append(containerViolations, "unmasked procMount is not allowed")
+			}
+			if v := seccompProfileViolation(sc.SeccompProfile, restricted); v != "" {
+				containerViolations = append(containerViolations, v)
+			}
+			if restricted {
+				if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+					containerViolations = append(containerViolations, "allowPrivilegeEscalation is not allowed")
+				}
+				containerViolations = append(containerViolations, runAsViolations(sc.RunAsNonRoot, sc.RunAsUser)...)
+			}
+		}
+		violations = append(violations, prefixed(fmt.Sprintf("container %q", container.Name), containerViolations)...)
+	}
+
+	return violations
+}
+
+func seccompProfileViolation(profile *corev1.SeccompProfile, restricted bool) string {
+	switch {
+	case profile == nil:
+		return ""
+	case profile.Type == corev1.SeccompProfileTypeUnconfined:
+		return "Unconfined seccomp profile is not allowed"
+	case restricted && profile.Type != corev1.SeccompProfileTypeRuntimeDefault && profile.Type != corev1.SeccompProfileTypeLocalhost:
+		return fmt.Sprintf("%s seccomp profile is not allowed", profile.Type)
+	default:
+		return ""
+	}
+}
+
+func runAsViolations(runAsNonRoot *bool, runAsUser *int64) []string {
+	var violations []string
+	if runAsNonRoot != nil && !*runAsNonRoot {
+		violations = append(violations, "runAsNonRoot must not be false")
+	}
+	if runAsUser != nil && *runAsUser == 0 {
+		violations = append(violations, "runAsUser must not be 0")
+	}
+	return violations
+}
+
+func isRestrictedVolumeSource(source corev1.VolumeSource) bool {
+	source.ConfigMap = nil
+	source.CSI = nil
+	source.DownwardAPI = nil
+	source.EmptyDir = nil
+	source.Ephemeral = nil
+	source.PersistentVolumeClaim = nil
+	source.Projected = nil
+	source.Secret = nil
+	return reflect.DeepEqual(source, corev1.VolumeSource{})
+}
+
+func prefixed(prefix string, violations []string) []string {
+	return lo.Map(violations, func(v string, _ int) string {
+		return prefix + ": " + v
+	})
+}
+
+// PodSecurityCondition returns the PodSecurityCompliant condition reporting
+// whether the PodTemplateSpec patches provided by the user comply with the Pod
+// Security Standard of the hardening level. It returns false when the hardening
+// level doesn't map to a Pod Security Standard, in which case the condition
+// should be removed.
+func PodSecurityCondition(
+	pts *corev1.PodTemplateSpec,
+	state commonv1alpha1.HardeningState,
+	generation int64,
+) (metav1.Condition, bool) {
+	if state != commonv1alpha1.HardeningStateBaseline && state != commonv1alpha1.HardeningStateRestricted {
+		return metav1.Condition{}, false
+	}
+
+	var violations []string
+	if pts != nil {
+		violations = PodSecurityViolations(&pts.Spec, state)
+	}
+	if len(violations) > 0 {
+		return k8sutils.NewConditionWithGeneration(
+			commonv1alpha1.PodSecurityCompliantType,
+			metav1.ConditionFalse,
+			commonv1alpha1.PodSecurityViolationReason,
+			fmt.Sprintf("PodTemplateSpec violates the %s Pod Security Standard: %s", state, strings.Join(violations, "; ")),
+			generation,
+		), true
+	}
+	return k8sutils.NewConditionWithGeneration(
+		commonv1alpha1.PodSecurityCompliantType,
+		metav1.ConditionTrue,
+		commonv1alpha1.PodSecurityCompliantReason,
+		fmt.Sprintf("PodTemplateSpec complies with the %s Pod Security Standard", state),
+		generation,
+	), true
+}
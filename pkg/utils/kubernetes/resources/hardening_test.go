@@ -0,0 +1,294 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
+)
+
+func TestHardenContainer(t *testing.T) {
+	container := corev1.Container{Name: "proxy"}
+
+	testCases := []struct {
+		name        string
+		state       commonv1alpha1.HardeningState
+		assertions  func(t *testing.T, container corev1.Container, volumes []corev1.Volume)
+		wantVolumes []string
+	}{
+		{
+			name:  "disabled leaves the container untouched",
+			state: commonv1alpha1.HardeningStateDisabled,
+			assertions: func(t *testing.T, c corev1.Container, _ []corev1.Volume) {
+				assert.Equal(t, container, c)
+			},
+		},
+		{
+			name:  "empty state leaves the container untouched",
+			state: "",
+			assertions: func(t *testing.T, c corev1.Container, _ []corev1.Volume) {
+				assert.Equal(t, container, c)
+			},
+		},
+		{
+			name:        "enabled applies the hardened security context",
+			state:       commonv1alpha1.HardeningStateEnabled,
+			wantVolumes: []string{"tmp", "var-kong"},
+			assertions: func(t *testing.T, c corev1.Container, _ []corev1.Volume) {
+				require.NotNil(t, c.SecurityContext)
+				assert.True(t, *c.SecurityContext.ReadOnlyRootFilesystem)
+				assert.True(t, *c.SecurityContext.RunAsNonRoot)
+				assert.Nil(t, c.SecurityContext.SeccompProfile)
+			},
+		},
+		{
+			name:  "baseline drops capabilities and sets the RuntimeDefault seccomp profile",
+			state: commonv1alpha1.HardeningStateBaseline,
+			assertions: func(t *testing.T, c corev1.Container, _ []corev1.Volume) {
+				require.NotNil(t, c.SecurityContext)
+				assert.False(t, *c.SecurityContext.AllowPrivilegeEscalation)
+				assert.Equal(t, []corev1.Capability{"ALL"}, c.SecurityContext.Capabilities.Drop)
+				assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, c.SecurityContext.SeccompProfile.Type)
+				assert.Nil(t, c.SecurityContext.ReadOnlyRootFilesystem)
+				assert.Empty(t, c.VolumeMounts)
+			},
+		},
+		{
+			name:        "restricted applies the hardened security context and the RuntimeDefault seccomp profile",
+			state:       commonv1alpha1.HardeningStateRestricted,
+			wantVolumes: []string{"tmp", "var-kong"},
+			assertions: func(t *testing.T, c corev1.Container, _ []corev1.Volume) {
+				require.NotNil(t, c.SecurityContext)
+				assert.True(t, *c.SecurityContext.ReadOnlyRootFilesystem)
+				assert.True(t, *c.SecurityContext.RunAsNonRoot)
+				assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, c.SecurityContext.SeccompProfile.Type)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, volumes := HardenContainer(container, DataPlaneTypeGateway, tc.state)
+			tc.assertions(t, c, volumes)
+
+			var names []string
+			for _, v := range volumes {
+				names = append(names, v.Name)
+			}
+			assert.Equal(t, tc.wantVolumes, names)
+			assert.Empty(t, PodSecurityViolations(&corev1.PodSpec{Containers: []corev1.Container{c}}, tc.state))
+		})
+	}
+}
+
+func TestHardenPodSpec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    commonv1alpha1.HardeningState
+		expected corev1.PodSpec
+	}{
+		{
+			name:  "enabled leaves the pod spec untouched",
+			state: commonv1alpha1.HardeningStateEnabled,
+		},
+		{
+			name:  "baseline",
+			state: commonv1alpha1.HardeningStateBaseline,
+			expected: corev1.PodSpec{
+				AutomountServiceAccountToken: new(false),
+				SecurityContext: &corev1.PodSecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			},
+		},
+		{
+			name:  "restricted",
+			state: commonv1alpha1.HardeningStateRestricted,
+			expected: corev1.PodSpec{
+				AutomountServiceAccountToken: new(false),
+				SecurityContext: &corev1.PodSecurityContext{
+					RunAsNonRoot:   new(true),
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := corev1.PodSpec{}
+			HardenPodSpec(&spec, tc.state)
+			assert.Equal(t, tc.expected, spec)
+		})
+	}
+}
+
+func TestPodSecurityViolations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     corev1.PodSpec
+		state    commonv1alpha1.HardeningState
+		expected []string
+	}{
+		{
+			name: "violations are not reported when the level doesn't map to a Pod Security Standard",
+			spec: corev1.PodSpec{
+				HostNetwork: true,
+			},
+			state: commonv1alpha1.HardeningStateEnabled,
+		},
+		{
+			name: "empty pod spec complies with restricted",
+			spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "proxy"}},
+			},
+			state: commonv1alpha1.HardeningStateRestricted,
+		},
+		{
+			name: "host namespaces, hostPath volumes and privileged containers violate baseline",
+			spec: corev1.PodSpec{
+				HostNetwork: true,
+				HostPID:     true,
+				Volumes: []corev1.Volume{
+					{
+						Name: "host",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{Path: "/var/run"},
+						},
+					},
+				},
+				Containers: []corev1.Container{
+					{
+						Name:  "proxy",
+						Ports: []corev1.ContainerPort{{ContainerPort: 8000, HostPort: 80}},
+						SecurityContext: &corev1.SecurityContext{
+							Privileged: new(true),
+							Capabilities: &corev1.Capabilities{
+								Add: []corev1.Capability{"NET_ADMIN", "CHOWN"},
+							},
+							SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+						},
+					},
+				},
+			},
+			state: commonv1alpha1.HardeningStateBaseline,
+			expected: []string{
+				"hostNetwork is not allowed",
+				"hostPID is not allowed",
+				`volume "host": hostPath volumes are not allowed`,
+				`container "proxy": hostPort 80 is not allowed`,
+				`container "proxy": privileged is not allowed`,
+				`container "proxy": capability NET_ADMIN is not allowed`,
+				`container "proxy": Unconfined seccomp profile is not allowed`,
+			},
+		},
+		{
+			name: "unsafe sysctls violate baseline",
+			spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{
+					Sysctls: []corev1.Sysctl{
+						{Name: "net.ipv4.tcp_syncookies", Value: "1"},
+						{Name: "net.core.somaxconn", Value: "1024"},
+					},
+				},
+			},
+			state: commonv1alpha1.HardeningStateBaseline,
+			expected: []string{
+				"pod: sysctl net.core.somaxconn is not allowed",
+			},
+		},
+		{
+			name: "root users, privilege escalation and non-restricted volumes violate restricted",
+			spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{
+					RunAsUser: new(int64(0)),
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name: "config",
+						VolumeSource: corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{},
+						},
+					},
+					{
+						Name: "nfs",
+						VolumeSource: corev1.VolumeSource{
+							NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/"},
+						},
+					},
+				},
+				InitContainers: []corev1.Container{
+					{
+						Name: "init",
+						SecurityContext: &corev1.SecurityContext{
+							RunAsNonRoot: new(false),
+						},
+					},
+				},
+				Containers: []corev1.Container{
+					{
+						Name: "proxy",
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: new(true),
+							Capabilities: &corev1.Capabilities{
+								Add: []corev1.Capability{"CHOWN"},
+							},
+						},
+					},
+				},
+			},
+			state: commonv1alpha1.HardeningStateRestricted,
+			expected: []string{
+				"pod: runAsUser must not be 0",
+				`volume "nfs": only configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, projected and secret volumes are allowed`,
+				`container "init": runAsNonRoot must not be false`,
+				`container "proxy": capability CHOWN is not allowed`,
+				`container "proxy": allowPrivilegeEscalation is not allowed`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PodSecurityViolations(&tc.spec, tc.state))
+		})
+	}
+}
+
+func TestPodSecurityCondition(t *testing.T) {
+	t.Run("no condition when the level doesn't map to a Pod Security Standard", func(t *testing.T) {
+		_, ok := PodSecurityCondition(nil, commonv1alpha1.HardeningStateEnabled, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("compliant without PodTemplateSpec", func(t *testing.T) {
+		condition, ok := PodSecurityCondition(nil, commonv1alpha1.HardeningStateBaseline, 2)
+		require.True(t, ok)
+		assert.Equal(t, string(commonv1alpha1.PodSecurityCompliantType), condition.Type)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, string(commonv1alpha1.PodSecurityCompliantReason), condition.Reason)
+		assert.Equal(t, "PodTemplateSpec complies with the baseline Pod Security Standard", condition.Message)
+		assert.Equal(t, int64(2), condition.ObservedGeneration)
+	})
+
+	t.Run("violations are reported in the message", func(t *testing.T) {
+		pts := &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				HostNetwork: true,
+				HostIPC:     true,
+			},
+		}
+		condition, ok := PodSecurityCondition(pts, commonv1alpha1.HardeningStateRestricted, 3)
+		require.True(t, ok)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, string(commonv1alpha1.PodSecurityViolationReason), condition.Reason)
+		assert.Equal(t,
+			"PodTemplateSpec violates the restricted Pod Security Standard: hostNetwork is not allowed; hostIPC is not allowed",
+			condition.Message,
+		)
+	})
+}
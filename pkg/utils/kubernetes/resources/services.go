@@ -3,6 +3,7 @@ package resources
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
@@ -51,6 +52,7 @@ func GenerateNewIngressServiceForDataPlane(dataplane *operatorv1beta1.DataPlane,
 	setDataPlaneIngressServiceExternalTrafficPolicy(dataplane, svc)
 	setDataPlaneIngressServiceTrafficDistribution(dataplane, svc)
 	setDataPlaneIngressServiceInternalTrafficPolicy(dataplane, svc)
+	setDataPlaneIngressServiceAddresses(dataplane, svc)
 	LabelObjectAsDataPlaneManaged(svc)
 
 	for _, opt := range opts {
@@ -116,10 +118,17 @@ func setDataPlaneIngressServiceTrafficDistribution(
 	dataplane *operatorv1beta1.DataPlane,
 	svc *corev1.Service,
 ) {
-	if dataplane == nil ||
-		dataplane.Spec.Network.Services == nil ||
+	if dataplane == nil {
+		return
+	}
+	if dataplane.Spec.Network.Services == nil ||
 		dataplane.Spec.Network.Services.Ingress == nil ||
 		dataplane.Spec.Network.Services.Ingress.TrafficDistribution == nil {
+		// DataPlanes spread across zones prefer routing traffic to the
+		// endpoints in the client's zone, unless told otherwise.
+		if dataplane.Spec.Deployment.Topology != nil {
+			svc.Spec.TrafficDistribution = new(corev1.ServiceTrafficDistributionPreferSameZone)
+		}
 		return
 	}
 	svc.Spec.TrafficDistribution = dataplane.Spec.Network.Services.Ingress.TrafficDistribution
@@ -138,6 +147,26 @@ func setDataPlaneIngressServiceInternalTrafficPolicy(
 	svc.Spec.InternalTrafficPolicy = dataplane.Spec.Network.Services.Ingress.InternalTrafficPolicy
 }
 
+func setDataPlaneIngressServiceAddresses(
+	dataplane *operatorv1beta1.DataPlane,
+	svc *corev1.Service,
+) {
+	if dataplane == nil ||
+		dataplane.Spec.Network.Services == nil ||
+		dataplane.Spec.Network.Services.Ingress == nil {
+		return
+	}
+	ingress := dataplane.Spec.Network.Services.Ingress
+	// LoadBalancerIP is only allowed for LoadBalancer Services, the API server
+	// rejects it for any other Service type.
+	if ingress.LoadBalancerIP != nil && svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		svc.Spec.LoadBalancerIP = *ingress.LoadBalancerIP
+	}
+	if len(ingress.ExternalIPs) > 0 {
+		svc.Spec.ExternalIPs = slices.Clone(ingress.ExternalIPs)
+	}
+}
+
 // ServiceOpt is an option function for a Service.
 type ServiceOpt func(*corev1.Service)
 
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
 )
 
@@ -359,6 +360,86 @@ func TestGenerateNewIngressServiceForDataPlane(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "topology defaults TrafficDistribution to PreferSameZone",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dp-1",
+					Namespace: "default",
+					UID:       types.UID("1234"),
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "gateway.konghq.com/v1beta1",
+					Kind:       "DataPlane",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Deployment: operatorv1beta1.DataPlaneDeploymentOptions{
+							Topology: &commonv1alpha1.DataPlaneTopology{
+								Zones: []commonv1alpha1.DataPlaneTopologyZone{
+									{Name: "zone-a"},
+									{Name: "zone-b"},
+								},
+							},
+						},
+						Network: operatorv1beta1.DataPlaneNetworkOptions{
+							Services: &operatorv1beta1.DataPlaneServices{
+								Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+									ServiceOptions: operatorv1beta1.ServiceOptions{
+										Type: corev1.ServiceTypeLoadBalancer,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "dataplane-ingress-dp-1-",
+					Namespace:    "default",
+					Labels: map[string]string{
+						"app": "dp-1",
+						"gateway-operator.konghq.com/dataplane-service-type": "ingress",
+						"gateway-operator.konghq.com/managed-by":             "dataplane",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "gateway.konghq.com/v1beta1",
+							Kind:       "DataPlane",
+							Name:       "dp-1",
+							UID:        "1234",
+							Controller: new(true),
+						},
+					},
+					Finalizers: []string{
+						"gateway-operator.konghq.com/wait-for-owner",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Protocol:   corev1.ProtocolTCP,
+							Port:       80,
+							TargetPort: intstr.FromInt(8000),
+						},
+						{
+							Name:       "https",
+							Protocol:   corev1.ProtocolTCP,
+							Port:       443,
+							TargetPort: intstr.FromInt(8443),
+						},
+					},
+					Selector: map[string]string{
+						"app": "dp-1",
+					},
+					TrafficDistribution: new("PreferSameZone"),
+				},
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "setting InternalTrafficPolicy to Local",
 			dataplane: &operatorv1beta1.DataPlane{
@@ -432,6 +513,81 @@ func TestGenerateNewIngressServiceForDataPlane(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "setting LoadBalancerIP and ExternalIPs",
+			dataplane: &operatorv1beta1.DataPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dp-1",
+					Namespace: "default",
+					UID:       types.UID("1234"),
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "gateway.konghq.com/v1beta1",
+					Kind:       "DataPlane",
+				},
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: operatorv1beta1.DataPlaneOptions{
+						Network: operatorv1beta1.DataPlaneNetworkOptions{
+							Services: &operatorv1beta1.DataPlaneServices{
+								Ingress: &operatorv1beta1.DataPlaneServiceOptions{
+									ServiceOptions: operatorv1beta1.ServiceOptions{
+										Type: corev1.ServiceTypeLoadBalancer,
+									},
+									LoadBalancerIP: new("203.0.113.10"),
+									ExternalIPs:    []string{"198.51.100.1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "dataplane-ingress-dp-1-",
+					Namespace:    "default",
+					Labels: map[string]string{
+						"app": "dp-1",
+						"gateway-operator.konghq.com/dataplane-service-type": "ingress",
+						"gateway-operator.konghq.com/managed-by":             "dataplane",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "gateway.konghq.com/v1beta1",
+							Kind:       "DataPlane",
+							Name:       "dp-1",
+							UID:        "1234",
+							Controller: new(true),
+						},
+					},
+					Finalizers: []string{
+						"gateway-operator.konghq.com/wait-for-owner",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Protocol:   corev1.ProtocolTCP,
+							Port:       80,
+							TargetPort: intstr.FromInt(8000),
+						},
+						{
+							Name:       "https",
+							Protocol:   corev1.ProtocolTCP,
+							Port:       443,
+							TargetPort: intstr.FromInt(8443),
+						},
+					},
+					Selector: map[string]string{
+						"app": "dp-1",
+					},
+					LoadBalancerIP: "203.0.113.10",
+					ExternalIPs:    []string{"198.51.100.1"},
+				},
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+	k8sutils "github.com/kong/kong-operator/v2/pkg/utils/kubernetes"
+)
+
+// VerticalPodAutoscalerGVK is the GroupVersionKind of the VerticalPodAutoscalers
+// provided by the Kubernetes autoscaler project. Their Go types are not a
+// dependency of the operator, which handles them as unstructured objects.
+var VerticalPodAutoscalerGVK = schema.GroupVersionKind{
+	Group:   "autoscaling.k8s.io",
+	Version: "v1",
+	Kind:    "VerticalPodAutoscaler",
+}
+
+// GenerateVPAForDataPlane generates a VPA for the given DataPlane.
+// The provided deploymentName is the name of the Deployment that the VPA
+// will target using its TargetRef. The VPA only recommends resources for
+// the proxy container and never evicts the DataPlane's Pods.
+func GenerateVPAForDataPlane(dataplane *operatorv1beta1.DataPlane, deploymentName string) (
+	*unstructured.Unstructured, error,
+) {
+	if scaling := dataplane.Spec.Deployment.Scaling; scaling == nil || scaling.VerticalScaling == nil {
+		return nil, fmt.Errorf("cannot generate VPA for DataPlane %s which doesn't have vertical autoscaling turned on", dataplane.Name)
+	}
+	verticalScaling := dataplane.Spec.Deployment.Scaling.VerticalScaling
+
+	labels := GetManagedLabelForOwner(dataplane)
+	labels["app"] = dataplane.Name
+
+	proxyPolicy := map[string]any{
+		"containerName":       consts.DataPlaneProxyContainerName,
+		"controlledResources": []any{string(corev1.ResourceCPU), string(corev1.ResourceMemory)},
+	}
+	if len(verticalScaling.MinAllowed) > 0 {
+		proxyPolicy["minAllowed"] = resourceListToUnstructured(verticalScaling.MinAllowed)
+	}
+	if len(verticalScaling.MaxAllowed) > 0 {
+		proxyPolicy["maxAllowed"] = resourceListToUnstructured(verticalScaling.MaxAllowed)
+	}
+
+	vpa := &unstructured.Unstructured{}
+	vpa.SetGroupVersionKind(VerticalPodAutoscalerGVK)
+	vpa.SetName(dataplane.Name)
+	vpa.SetNamespace(dataplane.Namespace)
+	vpa.SetLabels(labels)
+	vpa.Object["spec"] = map[string]any{
+		"targetRef": map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       deploymentName,
+		},
+		// The operator applies the recommendations itself, if at all.
+		"updatePolicy": map[string]any{
+			"updateMode": "Off",
+		},
+		"resourcePolicy": map[string]any{
+			"containerPolicies": []any{
+				proxyPolicy,
+				map[string]any{
+					"containerName": "*",
+					"mode":          "Off",
+				},
+			},
+		},
+	}
+
+	k8sutils.SetOwnerForObject(vpa, dataplane)
+
+	return vpa, nil
+}
+
+// VPARecommendation contains the resources recommended by a VPA for a container.
+type VPARecommendation struct {
+	Target     corev1.ResourceList
+	LowerBound corev1.ResourceList
+	UpperBound corev1.ResourceList
+}
+
+// GetVPARecommendationForContainer returns the resources recommended by the provided
+// VPA for the container with the provided name. It returns nil if the VPA has no
+// recommendation for the container yet.
+func GetVPARecommendationForContainer(vpa *unstructured.Unstructured, containerName string) (*VPARecommendation, error) {
+	recommendations, _, err := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+	if err != nil {
+		return nil, fmt.Errorf("failed reading recommendations of VPA %s/%s: %w", vpa.GetNamespace(), vpa.GetName(), err)
+	}
+
+	for _, r := range recommendations {
+		recommendation, ok := r.(map[string]any)
+		if !ok || recommendation["containerName"] != containerName {
+			continue
+		}
+
+		var result VPARecommendation
+		for field, list := range map[string]*corev1.ResourceList{
+			"target":     &result.Target,
+			"lowerBound": &result.LowerBound,
+			"upperBound": &result.UpperBound,
+		} {
+			if *list, err = resourceListFromUnstructured(recommendation, field); err != nil {
+				return nil, fmt.Errorf("failed reading recommendation of VPA %s/%s for container %s: %w",
+					vpa.GetNamespace(), vpa.GetName(), containerName, err)
+			}
+		}
+		return &result, nil
+	}
+
+	return nil, nil
+}
+
+func resourceListToUnstructured(list corev1.ResourceList) map[string]any {
+	out := make(map[string]any, len(list))
+	for name, quantity := range list {
+		out[string(name)] = quantity.String()
+	}
+	return out
+}
+
+func resourceListFromUnstructured(obj map[string]any, field string) (corev1.ResourceList, error) {
+	values, found, err := unstructured.NestedStringMap(obj, field)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	list := make(corev1.ResourceList, len(values))
+	for name, value := range values {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s quantity %q for %s: %w", field, value, name, err)
+		}
+		list[corev1.ResourceName(name)] = quantity
+	}
+	return list, nil
+}
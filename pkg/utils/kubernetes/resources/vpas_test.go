@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	operatorv1beta1 "github.com/kong/kong-operator/v2/api/gateway-operator/v1beta1"
+	"github.com/kong/kong-operator/v2/pkg/consts"
+)
+
+func TestGenerateVPAForDataPlane(t *testing.T) {
+	dataplane := &operatorv1beta1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dp",
+			Namespace: "default",
+			UID:       "dp-uid",
+		},
+	}
+
+	t.Run("without vertical scaling", func(t *testing.T) {
+		_, err := GenerateVPAForDataPlane(dataplane, "dp-deployment")
+		require.Error(t, err)
+	})
+
+	t.Run("with vertical scaling", func(t *testing.T) {
+		dp := dataplane.DeepCopy()
+		dp.Spec.Deployment.Scaling = &operatorv1beta1.Scaling{
+			VerticalScaling: &operatorv1beta1.VerticalScaling{
+				Mode: operatorv1beta1.VerticalScalingModeApplyOnRollout,
+				MaxAllowed: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		}
+
+		vpa, err := GenerateVPAForDataPlane(dp, "dp-deployment")
+		require.NoError(t, err)
+		assert.Equal(t, VerticalPodAutoscalerGVK, vpa.GroupVersionKind())
+		assert.Equal(t, "dp", vpa.GetName())
+		assert.Equal(t, "default", vpa.GetNamespace())
+		require.Len(t, vpa.GetOwnerReferences(), 1)
+		assert.Equal(t, dp.UID, vpa.GetOwnerReferences()[0].UID)
+
+		targetName, _, err := unstructured.NestedString(vpa.Object, "spec", "targetRef", "name")
+		require.NoError(t, err)
+		assert.Equal(t, "dp-deployment", targetName)
+
+		updateMode, _, err := unstructured.NestedString(vpa.Object, "spec", "updatePolicy", "updateMode")
+		require.NoError(t, err)
+		assert.Equal(t, "Off", updateMode, "the VPA must never evict the DataPlane's Pods")
+
+		policies, _, err := unstructured.NestedSlice(vpa.Object, "spec", "resourcePolicy", "containerPolicies")
+		require.NoError(t, err)
+		require.Len(t, policies, 2)
+		proxyPolicy := policies[0].(map[string]any)
+		assert.Equal(t, consts.DataPlaneProxyContainerName, proxyPolicy["containerName"])
+		assert.Equal(t, map[string]any{"memory": "1Gi"}, proxyPolicy["maxAllowed"])
+		assert.NotContains(t, proxyPolicy, "minAllowed")
+	})
+}
+
+func TestGetVPARecommendationForContainer(t *testing.T) {
+	vpa := &unstructured.Unstructured{Object: map[string]any{}}
+	vpa.SetGroupVersionKind(VerticalPodAutoscalerGVK)
+
+	t.Run("without recommendation", func(t *testing.T) {
+		recommendation, err := GetVPARecommendationForContainer(vpa, consts.DataPlaneProxyContainerName)
+		require.NoError(t, err)
+		assert.Nil(t, recommendation)
+	})
+
+	t.Run("with recommendation", func(t *testing.T) {
+		vpa := vpa.DeepCopy()
+		require.NoError(t, unstructured.SetNestedSlice(vpa.Object, []any{
+			map[string]any{
+				"containerName": "sidecar",
+				"target":        map[string]any{"cpu": "1"},
+			},
+			map[string]any{
+				"containerName": consts.DataPlaneProxyContainerName,
+				"target":        map[string]any{"cpu": "250m", "memory": "262144k"},
+				"lowerBound":    map[string]any{"cpu": "100m"},
+			},
+		}, "status", "recommendation", "containerRecommendations"))
+
+		recommendation, err := GetVPARecommendationForContainer(vpa, consts.DataPlaneProxyContainerName)
+		require.NoError(t, err)
+		require.NotNil(t, recommendation)
+		assert.True(t, resource.MustParse("250m").Equal(recommendation.Target[corev1.ResourceCPU]))
+		assert.True(t, resource.MustParse("262144k").Equal(recommendation.Target[corev1.ResourceMemory]))
+		assert.True(t, resource.MustParse("100m").Equal(recommendation.LowerBound[corev1.ResourceCPU]))
+		assert.Nil(t, recommendation.UpperBound)
+	})
+
+	t.Run("with invalid quantity", func(t *testing.T) {
+		vpa := vpa.DeepCopy()
+		require.NoError(t, unstructured.SetNestedSlice(vpa.Object, []any{
+			map[string]any{
+				"containerName": consts.DataPlaneProxyContainerName,
+				"target":        map[string]any{"cpu": "a lot"},
+			},
+		}, "status", "recommendation", "containerRecommendations"))
+
+		_, err := GetVPARecommendationForContainer(vpa, consts.DataPlaneProxyContainerName)
+		require.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	kcfgconsts "github.com/kong/kong-operator/v2/api/common/consts"
+	commonv1alpha1 "github.com/kong/kong-operator/v2/api/common/v1alpha1"
 	kcfgdataplane "github.com/kong/kong-operator/v2/api/gateway-operator/dataplane"
 )
 
@@ -263,6 +264,10 @@ func AreAllConditionsHaveTrueStatus(resource ConditionsAware) bool {
 		switch condition.Type {
 		case string(kcfgdataplane.ReadyType), string(gatewayv1.GatewayConditionProgrammed):
 			continue
+		// PodSecurityCompliant is informational, Pods violating the Pod Security
+		// Standard of the hardening level still serve traffic.
+		case string(commonv1alpha1.PodSecurityCompliantType):
+			continue
 		default:
 			if condition.Status != metav1.ConditionTrue {
 				return false
@@ -126,6 +126,16 @@ var supportedKonnectTypesWithControlPlaneConfig = []supportedTypesT{
 				KonnectStatusType:          "*konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs",
 				GetKonnectStatusReturnType: "*konnectv1alpha2.KonnectEntityStatus",
 			},
+			{
+				Type:                       "KongCredentialOAuth2",
+				KonnectStatusType:          "*konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs",
+				GetKonnectStatusReturnType: "*konnectv1alpha2.KonnectEntityStatus",
+			},
+			{
+				Type:                       "KongCredentialMTLS",
+				KonnectStatusType:          "*konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs",
+				GetKonnectStatusReturnType: "*konnectv1alpha2.KonnectEntityStatus",
+			},
 			{
 				Type:                       "KongCACertificate",
 				KonnectStatusType:          "*konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef",
@@ -190,6 +200,12 @@ var supportedKonnectTypesWithControlPlaneConfig = []supportedTypesT{
 				GetKonnectStatusReturnType: "*konnectv1alpha2.KonnectEntityStatus",
 				ControlPlaneRefType:        "commonv1alpha1.ControlPlaneRef",
 			},
+			{
+				Type:                       "KongCustomEntity",
+				KonnectStatusType:          "*konnectv1alpha2.KonnectEntityStatusWithControlPlaneRef",
+				GetKonnectStatusReturnType: "*konnectv1alpha2.KonnectEntityStatus",
+				ControlPlaneRefType:        "commonv1alpha1.ControlPlaneRef",
+			},
 		},
 	},
 }
@@ -357,6 +373,12 @@ var supportedConfigurationPackageTypesWithAdopt = []supportedTypesT{
 			{
 				Type: "KongCredentialHMAC",
 			},
+			{
+				Type: "KongCredentialOAuth2",
+			},
+			{
+				Type: "KongCredentialMTLS",
+			},
 			{
 				Type: "KongCACertificate",
 			},
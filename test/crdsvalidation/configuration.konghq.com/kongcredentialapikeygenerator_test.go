@@ -0,0 +1,82 @@
+package configuration_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/crdsvalidation/common"
+	"github.com/kong/kong-operator/v2/test/envtest"
+)
+
+func TestKongCredentialAPIKeyGenerator(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	scheme := scheme.Get()
+	cfg, ns := envtest.Setup(t, ctx, scheme)
+
+	generatorWithRotation := func(rotation *configurationv1alpha1.KongCredentialAPIKeyRotation) *configurationv1alpha1.KongCredentialAPIKeyGenerator {
+		return &configurationv1alpha1.KongCredentialAPIKeyGenerator{
+			ObjectMeta: common.CommonObjectMeta(ns.Name),
+			Spec: configurationv1alpha1.KongCredentialAPIKeyGeneratorSpec{
+				ConsumerRef: corev1.LocalObjectReference{
+					Name: "test-kong-consumer",
+				},
+				Rotation: rotation,
+			},
+		}
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongCredentialAPIKeyGenerator]{
+			{
+				Name:       "no rotation is allowed",
+				TestObject: generatorWithRotation(nil),
+			},
+			{
+				Name: "interval of 1m is allowed",
+				TestObject: generatorWithRotation(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+					Interval: metav1.Duration{Duration: time.Minute},
+					Overlap:  &metav1.Duration{Duration: 30 * time.Second},
+				}),
+			},
+			{
+				Name: "interval shorter than 1m is not allowed",
+				TestObject: generatorWithRotation(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+					Interval: metav1.Duration{Duration: 59 * time.Second},
+					Overlap:  &metav1.Duration{Duration: 30 * time.Second},
+				}),
+				ExpectedErrorMessage: new("interval must be at least 1m"),
+			},
+			{
+				Name:                 "zero interval is not allowed",
+				TestObject:           generatorWithRotation(&configurationv1alpha1.KongCredentialAPIKeyRotation{}),
+				ExpectedErrorMessage: new("interval must be at least 1m"),
+			},
+			{
+				Name: "overlap not shorter than interval is not allowed",
+				TestObject: generatorWithRotation(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+					Interval: metav1.Duration{Duration: time.Hour},
+					Overlap:  &metav1.Duration{Duration: time.Hour},
+				}),
+				ExpectedErrorMessage: new("overlap must be shorter than interval"),
+			},
+			{
+				Name: "interval can't be updated below 1m",
+				TestObject: generatorWithRotation(&configurationv1alpha1.KongCredentialAPIKeyRotation{
+					Interval: metav1.Duration{Duration: 24 * time.Hour},
+				}),
+				Update: func(g *configurationv1alpha1.KongCredentialAPIKeyGenerator) {
+					g.Spec.Rotation.Interval = metav1.Duration{Duration: 10 * time.Second}
+				},
+				ExpectedUpdateErrorMessage: new("interval must be at least 1m"),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+}
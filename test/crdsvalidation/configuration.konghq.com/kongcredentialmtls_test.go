@@ -0,0 +1,89 @@
+package configuration_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/crdsvalidation/common"
+	"github.com/kong/kong-operator/v2/test/envtest"
+)
+
+func TestKongCredentialMTLS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	scheme := scheme.Get()
+	cfg, ns := envtest.Setup(t, ctx, scheme)
+
+	t.Run("updates not allowed for status conditions", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongCredentialMTLS]{
+			{
+				Name: "consumerRef change is not allowed for Programmed=True",
+				TestObject: &configurationv1alpha1.KongCredentialMTLS{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialMTLSSpec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialMTLSAPISpec: configurationv1alpha1.KongCredentialMTLSAPISpec{
+							SubjectName: "client.example.com",
+						},
+					},
+					Status: configurationv1alpha1.KongCredentialMTLSStatus{
+						Konnect: &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs{},
+						Conditions: []metav1.Condition{
+							{
+								Type:               "Programmed",
+								Status:             metav1.ConditionTrue,
+								Reason:             "Valid",
+								LastTransitionTime: metav1.Now(),
+							},
+						},
+					},
+				},
+				Update: func(c *configurationv1alpha1.KongCredentialMTLS) {
+					c.Spec.ConsumerRef.Name = "new-consumer"
+				},
+				ExpectedUpdateErrorMessage: new("spec.consumerRef is immutable when an entity is already Programmed"),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+
+	t.Run("fields validation", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongCredentialMTLS]{
+			{
+				Name: "subject_name is required",
+				TestObject: &configurationv1alpha1.KongCredentialMTLS{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialMTLSSpec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+					},
+				},
+				ExpectedErrorMessage: new("spec.subject_name in body should be at least 1 chars long"),
+			},
+			{
+				Name: "subject_name is set",
+				TestObject: &configurationv1alpha1.KongCredentialMTLS{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialMTLSSpec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialMTLSAPISpec: configurationv1alpha1.KongCredentialMTLSAPISpec{
+							SubjectName: "client.example.com",
+						},
+					},
+				},
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+}
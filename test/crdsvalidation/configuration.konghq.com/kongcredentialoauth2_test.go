@@ -0,0 +1,194 @@
+package configuration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configurationv1alpha1 "github.com/kong/kong-operator/v2/api/configuration/v1alpha1"
+	konnectv1alpha2 "github.com/kong/kong-operator/v2/api/konnect/v1alpha2"
+	"github.com/kong/kong-operator/v2/modules/manager/scheme"
+	"github.com/kong/kong-operator/v2/test/crdsvalidation/common"
+	"github.com/kong/kong-operator/v2/test/envtest"
+)
+
+func TestKongCredentialOAuth2(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	scheme := scheme.Get()
+	cfg, ns := envtest.Setup(t, ctx, scheme)
+
+	inlineClientSecret := &configurationv1alpha1.SensitiveDataSource{
+		Type:  configurationv1alpha1.SensitiveDataSourceTypeInline,
+		Value: new("client-secret"),
+	}
+
+	t.Run("updates not allowed for status conditions", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongCredentialOAuth2]{
+			{
+				Name: "consumerRef change is not allowed for Programmed=True",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:         "app",
+							ClientID:     "client-id",
+							ClientSecret: inlineClientSecret,
+						},
+					},
+					Status: configurationv1alpha1.KongCredentialOAuth2Status{
+						Konnect: &konnectv1alpha2.KonnectEntityStatusWithControlPlaneAndConsumerRefs{},
+						Conditions: []metav1.Condition{
+							{
+								Type:               "Programmed",
+								Status:             metav1.ConditionTrue,
+								Reason:             "Valid",
+								LastTransitionTime: metav1.Now(),
+							},
+						},
+					},
+				},
+				Update: func(c *configurationv1alpha1.KongCredentialOAuth2) {
+					c.Spec.ConsumerRef.Name = "new-consumer"
+				},
+				ExpectedUpdateErrorMessage: new("spec.consumerRef is immutable when an entity is already Programmed"),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+
+	t.Run("fields validation", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongCredentialOAuth2]{
+			{
+				Name: "name is required",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+					},
+				},
+				ExpectedErrorMessage: new("spec.name in body should be at least 1 chars long"),
+			},
+			{
+				Name: "client_id is required",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:         "app",
+							ClientSecret: inlineClientSecret,
+						},
+					},
+				},
+				ExpectedErrorMessage: new("spec.client_id in body should be at least 1 chars long"),
+			},
+			{
+				Name: "client_secret is required",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:     "app",
+							ClientID: "client-id",
+						},
+					},
+				},
+				ExpectedErrorMessage: new("spec.client_secret: Required value"),
+			},
+			{
+				Name: "client_type defaults to confidential",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:         "app",
+							ClientID:     "client-id",
+							ClientSecret: inlineClientSecret,
+						},
+					},
+				},
+				Assert: func(t *testing.T, c *configurationv1alpha1.KongCredentialOAuth2) {
+					require.Equal(t, configurationv1alpha1.KongCredentialOAuth2ClientTypeConfidential, c.Spec.ClientType)
+				},
+			},
+			{
+				Name: "client_type must be confidential or public",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:         "app",
+							ClientID:     "client-id",
+							ClientSecret: inlineClientSecret,
+							ClientType:   "other",
+						},
+					},
+				},
+				ExpectedErrorMessage: new(`spec.client_type: Unsupported value: "other"`),
+			},
+			{
+				Name: "client_secret can be sourced from a Secret",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:     "app",
+							ClientID: "client-id",
+							ClientSecret: &configurationv1alpha1.SensitiveDataSource{
+								Type: configurationv1alpha1.SensitiveDataSourceTypeSecretRef,
+								SecretRef: &configurationv1alpha1.SensitiveDataSecretRef{
+									Name: "oauth2-secret",
+									Key:  "client_secret",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "client_secret of type secretRef requires secretRef",
+				TestObject: &configurationv1alpha1.KongCredentialOAuth2{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongCredentialOAuth2Spec{
+						ConsumerRef: corev1.LocalObjectReference{
+							Name: "test-kong-consumer",
+						},
+						KongCredentialOAuth2APISpec: configurationv1alpha1.KongCredentialOAuth2APISpec{
+							Name:     "app",
+							ClientID: "client-id",
+							ClientSecret: &configurationv1alpha1.SensitiveDataSource{
+								Type:  configurationv1alpha1.SensitiveDataSourceTypeSecretRef,
+								Value: new("secret"),
+							},
+						},
+					},
+				},
+				ExpectedErrorMessage: new("value required when type=inline; secretRef required when type=secretRef"),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+}
@@ -73,6 +73,57 @@ func TestKongReferenceGrant(t *testing.T) {
 		}.RunWithConfig(t, cfg, scheme)
 	})
 
+	t.Run("APISpecification to ConfigMap reference", func(t *testing.T) {
+		common.TestCasesGroup[*configurationv1alpha1.KongReferenceGrant]{
+			{
+				Name: "ConfigMap works",
+				TestObject: &configurationv1alpha1.KongReferenceGrant{
+					TypeMeta:   typeMeta,
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongReferenceGrantSpec{
+						From: []configurationv1alpha1.ReferenceGrantFrom{
+							{
+								Namespace: configurationv1alpha1.Namespace("other"),
+								Kind:      "APISpecification",
+								Group:     "konnect.konghq.com",
+							},
+						},
+						To: []configurationv1alpha1.ReferenceGrantTo{
+							{
+								Group: "core",
+								Kind:  "ConfigMap",
+								Name:  new(configurationv1alpha1.ObjectName("api-spec")),
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "other core kinds are rejected",
+				TestObject: &configurationv1alpha1.KongReferenceGrant{
+					TypeMeta:   typeMeta,
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: configurationv1alpha1.KongReferenceGrantSpec{
+						From: []configurationv1alpha1.ReferenceGrantFrom{
+							{
+								Namespace: configurationv1alpha1.Namespace("other"),
+								Kind:      "APISpecification",
+								Group:     "konnect.konghq.com",
+							},
+						},
+						To: []configurationv1alpha1.ReferenceGrantTo{
+							{
+								Group: "core",
+								Kind:  "Service",
+							},
+						},
+					},
+				},
+				ExpectedErrorMessage: new("Only 'Secret' and 'ConfigMap' kinds are supported for 'core' group"),
+			},
+		}.RunWithConfig(t, cfg, scheme)
+	})
+
 	t.Run("KongRoute to KonnectGatewayControlPlane reference", func(t *testing.T) {
 		common.TestCasesGroup[*configurationv1alpha1.KongReferenceGrant]{
 			{
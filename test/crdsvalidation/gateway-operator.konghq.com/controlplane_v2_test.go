@@ -210,6 +210,215 @@ func TestControlPlaneV2(t *testing.T) {
 				},
 				ExpectedErrorMessage: new("Ref cannot be provided when type is set to managedByOwner"),
 			},
+			{
+				Name: "partitions with namespaces or selector pass",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "team-a",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Namespaces: []string{"team-a"},
+								},
+								{
+									Name: "external",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-3",
+									},
+									Selector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"exposure": "external"},
+									},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+			},
+			{
+				Name: "partitions cannot be set when type is managedByOwner",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetManagedByType,
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "team-a",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Namespaces: []string{"team-a"},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+				ExpectedErrorMessage: new("Partitions can only be provided when type is set to ref"),
+			},
+			{
+				Name: "partition cannot reference the DataPlane referenced by ref",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "team-a",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-1",
+									},
+									Namespaces: []string{"team-a"},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+				ExpectedErrorMessage: new("Partitions cannot reference the DataPlane referenced by ref"),
+			},
+			{
+				Name: "partitions cannot share a DataPlane",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "team-a",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Namespaces: []string{"team-a"},
+								},
+								{
+									Name: "team-b",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Namespaces: []string{"team-b"},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+				ExpectedErrorMessage: new("DataPlanes of partitions must be unique"),
+			},
+			{
+				Name: "partition with Gateway listeners is valid",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "external",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Listeners: []operatorv2beta1.ControlPlaneDataPlanePartitionListener{
+										{
+											GatewayName:      "gateway",
+											GatewayNamespace: "kong",
+											SectionName:      new("external"),
+										},
+									},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+			},
+			{
+				Name: "partition listener requires Gateway namespace",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "external",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+									Listeners: []operatorv2beta1.ControlPlaneDataPlanePartitionListener{
+										{
+											GatewayName: "gateway",
+										},
+									},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+				ExpectedErrorMessage: new("spec.dataplane.partitions[0].listeners[0].gatewayNamespace in body should be at least 1 chars long"),
+			},
+			{
+				Name: "partition requires namespaces, selector or listeners",
+				TestObject: &operatorv2beta1.ControlPlane{
+					ObjectMeta: common.CommonObjectMeta(ns.Name),
+					Spec: operatorv2beta1.ControlPlaneSpec{
+						DataPlane: operatorv2beta1.ControlPlaneDataPlaneTarget{
+							Type: operatorv2beta1.ControlPlaneDataPlaneTargetRefType,
+							Ref: &operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+								Name: "dataplane-1",
+							},
+							Partitions: []operatorv2beta1.ControlPlaneDataPlanePartition{
+								{
+									Name: "team-a",
+									Ref: operatorv2beta1.ControlPlaneDataPlaneTargetRef{
+										Name: "dataplane-2",
+									},
+								},
+							},
+						},
+						ControlPlaneOptions: operatorv2beta1.ControlPlaneOptions{
+							IngressClass: new("kong"),
+						},
+					},
+				},
+				ExpectedErrorMessage: new("At least one of namespaces, selector or listeners has to be provided"),
+			},
 		}.
 			RunWithConfig(t, cfg, scheme)
 	})
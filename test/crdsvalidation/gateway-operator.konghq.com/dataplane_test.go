@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -1074,6 +1075,280 @@ func TestDataplane(t *testing.T) {
 		}.
 			RunWithConfig(t, cfg, scheme)
 	})
+
+	t.Run("workload type", func(t *testing.T) {
+		dataPlaneWithDeployment := func(mutate func(*operatorv1beta1.DataPlaneDeploymentOptions)) *operatorv1beta1.DataPlane {
+			options := *validDataplaneOptions.DeepCopy()
+			mutate(&options.Deployment)
+			return &operatorv1beta1.DataPlane{
+				ObjectMeta: common.CommonObjectMeta(ns.Name),
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: options,
+				},
+			}
+		}
+
+		common.TestCasesGroup[*operatorv1beta1.DataPlane]{
+			{
+				Name: "DaemonSet with host network",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.HostBinding = commonv1alpha1.HostBindingHostNetwork
+				}),
+			},
+			{
+				Name: "DaemonSet with replicas is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.Replicas = new(int32(2))
+				}),
+				ExpectedErrorMessage: new("Using replicas or scaling is not allowed when workloadType is DaemonSet."),
+			},
+			{
+				Name: "DaemonSet with scaling is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.Scaling = &operatorv1beta1.Scaling{
+						HorizontalScaling: &operatorv1beta1.HorizontalScaling{
+							MaxReplicas: 5,
+						},
+					}
+				}),
+				ExpectedErrorMessage: new("Using replicas or scaling is not allowed when workloadType is DaemonSet."),
+			},
+			{
+				Name: "DaemonSet with rollout is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.Rollout = &operatorv1beta1.Rollout{
+						Strategy: operatorv1beta1.RolloutStrategy{
+							BlueGreen: &operatorv1beta1.BlueGreenStrategy{
+								Promotion: operatorv1beta1.Promotion{
+									Strategy: operatorv1beta1.BreakBeforePromotion,
+								},
+							},
+						},
+					}
+				}),
+				ExpectedErrorMessage: new("Using rollout is not allowed when workloadType is DaemonSet."),
+			},
+			{
+				Name: "host port with Deployment is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.HostBinding = commonv1alpha1.HostBindingHostPort
+				}),
+				ExpectedErrorMessage: new("hostBinding can only be set when workloadType is DaemonSet."),
+			},
+			{
+				Name: "host network with enabled hardening is allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.HostBinding = commonv1alpha1.HostBindingHostNetwork
+					d.Hardened = commonv1alpha1.HardeningStateEnabled
+				}),
+			},
+			{
+				Name: "host network with baseline hardening is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.HostBinding = commonv1alpha1.HostBindingHostNetwork
+					d.Hardened = commonv1alpha1.HardeningStateBaseline
+				}),
+				ExpectedErrorMessage: new("hostBinding cannot be set when hardened is baseline or restricted."),
+			},
+			{
+				Name: "host port with restricted hardening is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.HostBinding = commonv1alpha1.HostBindingHostPort
+					d.Hardened = commonv1alpha1.HardeningStateRestricted
+				}),
+				ExpectedErrorMessage: new("hostBinding cannot be set when hardened is baseline or restricted."),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+
+	t.Run("topology", func(t *testing.T) {
+		dataPlaneWithDeployment := func(mutate func(*operatorv1beta1.DataPlaneDeploymentOptions)) *operatorv1beta1.DataPlane {
+			options := *validDataplaneOptions.DeepCopy()
+			mutate(&options.Deployment)
+			return &operatorv1beta1.DataPlane{
+				ObjectMeta: common.CommonObjectMeta(ns.Name),
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: options,
+				},
+			}
+		}
+		topology := func(replicas ...*int32) *commonv1alpha1.DataPlaneTopology {
+			topo := &commonv1alpha1.DataPlaneTopology{}
+			for i, r := range replicas {
+				topo.Zones = append(topo.Zones, commonv1alpha1.DataPlaneTopologyZone{
+					Name:     fmt.Sprintf("zone-%d", i),
+					Replicas: r,
+				})
+			}
+			return topo
+		}
+
+		common.TestCasesGroup[*operatorv1beta1.DataPlane]{
+			{
+				Name: "zones with replicas",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = topology(new(int32(2)), new(int32(3)))
+				}),
+			},
+			{
+				Name: "zones with scaling",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = topology(nil, nil)
+					d.Scaling = &operatorv1beta1.Scaling{
+						HorizontalScaling: &operatorv1beta1.HorizontalScaling{
+							MaxReplicas: 5,
+						},
+					}
+				}),
+			},
+			{
+				Name: "no zones is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = &commonv1alpha1.DataPlaneTopology{
+						Zones: []commonv1alpha1.DataPlaneTopologyZone{},
+					}
+				}),
+				ExpectedErrorMessage: new("spec.deployment.topology.zones in body should have at least 1 items"),
+			},
+			{
+				Name: "replicas with topology is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = topology(nil)
+					d.Replicas = new(int32(2))
+				}),
+				ExpectedErrorMessage: new("Using replicas is not allowed when topology is set, set the zones' replicas instead."),
+			},
+			{
+				Name: "zones' replicas with scaling is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = topology(nil, new(int32(2)))
+					d.Scaling = &operatorv1beta1.Scaling{
+						HorizontalScaling: &operatorv1beta1.HorizontalScaling{
+							MaxReplicas: 5,
+						},
+					}
+				}),
+				ExpectedErrorMessage: new("Using zones' replicas is not allowed when scaling is set."),
+			},
+			{
+				Name: "topology with DaemonSet is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = topology(nil)
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+				}),
+				ExpectedErrorMessage: new("Using topology is not allowed with rollout or when workloadType is DaemonSet."),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
+
+	t.Run("vertical scaling", func(t *testing.T) {
+		dataPlaneWithDeployment := func(mutate func(*operatorv1beta1.DataPlaneDeploymentOptions)) *operatorv1beta1.DataPlane {
+			options := *validDataplaneOptions.DeepCopy()
+			mutate(&options.Deployment)
+			return &operatorv1beta1.DataPlane{
+				ObjectMeta: common.CommonObjectMeta(ns.Name),
+				Spec: operatorv1beta1.DataPlaneSpec{
+					DataPlaneOptions: options,
+				},
+			}
+		}
+
+		common.TestCasesGroup[*operatorv1beta1.DataPlane]{
+			{
+				Name: "vertical scaling with bounds",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Scaling = &operatorv1beta1.Scaling{
+						VerticalScaling: &operatorv1beta1.VerticalScaling{
+							Mode: operatorv1beta1.VerticalScalingModeApplyOnRollout,
+							MinAllowed: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("100m"),
+							},
+							MaxAllowed: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("2"),
+								corev1.ResourceMemory: resource.MustParse("2Gi"),
+							},
+						},
+					}
+				}),
+			},
+			{
+				Name: "vertical scaling with replicas",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Replicas = new(int32(3))
+					d.Scaling = &operatorv1beta1.Scaling{
+						VerticalScaling: &operatorv1beta1.VerticalScaling{},
+					}
+				}),
+			},
+			{
+				Name: "vertical scaling with horizontal scaling",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Scaling = &operatorv1beta1.Scaling{
+						HorizontalScaling: &operatorv1beta1.HorizontalScaling{
+							MaxReplicas: 5,
+						},
+						VerticalScaling: &operatorv1beta1.VerticalScaling{},
+					}
+				}),
+			},
+			{
+				Name: "horizontal scaling with replicas is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Replicas = new(int32(3))
+					d.Scaling = &operatorv1beta1.Scaling{
+						HorizontalScaling: &operatorv1beta1.HorizontalScaling{
+							MaxReplicas: 5,
+						},
+						VerticalScaling: &operatorv1beta1.VerticalScaling{},
+					}
+				}),
+				ExpectedErrorMessage: new("Using both replicas and scaling fields is not allowed."),
+			},
+			{
+				Name: "unknown mode is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Scaling = &operatorv1beta1.Scaling{
+						VerticalScaling: &operatorv1beta1.VerticalScaling{
+							Mode: "Auto",
+						},
+					}
+				}),
+				ExpectedErrorMessage: new("spec.deployment.scaling.vertical.mode: Unsupported value: \"Auto\": supported values: \"RecommendOnly\", \"ApplyOnRollout\""),
+			},
+			{
+				Name: "vertical scaling with topology is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.Topology = &commonv1alpha1.DataPlaneTopology{
+						Zones: []commonv1alpha1.DataPlaneTopologyZone{{Name: "zone-a"}},
+					}
+					d.Scaling = &operatorv1beta1.Scaling{
+						VerticalScaling: &operatorv1beta1.VerticalScaling{},
+					}
+				}),
+				ExpectedErrorMessage: new("Using vertical scaling is not allowed when topology is set."),
+			},
+			{
+				Name: "vertical scaling with DaemonSet is not allowed",
+				TestObject: dataPlaneWithDeployment(func(d *operatorv1beta1.DataPlaneDeploymentOptions) {
+					d.WorkloadType = commonv1alpha1.WorkloadTypeDaemonSet
+					d.Scaling = &operatorv1beta1.Scaling{
+						VerticalScaling: &operatorv1beta1.VerticalScaling{},
+					}
+				}),
+				ExpectedErrorMessage: new("Using replicas or scaling is not allowed when workloadType is DaemonSet."),
+			},
+		}.
+			RunWithConfig(t, cfg, scheme)
+	})
 }
 
 func generatePorts(n int32) []operatorv1beta1.DataPlaneServicePort {
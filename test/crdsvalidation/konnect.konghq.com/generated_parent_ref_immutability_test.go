@@ -96,4 +96,21 @@ func TestGeneratedParentRefImmutability(t *testing.T) {
 
 		common.NewCRDValidationTestCasesGroupParentRefChange(t, cfg, obj).RunWithConfig(t, cfg, scheme)
 	})
+
+	t.Run("APISpecification", func(t *testing.T) {
+		obj := &konnectv1alpha1.APISpecification{
+			ObjectMeta: common.CommonObjectMeta(ns.Name),
+			Spec: konnectv1alpha1.APISpecificationSpec{
+				APIRef: generatedParentRef(),
+				APISpec: konnectv1alpha1.APISpecificationAPISpec{
+					Content: konnectv1alpha1.ConfigMapDataSource{
+						Type:  konnectv1alpha1.ConfigMapDataSourceTypeInline,
+						Value: new("openapi: 3.0.3"),
+					},
+				},
+			},
+		}
+
+		common.NewCRDValidationTestCasesGroupParentRefChange(t, cfg, obj).RunWithConfig(t, cfg, scheme)
+	})
 }
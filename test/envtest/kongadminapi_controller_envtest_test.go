@@ -49,6 +49,10 @@ func (n *notifier) LastNotified() []adminapi.DiscoveredAdminAPI {
 
 // startKongAdminAPIServiceReconciler starts KongAdminAPIServiceReconciler with
 // the manager in a separate goroutine.
+// partitionAdminServiceName is the name of the Admin API Service of the "internal" configuration partition watched by
+// the reconciler started with startKongAdminAPIServiceReconciler.
+const partitionAdminServiceName = "kong-admin-internal"
+
 func startKongAdminAPIServiceReconciler(ctx context.Context, t *testing.T, client ctrlclient.Client, cfg *rest.Config) (
 	adminService corev1.Service,
 	adminPod corev1.Pod,
@@ -96,6 +100,9 @@ func startKongAdminAPIServiceReconciler(ctx context.Context, t *testing.T, clien
 				Name:      adminService.Name,
 				Namespace: adminService.Namespace,
 			},
+			PartitionServiceNNs: map[k8stypes.NamespacedName]string{
+				{Name: partitionAdminServiceName, Namespace: adminService.Namespace}: "internal",
+			},
 			EndpointsNotifier:   n,
 			Log:                 mgr.GetLogger(),
 			AdminAPIsDiscoverer: adminAPIsDiscoverer,
@@ -592,4 +599,71 @@ func TestKongAdminAPIController(t *testing.T) {
 		assert.Eventually(t, func() bool { return len(n.LastNotified()) == 0 }, 3*time.Second, time.Millisecond)
 		assert.Nil(t, n.LastNotified())
 	})
+
+	t.Run("Endpoints of partition Services are assigned their partition", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		adminService, adminPod, n := startKongAdminAPIServiceReconciler(ctx, t, client, cfg)
+
+		newEndpointSlice := func(serviceName, address string) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind:       "Service",
+							Name:       serviceName,
+							APIVersion: "v1",
+							UID:        k8stypes.UID(uuid.NewString()),
+						},
+					},
+					GenerateName: "endpointslice-",
+					Namespace:    adminService.Namespace,
+					Labels: map[string]string{
+						"kubernetes.io/service-name": serviceName,
+					},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{
+						Addresses: []string{address},
+						Conditions: discoveryv1.EndpointConditions{
+							Ready: new(true),
+						},
+						TargetRef: &corev1.ObjectReference{
+							Kind:      "Pod",
+							Name:      adminPod.Name,
+							Namespace: adminPod.Namespace,
+						},
+					},
+				},
+				Ports: builder.NewEndpointPort(8080).WithName("admin").IntoSlice(),
+			}
+		}
+		require.NoError(t, client.Create(ctx, newEndpointSlice(adminService.Name, "10.0.0.1"), &ctrlclient.CreateOptions{}))
+		require.NoError(t, client.Create(ctx, newEndpointSlice(partitionAdminServiceName, "10.0.0.2"), &ctrlclient.CreateOptions{}))
+		require.NoError(t, client.Create(ctx, newEndpointSlice("kong-admin-unrelated", "10.0.0.3"), &ctrlclient.CreateOptions{}))
+
+		podRef := k8stypes.NamespacedName{
+			Namespace: adminPod.Namespace,
+			Name:      adminPod.Name,
+		}
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.ElementsMatch(c,
+				[]adminapi.DiscoveredAdminAPI{
+					{
+						Address:       "https://10.0.0.1:8080",
+						TLSServerName: getTLSServerName(adminService),
+						PodRef:        podRef,
+					},
+					{
+						Address:       "https://10.0.0.2:8080",
+						TLSServerName: fmt.Sprintf("pod.%s.%s.svc", partitionAdminServiceName, adminService.Namespace),
+						PodRef:        podRef,
+						Partition:     "internal",
+					},
+				},
+				n.LastNotified(),
+			)
+		}, 3*time.Second, time.Millisecond)
+	})
 }
@@ -0,0 +1,116 @@
+package sdkmocks
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
+	"github.com/google/uuid"
+
+	sdkops "github.com/kong/kong-operator/v2/controller/konnect/ops/sdk"
+)
+
+// FakeCustomEntitiesSDK is an in-memory implementation of sdkops.CustomEntitiesSDK.
+type FakeCustomEntitiesSDK struct {
+	lock sync.Mutex
+	// Entities holds the entities keyed by control plane ID, entity type and entity ID.
+	Entities map[string]map[string]map[string]sdkops.CustomEntity
+}
+
+var _ sdkops.CustomEntitiesSDK = &FakeCustomEntitiesSDK{}
+
+// NewFakeCustomEntitiesSDK returns an empty FakeCustomEntitiesSDK.
+func NewFakeCustomEntitiesSDK() *FakeCustomEntitiesSDK {
+	return &FakeCustomEntitiesSDK{
+		Entities: map[string]map[string]map[string]sdkops.CustomEntity{},
+	}
+}
+
+// CreateCustomEntity stores the entity under a newly generated ID.
+func (f *FakeCustomEntitiesSDK) CreateCustomEntity(
+	_ context.Context, controlPlaneID string, entityType string, entity sdkops.CustomEntity,
+) (sdkops.CustomEntity, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	created := cloneCustomEntity(entity)
+	created["id"] = uuid.NewString()
+	f.entitiesOf(controlPlaneID, entityType)[created.GetID()] = created
+	return cloneCustomEntity(created), nil
+}
+
+// UpsertCustomEntity stores the entity under the provided ID.
+func (f *FakeCustomEntitiesSDK) UpsertCustomEntity(
+	_ context.Context, controlPlaneID string, entityType string, id string, entity sdkops.CustomEntity,
+) (sdkops.CustomEntity, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	upserted := cloneCustomEntity(entity)
+	upserted["id"] = id
+	f.entitiesOf(controlPlaneID, entityType)[id] = upserted
+	return cloneCustomEntity(upserted), nil
+}
+
+// DeleteCustomEntity removes the entity with the provided ID or returns a 404 SDK error.
+func (f *FakeCustomEntitiesSDK) DeleteCustomEntity(
+	_ context.Context, controlPlaneID string, entityType string, id string,
+) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	entities := f.entitiesOf(controlPlaneID, entityType)
+	if _, ok := entities[id]; !ok {
+		return sdkkonnecterrs.NewSDKError("not found", http.StatusNotFound, "", nil)
+	}
+	delete(entities, id)
+	return nil
+}
+
+// ListCustomEntities returns the entities which have all the provided comma separated tags.
+func (f *FakeCustomEntitiesSDK) ListCustomEntities(
+	_ context.Context, controlPlaneID string, entityType string, tags string,
+) ([]sdkops.CustomEntity, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var ret []sdkops.CustomEntity
+	for _, e := range f.entitiesOf(controlPlaneID, entityType) {
+		entityTags, _ := e["tags"].([]string)
+		if tags != "" && !hasAllTags(entityTags, strings.Split(tags, ",")) {
+			continue
+		}
+		ret = append(ret, cloneCustomEntity(e))
+	}
+	return ret, nil
+}
+
+func (f *FakeCustomEntitiesSDK) entitiesOf(controlPlaneID, entityType string) map[string]sdkops.CustomEntity {
+	if _, ok := f.Entities[controlPlaneID]; !ok {
+		f.Entities[controlPlaneID] = map[string]map[string]sdkops.CustomEntity{}
+	}
+	if _, ok := f.Entities[controlPlaneID][entityType]; !ok {
+		f.Entities[controlPlaneID][entityType] = map[string]sdkops.CustomEntity{}
+	}
+	return f.Entities[controlPlaneID][entityType]
+}
+
+func hasAllTags(entityTags, tags []string) bool {
+	for _, t := range tags {
+		if !slices.Contains(entityTags, t) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneCustomEntity(e sdkops.CustomEntity) sdkops.CustomEntity {
+	ret := make(sdkops.CustomEntity, len(e))
+	for k, v := range e {
+		ret[k] = v
+	}
+	return ret
+}
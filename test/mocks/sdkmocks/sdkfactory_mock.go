@@ -16,30 +16,38 @@ import (
 type MockSDKWrapper struct {
 	generatedMockSDKWrapper
 
-	ControlPlaneSDK             *mocks.MockControlPlanesSDK
-	CloudGatewaysSDK            *mocks.MockCloudGatewaysSDK
-	ControlPlaneGroupSDK        *mocks.MockControlPlaneGroupsSDK
-	ServicesSDK                 *mocks.MockServicesSDK
-	RoutesSDK                   *mocks.MockRoutesSDK
-	ConsumersSDK                *mocks.MockConsumersSDK
-	ConsumerGroupSDK            *mocks.MockConsumerGroupsSDK
-	PluginSDK                   *mocks.MockPluginsSDK
-	UpstreamsSDK                *mocks.MockUpstreamsSDK
-	TargetsSDK                  *mocks.MockTargetsSDK
-	MeSDK                       *mocks.MockMeSDK
-	KongCredentialsBasicAuthSDK *mocks.MockBasicAuthCredentialsSDK
-	KongCredentialsAPIKeySDK    *mocks.MockAPIKeysSDK
-	KongCredentialsACLSDK       *mocks.MockACLsSDK
-	KongCredentialsJWTSDK       *mocks.MockJWTsSDK
-	KongCredentialsHMACSDK      *mocks.MockHMACAuthCredentialsSDK
-	CACertificatesSDK           *mocks.MockCACertificatesSDK
-	CertificatesSDK             *mocks.MockCertificatesSDK
-	VaultSDK                    *mocks.MockVaultsSDK
-	KeysSDK                     *mocks.MockKeysSDK
-	KeySetsSDK                  *mocks.MockKeySetsSDK
-	SNIsSDK                     *mocks.MockSNIsSDK
-	DataPlaneCertificatesSDK    *mocks.MockDPCertificatesSDK
-	MCPServersSDK               *sdkkonnectgo.MCPServers
+	ControlPlaneSDK               *mocks.MockControlPlanesSDK
+	CloudGatewaysSDK              *mocks.MockCloudGatewaysSDK
+	ControlPlaneGroupSDK          *mocks.MockControlPlaneGroupsSDK
+	ServicesSDK                   *mocks.MockServicesSDK
+	RoutesSDK                     *mocks.MockRoutesSDK
+	ConsumersSDK                  *mocks.MockConsumersSDK
+	ConsumerGroupSDK              *mocks.MockConsumerGroupsSDK
+	PluginSDK                     *mocks.MockPluginsSDK
+	UpstreamsSDK                  *mocks.MockUpstreamsSDK
+	TargetsSDK                    *mocks.MockTargetsSDK
+	MeSDK                         *mocks.MockMeSDK
+	KongCredentialsBasicAuthSDK   *mocks.MockBasicAuthCredentialsSDK
+	KongCredentialsAPIKeySDK      *mocks.MockAPIKeysSDK
+	KongCredentialsACLSDK         *mocks.MockACLsSDK
+	KongCredentialsJWTSDK         *mocks.MockJWTsSDK
+	KongCredentialsHMACSDK        *mocks.MockHMACAuthCredentialsSDK
+	KongCredentialsOAuth2SDK      *mocks.MockOAuth2CredentialsSDK
+	KongCredentialsMTLSSDK        *mocks.MockMTLSAuthCredentialsSDK
+	CACertificatesSDK             *mocks.MockCACertificatesSDK
+	CertificatesSDK               *mocks.MockCertificatesSDK
+	VaultSDK                      *mocks.MockVaultsSDK
+	KeysSDK                       *mocks.MockKeysSDK
+	KeySetsSDK                    *mocks.MockKeySetsSDK
+	SNIsSDK                       *mocks.MockSNIsSDK
+	DataPlaneCertificatesSDK      *mocks.MockDPCertificatesSDK
+	MCPServersSDK                 *sdkkonnectgo.MCPServers
+	TeamRolesSDK                  *mocks.MockTeamRolesSDK
+	SystemAccountsRolesSDK        *mocks.MockSystemAccountsRolesSDK
+	SystemAccountsAccessTokensSDK *mocks.MockSystemAccountsAccessTokensSDK
+	APIImplementationSDK          *mocks.MockAPIImplementationSDK
+	APIPublicationSDK             *mocks.MockAPIPublicationSDK
+	CustomEntitiesSDK             *FakeCustomEntitiesSDK
 
 	server server.Server
 }
@@ -50,29 +58,37 @@ func NewMockSDKWrapperWithT(t *testing.T) *MockSDKWrapper {
 	return &MockSDKWrapper{
 		generatedMockSDKWrapper: newGeneratedMockSDKWrapper(t),
 
-		ControlPlaneSDK:             mocks.NewMockControlPlanesSDK(t),
-		ControlPlaneGroupSDK:        mocks.NewMockControlPlaneGroupsSDK(t),
-		CloudGatewaysSDK:            mocks.NewMockCloudGatewaysSDK(t),
-		ServicesSDK:                 mocks.NewMockServicesSDK(t),
-		RoutesSDK:                   mocks.NewMockRoutesSDK(t),
-		ConsumersSDK:                mocks.NewMockConsumersSDK(t),
-		ConsumerGroupSDK:            mocks.NewMockConsumerGroupsSDK(t),
-		PluginSDK:                   mocks.NewMockPluginsSDK(t),
-		UpstreamsSDK:                mocks.NewMockUpstreamsSDK(t),
-		TargetsSDK:                  mocks.NewMockTargetsSDK(t),
-		MeSDK:                       mocks.NewMockMeSDK(t),
-		KongCredentialsBasicAuthSDK: mocks.NewMockBasicAuthCredentialsSDK(t),
-		KongCredentialsAPIKeySDK:    mocks.NewMockAPIKeysSDK(t),
-		KongCredentialsACLSDK:       mocks.NewMockACLsSDK(t),
-		KongCredentialsJWTSDK:       mocks.NewMockJWTsSDK(t),
-		KongCredentialsHMACSDK:      mocks.NewMockHMACAuthCredentialsSDK(t),
-		CACertificatesSDK:           mocks.NewMockCACertificatesSDK(t),
-		CertificatesSDK:             mocks.NewMockCertificatesSDK(t),
-		VaultSDK:                    mocks.NewMockVaultsSDK(t),
-		KeysSDK:                     mocks.NewMockKeysSDK(t),
-		KeySetsSDK:                  mocks.NewMockKeySetsSDK(t),
-		SNIsSDK:                     mocks.NewMockSNIsSDK(t),
-		DataPlaneCertificatesSDK:    mocks.NewMockDPCertificatesSDK(t),
+		ControlPlaneSDK:               mocks.NewMockControlPlanesSDK(t),
+		ControlPlaneGroupSDK:          mocks.NewMockControlPlaneGroupsSDK(t),
+		CloudGatewaysSDK:              mocks.NewMockCloudGatewaysSDK(t),
+		ServicesSDK:                   mocks.NewMockServicesSDK(t),
+		RoutesSDK:                     mocks.NewMockRoutesSDK(t),
+		ConsumersSDK:                  mocks.NewMockConsumersSDK(t),
+		ConsumerGroupSDK:              mocks.NewMockConsumerGroupsSDK(t),
+		PluginSDK:                     mocks.NewMockPluginsSDK(t),
+		UpstreamsSDK:                  mocks.NewMockUpstreamsSDK(t),
+		TargetsSDK:                    mocks.NewMockTargetsSDK(t),
+		MeSDK:                         mocks.NewMockMeSDK(t),
+		KongCredentialsBasicAuthSDK:   mocks.NewMockBasicAuthCredentialsSDK(t),
+		KongCredentialsAPIKeySDK:      mocks.NewMockAPIKeysSDK(t),
+		KongCredentialsACLSDK:         mocks.NewMockACLsSDK(t),
+		KongCredentialsJWTSDK:         mocks.NewMockJWTsSDK(t),
+		KongCredentialsHMACSDK:        mocks.NewMockHMACAuthCredentialsSDK(t),
+		KongCredentialsOAuth2SDK:      mocks.NewMockOAuth2CredentialsSDK(t),
+		KongCredentialsMTLSSDK:        mocks.NewMockMTLSAuthCredentialsSDK(t),
+		CACertificatesSDK:             mocks.NewMockCACertificatesSDK(t),
+		CertificatesSDK:               mocks.NewMockCertificatesSDK(t),
+		VaultSDK:                      mocks.NewMockVaultsSDK(t),
+		KeysSDK:                       mocks.NewMockKeysSDK(t),
+		KeySetsSDK:                    mocks.NewMockKeySetsSDK(t),
+		SNIsSDK:                       mocks.NewMockSNIsSDK(t),
+		DataPlaneCertificatesSDK:      mocks.NewMockDPCertificatesSDK(t),
+		TeamRolesSDK:                  mocks.NewMockTeamRolesSDK(t),
+		SystemAccountsRolesSDK:        mocks.NewMockSystemAccountsRolesSDK(t),
+		SystemAccountsAccessTokensSDK: mocks.NewMockSystemAccountsAccessTokensSDK(t),
+		APIImplementationSDK:          mocks.NewMockAPIImplementationSDK(t),
+		APIPublicationSDK:             mocks.NewMockAPIPublicationSDK(t),
+		CustomEntitiesSDK:             NewFakeCustomEntitiesSDK(),
 
 		server: lo.Must(server.NewServer[*gwtypes.ControlPlane](SDKServerURL)),
 	}
@@ -143,6 +159,14 @@ func (m MockSDKWrapper) GetHMACCredentialsSDK() sdkkonnectgo.HMACAuthCredentials
 	return m.KongCredentialsHMACSDK
 }
 
+func (m MockSDKWrapper) GetOAuth2CredentialsSDK() sdkkonnectgo.OAuth2CredentialsSDK {
+	return m.KongCredentialsOAuth2SDK
+}
+
+func (m MockSDKWrapper) GetMTLSAuthCredentialsSDK() sdkkonnectgo.MTLSAuthCredentialsSDK {
+	return m.KongCredentialsMTLSSDK
+}
+
 func (m MockSDKWrapper) GetTargetsSDK() sdkkonnectgo.TargetsSDK {
 	return m.TargetsSDK
 }
@@ -187,6 +211,30 @@ func (m MockSDKWrapper) GetMCPServersSDK() *sdkkonnectgo.MCPServers {
 	return m.MCPServersSDK
 }
 
+func (m MockSDKWrapper) GetTeamRolesSDK() sdkkonnectgo.TeamRolesSDK {
+	return m.TeamRolesSDK
+}
+
+func (m MockSDKWrapper) GetSystemAccountsRolesSDK() sdkkonnectgo.SystemAccountsRolesSDK {
+	return m.SystemAccountsRolesSDK
+}
+
+func (m MockSDKWrapper) GetSystemAccountsAccessTokensSDK() sdkkonnectgo.SystemAccountsAccessTokensSDK {
+	return m.SystemAccountsAccessTokensSDK
+}
+
+func (m MockSDKWrapper) GetAPIImplementationSDK() sdkkonnectgo.APIImplementationSDK {
+	return m.APIImplementationSDK
+}
+
+func (m MockSDKWrapper) GetAPIPublicationSDK() sdkkonnectgo.APIPublicationSDK {
+	return m.APIPublicationSDK
+}
+
+func (m MockSDKWrapper) GetCustomEntitiesSDK() sdkops.CustomEntitiesSDK {
+	return m.CustomEntitiesSDK
+}
+
 type MockSDKFactory struct {
 	t   *testing.T
 	SDK *MockSDKWrapper
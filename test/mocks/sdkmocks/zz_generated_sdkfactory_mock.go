@@ -19,6 +19,7 @@ type generatedMockSDKWrapper struct {
 	AIGatewayModelsSDK                           *mocks.MockAIGatewayModelsSDK
 	AIGatewayModelProvidersSDK                   *mocks.MockAIGatewayModelProvidersSDK
 	AIGatewayPoliciesSDK                         *mocks.MockAIGatewayPoliciesSDK
+	APISpecificationSDK                          *mocks.MockAPISpecificationSDK
 	EventGatewayBackendClustersSDK               *mocks.MockEventGatewayBackendClustersSDK
 	EventGatewayDataPlaneCertificatesSDK         *mocks.MockEventGatewayDataPlaneCertificatesSDK
 	EventGatewayListenersSDK                     *mocks.MockEventGatewayListenersSDK
@@ -29,8 +30,11 @@ type generatedMockSDKWrapper struct {
 	EventGatewayVirtualClusterPoliciesSDK        *mocks.MockEventGatewayVirtualClusterPoliciesSDK
 	EventGatewayVirtualClusterProducePoliciesSDK *mocks.MockEventGatewayVirtualClusterProducePoliciesSDK
 	AIGatewaysSDK                                *mocks.MockAIGatewaysSDK
+	APISDK                                       *mocks.MockAPISDK
 	ConfigStoresSDK                              *mocks.MockConfigStoresSDK
 	EventGatewaysSDK                             *mocks.MockEventGatewaysSDK
+	SystemAccountsSDK                            *mocks.MockSystemAccountsSDK
+	TeamsSDK                                     *mocks.MockTeamsSDK
 	PortalsSDK                                   *mocks.MockPortalsSDK
 	PortalCustomDomainsSDK                       *mocks.MockPortalCustomDomainsSDK
 	PortalCustomizationSDK                       *mocks.MockPortalCustomizationSDK
@@ -52,6 +56,7 @@ func newGeneratedMockSDKWrapper(t *testing.T) generatedMockSDKWrapper {
 		AIGatewayModelsSDK:                           mocks.NewMockAIGatewayModelsSDK(t),
 		AIGatewayModelProvidersSDK:                   mocks.NewMockAIGatewayModelProvidersSDK(t),
 		AIGatewayPoliciesSDK:                         mocks.NewMockAIGatewayPoliciesSDK(t),
+		APISpecificationSDK:                          mocks.NewMockAPISpecificationSDK(t),
 		EventGatewayBackendClustersSDK:               mocks.NewMockEventGatewayBackendClustersSDK(t),
 		EventGatewayDataPlaneCertificatesSDK:         mocks.NewMockEventGatewayDataPlaneCertificatesSDK(t),
 		EventGatewayListenersSDK:                     mocks.NewMockEventGatewayListenersSDK(t),
@@ -62,8 +67,11 @@ func newGeneratedMockSDKWrapper(t *testing.T) generatedMockSDKWrapper {
 		EventGatewayVirtualClusterPoliciesSDK:        mocks.NewMockEventGatewayVirtualClusterPoliciesSDK(t),
 		EventGatewayVirtualClusterProducePoliciesSDK: mocks.NewMockEventGatewayVirtualClusterProducePoliciesSDK(t),
 		AIGatewaysSDK:                                mocks.NewMockAIGatewaysSDK(t),
+		APISDK:                                       mocks.NewMockAPISDK(t),
 		ConfigStoresSDK:                              mocks.NewMockConfigStoresSDK(t),
 		EventGatewaysSDK:                             mocks.NewMockEventGatewaysSDK(t),
+		SystemAccountsSDK:                            mocks.NewMockSystemAccountsSDK(t),
+		TeamsSDK:                                     mocks.NewMockTeamsSDK(t),
 		PortalsSDK:                                   mocks.NewMockPortalsSDK(t),
 		PortalCustomDomainsSDK:                       mocks.NewMockPortalCustomDomainsSDK(t),
 		PortalCustomizationSDK:                       mocks.NewMockPortalCustomizationSDK(t),
@@ -120,6 +128,11 @@ func (m generatedMockSDKWrapper) GetAIGatewayPoliciesSDK() sdkkonnectgo.AIGatewa
 	return m.AIGatewayPoliciesSDK
 }
 
+// GetAPISpecificationSDK returns the SDK to operate APISpecification.
+func (m generatedMockSDKWrapper) GetAPISpecificationSDK() sdkkonnectgo.APISpecificationSDK {
+	return m.APISpecificationSDK
+}
+
 // GetEventGatewayBackendClustersSDK returns the SDK to operate EventGatewayBackendCluster.
 func (m generatedMockSDKWrapper) GetEventGatewayBackendClustersSDK() sdkkonnectgo.EventGatewayBackendClustersSDK {
 	return m.EventGatewayBackendClustersSDK
@@ -170,6 +183,11 @@ func (m generatedMockSDKWrapper) GetAIGatewaysSDK() sdkkonnectgo.AIGatewaysSDK {
 	return m.AIGatewaysSDK
 }
 
+// GetAPISDK returns the SDK to operate KonnectAPI.
+func (m generatedMockSDKWrapper) GetAPISDK() sdkkonnectgo.APISDK {
+	return m.APISDK
+}
+
 // GetConfigStoresSDK returns the SDK to operate KonnectConfigStore.
 func (m generatedMockSDKWrapper) GetConfigStoresSDK() sdkkonnectgo.ConfigStoresSDK {
 	return m.ConfigStoresSDK
@@ -180,6 +198,16 @@ func (m generatedMockSDKWrapper) GetEventGatewaysSDK() sdkkonnectgo.EventGateway
 	return m.EventGatewaysSDK
 }
 
+// GetSystemAccountsSDK returns the SDK to operate KonnectSystemAccount.
+func (m generatedMockSDKWrapper) GetSystemAccountsSDK() sdkkonnectgo.SystemAccountsSDK {
+	return m.SystemAccountsSDK
+}
+
+// GetTeamsSDK returns the SDK to operate KonnectTeam.
+func (m generatedMockSDKWrapper) GetTeamsSDK() sdkkonnectgo.TeamsSDK {
+	return m.TeamsSDK
+}
+
 // GetPortalsSDK returns the SDK to operate Portal.
 func (m generatedMockSDKWrapper) GetPortalsSDK() sdkkonnectgo.PortalsSDK {
 	return m.PortalsSDK